@@ -54,6 +54,11 @@ The command-line parameters to vtbackup specify a policy for when a new backup
 is needed, and when old backups should be removed. If the existing backups
 already satisfy the policy, then vtbackup will do nothing and return success
 immediately.
+
+With -verify_backup, vtbackup instead checks that the most recent backup is
+usable: it compares the backup files with the hashes in the MANIFEST, restores
+the backup into a scratch mysqld, runs a set of sanity queries, and records
+the result in the backup storage.
 */
 package main
 
@@ -62,6 +67,7 @@ import (
 	"crypto/rand"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"math"
 	"math/big"
 	"os"
@@ -110,10 +116,20 @@ var (
 	minBackupInterval = flag.Duration("min_backup_interval", 0, "Only take a new backup if it's been at least this long since the most recent backup.")
 	minRetentionTime  = flag.Duration("min_retention_time", 0, "Keep each old backup for at least this long before removing it. Set to 0 to disable pruning of old backups.")
 	minRetentionCount = flag.Int("min_retention_count", 1, "Always keep at least this many of the most recent backups in this backup storage location, even if some are older than the min_retention_time. This must be at least 1 since a backup must always exist to allow new backups to be made")
+	retentionDaily    = flag.Int("retention_keep_daily", 0, "Keep the most recent backup of each of the last N days with a backup, even if it is older than the min_retention_time. Setting this enables pruning of old backups.")
+	retentionWeekly   = flag.Int("retention_keep_weekly", 0, "Keep the most recent backup of each of the last N weeks with a backup, even if it is older than the min_retention_time. Setting this enables pruning of old backups.")
+	retentionMonthly  = flag.Int("retention_keep_monthly", 0, "Keep the most recent backup of each of the last N months with a backup, even if it is older than the min_retention_time. Setting this enables pruning of old backups.")
+	pruneDryRun       = flag.Bool("prune_dry_run", false, "Only log the old backups that would be pruned, without removing them.")
 
 	initialBackup    = flag.Bool("initial_backup", false, "Instead of restoring from backup, initialize an empty database with the provided init_db_sql_file and upload a backup of that for the shard, if the shard has no backups yet. This can be used to seed a brand new shard with an initial, empty backup. If any backups already exist for the shard, this will be considered a successful no-op. This can only be done before the shard exists in topology (i.e. before any tablets are deployed).")
 	allowFirstBackup = flag.Bool("allow_first_backup", false, "Allow this job to take the first backup of an existing shard.")
 
+	incrementalFromPos = flag.String("incremental_from_pos", "", "Instead of a full backup, take an incremental backup of the binary logs replicated since this position. Use 'auto' to start at the position of the most recent backup. Requires binary logging of replicated transactions (log_slave_updates).")
+
+	verifyBackup            = flag.Bool("verify_backup", false, "Instead of taking a backup, verify the most recent backup (or -verify_backup_name): check its files against the MANIFEST, restore it into a scratch mysqld, run the queries in -verify_sanity_queries_file, and record the result in the backup storage.")
+	verifyBackupName        = flag.String("verify_backup_name", "", "Name of the backup to verify with -verify_backup. Defaults to the most recent backup.")
+	verifySanityQueriesFile = flag.String("verify_sanity_queries_file", "", "Path to a file of sanity queries, one per line, to run on the restored backup with -verify_backup. The verification fails if any of them fails.")
+
 	// vttablet-like flags
 	initDbNameOverride = flag.String("init_db_name_override", "", "(init parameter) override the name of the db used by vttablet")
 	initKeyspace       = flag.String("init_keyspace", "", "(init parameter) keyspace to use for this tablet")
@@ -168,6 +184,14 @@ func main() {
 	topoServer := topo.Open()
 	defer topoServer.Close()
 
+	if *verifyBackup {
+		if err := verifyLatestBackup(ctx); err != nil {
+			log.Errorf("Failed to verify backup: %v", err)
+			exit.Return(1)
+		}
+		return
+	}
+
 	// Try to take a backup, if it's been long enough since the last one.
 	// Skip pruning if backup wasn't fully successful. We don't want to be
 	// deleting things if the backup process is not healthy.
@@ -191,7 +215,11 @@ func main() {
 	}
 }
 
-func takeBackup(ctx context.Context, topoServer *topo.Server, backupStorage backupstorage.BackupStorage) error {
+// startScratchMysqld starts up a mysqld as if we are mysqlctld provisioning
+// a fresh tablet, for an imaginary tablet alias. The returned function shuts
+// mysqld down and removes its data dir, and must be called even if there
+// is an error.
+func startScratchMysqld(ctx context.Context) (*topodatapb.TabletAlias, *mysqlctl.Mysqld, *mysqlctl.Mycnf, func(), error) {
 	// This is an imaginary tablet alias. The value doesn't matter for anything,
 	// except that we generate a random UID to ensure the target backup
 	// directory is unique if multiple vtbackup instances are launched for the
@@ -199,7 +227,7 @@ func takeBackup(ctx context.Context, topoServer *topo.Server, backupStorage back
 	// storage location.
 	bigN, err := rand.Int(rand.Reader, big.NewInt(math.MaxUint32))
 	if err != nil {
-		return fmt.Errorf("can't generate random tablet UID: %v", err)
+		return nil, nil, nil, func() {}, fmt.Errorf("can't generate random tablet UID: %v", err)
 	}
 	tabletAlias := &topodatapb.TabletAlias{
 		Cell: "vtbackup",
@@ -210,31 +238,41 @@ func takeBackup(ctx context.Context, topoServer *topo.Server, backupStorage back
 	// every invocation of vtbackup starts with a clean slate, and it does not
 	// accumulate garbage (and run out of disk space) if it's restarted.
 	tabletDir := mysqlctl.TabletDir(tabletAlias.Uid)
-	defer func() {
+	removeTabletDir := func() {
 		log.Infof("Removing temporary tablet directory: %v", tabletDir)
 		if err := os.RemoveAll(tabletDir); err != nil {
 			log.Warningf("Failed to remove temporary tablet directory: %v", err)
 		}
-	}()
+	}
 
 	// Start up mysqld as if we are mysqlctld provisioning a fresh tablet.
 	mysqld, mycnf, err := mysqlctl.CreateMysqldAndMycnf(tabletAlias.Uid, *mysqlSocket, int32(*mysqlPort))
 	if err != nil {
-		return fmt.Errorf("failed to initialize mysql config: %v", err)
-	}
-	initCtx, initCancel := context.WithTimeout(ctx, *mysqlTimeout)
-	defer initCancel()
-	if err := mysqld.Init(initCtx, mycnf, *initDBSQLFile); err != nil {
-		return fmt.Errorf("failed to initialize mysql data dir and start mysqld: %v", err)
+		return nil, nil, nil, removeTabletDir, fmt.Errorf("failed to initialize mysql config: %v", err)
 	}
 	// Shut down mysqld when we're done.
-	defer func() {
+	cleanup := func() {
 		// Be careful not to use the original context, because we don't want to
 		// skip shutdown just because we timed out waiting for other things.
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 		mysqld.Shutdown(ctx, mycnf, false)
-	}()
+		removeTabletDir()
+	}
+	initCtx, initCancel := context.WithTimeout(ctx, *mysqlTimeout)
+	defer initCancel()
+	if err := mysqld.Init(initCtx, mycnf, *initDBSQLFile); err != nil {
+		return nil, nil, nil, cleanup, fmt.Errorf("failed to initialize mysql data dir and start mysqld: %v", err)
+	}
+	return tabletAlias, mysqld, mycnf, cleanup, nil
+}
+
+func takeBackup(ctx context.Context, topoServer *topo.Server, backupStorage backupstorage.BackupStorage) error {
+	tabletAlias, mysqld, mycnf, cleanup, err := startScratchMysqld(ctx)
+	defer cleanup()
+	if err != nil {
+		return err
+	}
 
 	extraEnv := map[string]string{
 		"TABLET_ALIAS": topoproto.TabletAliasString(tabletAlias),
@@ -393,6 +431,7 @@ func takeBackup(ctx context.Context, topoServer *topo.Server, backupStorage back
 	}
 
 	// Now we can take a new backup.
+	backupParams.IncrementalFromPos = *incrementalFromPos
 	if err := mysqlctl.Backup(ctx, backupParams); err != nil {
 		return fmt.Errorf("error taking backup: %v", err)
 	}
@@ -406,6 +445,52 @@ func takeBackup(ctx context.Context, topoServer *topo.Server, backupStorage back
 	return nil
 }
 
+// verifyLatestBackup verifies a backup of the shard, restoring it into a
+// scratch mysqld.
+func verifyLatestBackup(ctx context.Context) error {
+	var sanityQueries []string
+	if *verifySanityQueriesFile != "" {
+		data, err := ioutil.ReadFile(*verifySanityQueriesFile)
+		if err != nil {
+			return fmt.Errorf("can't read sanity queries: %v", err)
+		}
+		for _, line := range strings.Split(string(data), "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				sanityQueries = append(sanityQueries, line)
+			}
+		}
+	}
+
+	tabletAlias, mysqld, mycnf, cleanup, err := startScratchMysqld(ctx)
+	defer cleanup()
+	if err != nil {
+		return err
+	}
+	dbName := *initDbNameOverride
+	if dbName == "" {
+		dbName = fmt.Sprintf("vt_%s", *initKeyspace)
+	}
+
+	result, err := mysqlctl.VerifyBackup(ctx, mysqlctl.VerifyParams{
+		Logger:        logutil.NewConsoleLogger(),
+		Concurrency:   *concurrency,
+		Keyspace:      *initKeyspace,
+		Shard:         *initShard,
+		BackupName:    *verifyBackupName,
+		Cnf:           mycnf,
+		Mysqld:        mysqld,
+		HookExtraEnv:  map[string]string{"TABLET_ALIAS": topoproto.TabletAliasString(tabletAlias)},
+		DbName:        dbName,
+		SanityQueries: sanityQueries,
+		Record:        true,
+	})
+	if err != nil {
+		return err
+	}
+	log.Infof("Backup %v verified: %v files checked, %v sanity queries run.", result.BackupName, result.FilesChecked, result.SanityQueries)
+	return nil
+}
+
 func resetReplication(ctx context.Context, pos mysql.Position, mysqld mysqlctl.MysqlDaemon) error {
 	cmds := []string{
 		"STOP SLAVE",
@@ -503,60 +588,23 @@ func retryOnError(ctx context.Context, fn func() error) error {
 }
 
 func pruneBackups(ctx context.Context, backupStorage backupstorage.BackupStorage, backupDir string) error {
-	if *minRetentionTime == 0 {
+	if *minRetentionTime == 0 && *retentionDaily == 0 && *retentionWeekly == 0 && *retentionMonthly == 0 {
 		log.Info("Pruning of old backups is disabled.")
 		return nil
 	}
-	backups, err := backupStorage.ListBackups(ctx, backupDir)
-	if err != nil {
-		return fmt.Errorf("can't list backups: %v", err)
+	policy := mysqlctl.RetentionPolicy{
+		KeepLast:    *minRetentionCount,
+		KeepDaily:   *retentionDaily,
+		KeepWeekly:  *retentionWeekly,
+		KeepMonthly: *retentionMonthly,
+		MinAge:      *minRetentionTime,
 	}
-	numBackups := len(backups)
-	if numBackups <= *minRetentionCount {
-		log.Infof("Found %v backups. Not pruning any since this is within the min_retention_count of %v.", numBackups, *minRetentionCount)
-		return nil
-	}
-	// We have more than the minimum retention count, so we could afford to
-	// prune some. See if any are beyond the minimum retention time.
-	// ListBackups returns them sorted by oldest first.
-	for _, backup := range backups {
-		backupTime, err := parseBackupTime(backup.Name())
-		if err != nil {
-			return err
-		}
-		if time.Since(backupTime) < *minRetentionTime {
-			// The oldest remaining backup is not old enough to prune.
-			log.Infof("Oldest backup taken at %v has not reached min_retention_time of %v. Nothing left to prune.", backupTime, *minRetentionTime)
-			break
-		}
-		// Remove the backup.
-		log.Infof("Removing old backup %v from %v, since it's older than min_retention_time of %v", backup.Name(), backupDir, *minRetentionTime)
-		if err := backupStorage.RemoveBackup(ctx, backupDir, backup.Name()); err != nil {
-			return fmt.Errorf("couldn't remove backup %v from %v: %v", backup.Name(), backupDir, err)
-		}
-		// We successfully removed one backup. Can we afford to prune any more?
-		numBackups--
-		if numBackups == *minRetentionCount {
-			log.Infof("Successfully pruned backup count to min_retention_count of %v.", *minRetentionCount)
-			break
-		}
+	if _, err := mysqlctl.PruneBackups(ctx, backupStorage, *initKeyspace, *initShard, policy, *pruneDryRun, logutil.NewConsoleLogger()); err != nil {
+		return fmt.Errorf("couldn't prune backups in %v: %v", backupDir, err)
 	}
 	return nil
 }
 
-func parseBackupTime(name string) (time.Time, error) {
-	// Backup names are formatted as "date.time.tablet-alias".
-	parts := strings.Split(name, ".")
-	if len(parts) != 3 {
-		return time.Time{}, fmt.Errorf("backup name not in expected format (date.time.tablet-alias): %v", name)
-	}
-	backupTime, err := time.Parse(mysqlctl.BackupTimestampFormat, fmt.Sprintf("%s.%s", parts[0], parts[1]))
-	if err != nil {
-		return time.Time{}, fmt.Errorf("can't parse timestamp from backup %q: %v", name, err)
-	}
-	return backupTime, nil
-}
-
 func shouldBackup(ctx context.Context, topoServer *topo.Server, backupStorage backupstorage.BackupStorage, backupDir string) (bool, error) {
 	// Look for the most recent, complete backup.
 	backups, err := backupStorage.ListBackups(ctx, backupDir)
@@ -624,7 +672,7 @@ func shouldBackup(ctx context.Context, topoServer *topo.Server, backupStorage ba
 		// No minimum interval is set, so always backup.
 		return true, nil
 	}
-	lastBackupTime, err := parseBackupTime(lastBackup.Name())
+	lastBackupTime, err := mysqlctl.ParseBackupTime(lastBackup.Name())
 	if err != nil {
 		return false, fmt.Errorf("can't check last backup time: %v", err)
 	}
@@ -648,22 +696,28 @@ func lastCompleteBackup(ctx context.Context, backups []backupstorage.BackupHandl
 		// Check if this backup is complete by looking for the MANIFEST file,
 		// which is written at the end after all files are uploaded.
 		backup := backups[i]
-		if err := checkBackupComplete(ctx, backup); err != nil {
+		manifest, err := checkBackupComplete(ctx, backup)
+		if err != nil {
 			log.Warningf("Ignoring backup %v because it's incomplete: %v", backup.Name(), err)
 			continue
 		}
+		// Incremental backups only contain binary logs, so they don't
+		// replace a full backup.
+		if manifest.Incremental {
+			continue
+		}
 		return backup
 	}
 
 	return nil
 }
 
-func checkBackupComplete(ctx context.Context, backup backupstorage.BackupHandle) error {
+func checkBackupComplete(ctx context.Context, backup backupstorage.BackupHandle) (*mysqlctl.BackupManifest, error) {
 	manifest, err := mysqlctl.GetBackupManifest(ctx, backup)
 	if err != nil {
-		return fmt.Errorf("can't get backup MANIFEST: %v", err)
+		return nil, fmt.Errorf("can't get backup MANIFEST: %v", err)
 	}
 
 	log.Infof("Found complete backup %v taken at position %v", backup.Name(), manifest.Position.String())
-	return nil
+	return manifest, nil
 }
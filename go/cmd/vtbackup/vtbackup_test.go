@@ -0,0 +1,71 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+func TestShouldBackupIgnoresIncrementalBackups(t *testing.T) {
+	root, err := ioutil.TempDir("", "vtbackup_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	*filebackupstorage.FileBackupStorageRoot = root
+
+	defer func(interval time.Duration) { *minBackupInterval = interval }(*minBackupInterval)
+	*minBackupInterval = time.Hour
+
+	ctx := context.Background()
+	fbs := &filebackupstorage.FileBackupStorage{}
+	addBackup := func(age time.Duration, incremental bool) {
+		name := time.Now().UTC().Add(-age).Format(mysqlctl.BackupTimestampFormat) + ".cell1-0000000100"
+		bh, err := fbs.StartBackup(ctx, "ks/0", name)
+		require.NoError(t, err)
+		data, err := json.Marshal(&mysqlctl.BackupManifest{BackupMethod: "builtin", Incremental: incremental})
+		require.NoError(t, err)
+		wc, err := bh.AddFile(ctx, "MANIFEST", int64(len(data)))
+		require.NoError(t, err)
+		_, err = wc.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, wc.Close())
+		require.NoError(t, bh.EndBackup(ctx))
+	}
+
+	// The last full backup is older than the interval, so a recent
+	// incremental backup doesn't prevent a new full one.
+	addBackup(2*time.Hour, false)
+	addBackup(time.Minute, true)
+	ok, err := shouldBackup(ctx, nil, fbs, "ks/0")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	addBackup(30*time.Second, false)
+	ok, err = shouldBackup(ctx, nil, fbs, "ks/0")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// vtcdc streams the row changes of a keyspace from vtgate to a
+// Kafka-compatible broker.
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"vitess.io/vitess/go/exit"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtcdc"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+
+	// Include the gRPC vtgate client.
+	_ "vitess.io/vitess/go/vt/vtgate/grpcvtgateconn"
+)
+
+var (
+	server             = flag.String("server", "", "vtgate server to connect to")
+	keyspace           = flag.String("keyspace", "", "keyspace to stream")
+	tabletType         = flag.String("tablet_type", "master", "type of the tablets to stream from")
+	tables             = flag.String("tables", "", "comma-separated list of the tables to stream, all the tables are streamed if empty")
+	producer           = flag.String("producer", "kafka", "name of the producer implementation of the broker")
+	brokers            = flag.String("brokers", "", "comma-separated list of the broker addresses")
+	topicTemplate      = flag.String("topic_template", vtcdc.DefaultTopicTemplate, "topic of the tables, {keyspace} and {table} are replaced with the names of the table")
+	topicMap           = flag.String("topic_map", "", "comma-separated list of table=topic pairs that override -topic_template")
+	envelope           = flag.String("envelope", vtcdc.EnvelopeJSON, "encoding of the messages: json or json_schema")
+	name               = flag.String("name", "", "name of the stream in the checkpoint table, defaults to the keyspace")
+	checkpointKeyspace = flag.String("checkpoint_keyspace", "", "unsharded keyspace of the checkpoint table. If it is the streamed keyspace, -tables must not include the checkpoint table")
+	checkpointTable    = flag.String("checkpoint_table", "vtcdc_checkpoint", "name of the checkpoint table")
+	checkpointInterval = flag.Duration("checkpoint_interval", time.Second, "minimum interval between the checkpoints of the transactions that produce no message")
+	heartbeatInterval  = flag.Duration("heartbeat_interval", 10*time.Second, "interval of the heartbeats requested from vtgate")
+	retryDelay         = flag.Duration("retry_delay", 5*time.Second, "delay before the stream is restarted after an error")
+)
+
+func main() {
+	defer exit.Recover()
+	logger := logutil.NewConsoleLogger()
+	flag.CommandLine.SetOutput(logutil.NewLoggerWriter(logger))
+	flag.Parse()
+
+	if *server == "" || *keyspace == "" || *producer == "" || *checkpointKeyspace == "" {
+		log.Exitf("-server, -keyspace, -producer and -checkpoint_keyspace must be specified")
+	}
+	if *name == "" {
+		*name = *keyspace
+	}
+	tt, err := topoproto.ParseTabletType(*tabletType)
+	if err != nil {
+		log.Exitf("invalid -tablet_type: %v", err)
+	}
+	topics, err := vtcdc.ParseTopicMap(*topicMap)
+	if err != nil {
+		log.Exitf("invalid -topic_map: %v", err)
+	}
+	config := vtcdc.Config{
+		Keyspace:           *keyspace,
+		TabletType:         tt,
+		Topics:             vtcdc.NewTopicMapper(*topicTemplate, topics),
+		Envelope:           *envelope,
+		HeartbeatInterval:  *heartbeatInterval,
+		CheckpointInterval: *checkpointInterval,
+	}
+	if *tables != "" {
+		config.Filter = &binlogdatapb.Filter{}
+		for _, table := range strings.Split(*tables, ",") {
+			config.Filter.Rules = append(config.Filter.Rules, &binlogdatapb.Rule{
+				Match: strings.TrimSpace(table),
+			})
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGTERM, syscall.SIGINT)
+	go func() {
+		s := <-sigChan
+		log.Infof("Stopping after receiving signal: %v", s)
+		cancel()
+	}()
+
+	p, err := vtcdc.NewProducer(*producer, strings.Split(*brokers, ","))
+	if err != nil {
+		log.Exitf("cannot create producer: %v", err)
+	}
+	defer p.Close()
+	conn, err := vtgateconn.Dial(ctx, *server)
+	if err != nil {
+		log.Exitf("cannot connect to vtgate %s: %v", *server, err)
+	}
+	defer conn.Close()
+
+	checkpointer := vtcdc.NewCheckpointer(conn.Session(*checkpointKeyspace, nil), *checkpointKeyspace, *checkpointTable, *name)
+	streamer, err := vtcdc.NewStreamer(config, conn, p, checkpointer)
+	if err != nil {
+		log.Exitf("%v", err)
+	}
+	for {
+		err := streamer.Run(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Errorf("Stream of keyspace %s stopped, restarting in %v: %v", *keyspace, *retryDelay, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(*retryDelay):
+		}
+	}
+}
@@ -18,6 +18,7 @@ package main
 
 import (
 	"fmt"
+	"os/user"
 	"strings"
 	"time"
 
@@ -31,6 +32,16 @@ import (
 )
 
 var (
+	addQueryRuleCmd = &cobra.Command{
+		Use:  "AddQueryRule keyspace/shard tablet_type rule_json",
+		Args: cobra.ExactArgs(3),
+		RunE: commandAddQueryRule,
+	}
+	deleteQueryRuleCmd = &cobra.Command{
+		Use:  "DeleteQueryRule keyspace/shard tablet_type name",
+		Args: cobra.ExactArgs(3),
+		RunE: commandDeleteQueryRule,
+	}
 	findAllShardsInKeyspaceCmd = &cobra.Command{
 		Use:     "FindAllShardsInKeyspace keyspace",
 		Aliases: []string{"findallshardsinkeyspace"},
@@ -64,13 +75,94 @@ var (
 		Args:    cobra.NoArgs,
 		RunE:    commandGetKeyspaces,
 	}
+	getQueryRulesCmd = &cobra.Command{
+		Use:  "GetQueryRules keyspace/shard tablet_type",
+		Args: cobra.ExactArgs(2),
+		RunE: commandGetQueryRules,
+	}
+	getThrottlerConfigCmd = &cobra.Command{
+		Use:  "GetThrottlerConfig keyspace",
+		Args: cobra.ExactArgs(1),
+		RunE: commandGetThrottlerConfig,
+	}
 	initShardPrimaryCmd = &cobra.Command{
 		Use:  "InitShardPrimary",
 		Args: cobra.ExactArgs(2),
 		RunE: commandInitShardPrimary,
 	}
+	throttleAppCmd = &cobra.Command{
+		Use:  "ThrottleApp keyspace app",
+		Args: cobra.ExactArgs(2),
+		RunE: commandThrottleApp,
+	}
+	unthrottleAppCmd = &cobra.Command{
+		Use:  "UnthrottleApp keyspace app",
+		Args: cobra.ExactArgs(2),
+		RunE: commandUnthrottleApp,
+	}
+	updateThrottlerConfigCmd = &cobra.Command{
+		Use:  "UpdateThrottlerConfig keyspace",
+		Args: cobra.ExactArgs(1),
+		RunE: commandUpdateThrottlerConfig,
+	}
 )
 
+var addQueryRuleArgs = struct {
+	TTL     time.Duration
+	AddedBy string
+}{}
+
+func commandAddQueryRule(cmd *cobra.Command, args []string) error {
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	tabletType, err := topoproto.ParseTabletType(cmd.Flags().Arg(1))
+	if err != nil {
+		return err
+	}
+
+	req := &vtctldatapb.AddQueryRuleRequest{
+		Keyspace:   keyspace,
+		Shard:      shard,
+		TabletType: tabletType,
+		Rule:       cmd.Flags().Arg(2),
+		AddedBy:    addQueryRuleArgs.AddedBy,
+	}
+	if addQueryRuleArgs.TTL != 0 {
+		req.Ttl = ptypes.DurationProto(addQueryRuleArgs.TTL)
+	}
+	if req.AddedBy == "" {
+		if u, err := user.Current(); err == nil {
+			req.AddedBy = u.Username
+		}
+	}
+
+	_, err = client.AddQueryRule(commandCtx, req)
+	return err
+}
+
+func commandDeleteQueryRule(cmd *cobra.Command, args []string) error {
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	tabletType, err := topoproto.ParseTabletType(cmd.Flags().Arg(1))
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DeleteQueryRule(commandCtx, &vtctldatapb.DeleteQueryRuleRequest{
+		Keyspace:   keyspace,
+		Shard:      shard,
+		TabletType: tabletType,
+		Name:       cmd.Flags().Arg(2),
+	})
+	return err
+}
+
 func commandFindAllShardsInKeyspace(cmd *cobra.Command, args []string) error {
 	ks := cmd.Flags().Arg(0)
 	resp, err := client.FindAllShardsInKeyspace(commandCtx, &vtctldatapb.FindAllShardsInKeyspaceRequest{
@@ -166,6 +258,55 @@ func commandGetKeyspaces(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func commandGetQueryRules(cmd *cobra.Command, args []string) error {
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	tabletType, err := topoproto.ParseTabletType(cmd.Flags().Arg(1))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetQueryRules(commandCtx, &vtctldatapb.GetQueryRulesRequest{
+		Keyspace:   keyspace,
+		Shard:      shard,
+		TabletType: tabletType,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", resp.Rules)
+
+	return nil
+}
+
+func commandGetThrottlerConfig(cmd *cobra.Command, args []string) error {
+	resp, err := client.GetThrottlerConfig(commandCtx, &vtctldatapb.GetThrottlerConfigRequest{
+		Keyspace: cmd.Flags().Arg(0),
+	})
+	if err != nil {
+		return err
+	}
+
+	if resp.ThrottlerConfig == nil {
+		// the keyspace has no throttler config, its tablets use their flags
+		fmt.Println("null")
+		return nil
+	}
+
+	data, err := MarshalJSON(resp.ThrottlerConfig)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
 var initShardPrimaryArgs = struct {
 	WaitReplicasTimeout time.Duration
 	Force               bool
@@ -197,15 +338,83 @@ func commandInitShardPrimary(cmd *cobra.Command, args []string) error {
 	return err
 }
 
+var throttleAppArgs = struct {
+	Ratio    float64
+	Duration time.Duration
+}{}
+
+func commandThrottleApp(cmd *cobra.Command, args []string) error {
+	return updateThrottlerConfig(&vtctldatapb.UpdateThrottlerConfigRequest{
+		Keyspace:            cmd.Flags().Arg(0),
+		ThrottleApp:         cmd.Flags().Arg(1),
+		ThrottleAppRatio:    throttleAppArgs.Ratio,
+		ThrottleAppDuration: ptypes.DurationProto(throttleAppArgs.Duration),
+	})
+}
+
+func commandUnthrottleApp(cmd *cobra.Command, args []string) error {
+	return updateThrottlerConfig(&vtctldatapb.UpdateThrottlerConfigRequest{
+		Keyspace:      cmd.Flags().Arg(0),
+		UnthrottleApp: cmd.Flags().Arg(1),
+	})
+}
+
+var updateThrottlerConfigArgs = struct {
+	Enable    bool
+	Disable   bool
+	Threshold float64
+}{}
+
+func commandUpdateThrottlerConfig(cmd *cobra.Command, args []string) error {
+	return updateThrottlerConfig(&vtctldatapb.UpdateThrottlerConfigRequest{
+		Keyspace:  cmd.Flags().Arg(0),
+		Enable:    updateThrottlerConfigArgs.Enable,
+		Disable:   updateThrottlerConfigArgs.Disable,
+		Threshold: updateThrottlerConfigArgs.Threshold,
+	})
+}
+
+func updateThrottlerConfig(req *vtctldatapb.UpdateThrottlerConfigRequest) error {
+	resp, err := client.UpdateThrottlerConfig(commandCtx, req)
+	if err != nil {
+		return err
+	}
+
+	data, err := MarshalJSON(resp.ThrottlerConfig)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
 func init() {
+	addQueryRuleCmd.Flags().DurationVar(&addQueryRuleArgs.TTL, "ttl", 0, "how long the rule is enforced; it never expires if zero")
+	addQueryRuleCmd.Flags().StringVar(&addQueryRuleArgs.AddedBy, "added-by", "", "who adds the rule; defaults to the current user")
+	rootCmd.AddCommand(addQueryRuleCmd)
+	rootCmd.AddCommand(deleteQueryRuleCmd)
 	rootCmd.AddCommand(findAllShardsInKeyspaceCmd)
 	rootCmd.AddCommand(getCellInfoNamesCmd)
 	rootCmd.AddCommand(getCellInfoCmd)
 	rootCmd.AddCommand(getCellsAliasesCmd)
 	rootCmd.AddCommand(getKeyspaceCmd)
 	rootCmd.AddCommand(getKeyspacesCmd)
+	rootCmd.AddCommand(getQueryRulesCmd)
+	rootCmd.AddCommand(getThrottlerConfigCmd)
 
 	initShardPrimaryCmd.Flags().DurationVar(&initShardPrimaryArgs.WaitReplicasTimeout, "wait-replicas-timeout", 30*time.Second, "time to wait for replicas to catch up in reparenting")
 	initShardPrimaryCmd.Flags().BoolVar(&initShardPrimaryArgs.Force, "force", false, "will force the reparent even if the provided tablet is not a master or the shard master")
 	rootCmd.AddCommand(initShardPrimaryCmd)
+
+	throttleAppCmd.Flags().Float64Var(&throttleAppArgs.Ratio, "ratio", 1, "ratio of the checks of the app which are throttled, from 0 to 1")
+	throttleAppCmd.Flags().DurationVar(&throttleAppArgs.Duration, "duration", time.Hour, "how long the app is throttled")
+	rootCmd.AddCommand(throttleAppCmd)
+	rootCmd.AddCommand(unthrottleAppCmd)
+
+	updateThrottlerConfigCmd.Flags().BoolVar(&updateThrottlerConfigArgs.Enable, "enable", false, "enable the throttler")
+	updateThrottlerConfigCmd.Flags().BoolVar(&updateThrottlerConfigArgs.Disable, "disable", false, "disable the throttler")
+	updateThrottlerConfigCmd.Flags().Float64Var(&updateThrottlerConfigArgs.Threshold, "threshold", 0, "replication lag threshold, in seconds; unchanged if zero")
+	rootCmd.AddCommand(updateThrottlerConfigCmd)
 }
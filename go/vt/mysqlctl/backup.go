@@ -40,6 +40,8 @@ const (
 	backupInnodbDataHomeDir     = "InnoDBData"
 	backupInnodbLogGroupHomeDir = "InnoDBLog"
 	backupData                  = "Data"
+	// backupBinlogDir is the base for binary logs in incremental backups
+	backupBinlogDir = "BinLog"
 
 	// backupManifestFileName is the MANIFEST file name within a backup.
 	backupManifestFileName = "MANIFEST"
@@ -63,6 +65,10 @@ var (
 	// but none of them are complete.
 	ErrNoCompleteBackup = errors.New("backup(s) found but none are complete")
 
+	// ErrNoNewTransactions is returned when an incremental backup is
+	// requested, but no transaction was executed since its start position.
+	ErrNoNewTransactions = errors.New("no new transactions since the incremental backup position")
+
 	// backupStorageHook contains the hook name to use to process
 	// backup files. If not set, we will not process the files. It is
 	// only used at backup time. Then it is put in the manifest,
@@ -98,15 +104,30 @@ func Backup(ctx context.Context, params BackupParams) error {
 		return vterrors.Wrap(err, "unable to get backup storage")
 	}
 	defer bs.Close()
-	bh, err := bs.StartBackup(ctx, backupDir, name)
-	if err != nil {
-		return vterrors.Wrap(err, "StartBackup failed")
-	}
 
 	be, err := GetBackupEngine()
 	if err != nil {
 		return vterrors.Wrap(err, "failed to find backup engine")
 	}
+	if params.IncrementalFromPos != "" {
+		// Only the builtin engine knows how to back up binary logs.
+		be = BackupRestoreEngineMap[builtinBackupEngineName]
+		fromPos, parent, err := resolveIncrementalFromPos(ctx, params, bs)
+		if err != nil {
+			return err
+		}
+		if pos, err := params.Mysqld.MasterPosition(); err == nil && fromPos.AtLeast(pos) {
+			return ErrNoNewTransactions
+		}
+		params.IncrementalFromPos = mysql.EncodePosition(fromPos)
+		params.incrementalParentBackup = parent
+		params.Logger.Infof("taking incremental backup from position %v (parent backup: %q)", fromPos, parent)
+	}
+
+	bh, err := bs.StartBackup(ctx, backupDir, name)
+	if err != nil {
+		return vterrors.Wrap(err, "StartBackup failed")
+	}
 
 	// Take the backup, and either AbortBackup or EndBackup.
 	usable, err := be.ExecuteBackup(ctx, params, bh)
@@ -270,6 +291,20 @@ func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error)
 		return nil, vterrors.Wrap(err, "Failed to find restore engine")
 	}
 
+	// For a point in time restore, find the incremental backups to apply
+	// before touching the existing data.
+	var incrementalBackups []incrementalBackup
+	if params.isPointInTimeRestore() {
+		bm, err := GetBackupManifest(ctx, bh)
+		if err != nil {
+			return nil, err
+		}
+		incrementalBackups, err = findIncrementalBackupsToRestore(ctx, params, bhs, bm.Position)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	manifest, err := re.ExecuteRestore(ctx, params, bh)
 	if err != nil {
 		return nil, err
@@ -318,6 +353,20 @@ func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error)
 		return nil, err
 	}
 
+	if params.isPointInTimeRestore() {
+		params.Logger.Infof("Restore: applying %v incremental backups", len(incrementalBackups))
+		pos, err := applyIncrementalBackups(ctx, params, incrementalBackups, manifest.Position)
+		if err != nil {
+			return nil, err
+		}
+		params.Logger.Infof("Restore: reached position %v", pos)
+		manifest.Position = pos
+		params.LocalMetadata["RestorePosition"] = mysql.EncodePosition(pos)
+		if err := PopulateMetadataTables(params.Mysqld, params.LocalMetadata, params.DbName); err != nil {
+			return nil, err
+		}
+	}
+
 	if err = removeStateFile(params.Cnf); err != nil {
 		return nil, err
 	}
@@ -66,6 +66,14 @@ type BackupParams struct {
 	TabletAlias string
 	// BackupTime is the time at which the backup is being started
 	BackupTime time.Time
+	// IncrementalFromPos, if set, requests an incremental backup that only
+	// contains the binary logs applied since this position. The special value
+	// "auto" means the position of the most recent complete backup.
+	IncrementalFromPos string
+
+	// incrementalParentBackup is the name of the backup ending at
+	// IncrementalFromPos, filled in by Backup.
+	incrementalParentBackup string
 }
 
 // RestoreParams is the struct that holds all params passed to ExecuteRestore
@@ -92,6 +100,18 @@ type RestoreParams struct {
 	// StartTime: if non-zero, look for a backup that was taken at or before this time
 	// Otherwise, find the most recent backup
 	StartTime time.Time
+	// RestoreToPos: if non-zero, restore the most recent full backup taken at or
+	// before this position, then apply incremental backups up to this position.
+	RestoreToPos mysql.Position
+	// RestoreToTimestamp: if non-zero, restore the most recent full backup taken
+	// at or before this time, then apply incremental backups up to this time.
+	RestoreToTimestamp time.Time
+}
+
+// isPointInTimeRestore returns true if the restore should stop at a specific
+// position or time rather than at the end of the most recent backup.
+func (p *RestoreParams) isPointInTimeRestore() bool {
+	return !p.RestoreToPos.IsZero() || !p.RestoreToTimestamp.IsZero()
 }
 
 // RestoreEngine is the interface to restore a backup with a given engine.
@@ -156,14 +176,19 @@ func GetBackupManifest(ctx context.Context, backup backupstorage.BackupHandle) (
 
 // getBackupManifestInto fetches and decodes a MANIFEST file into the specified object.
 func getBackupManifestInto(ctx context.Context, backup backupstorage.BackupHandle, outManifest interface{}) error {
-	file, err := backup.ReadFile(ctx, backupManifestFileName)
+	return getBackupFileInto(ctx, backup, backupManifestFileName, outManifest)
+}
+
+// getBackupFileInto fetches and decodes a JSON file of a backup into the specified object.
+func getBackupFileInto(ctx context.Context, backup backupstorage.BackupHandle, filename string, out interface{}) error {
+	file, err := backup.ReadFile(ctx, filename)
 	if err != nil {
-		return vterrors.Wrap(err, "can't read MANIFEST")
+		return vterrors.Wrapf(err, "can't read %v", filename)
 	}
 	defer file.Close()
 
-	if err := json.NewDecoder(file).Decode(outManifest); err != nil {
-		return vterrors.Wrap(err, "can't decode MANIFEST")
+	if err := json.NewDecoder(file).Decode(out); err != nil {
+		return vterrors.Wrapf(err, "can't decode %v", filename)
 	}
 	return nil
 }
@@ -188,6 +213,19 @@ type BackupManifest struct {
 	// FinishedTime is the time (in RFC 3339 format, UTC) at which the backup finished, if known.
 	// Some backups may not set this field if they were created before the field was added.
 	FinishedTime string
+
+	// Incremental is true if this backup only contains the binary logs
+	// needed to go from FromPosition to Position, rather than a copy of
+	// the data directory. Only the builtin engine creates such backups.
+	Incremental bool
+
+	// FromPosition is the replication position an incremental backup starts
+	// from. It is empty for full backups.
+	FromPosition mysql.Position
+
+	// ParentBackup is the name of the backup an incremental backup builds
+	// upon, if it could be determined when the backup was taken.
+	ParentBackup string
 }
 
 // FindBackupToRestore returns a selected candidate backup to be restored.
@@ -197,7 +235,11 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 	var bh backupstorage.BackupHandle
 	var index int
 	// if a StartTime is provided in params, then find a backup that was taken at or before that time
-	checkBackupTime := !params.StartTime.IsZero()
+	startTime := params.StartTime
+	if !params.RestoreToTimestamp.IsZero() {
+		startTime = params.RestoreToTimestamp
+	}
+	checkBackupTime := !startTime.IsZero()
 	backupDir := GetBackupDir(params.Keyspace, params.Shard)
 
 	for index = len(bhs) - 1; index >= 0; index-- {
@@ -208,6 +250,15 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 			params.Logger.Warningf("Possibly incomplete backup %v in directory %v on BackupStorage: can't read MANIFEST: %v)", bh.Name(), backupDir, err)
 			continue
 		}
+		if bm.Incremental {
+			// Incremental backups are applied on top of a full backup,
+			// see findIncrementalBackupsToRestore.
+			continue
+		}
+		if !params.RestoreToPos.IsZero() && !params.RestoreToPos.AtLeast(bm.Position) {
+			params.Logger.Infof("Restore: skipping backup %v/%v taken after requested position %v", backupDir, bh.Name(), params.RestoreToPos)
+			continue
+		}
 
 		var backupTime time.Time
 		if checkBackupTime {
@@ -217,14 +268,14 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 				continue
 			}
 		}
-		if !checkBackupTime /* not snapshot */ || backupTime.Equal(params.StartTime) || backupTime.Before(params.StartTime) {
+		if !checkBackupTime /* not snapshot */ || backupTime.Equal(startTime) || backupTime.Before(startTime) {
 			params.Logger.Infof("Restore: found backup %v %v to restore", bh.Directory(), bh.Name())
 			break
 		}
 	}
 	if index < 0 {
 		if checkBackupTime {
-			params.Logger.Errorf("No valid backup found before time %v", params.StartTime.Format(BackupTimestampFormat))
+			params.Logger.Errorf("No valid backup found before time %v", startTime.Format(BackupTimestampFormat))
 		}
 		// There is at least one attempted backup, but none could be read.
 		// This implies there is data we ought to have, so it's not safe to start
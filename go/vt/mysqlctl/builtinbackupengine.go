@@ -90,6 +90,7 @@ type FileEntry struct {
 	// - backupInnodbDataHomeDir for files that go into Mycnf.InnodbDataHomeDir
 	// - backupInnodbLogGroupHomeDir for files that go into Mycnf.InnodbLogGroupHomeDir
 	// - backupData for files that go into Mycnf.DataDir
+	// - backupBinlogDir for binary logs, which live next to Mycnf.BinLogPath
 	Base string
 
 	// Name is the file name, relative to Base
@@ -98,9 +99,15 @@ type FileEntry struct {
 	// Hash is the hash of the final data (transformed and
 	// compressed if specified) stored in the BackupStorage.
 	Hash string
+
+	// ParentPath is an optional directory prepended to the root of Base.
+	// It is used to restore files somewhere else than their original
+	// location, and is never stored in the MANIFEST.
+	ParentPath string `json:"-"`
 }
 
-func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
+// fullPath returns the location of the file on disk.
+func (fe *FileEntry) fullPath(cnf *Mycnf) (string, error) {
 	// find the root to use
 	var root string
 	switch fe.Base {
@@ -110,14 +117,25 @@ func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
 		root = cnf.InnodbLogGroupHomeDir
 	case backupData:
 		root = cnf.DataDir
+	case backupBinlogDir:
+		root = path.Dir(cnf.BinLogPath)
 	default:
-		return nil, vterrors.Errorf(vtrpc.Code_UNKNOWN, "unknown base: %v", fe.Base)
+		return "", vterrors.Errorf(vtrpc.Code_UNKNOWN, "unknown base: %v", fe.Base)
+	}
+	if fe.ParentPath != "" {
+		root = path.Join(fe.ParentPath, root)
+	}
+	return path.Join(root, fe.Name), nil
+}
+
+func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
+	name, err := fe.fullPath(cnf)
+	if err != nil {
+		return nil, err
 	}
 
 	// and open the file
-	name := path.Join(root, fe.Name)
 	var fd *os.File
-	var err error
 	if readOnly {
 		if fd, err = os.Open(name); err != nil {
 			return nil, vterrors.Wrapf(err, "cannot open source file %v", name)
@@ -140,6 +158,10 @@ func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupP
 
 	params.Logger.Infof("Hook: %v, Compress: %v", *backupStorageHook, *backupStorageCompress)
 
+	if params.IncrementalFromPos != "" {
+		return be.executeIncrementalBackup(ctx, params, bh)
+	}
+
 	// Save initial state so we can restore.
 	replicaStartRequired := false
 	sourceIsMaster := false
@@ -276,7 +298,7 @@ func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupP
 }
 
 // backupFiles finds the list of files to backup, and creates the backup.
-func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, replicationPosition mysql.Position) (finalErr error) {
+func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, replicationPosition mysql.Position) error {
 
 	// Get the files to backup.
 	// We don't care about totalSize because we add each file separately.
@@ -286,7 +308,31 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 	}
 	params.Logger.Infof("found %v files to backup", len(fes))
 
-	// Backup with the provided concurrency.
+	if err := be.backupFileEntries(ctx, params, bh, fes); err != nil {
+		return err
+	}
+
+	// JSON-encode and write the MANIFEST
+	bm := &builtinBackupManifest{
+		// Common base fields
+		BackupManifest: BackupManifest{
+			BackupMethod: builtinBackupEngineName,
+			Position:     replicationPosition,
+			BackupTime:   params.BackupTime.UTC().Format(time.RFC3339),
+			FinishedTime: time.Now().UTC().Format(time.RFC3339),
+		},
+
+		// Builtin-specific fields
+		FileEntries:   fes,
+		TransformHook: *backupStorageHook,
+		SkipCompress:  !*backupStorageCompress,
+	}
+	return writeBackupManifest(ctx, bh, bm)
+}
+
+// backupFileEntries backs up the given files with the provided concurrency.
+// The Hash of each FileEntry is filled in along the way.
+func (be *BuiltinBackupEngine) backupFileEntries(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, fes []FileEntry) error {
 	sema := sync2.NewSemaphore(params.Concurrency, 0)
 	wg := sync.WaitGroup{}
 	for i := range fes {
@@ -321,7 +367,11 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 	if bh.HasErrors() {
 		return bh.Error()
 	}
+	return nil
+}
 
+// writeBackupManifest JSON-encodes the manifest and adds it to the backup.
+func writeBackupManifest(ctx context.Context, bh backupstorage.BackupHandle, bm *builtinBackupManifest) (finalErr error) {
 	// open the MANIFEST
 	wc, err := bh.AddFile(ctx, backupManifestFileName, backupstorage.FileSizeUnknown)
 	if err != nil {
@@ -333,21 +383,6 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 		}
 	}()
 
-	// JSON-encode and write the MANIFEST
-	bm := &builtinBackupManifest{
-		// Common base fields
-		BackupManifest: BackupManifest{
-			BackupMethod: builtinBackupEngineName,
-			Position:     replicationPosition,
-			BackupTime:   params.BackupTime.UTC().Format(time.RFC3339),
-			FinishedTime: time.Now().UTC().Format(time.RFC3339),
-		},
-
-		// Builtin-specific fields
-		FileEntries:   fes,
-		TransformHook: *backupStorageHook,
-		SkipCompress:  !*backupStorageCompress,
-	}
 	data, err := json.MarshalIndent(bm, "", "  ")
 	if err != nil {
 		return vterrors.Wrapf(err, "cannot JSON encode %v", backupManifestFileName)
@@ -6,6 +6,7 @@ package mysqlctl_test
 
 import (
 	"context"
+	"io/ioutil"
 	"os"
 	"path"
 	"testing"
@@ -14,7 +15,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/mysqlctl/fakemysqldaemon"
@@ -134,3 +137,86 @@ func TestExecuteBackup(t *testing.T) {
 	assert.Error(t, err)
 	assert.False(t, ok)
 }
+
+func TestExecuteIncrementalBackup(t *testing.T) {
+	backupRoot, err := ioutil.TempDir("", "incrementalbackup_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(backupRoot)
+	*filebackupstorage.FileBackupStorageRoot = path.Join(backupRoot, "backups")
+	binlogDir := path.Join(backupRoot, "binlogs")
+	require.NoError(t, os.MkdirAll(binlogDir, 0755))
+	for _, name := range []string{"binlog.000001", "binlog.000002", "binlog.000003"} {
+		require.NoError(t, ioutil.WriteFile(path.Join(binlogDir, name), []byte(name+" contents"), 0644))
+	}
+
+	ctx := context.Background()
+	sid := "00010203-0405-0607-0809-0a0b0c0d0e0f"
+	binlogEvents := func(previousGTIDs string) *sqltypes.Result {
+		return sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("Log_name|Pos|Event_type|Server_id|End_log_pos|Info", "varchar|int64|varchar|int64|int64|varchar"),
+			"binlog|4|Format_desc|1|123|Server ver: 5.7.31-log, Binlog ver: 4",
+			"binlog|123|Previous_gtids|1|154|"+previousGTIDs,
+		)
+	}
+	mysqld := fakemysqldaemon.NewFakeMysqlDaemon(fakesqldb.New(t))
+	mysqld.ExpectedExecuteSuperQueryList = []string{"FLUSH BINARY LOGS"}
+	mysqld.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"SHOW BINARY LOGS": sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("Log_name|File_size", "varchar|int64"),
+			"binlog.000001|100",
+			"binlog.000002|100",
+			"binlog.000003|100",
+		),
+		"SHOW BINLOG EVENTS IN 'binlog.000001' LIMIT 2": binlogEvents(""),
+		"SHOW BINLOG EVENTS IN 'binlog.000002' LIMIT 2": binlogEvents(sid + ":1-5"),
+		"SHOW BINLOG EVENTS IN 'binlog.000003' LIMIT 2": binlogEvents(sid + ":1-10"),
+	}
+
+	fbs := &filebackupstorage.FileBackupStorage{}
+	bh, err := fbs.StartBackup(ctx, "ks/0", "incremental")
+	require.NoError(t, err)
+
+	be := &mysqlctl.BuiltinBackupEngine{}
+	ok, err := be.ExecuteBackup(ctx, mysqlctl.BackupParams{
+		Logger:             logutil.NewConsoleLogger(),
+		Mysqld:             mysqld,
+		Cnf:                &mysqlctl.Mycnf{BinLogPath: path.Join(binlogDir, "binlog")},
+		Concurrency:        2,
+		HookExtraEnv:       map[string]string{},
+		Keyspace:           "ks",
+		Shard:              "0",
+		BackupTime:         time.Now(),
+		IncrementalFromPos: "MySQL56/" + sid + ":1-7",
+	}, bh)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	require.NoError(t, bh.EndBackup(ctx))
+	require.NoError(t, mysqld.CheckSuperQueryList())
+
+	bhs, err := fbs.ListBackups(ctx, "ks/0")
+	require.NoError(t, err)
+	require.Len(t, bhs, 1)
+	manifest, err := mysqlctl.GetBackupManifest(ctx, bhs[0])
+	require.NoError(t, err)
+	assert.True(t, manifest.Incremental)
+	assert.Equal(t, "MySQL56/"+sid+":1-5", mysql.EncodePosition(manifest.FromPosition))
+	assert.Equal(t, "MySQL56/"+sid+":1-10", mysql.EncodePosition(manifest.Position))
+
+	// Nothing is left to back up from the current position.
+	mysqld.ExpectedExecuteSuperQueryCurrent = 0
+	bh, err = fbs.StartBackup(ctx, "ks/0", "empty")
+	require.NoError(t, err)
+	ok, err = be.ExecuteBackup(ctx, mysqlctl.BackupParams{
+		Logger:             logutil.NewConsoleLogger(),
+		Mysqld:             mysqld,
+		Cnf:                &mysqlctl.Mycnf{BinLogPath: path.Join(binlogDir, "binlog")},
+		Concurrency:        2,
+		HookExtraEnv:       map[string]string{},
+		Keyspace:           "ks",
+		Shard:              "0",
+		BackupTime:         time.Now(),
+		IncrementalFromPos: "MySQL56/" + sid + ":1-10",
+	}, bh)
+	assert.Error(t, err)
+	assert.False(t, ok)
+}
@@ -0,0 +1,337 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file handles incremental backups: backups that contain the binary
+// logs applied since a previous backup, rather than a full copy of the
+// data directory. They are restored by restoring a full backup, then
+// applying the binary logs of a chain of incremental backups on top of it.
+
+const (
+	// IncrementalFromPosAuto can be used as BackupParams.IncrementalFromPos
+	// to take an incremental backup starting at the position of the most
+	// recent complete backup, or of an older one if it goes further.
+	IncrementalFromPosAuto = "auto"
+)
+
+// resolveIncrementalFromPos turns params.IncrementalFromPos into a
+// position, and finds the backup the incremental backup builds upon.
+// The parent backup name is empty if no backup ends at that position.
+// In auto mode, only the backups taken since the most recent incremental
+// backup are considered.
+func resolveIncrementalFromPos(ctx context.Context, params BackupParams, bs backupstorage.BackupStorage) (mysql.Position, string, error) {
+	backupDir := GetBackupDir(params.Keyspace, params.Shard)
+	bhs, err := bs.ListBackups(ctx, backupDir)
+	if err != nil {
+		return mysql.Position{}, "", vterrors.Wrap(err, "ListBackups failed")
+	}
+
+	var fromPos mysql.Position
+	auto := params.IncrementalFromPos == IncrementalFromPosAuto
+	if !auto {
+		fromPos, err = mysql.DecodePosition(params.IncrementalFromPos)
+		if err != nil {
+			return mysql.Position{}, "", vterrors.Wrapf(err, "cannot decode incremental backup position %q", params.IncrementalFromPos)
+		}
+	}
+	parent := ""
+	for i := len(bhs) - 1; i >= 0; i-- {
+		bm, err := GetBackupManifest(ctx, bhs[i])
+		if err != nil {
+			params.Logger.Warningf("Possibly incomplete backup %v in directory %v on BackupStorage: can't read MANIFEST: %v)", bhs[i].Name(), backupDir, err)
+			continue
+		}
+		if auto {
+			// Use the most recent backup, unless an older one goes further
+			// (e.g. a full backup of a lagging replica taken after the
+			// last incremental backup of the master).
+			if parent == "" || (bm.Position.AtLeast(fromPos) && !fromPos.AtLeast(bm.Position)) {
+				fromPos, parent = bm.Position, bhs[i].Name()
+			}
+			// Incremental backups are taken on the master, so the older
+			// backups are contained in the most recent one, and their
+			// MANIFEST is not read.
+			if bm.Incremental {
+				break
+			}
+			continue
+		}
+		if bm.Position.Equal(fromPos) {
+			return fromPos, bhs[i].Name(), nil
+		}
+	}
+	if auto && parent == "" {
+		return mysql.Position{}, "", vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "no complete backup found in %v to take an incremental backup from", backupDir)
+	}
+	return fromPos, parent, nil
+}
+
+// executeIncrementalBackup backs up the binary logs that contain the
+// transactions executed since params.IncrementalFromPos. mysqld keeps
+// running (and replicating) while the backup is taken.
+func (be *BuiltinBackupEngine) executeIncrementalBackup(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle) (bool, error) {
+	fromPos, err := mysql.DecodePosition(params.IncrementalFromPos)
+	if err != nil {
+		return false, vterrors.Wrapf(err, "cannot decode incremental backup position %q", params.IncrementalFromPos)
+	}
+	if !fromPos.MatchesFlavor(mysql.Mysql56FlavorID) {
+		return false, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "incremental backups require MySQL 5.6+ GTID positions, got %q", params.IncrementalFromPos)
+	}
+
+	// Rotate the binary logs, so all transactions executed so far are in
+	// binary logs that mysqld no longer writes to.
+	if err := params.Mysqld.ExecuteSuperQueryList(ctx, []string{"FLUSH BINARY LOGS"}); err != nil {
+		return false, vterrors.Wrap(err, "can't flush binary logs")
+	}
+	qr, err := params.Mysqld.FetchSuperQuery(ctx, "SHOW BINARY LOGS")
+	if err != nil {
+		return false, vterrors.Wrap(err, "can't list binary logs")
+	}
+	if len(qr.Rows) < 2 {
+		return false, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "expected at least two binary logs after FLUSH BINARY LOGS, got %v", len(qr.Rows))
+	}
+	binlogs := make([]string, 0, len(qr.Rows))
+	previousGTIDs := make([]mysql.Position, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		binlog := row[0].ToString()
+		pos, err := binlogPreviousGTIDs(ctx, params.Mysqld, binlog)
+		if err != nil {
+			return false, err
+		}
+		binlogs = append(binlogs, binlog)
+		previousGTIDs = append(previousGTIDs, pos)
+	}
+
+	// The last binary log was just opened by FLUSH BINARY LOGS, so the
+	// GTIDs executed before it are the position we back up to.
+	toPos := previousGTIDs[len(previousGTIDs)-1]
+	if !toPos.AtLeast(fromPos) {
+		return false, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "incremental backup position %v is not contained in the current position %v", fromPos, toPos)
+	}
+	if fromPos.AtLeast(toPos) {
+		return false, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "nothing to back up: position %v already contains the current position %v", fromPos, toPos)
+	}
+
+	// Binary log i contains the transactions between previousGTIDs[i]
+	// and previousGTIDs[i+1]. Skip the ones that are already backed up.
+	var fes []FileEntry
+	var firstPos mysql.Position
+	for i := 0; i < len(binlogs)-1; i++ {
+		if fromPos.AtLeast(previousGTIDs[i+1]) {
+			continue
+		}
+		if len(fes) == 0 {
+			if !fromPos.AtLeast(previousGTIDs[i]) {
+				return false, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "binary logs needed to back up from position %v were purged: the oldest available binary log %v starts at %v", fromPos, binlogs[i], previousGTIDs[i])
+			}
+			firstPos = previousGTIDs[i]
+		}
+		fes = append(fes, FileEntry{
+			Base: backupBinlogDir,
+			Name: binlogs[i],
+		})
+	}
+	params.Logger.Infof("found %v binary logs to back up from position %v to %v", len(fes), fromPos, toPos)
+
+	if err := be.backupFileEntries(ctx, params, bh, fes); err != nil {
+		return false, err
+	}
+
+	bm := &builtinBackupManifest{
+		BackupManifest: BackupManifest{
+			BackupMethod: builtinBackupEngineName,
+			Position:     toPos,
+			BackupTime:   params.BackupTime.UTC().Format(time.RFC3339),
+			FinishedTime: time.Now().UTC().Format(time.RFC3339),
+			Incremental:  true,
+			FromPosition: firstPos,
+			ParentBackup: params.incrementalParentBackup,
+		},
+		FileEntries:   fes,
+		TransformHook: *backupStorageHook,
+		SkipCompress:  !*backupStorageCompress,
+	}
+	if err := writeBackupManifest(ctx, bh, bm); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// binlogPreviousGTIDs returns the content of the Previous_gtids event of
+// the given binary log, i.e. the GTIDs executed before it was opened.
+func binlogPreviousGTIDs(ctx context.Context, mysqld MysqlDaemon, binlog string) (mysql.Position, error) {
+	qr, err := mysqld.FetchSuperQuery(ctx, fmt.Sprintf("SHOW BINLOG EVENTS IN %s LIMIT 2", encodeString(binlog)))
+	if err != nil {
+		return mysql.Position{}, vterrors.Wrapf(err, "can't read events of binary log %v", binlog)
+	}
+	for _, row := range qr.Named().Rows {
+		if row.AsString("Event_type", "") != "Previous_gtids" {
+			continue
+		}
+		pos, err := mysql.ParsePosition(mysql.Mysql56FlavorID, row.AsString("Info", ""))
+		if err != nil {
+			return mysql.Position{}, vterrors.Wrapf(err, "can't parse Previous_gtids event of binary log %v", binlog)
+		}
+		return pos, nil
+	}
+	return mysql.Position{}, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "binary log %v has no Previous_gtids event, is GTID mode enabled?", binlog)
+}
+
+// incrementalBackup is an incremental backup selected for a restore.
+type incrementalBackup struct {
+	bh       backupstorage.BackupHandle
+	manifest builtinBackupManifest
+}
+
+// findIncrementalBackupsToRestore returns the chain of incremental backups
+// to apply on top of a full backup taken at fromPos, to reach the position
+// or time requested in params. The chain is built greedily: each pass over
+// the backups, in the order they were taken, appends the ones that start
+// at or before the position reached so far and bring new transactions.
+func findIncrementalBackupsToRestore(ctx context.Context, params RestoreParams, bhs []backupstorage.BackupHandle, fromPos mysql.Position) ([]incrementalBackup, error) {
+	backupDir := GetBackupDir(params.Keyspace, params.Shard)
+	var candidates []incrementalBackup
+	for _, bh := range bhs {
+		var bm builtinBackupManifest
+		if err := getBackupManifestInto(ctx, bh, &bm); err != nil {
+			params.Logger.Warningf("Possibly incomplete backup %v in directory %v on BackupStorage: can't read MANIFEST: %v)", bh.Name(), backupDir, err)
+			continue
+		}
+		if bm.Incremental {
+			candidates = append(candidates, incrementalBackup{bh: bh, manifest: bm})
+		}
+	}
+
+	var chain []incrementalBackup
+	pos := fromPos
+	done := func() bool {
+		return !params.RestoreToPos.IsZero() && pos.AtLeast(params.RestoreToPos)
+	}
+	for progress := true; progress && !done(); {
+		progress = false
+		for _, ib := range candidates {
+			bm := ib.manifest
+			if pos.AtLeast(bm.Position) || !pos.AtLeast(bm.FromPosition) {
+				// Nothing new in it, or a gap between our position and the backup.
+				continue
+			}
+			params.Logger.Infof("Restore: found incremental backup %v/%v from %v to %v", backupDir, ib.bh.Name(), bm.FromPosition, bm.Position)
+			chain = append(chain, ib)
+			pos = mysql.Position{GTIDSet: pos.GTIDSet.Union(bm.Position.GTIDSet)}
+			progress = true
+
+			if !params.RestoreToTimestamp.IsZero() {
+				backupTime, err := time.Parse(time.RFC3339, bm.BackupTime)
+				if err != nil {
+					return nil, vterrors.Wrapf(err, "invalid time %v in incremental backup %v/%v", bm.BackupTime, backupDir, ib.bh.Name())
+				}
+				// The binary logs were rotated after BackupTime, so this
+				// backup contains everything up to the requested time.
+				if !backupTime.Before(params.RestoreToTimestamp) {
+					return chain, nil
+				}
+			}
+			if done() {
+				return chain, nil
+			}
+		}
+	}
+	if done() {
+		return chain, nil
+	}
+	if !params.RestoreToPos.IsZero() {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "no chain of incremental backups in %v reaches position %v, the furthest is %v", backupDir, params.RestoreToPos, pos)
+	}
+	return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "no chain of incremental backups in %v reaches time %v, the furthest is %v", backupDir, params.RestoreToTimestamp.Format(time.RFC3339), pos)
+}
+
+// applyIncrementalBackups downloads the binary logs of each incremental
+// backup to a temporary directory and applies them to the running mysqld,
+// stopping at the position or time requested in params. It returns the
+// position reached.
+func applyIncrementalBackups(ctx context.Context, params RestoreParams, chain []incrementalBackup, pos mysql.Position) (mysql.Position, error) {
+	be := &BuiltinBackupEngine{}
+	for _, ib := range chain {
+		if err := applyIncrementalBackup(ctx, be, params, ib); err != nil {
+			return pos, err
+		}
+	}
+
+	// Ask mysqld where we ended up, as the last binary logs may have been
+	// applied partially.
+	newPos, err := params.Mysqld.MasterPosition()
+	if err != nil {
+		return pos, vterrors.Wrap(err, "can't get position after applying incremental backups")
+	}
+	return newPos, nil
+}
+
+// applyIncrementalBackup copies the binary logs of ib to a temporary
+// directory, applies them in order, and removes the directory.
+func applyIncrementalBackup(ctx context.Context, be *BuiltinBackupEngine, params RestoreParams, ib incrementalBackup) error {
+	tmpDir, err := ioutil.TempDir("", "restore-incremental-")
+	if err != nil {
+		return vterrors.Wrap(err, "can't create temporary directory for binary logs")
+	}
+	defer os.RemoveAll(tmpDir)
+
+	bm := ib.manifest
+	for i := range bm.FileEntries {
+		bm.FileEntries[i].ParentPath = tmpDir
+	}
+	params.Logger.Infof("Restore: copying %v binary logs of incremental backup %v", len(bm.FileEntries), ib.bh.Name())
+	if err := be.restoreFiles(ctx, params, ib.bh, bm); err != nil {
+		return vterrors.Wrapf(err, "failed to restore binary logs of incremental backup %v", ib.bh.Name())
+	}
+
+	// Binary log names end with an increasing sequence number.
+	fes := bm.FileEntries
+	sort.Slice(fes, func(i, j int) bool { return fes[i].Name < fes[j].Name })
+	for i := range fes {
+		name, err := fes[i].fullPath(params.Cnf)
+		if err != nil {
+			return err
+		}
+		params.Logger.Infof("Restore: applying binary log %v", fes[i].Name)
+		if err := params.Mysqld.ApplyBinlogFile(ctx, name, params.RestoreToPos, params.RestoreToTimestamp); err != nil {
+			return vterrors.Wrapf(err, "failed to apply binary log %v of incremental backup %v", fes[i].Name, ib.bh.Name())
+		}
+	}
+	return nil
+}
+
+func encodeString(in string) string {
+	buf := bytes.NewBuffer(nil)
+	sqltypes.NewVarChar(in).EncodeSQL(buf)
+	return buf.String()
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+func TestFindIncrementalBackupsToRestore(t *testing.T) {
+	root, err := ioutil.TempDir("", "incrementalbackup_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	*filebackupstorage.FileBackupStorageRoot = root
+
+	ctx := context.Background()
+	sid := "00010203-0405-0607-0809-0a0b0c0d0e0f"
+	pos := func(gtids string) mysql.Position {
+		return mysql.MustParsePosition(mysql.Mysql56FlavorID, sid+":"+gtids)
+	}
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	fbs := &filebackupstorage.FileBackupStorage{}
+	for i, bm := range []BackupManifest{
+		{Position: pos("1-10")},
+		{Position: pos("1-20"), Incremental: true, FromPosition: pos("1-8")},
+		// Overlaps the previous one, and adds nothing.
+		{Position: pos("1-15"), Incremental: true, FromPosition: pos("1-12")},
+		// Starts after a gap.
+		{Position: pos("1-40"), Incremental: true, FromPosition: pos("1-30")},
+		{Position: pos("1-30"), Incremental: true, FromPosition: pos("1-20")},
+	} {
+		bm.BackupMethod = builtinBackupEngineName
+		bm.BackupTime = start.Add(time.Duration(i) * time.Hour).Format(time.RFC3339)
+		bh, err := fbs.StartBackup(ctx, "ks/0", start.Add(time.Duration(i)*time.Hour).Format(BackupTimestampFormat))
+		require.NoError(t, err)
+		require.NoError(t, writeBackupManifest(ctx, bh, &builtinBackupManifest{BackupManifest: bm}))
+		require.NoError(t, bh.EndBackup(ctx))
+	}
+	bhs, err := fbs.ListBackups(ctx, "ks/0")
+	require.NoError(t, err)
+
+	names := func(chain []incrementalBackup) []string {
+		var result []string
+		for _, ib := range chain {
+			result = append(result, ib.bh.Name())
+		}
+		return result
+	}
+	params := RestoreParams{
+		Logger:       logutil.NewMemoryLogger(),
+		Keyspace:     "ks",
+		Shard:        "0",
+		RestoreToPos: pos("1-25"),
+	}
+	chain, err := findIncrementalBackupsToRestore(ctx, params, bhs, pos("1-10"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{bhs[1].Name(), bhs[4].Name()}, names(chain))
+
+	params.RestoreToPos = pos("1-40")
+	chain, err = findIncrementalBackupsToRestore(ctx, params, bhs, pos("1-10"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{bhs[1].Name(), bhs[4].Name(), bhs[3].Name()}, names(chain))
+
+	params.RestoreToPos = pos("1-50")
+	_, err = findIncrementalBackupsToRestore(ctx, params, bhs, pos("1-10"))
+	assert.Error(t, err)
+
+	params.RestoreToPos = mysql.Position{}
+	params.RestoreToTimestamp = start.Add(30 * time.Minute)
+	chain, err = findIncrementalBackupsToRestore(ctx, params, bhs, pos("1-10"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{bhs[1].Name()}, names(chain))
+}
+
+func TestResolveIncrementalFromPos(t *testing.T) {
+	root, err := ioutil.TempDir("", "incrementalbackup_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	*filebackupstorage.FileBackupStorageRoot = root
+
+	ctx := context.Background()
+	sid := "00010203-0405-0607-0809-0a0b0c0d0e0f"
+	pos := func(gtids string) mysql.Position {
+		return mysql.MustParsePosition(mysql.Mysql56FlavorID, sid+":"+gtids)
+	}
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	fbs := &filebackupstorage.FileBackupStorage{}
+	for i, bm := range []BackupManifest{
+		// Goes further than the others, but is older than the most
+		// recent incremental backup, so it's not considered.
+		{Position: pos("1-100")},
+		{Position: pos("1-10")},
+		{Position: pos("1-20"), Incremental: true, FromPosition: pos("1-10")},
+		{Position: pos("1-30"), Incremental: true, FromPosition: pos("1-20")},
+		// A full backup of a lagging replica.
+		{Position: pos("1-25")},
+	} {
+		bm.BackupMethod = builtinBackupEngineName
+		bm.BackupTime = start.Add(time.Duration(i) * time.Hour).Format(time.RFC3339)
+		bh, err := fbs.StartBackup(ctx, "ks/0", start.Add(time.Duration(i)*time.Hour).Format(BackupTimestampFormat))
+		require.NoError(t, err)
+		require.NoError(t, writeBackupManifest(ctx, bh, &builtinBackupManifest{BackupManifest: bm}))
+		require.NoError(t, bh.EndBackup(ctx))
+	}
+	bhs, err := fbs.ListBackups(ctx, "ks/0")
+	require.NoError(t, err)
+
+	params := BackupParams{
+		Logger:             logutil.NewMemoryLogger(),
+		Keyspace:           "ks",
+		Shard:              "0",
+		IncrementalFromPos: IncrementalFromPosAuto,
+	}
+	fromPos, parent, err := resolveIncrementalFromPos(ctx, params, fbs)
+	require.NoError(t, err)
+	assert.Equal(t, pos("1-30"), fromPos)
+	assert.Equal(t, bhs[3].Name(), parent)
+
+	params.IncrementalFromPos = mysql.EncodePosition(pos("1-20"))
+	fromPos, parent, err = resolveIncrementalFromPos(ctx, params, fbs)
+	require.NoError(t, err)
+	assert.Equal(t, pos("1-20"), fromPos)
+	assert.Equal(t, bhs[2].Name(), parent)
+
+	params.Shard = "1"
+	params.IncrementalFromPos = IncrementalFromPosAuto
+	_, _, err = resolveIncrementalFromPos(ctx, params, fbs)
+	assert.Error(t, err)
+}
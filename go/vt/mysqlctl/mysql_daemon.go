@@ -18,6 +18,7 @@ package mysqlctl
 
 import (
 	"context"
+	"time"
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
@@ -94,6 +95,10 @@ type MysqlDaemon interface {
 	// DisableBinlogPlayback disable playback of binlog events
 	DisableBinlogPlayback() error
 
+	// ApplyBinlogFile applies the events of a binary log file, up to an
+	// optional position and time.
+	ApplyBinlogFile(ctx context.Context, binlogFile string, restorePos mysql.Position, restoreTime time.Time) error
+
 	// Close will close this instance of Mysqld. It will wait for all dba
 	// queries to be finished.
 	Close()
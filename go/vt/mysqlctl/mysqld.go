@@ -1047,6 +1047,81 @@ func (mysqld *Mysqld) executeMysqlScript(connParams *mysql.ConnParams, sql io.Re
 	return nil
 }
 
+// ApplyBinlogFile extracts the events of a binary log file with the
+// mysqlbinlog tool, and applies them through the mysql command line tool
+// with the dba credentials. If restorePos is set, only the transactions
+// it contains are applied. If restoreTime is set, events that happened
+// at or after that time are not applied. Transactions that were already
+// executed are skipped by mysqld, as they keep their original GTIDs.
+func (mysqld *Mysqld) ApplyBinlogFile(ctx context.Context, binlogFile string, restorePos mysql.Position, restoreTime time.Time) error {
+	dir, err := vtenv.VtMysqlRoot()
+	if err != nil {
+		return err
+	}
+	mysqlbinlogName, err := binaryPath(dir, "mysqlbinlog")
+	if err != nil {
+		return err
+	}
+	mysqlName, err := binaryPath(dir, "mysql")
+	if err != nil {
+		return err
+	}
+	env, err := buildLdPaths()
+	if err != nil {
+		return err
+	}
+
+	args := []string{}
+	if !restorePos.IsZero() {
+		args = append(args, "--include-gtids="+restorePos.GTIDSet.String())
+	}
+	if !restoreTime.IsZero() {
+		// mysqlbinlog interprets the time in the local time zone.
+		args = append(args, "--stop-datetime="+restoreTime.Local().Format("2006-01-02 15:04:05"))
+	}
+	args = append(args, binlogFile)
+	mysqlbinlogCmd := exec.CommandContext(ctx, mysqlbinlogName, args...)
+	mysqlbinlogCmd.Env = env
+	mysqlbinlogCmd.Dir = dir
+	var mysqlbinlogErr bytes.Buffer
+	mysqlbinlogCmd.Stderr = &mysqlbinlogErr
+
+	params, err := mysqld.dbcfgs.DbaConnector().MysqlParams()
+	if err != nil {
+		return err
+	}
+	cnf, err := mysqld.defaultsExtraFile(params)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(cnf)
+	mysqlCmd := exec.CommandContext(ctx, mysqlName, "--defaults-extra-file="+cnf, "--batch")
+	mysqlCmd.Env = env
+	mysqlCmd.Dir = dir
+	var mysqlOut bytes.Buffer
+	mysqlCmd.Stdout = &mysqlOut
+	mysqlCmd.Stderr = &mysqlOut
+
+	// Pipe the output of mysqlbinlog into mysql.
+	mysqlCmd.Stdin, err = mysqlbinlogCmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	log.Infof("ApplyBinlogFile: %v %v | %v", mysqlbinlogName, args, mysqlName)
+	if err := mysqlCmd.Start(); err != nil {
+		return fmt.Errorf("%v: %v", mysqlName, err)
+	}
+	if err := mysqlbinlogCmd.Run(); err != nil {
+		mysqlCmd.Process.Kill()
+		mysqlCmd.Wait()
+		return fmt.Errorf("%v: %v, output: %v", mysqlbinlogName, err, mysqlbinlogErr.String())
+	}
+	if err := mysqlCmd.Wait(); err != nil {
+		return fmt.Errorf("%v: %v, output: %v", mysqlName, err, mysqlOut.String())
+	}
+	return nil
+}
+
 // defaultsExtraFile returns the filename for a temporary config file
 // that contains the user, password and socket file to connect to
 // mysqld.  We write a temporary config file so the password is never
@@ -0,0 +1,199 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file handles the pruning of old backups according to a retention
+// policy.
+
+// RetentionPolicy describes which backups of a shard to keep. A complete
+// full backup is kept if it matches any of the rules. Incremental backups
+// are kept if they are more recent than the oldest full backup kept, as
+// they can't be restored without it.
+type RetentionPolicy struct {
+	// KeepLast is the number of most recent backups to keep.
+	KeepLast int
+	// KeepDaily is the number of days for which the most recent backup
+	// of the day is kept, starting with the most recent day with a backup.
+	KeepDaily int
+	// KeepWeekly is the same as KeepDaily, for ISO weeks.
+	KeepWeekly int
+	// KeepMonthly is the same as KeepDaily, for months.
+	KeepMonthly int
+	// MinAge is the age under which backups are always kept.
+	MinAge time.Duration
+}
+
+// Validate returns an error if the policy could remove all backups.
+func (p RetentionPolicy) Validate() error {
+	if p.KeepLast < 0 || p.KeepDaily < 0 || p.KeepWeekly < 0 || p.KeepMonthly < 0 || p.MinAge < 0 {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "retention policy values can't be negative: %+v", p)
+	}
+	if p.KeepLast+p.KeepDaily+p.KeepWeekly+p.KeepMonthly == 0 {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "retention policy must keep at least one backup: %+v", p)
+	}
+	return nil
+}
+
+// ParseBackupTime returns the time a backup was started at, from its name.
+func ParseBackupTime(name string) (time.Time, error) {
+	// Backup names are formatted as "date.time.tablet-alias".
+	parts := strings.Split(name, ".")
+	if len(parts) != 3 {
+		return time.Time{}, fmt.Errorf("backup name not in expected format (date.time.tablet-alias): %v", name)
+	}
+	backupTime, err := time.Parse(BackupTimestampFormat, fmt.Sprintf("%s.%s", parts[0], parts[1]))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("can't parse timestamp from backup %q: %v", name, err)
+	}
+	return backupTime, nil
+}
+
+// BackupInfo describes a backup for SelectBackupsToPrune.
+type BackupInfo struct {
+	Name string
+	Time time.Time
+	// Complete is true if the backup has a MANIFEST.
+	Complete bool
+	// Incremental is true for incremental backups.
+	Incremental bool
+}
+
+// SelectBackupsToPrune returns the names of the backups that the policy
+// doesn't keep at time now. backups must be sorted oldest first.
+// Incomplete backups are only removed if a more recent complete backup
+// exists, as they may still be in progress otherwise.
+func SelectBackupsToPrune(backups []BackupInfo, policy RetentionPolicy, now time.Time) []string {
+	keep := make(map[string]bool)
+
+	// Apply the rules to full backups, most recent first.
+	buckets := []struct {
+		count int
+		key   func(t time.Time) string
+		seen  map[string]bool
+	}{
+		{policy.KeepDaily, func(t time.Time) string { return t.UTC().Format("2006-01-02") }, map[string]bool{}},
+		{policy.KeepWeekly, func(t time.Time) string {
+			year, week := t.UTC().ISOWeek()
+			return fmt.Sprintf("%d-%02d", year, week)
+		}, map[string]bool{}},
+		{policy.KeepMonthly, func(t time.Time) string { return t.UTC().Format("2006-01") }, map[string]bool{}},
+	}
+	kept := 0
+	oldestKept := -1
+	newestComplete := -1
+	for i := len(backups) - 1; i >= 0; i-- {
+		b := backups[i]
+		if !b.Complete {
+			continue
+		}
+		if newestComplete == -1 {
+			newestComplete = i
+		}
+		if b.Incremental {
+			continue
+		}
+		if kept < policy.KeepLast {
+			keep[b.Name] = true
+		}
+		kept++
+		for j := range buckets {
+			key := buckets[j].key(b.Time)
+			if buckets[j].seen[key] || len(buckets[j].seen) >= buckets[j].count {
+				continue
+			}
+			buckets[j].seen[key] = true
+			keep[b.Name] = true
+		}
+		if now.Sub(b.Time) < policy.MinAge {
+			keep[b.Name] = true
+		}
+		if keep[b.Name] {
+			oldestKept = i
+		}
+	}
+
+	var result []string
+	for i, b := range backups {
+		switch {
+		case keep[b.Name]:
+			continue
+		case now.Sub(b.Time) < policy.MinAge:
+			continue
+		case !b.Complete && i > newestComplete:
+			// Possibly in progress.
+			continue
+		case b.Incremental && oldestKept != -1 && i > oldestKept:
+			// Needed to restore the full backups kept to a point in time.
+			continue
+		}
+		result = append(result, b.Name)
+	}
+	return result
+}
+
+// PruneBackups removes the backups of a shard that the retention policy
+// doesn't keep. If dryRun is set, it only returns the backups it would
+// remove.
+func PruneBackups(ctx context.Context, bs backupstorage.BackupStorage, keyspace, shard string, policy RetentionPolicy, dryRun bool, logger logutil.Logger) ([]string, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	backupDir := GetBackupDir(keyspace, shard)
+	bhs, err := bs.ListBackups(ctx, backupDir)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "ListBackups failed")
+	}
+	backups := make([]BackupInfo, 0, len(bhs))
+	for _, bh := range bhs {
+		backupTime, err := ParseBackupTime(bh.Name())
+		if err != nil {
+			return nil, err
+		}
+		info := BackupInfo{Name: bh.Name(), Time: backupTime}
+		if bm, err := GetBackupManifest(ctx, bh); err == nil {
+			info.Complete = true
+			info.Incremental = bm.Incremental
+		}
+		backups = append(backups, info)
+	}
+
+	toPrune := SelectBackupsToPrune(backups, policy, time.Now())
+	for _, name := range toPrune {
+		if dryRun {
+			logger.Infof("Would remove backup %v from %v", name, backupDir)
+			continue
+		}
+		logger.Infof("Removing backup %v from %v", name, backupDir)
+		if err := bs.RemoveBackup(ctx, backupDir, name); err != nil {
+			return nil, vterrors.Wrapf(err, "couldn't remove backup %v from %v", name, backupDir)
+		}
+	}
+	logger.Infof("Kept %v of %v backups in %v", len(backups)-len(toPrune), len(backups), backupDir)
+	return toPrune, nil
+}
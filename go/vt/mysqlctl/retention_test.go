@@ -0,0 +1,120 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+func TestSelectBackupsToPrune(t *testing.T) {
+	now := time.Date(2021, 3, 15, 12, 0, 0, 0, time.UTC)
+	backup := func(name string, age time.Duration) BackupInfo {
+		return BackupInfo{Name: name, Time: now.Add(-age), Complete: true}
+	}
+	day := 24 * time.Hour
+	backups := []BackupInfo{
+		backup("feb-1", 42*day),
+		backup("mar-1", 14*day),
+		backup("mar-8", 7*day),
+		backup("mar-13", 2*day),
+		backup("mar-14-am", day+6*time.Hour),
+		backup("mar-14-pm", day),
+		{Name: "mar-14-incr", Time: now.Add(-20 * time.Hour), Complete: true, Incremental: true},
+		backup("mar-15", time.Hour),
+	}
+
+	testcases := []struct {
+		policy RetentionPolicy
+		want   []string
+	}{{
+		policy: RetentionPolicy{KeepLast: 2},
+		want:   []string{"feb-1", "mar-1", "mar-8", "mar-13", "mar-14-am"},
+	}, {
+		policy: RetentionPolicy{KeepLast: 1, KeepDaily: 3},
+		want:   []string{"feb-1", "mar-1", "mar-8", "mar-14-am"},
+	}, {
+		// 2021-03-15 is a Monday.
+		policy: RetentionPolicy{KeepLast: 1, KeepWeekly: 3},
+		want:   []string{"feb-1", "mar-8", "mar-13", "mar-14-am"},
+	}, {
+		policy: RetentionPolicy{KeepLast: 1, KeepMonthly: 2},
+		want:   []string{"mar-1", "mar-8", "mar-13", "mar-14-am", "mar-14-pm"},
+	}, {
+		policy: RetentionPolicy{KeepLast: 1, MinAge: 3 * day},
+		want:   []string{"feb-1", "mar-1", "mar-8"},
+	}, {
+		// The incremental backup is older than the only full backup kept.
+		policy: RetentionPolicy{KeepLast: 1},
+		want:   []string{"feb-1", "mar-1", "mar-8", "mar-13", "mar-14-am", "mar-14-pm", "mar-14-incr"},
+	}}
+	for _, tc := range testcases {
+		assert.Equal(t, tc.want, SelectBackupsToPrune(backups, tc.policy, now), "%+v", tc.policy)
+	}
+
+	// Incomplete backups are kept if they may be in progress.
+	incomplete := append(backups, BackupInfo{Name: "in-progress", Time: now})
+	incomplete[0].Complete = false
+	assert.Equal(t, []string{"feb-1", "mar-1", "mar-8", "mar-13", "mar-14-am", "mar-14-pm", "mar-14-incr"}, SelectBackupsToPrune(incomplete, RetentionPolicy{KeepLast: 1}, now))
+}
+
+func TestPruneBackups(t *testing.T) {
+	root, err := ioutil.TempDir("", "retention_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	*filebackupstorage.FileBackupStorageRoot = root
+
+	ctx := context.Background()
+	fbs := &filebackupstorage.FileBackupStorage{}
+	start := time.Now().Add(-time.Hour).UTC()
+	var names []string
+	for i := 0; i < 3; i++ {
+		name := start.Add(time.Duration(i)*time.Minute).Format(BackupTimestampFormat) + ".cell1-0000000001"
+		names = append(names, name)
+		bh, err := fbs.StartBackup(ctx, "ks/0", name)
+		require.NoError(t, err)
+		require.NoError(t, writeBackupManifest(ctx, bh, &builtinBackupManifest{BackupManifest: BackupManifest{BackupMethod: builtinBackupEngineName}}))
+		require.NoError(t, bh.EndBackup(ctx))
+	}
+
+	_, err = PruneBackups(ctx, fbs, "ks", "0", RetentionPolicy{}, false, logutil.NewMemoryLogger())
+	assert.Error(t, err, "a policy that keeps nothing is rejected")
+
+	pruned, err := PruneBackups(ctx, fbs, "ks", "0", RetentionPolicy{KeepLast: 1}, true, logutil.NewMemoryLogger())
+	require.NoError(t, err)
+	assert.Equal(t, names[:2], pruned)
+	bhs, err := fbs.ListBackups(ctx, "ks/0")
+	require.NoError(t, err)
+	assert.Len(t, bhs, 3, "dry run doesn't remove anything")
+
+	pruned, err = PruneBackups(ctx, fbs, "ks", "0", RetentionPolicy{KeepLast: 1}, false, logutil.NewMemoryLogger())
+	require.NoError(t, err)
+	assert.Equal(t, names[:2], pruned)
+	bhs, err = fbs.ListBackups(ctx, "ks/0")
+	require.NoError(t, err)
+	require.Len(t, bhs, 1)
+	assert.Equal(t, names[2], bhs[0].Name())
+}
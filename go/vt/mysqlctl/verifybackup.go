@@ -0,0 +1,339 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file handles the verification of backups: checking that the files
+// stored in the BackupStorage match the hashes recorded in the MANIFEST,
+// and optionally that the backup can be restored and queried.
+
+const (
+	// backupVerificationFileName is the name of the file that contains the
+	// result of a backup verification.
+	backupVerificationFileName = "VERIFICATION"
+
+	// backupVerificationTimestampFormat is used to name verification
+	// results. It has sub-second precision, so a backup can be verified
+	// several times in a row.
+	backupVerificationTimestampFormat = "2006-01-02.150405.000000"
+)
+
+// VerifyParams is the struct that holds all params passed to VerifyBackup.
+type VerifyParams struct {
+	Logger logutil.Logger
+	// Concurrency is the number of files to check at once.
+	Concurrency int
+	// Keyspace and Shard identify the backups to look at.
+	Keyspace string
+	Shard    string
+	// BackupName is the name of the backup to verify. If empty, the most
+	// recent complete backup is verified.
+	BackupName string
+
+	// The following fields are only needed to restore the backup.
+	// If Cnf is nil, the backup is not restored.
+	// Cnf and Mysqld must describe a scratch mysqld, as its data is
+	// deleted before the restore.
+	Cnf          *Mycnf
+	Mysqld       MysqlDaemon
+	HookExtraEnv map[string]string
+	DbName       string
+	// SanityQueries are executed on the restored mysqld. The verification
+	// fails if any of them returns an error.
+	SanityQueries []string
+
+	// Record, if set, stores the result of the verification in the
+	// BackupStorage, in the directory returned by GetBackupVerificationDir.
+	Record bool
+}
+
+// BackupVerification is the result of a backup verification.
+type BackupVerification struct {
+	// BackupName is the name of the verified backup.
+	BackupName string
+	// VerificationTime is when the verification started, in RFC 3339 format.
+	VerificationTime string
+	// FilesChecked is the number of files whose hash matched the MANIFEST.
+	FilesChecked int
+	// Restored is true if the backup was restored into a mysqld.
+	Restored bool
+	// SanityQueries is the number of sanity queries that succeeded.
+	SanityQueries int
+	// Error is empty if the verification succeeded.
+	Error string
+}
+
+// GetBackupVerificationDir returns the BackupStorage directory where the
+// results of the verifications of a shard's backups are recorded. It is
+// outside of the backup directory, so they don't show up as backups.
+func GetBackupVerificationDir(keyspace, shard string) string {
+	return GetBackupDir(keyspace, shard) + ".verifications"
+}
+
+// VerifyBackup checks the integrity of a backup, and optionally restores it.
+// It returns the result of the verification, which may be recorded in the
+// BackupStorage even if the verification failed, and the verification error.
+func VerifyBackup(ctx context.Context, params VerifyParams) (*BackupVerification, error) {
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return nil, vterrors.Wrap(err, "unable to get backup storage")
+	}
+	defer bs.Close()
+
+	backupDir := GetBackupDir(params.Keyspace, params.Shard)
+	bhs, err := bs.ListBackups(ctx, backupDir)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "ListBackups failed")
+	}
+	bh, err := findBackupToVerify(ctx, params, bhs)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	result := &BackupVerification{
+		BackupName:       bh.Name(),
+		VerificationTime: now.Format(time.RFC3339),
+	}
+	verifyErr := verifyBackup(ctx, params, bh, result)
+	if verifyErr != nil {
+		result.Error = verifyErr.Error()
+		params.Logger.Errorf("Verification of backup %v/%v failed: %v", backupDir, bh.Name(), verifyErr)
+	} else {
+		params.Logger.Infof("Verification of backup %v/%v succeeded", backupDir, bh.Name())
+	}
+
+	if params.Record {
+		if err := recordBackupVerification(ctx, bs, params, result, now); err != nil {
+			if verifyErr != nil {
+				params.Logger.Errorf("Failed to record verification of backup %v/%v: %v", backupDir, bh.Name(), err)
+				return result, verifyErr
+			}
+			return result, err
+		}
+	}
+	return result, verifyErr
+}
+
+// findBackupToVerify returns the backup called params.BackupName, or the
+// most recent backup with a MANIFEST.
+func findBackupToVerify(ctx context.Context, params VerifyParams, bhs []backupstorage.BackupHandle) (backupstorage.BackupHandle, error) {
+	backupDir := GetBackupDir(params.Keyspace, params.Shard)
+	for i := len(bhs) - 1; i >= 0; i-- {
+		bh := bhs[i]
+		if params.BackupName != "" {
+			if bh.Name() == params.BackupName {
+				return bh, nil
+			}
+			continue
+		}
+		if _, err := GetBackupManifest(ctx, bh); err != nil {
+			params.Logger.Warningf("Possibly incomplete backup %v in directory %v on BackupStorage: can't read MANIFEST: %v)", bh.Name(), backupDir, err)
+			continue
+		}
+		return bh, nil
+	}
+	if params.BackupName != "" {
+		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "backup %v not found in %v", params.BackupName, backupDir)
+	}
+	if len(bhs) == 0 {
+		return nil, ErrNoBackup
+	}
+	return nil, ErrNoCompleteBackup
+}
+
+// verifyBackup runs the checks, and fills in result along the way.
+func verifyBackup(ctx context.Context, params VerifyParams, bh backupstorage.BackupHandle, result *BackupVerification) error {
+	bm, err := GetBackupManifest(ctx, bh)
+	if err != nil {
+		return err
+	}
+
+	switch bm.BackupMethod {
+	case "", builtinBackupEngineName:
+		var bbm builtinBackupManifest
+		if err := getBackupManifestInto(ctx, bh, &bbm); err != nil {
+			return err
+		}
+		params.Logger.Infof("Verify: checking the hashes of %v files", len(bbm.FileEntries))
+		if err := verifyFileHashes(ctx, params, bh, bbm.FileEntries, &result.FilesChecked); err != nil {
+			return err
+		}
+	default:
+		params.Logger.Warningf("Verify: backups taken with the %v engine have no file hashes, skipping hash verification", bm.BackupMethod)
+	}
+
+	if params.Cnf == nil {
+		return nil
+	}
+	if bm.Incremental {
+		return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "backup %v is an incremental backup and can't be restored on its own", bh.Name())
+	}
+	re, err := GetRestoreEngine(ctx, bh)
+	if err != nil {
+		return vterrors.Wrap(err, "failed to find restore engine")
+	}
+	params.Logger.Infof("Verify: restoring backup %v", bh.Name())
+	if _, err := re.ExecuteRestore(ctx, RestoreParams{
+		Cnf:                 params.Cnf,
+		Mysqld:              params.Mysqld,
+		Logger:              params.Logger,
+		Concurrency:         params.Concurrency,
+		HookExtraEnv:        params.HookExtraEnv,
+		LocalMetadata:       map[string]string{},
+		DeleteBeforeRestore: true,
+		DbName:              params.DbName,
+		Keyspace:            params.Keyspace,
+		Shard:               params.Shard,
+	}, bh); err != nil {
+		return vterrors.Wrap(err, "restore failed")
+	}
+	if err := removeStateFile(params.Cnf); err != nil {
+		return err
+	}
+	if err := params.Mysqld.Start(ctx, params.Cnf, "--skip-networking"); err != nil {
+		return vterrors.Wrap(err, "failed to start mysqld on the restored backup")
+	}
+	result.Restored = true
+
+	for _, query := range params.SanityQueries {
+		params.Logger.Infof("Verify: running sanity query: %v", query)
+		if _, err := params.Mysqld.FetchSuperQuery(ctx, query); err != nil {
+			return vterrors.Wrapf(err, "sanity query %q failed", query)
+		}
+		result.SanityQueries++
+	}
+	return nil
+}
+
+// verifyFileHashes reads the files of a builtin backup from the
+// BackupStorage, and compares their hashes with the ones in the MANIFEST.
+func verifyFileHashes(ctx context.Context, params VerifyParams, bh backupstorage.BackupHandle, fes []FileEntry, checked *int) error {
+	n := params.Concurrency
+	if n < 1 {
+		n = 1
+	}
+	sema := sync2.NewSemaphore(n, 0)
+	rec := concurrency.AllErrorRecorder{}
+	wg := sync.WaitGroup{}
+	var mu sync.Mutex
+	for i := range fes {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			sema.Acquire()
+			defer sema.Release()
+			if rec.HasErrors() {
+				return
+			}
+
+			name := fmt.Sprintf("%v", i)
+			if err := verifyFileHash(ctx, bh, &fes[i], name); err != nil {
+				rec.RecordError(vterrors.Wrapf(err, "can't verify file %v (%v)", name, fes[i].Name))
+				return
+			}
+			mu.Lock()
+			*checked++
+			mu.Unlock()
+		}(i)
+	}
+	wg.Wait()
+	return rec.Error()
+}
+
+// verifyFileHash checks the hash of an individual file.
+func verifyFileHash(ctx context.Context, bh backupstorage.BackupHandle, fe *FileEntry, name string) error {
+	source, err := bh.ReadFile(ctx, name)
+	if err != nil {
+		return vterrors.Wrap(err, "can't open file for reading")
+	}
+	defer source.Close()
+
+	// The hash is computed on the data as stored, so there is no need
+	// to run the transform hook or to uncompress it.
+	hasher := newHasher()
+	if _, err := io.Copy(hasher, source); err != nil {
+		return vterrors.Wrap(err, "failed to read file contents")
+	}
+	if hash := hasher.HashString(); hash != fe.Hash {
+		return vterrors.Errorf(vtrpc.Code_DATA_LOSS, "hash mismatch for %v, got %v expected %v", fe.Name, hash, fe.Hash)
+	}
+	return nil
+}
+
+// recordBackupVerification stores the result of a verification in the
+// BackupStorage.
+func recordBackupVerification(ctx context.Context, bs backupstorage.BackupStorage, params VerifyParams, result *BackupVerification, verificationTime time.Time) error {
+	name := fmt.Sprintf("%v.%v", result.BackupName, verificationTime.Format(backupVerificationTimestampFormat))
+	bh, err := bs.StartBackup(ctx, GetBackupVerificationDir(params.Keyspace, params.Shard), name)
+	if err != nil {
+		return vterrors.Wrap(err, "can't start recording backup verification")
+	}
+	wc, err := bh.AddFile(ctx, backupVerificationFileName, backupstorage.FileSizeUnknown)
+	if err != nil {
+		bh.AbortBackup(ctx)
+		return vterrors.Wrapf(err, "cannot add %v to backup verification", backupVerificationFileName)
+	}
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err == nil {
+		_, err = wc.Write(data)
+	}
+	if cerr := wc.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		bh.AbortBackup(ctx)
+		return vterrors.Wrapf(err, "cannot write %v", backupVerificationFileName)
+	}
+	return bh.EndBackup(ctx)
+}
+
+// GetBackupVerifications returns the recorded results of the verifications
+// of a backup, oldest first.
+func GetBackupVerifications(ctx context.Context, bs backupstorage.BackupStorage, keyspace, shard, backupName string) ([]*BackupVerification, error) {
+	bhs, err := bs.ListBackups(ctx, GetBackupVerificationDir(keyspace, shard))
+	if err != nil {
+		return nil, vterrors.Wrap(err, "ListBackups failed")
+	}
+	var result []*BackupVerification
+	for _, bh := range bhs {
+		var v BackupVerification
+		if err := getBackupFileInto(ctx, bh, backupVerificationFileName, &v); err != nil {
+			continue
+		}
+		if v.BackupName == backupName {
+			result = append(result, &v)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+func TestVerifyBackup(t *testing.T) {
+	root, err := ioutil.TempDir("", "verifybackup_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	*filebackupstorage.FileBackupStorageRoot = root
+	defer func(saved string) { *backupstorage.BackupStorageImplementation = saved }(*backupstorage.BackupStorageImplementation)
+	*backupstorage.BackupStorageImplementation = "file"
+
+	// Write a backup with two files.
+	ctx := context.Background()
+	fbs := &filebackupstorage.FileBackupStorage{}
+	bh, err := fbs.StartBackup(ctx, "ks/0", "backup1")
+	require.NoError(t, err)
+	bm := &builtinBackupManifest{BackupManifest: BackupManifest{BackupMethod: builtinBackupEngineName}}
+	for i, contents := range []string{"file 0 contents", "file 1 contents"} {
+		wc, err := bh.AddFile(ctx, string(rune('0'+i)), int64(len(contents)))
+		require.NoError(t, err)
+		_, err = wc.Write([]byte(contents))
+		require.NoError(t, err)
+		require.NoError(t, wc.Close())
+		h := newHasher()
+		h.Write([]byte(contents))
+		bm.FileEntries = append(bm.FileEntries, FileEntry{Base: backupData, Name: contents, Hash: h.HashString()})
+	}
+	require.NoError(t, writeBackupManifest(ctx, bh, bm))
+	require.NoError(t, bh.EndBackup(ctx))
+
+	params := VerifyParams{
+		Logger:      logutil.NewMemoryLogger(),
+		Concurrency: 2,
+		Keyspace:    "ks",
+		Shard:       "0",
+		Record:      true,
+	}
+	result, err := VerifyBackup(ctx, params)
+	require.NoError(t, err)
+	assert.Equal(t, "backup1", result.BackupName)
+	assert.Equal(t, 2, result.FilesChecked)
+	assert.False(t, result.Restored)
+	assert.Empty(t, result.Error)
+
+	// Corrupt a file.
+	require.NoError(t, ioutil.WriteFile(path.Join(root, "ks/0/backup1/1"), []byte("corrupted"), 0644))
+	result, err = VerifyBackup(ctx, params)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "hash mismatch")
+	assert.Equal(t, err.Error(), result.Error)
+
+	// Both results were recorded, without adding backups.
+	verifications, err := GetBackupVerifications(ctx, fbs, "ks", "0", "backup1")
+	require.NoError(t, err)
+	require.Len(t, verifications, 2)
+	assert.Empty(t, verifications[0].Error)
+	assert.Contains(t, verifications[1].Error, "hash mismatch")
+	bhs, err := fbs.ListBackups(ctx, "ks/0")
+	require.NoError(t, err)
+	assert.Len(t, bhs, 1)
+
+	params.BackupName = "nonexistent"
+	_, err = VerifyBackup(ctx, params)
+	assert.Error(t, err)
+}
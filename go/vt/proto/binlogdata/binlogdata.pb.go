@@ -32,6 +32,9 @@ const (
 	OnDDLAction_STOP        OnDDLAction = 1
 	OnDDLAction_EXEC        OnDDLAction = 2
 	OnDDLAction_EXEC_IGNORE OnDDLAction = 3
+	// SAFE applies the additive DDLs to the target tables, and stops
+	// on the others.
+	OnDDLAction_SAFE OnDDLAction = 4
 )
 
 var OnDDLAction_name = map[int32]string{
@@ -39,6 +42,7 @@ var OnDDLAction_name = map[int32]string{
 	1: "STOP",
 	2: "EXEC",
 	3: "EXEC_IGNORE",
+	4: "SAFE",
 }
 
 var OnDDLAction_value = map[string]int32{
@@ -46,6 +50,7 @@ var OnDDLAction_value = map[string]int32{
 	"STOP":        1,
 	"EXEC":        2,
 	"EXEC_IGNORE": 3,
+	"SAFE":        4,
 }
 
 func (x OnDDLAction) String() string {
@@ -691,10 +696,14 @@ type Filter struct {
 	// If the value is ERR_ON_MISMATCH (default), then it errors out.
 	// If it's BEST_EFFORT, it sends a field event with fake column
 	// names as "@1", "@2", etc.
-	FieldEventMode       Filter_FieldEventMode `protobuf:"varint,2,opt,name=fieldEventMode,proto3,enum=binlogdata.Filter_FieldEventMode" json:"fieldEventMode,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	FieldEventMode Filter_FieldEventMode `protobuf:"varint,2,opt,name=fieldEventMode,proto3,enum=binlogdata.Filter_FieldEventMode" json:"fieldEventMode,omitempty"`
+	// SendFieldsOnStart makes vstreamer send a FIELD event for every
+	// table that matches the rules when it starts streaming, instead of
+	// only before the first row of each table.
+	SendFieldsOnStart    bool     `protobuf:"varint,3,opt,name=send_fields_on_start,json=sendFieldsOnStart,proto3" json:"send_fields_on_start,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Filter) Reset()         { *m = Filter{} }
@@ -736,6 +745,13 @@ func (m *Filter) GetFieldEventMode() Filter_FieldEventMode {
 	return Filter_ERR_ON_MISMATCH
 }
 
+func (m *Filter) GetSendFieldsOnStart() bool {
+	if m != nil {
+		return m.SendFieldsOnStart
+	}
+	return false
+}
+
 // BinlogSource specifies the source  and filter parameters for
 // Filtered Replication. KeyRange and Tables are legacy. Filter
 // is the new way to specify the filtering rules.
@@ -2016,125 +2032,127 @@ func init() {
 func init() { proto.RegisterFile("binlogdata.proto", fileDescriptor_5fd02bcb2e350dad) }
 
 var fileDescriptor_5fd02bcb2e350dad = []byte{
-	// 1914 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4b, 0x73, 0xe3, 0xc6,
-	0x11, 0x5e, 0xbe, 0xc9, 0x06, 0x45, 0x41, 0xa3, 0x47, 0x98, 0x2d, 0xdb, 0x25, 0xa3, 0xb2, 0x5e,
-	0x59, 0x55, 0xa1, 0x1c, 0x26, 0xde, 0x5c, 0x62, 0x3b, 0x7c, 0x60, 0xb5, 0x5c, 0xf1, 0xa1, 0x1d,
-	0x62, 0xb5, 0x2e, 0x5f, 0x50, 0x58, 0x70, 0x24, 0x21, 0x02, 0x08, 0x2c, 0x30, 0x94, 0xcc, 0x1f,
-	0x90, 0xaa, 0xdc, 0xf3, 0x2b, 0x72, 0xce, 0x31, 0xc9, 0x35, 0xf9, 0x13, 0xb9, 0xe6, 0x94, 0x5f,
-	0x90, 0x5b, 0x6a, 0x1e, 0x78, 0x69, 0xed, 0x95, 0xd6, 0x55, 0x39, 0xc4, 0x17, 0xd6, 0x4c, 0x4f,
-	0x77, 0x4f, 0xbf, 0xbe, 0x46, 0x73, 0x40, 0x7d, 0xed, 0x2c, 0x5d, 0xff, 0x62, 0x61, 0x51, 0xab,
-	0x13, 0x84, 0x3e, 0xf5, 0x11, 0xa4, 0x94, 0x87, 0xca, 0x35, 0x0d, 0x03, 0x5b, 0x1c, 0x3c, 0x54,
-	0xde, 0xac, 0x48, 0xb8, 0x96, 0x9b, 0x16, 0xf5, 0x03, 0x3f, 0x95, 0xd2, 0x26, 0x50, 0x1b, 0x5c,
-	0x5a, 0x61, 0x44, 0x28, 0xda, 0x83, 0xaa, 0xed, 0x3a, 0x64, 0x49, 0xdb, 0x85, 0xfd, 0xc2, 0x41,
-	0x05, 0xcb, 0x1d, 0x42, 0x50, 0xb6, 0xfd, 0xe5, 0xb2, 0x5d, 0xe4, 0x54, 0xbe, 0x66, 0xbc, 0x11,
-	0x09, 0xaf, 0x49, 0xd8, 0x2e, 0x09, 0x5e, 0xb1, 0xd3, 0xfe, 0x55, 0x82, 0xad, 0x3e, 0xb7, 0xc3,
-	0x08, 0xad, 0x65, 0x64, 0xd9, 0xd4, 0xf1, 0x97, 0xe8, 0x18, 0x20, 0xa2, 0x16, 0x25, 0x1e, 0x59,
-	0xd2, 0xa8, 0x5d, 0xd8, 0x2f, 0x1d, 0x28, 0xdd, 0xc7, 0x9d, 0x8c, 0x07, 0x6f, 0x89, 0x74, 0xe6,
-	0x31, 0x3f, 0xce, 0x88, 0xa2, 0x2e, 0x28, 0xe4, 0x9a, 0x2c, 0xa9, 0x49, 0xfd, 0x2b, 0xb2, 0x6c,
-	0x97, 0xf7, 0x0b, 0x07, 0x4a, 0x77, 0xab, 0x23, 0x1c, 0xd4, 0xd9, 0x89, 0xc1, 0x0e, 0x30, 0x90,
-	0x64, 0xfd, 0xf0, 0xef, 0x45, 0x68, 0x24, 0xda, 0xd0, 0x18, 0xea, 0xb6, 0x45, 0xc9, 0x85, 0x1f,
-	0xae, 0xb9, 0x9b, 0xad, 0xee, 0x67, 0xf7, 0x34, 0xa4, 0x33, 0x90, 0x72, 0x38, 0xd1, 0x80, 0x7e,
-	0x0e, 0x35, 0x5b, 0x44, 0x8f, 0x47, 0x47, 0xe9, 0x6e, 0x67, 0x95, 0xc9, 0xc0, 0xe2, 0x98, 0x07,
-	0xa9, 0x50, 0x8a, 0xde, 0xb8, 0x3c, 0x64, 0x4d, 0xcc, 0x96, 0xda, 0x9f, 0x0a, 0x50, 0x8f, 0xf5,
-	0xa2, 0x6d, 0xd8, 0xec, 0x8f, 0xcd, 0x97, 0x53, 0xac, 0x0f, 0x66, 0xc7, 0xd3, 0xd1, 0x37, 0xfa,
-	0x50, 0x7d, 0x80, 0x9a, 0x50, 0xef, 0x8f, 0xcd, 0xbe, 0x7e, 0x3c, 0x9a, 0xaa, 0x05, 0xb4, 0x01,
-	0x8d, 0xfe, 0xd8, 0x1c, 0xcc, 0x26, 0x93, 0x91, 0xa1, 0x16, 0xd1, 0x26, 0x28, 0xfd, 0xb1, 0x89,
-	0x67, 0xe3, 0x71, 0xbf, 0x37, 0x38, 0x51, 0x4b, 0x68, 0x17, 0xb6, 0xfa, 0x63, 0x73, 0x38, 0x19,
-	0x9b, 0x43, 0xfd, 0x14, 0xeb, 0x83, 0x9e, 0xa1, 0x0f, 0xd5, 0x32, 0x02, 0xa8, 0x32, 0xf2, 0x70,
-	0xac, 0x56, 0xe4, 0x7a, 0xae, 0x1b, 0x6a, 0x55, 0xaa, 0x1b, 0x4d, 0xe7, 0x3a, 0x36, 0xd4, 0x9a,
-	0xdc, 0xbe, 0x3c, 0x1d, 0xf6, 0x0c, 0x5d, 0xad, 0xcb, 0xed, 0x50, 0x1f, 0xeb, 0x86, 0xae, 0x36,
-	0x9e, 0x97, 0xeb, 0x45, 0xb5, 0xf4, 0xbc, 0x5c, 0x2f, 0xa9, 0x65, 0xed, 0x8f, 0x05, 0xd8, 0x9d,
-	0xd3, 0x90, 0x58, 0xde, 0x09, 0x59, 0x63, 0x6b, 0x79, 0x41, 0x30, 0x79, 0xb3, 0x22, 0x11, 0x45,
-	0x0f, 0xa1, 0x1e, 0xf8, 0x91, 0xc3, 0x62, 0xc7, 0x03, 0xdc, 0xc0, 0xc9, 0x1e, 0x1d, 0x41, 0xe3,
-	0x8a, 0xac, 0xcd, 0x90, 0xf1, 0xcb, 0x80, 0xa1, 0x4e, 0x52, 0x90, 0x89, 0xa6, 0xfa, 0x95, 0x5c,
-	0x65, 0xe3, 0x5b, 0xba, 0x3b, 0xbe, 0xda, 0x39, 0xec, 0xdd, 0x36, 0x2a, 0x0a, 0xfc, 0x65, 0x44,
-	0xd0, 0x18, 0x90, 0x10, 0x34, 0x69, 0x9a, 0x5b, 0x6e, 0x9f, 0xd2, 0xfd, 0xf0, 0x9d, 0x05, 0x80,
-	0xb7, 0x5e, 0xdf, 0x26, 0x69, 0xdf, 0xc2, 0xb6, 0xb8, 0xc7, 0xb0, 0x5e, 0xbb, 0x24, 0xba, 0x8f,
-	0xeb, 0x7b, 0x50, 0xa5, 0x9c, 0xb9, 0x5d, 0xdc, 0x2f, 0x1d, 0x34, 0xb0, 0xdc, 0xbd, 0xaf, 0x87,
-	0x0b, 0xd8, 0xc9, 0xdf, 0xfc, 0x3f, 0xf1, 0xef, 0x57, 0x50, 0xc6, 0x2b, 0x97, 0xa0, 0x1d, 0xa8,
-	0x78, 0x16, 0xb5, 0x2f, 0xa5, 0x37, 0x62, 0xc3, 0x5c, 0x39, 0x77, 0x5c, 0x4a, 0x42, 0x9e, 0xc2,
-	0x06, 0x96, 0x3b, 0xed, 0xcf, 0x05, 0xa8, 0x3e, 0xe5, 0x4b, 0xf4, 0x09, 0x54, 0xc2, 0x15, 0x73,
-	0x56, 0x60, 0x5d, 0xcd, 0x5a, 0xc0, 0x34, 0x63, 0x71, 0x8c, 0x46, 0xd0, 0x3a, 0x77, 0x88, 0xbb,
-	0xe0, 0xd0, 0x9d, 0xf8, 0x0b, 0x51, 0x15, 0xad, 0xee, 0xc7, 0x59, 0x01, 0xa1, 0xb3, 0xf3, 0x34,
-	0xc7, 0x88, 0x6f, 0x09, 0x6a, 0x4f, 0xa0, 0x95, 0xe7, 0x60, 0x70, 0xd2, 0x31, 0x36, 0x67, 0x53,
-	0x73, 0x32, 0x9a, 0x4f, 0x7a, 0xc6, 0xe0, 0x99, 0xfa, 0x80, 0x23, 0x46, 0x9f, 0x1b, 0xa6, 0xfe,
-	0xf4, 0xe9, 0x0c, 0x1b, 0x6a, 0x41, 0xfb, 0x77, 0x11, 0x9a, 0x22, 0x28, 0x73, 0x7f, 0x15, 0xda,
-	0x84, 0x65, 0xf1, 0x8a, 0xac, 0xa3, 0xc0, 0xb2, 0x49, 0x9c, 0xc5, 0x78, 0xcf, 0x02, 0x12, 0x5d,
-	0x5a, 0xe1, 0x42, 0x7a, 0x2e, 0x36, 0xe8, 0x73, 0x50, 0x78, 0x36, 0xa9, 0x49, 0xd7, 0x01, 0xe1,
-	0x79, 0x6c, 0x75, 0x77, 0xd2, 0xc2, 0xe6, 0xb9, 0xa2, 0xc6, 0x3a, 0x20, 0x18, 0x68, 0xb2, 0xce,
-	0xa3, 0xa1, 0x7c, 0x0f, 0x34, 0xa4, 0x35, 0x54, 0xc9, 0xd5, 0xd0, 0x61, 0x92, 0x90, 0xaa, 0xd4,
-	0xf2, 0x56, 0xf4, 0xe2, 0x24, 0xa1, 0x0e, 0x54, 0xfd, 0xa5, 0xb9, 0x58, 0xb8, 0xed, 0x1a, 0x37,
-	0xf3, 0x27, 0x59, 0xde, 0xd9, 0x72, 0x38, 0x1c, 0xf7, 0x44, 0x59, 0x54, 0xfc, 0xe5, 0x70, 0xe1,
-	0xa2, 0x47, 0xd0, 0x22, 0xdf, 0x52, 0x12, 0x2e, 0x2d, 0xd7, 0xf4, 0xd6, 0xac, 0x7b, 0xd5, 0xb9,
-	0xeb, 0x1b, 0x31, 0x75, 0xc2, 0x88, 0xe8, 0x13, 0xd8, 0x8c, 0xa8, 0x1f, 0x98, 0xd6, 0x39, 0x25,
-	0xa1, 0x69, 0xfb, 0xc1, 0xba, 0xdd, 0xd8, 0x2f, 0x1c, 0xd4, 0xf1, 0x06, 0x23, 0xf7, 0x18, 0x75,
-	0xe0, 0x07, 0x6b, 0xed, 0x05, 0x34, 0xb0, 0x7f, 0x33, 0xb8, 0xe4, 0xfe, 0x68, 0x50, 0x7d, 0x4d,
-	0xce, 0xfd, 0x90, 0xc8, 0x42, 0x05, 0xd9, 0xc8, 0xb1, 0x7f, 0x83, 0xe5, 0x09, 0xda, 0x87, 0x0a,
-	0xd7, 0x29, 0xdb, 0x45, 0x96, 0x45, 0x1c, 0x68, 0x16, 0xd4, 0xb1, 0x7f, 0xc3, 0xd3, 0x8e, 0x3e,
-	0x04, 0x11, 0x60, 0x73, 0x69, 0x79, 0x71, 0xf6, 0x1a, 0x9c, 0x32, 0xb5, 0x3c, 0x82, 0x9e, 0x80,
-	0x12, 0xfa, 0x37, 0xa6, 0xcd, 0xaf, 0x17, 0x48, 0x54, 0xba, 0xbb, 0xb9, 0xe2, 0x8c, 0x8d, 0xc3,
-	0x10, 0xc6, 0xcb, 0x48, 0x7b, 0x01, 0x90, 0xd6, 0xd6, 0x5d, 0x97, 0xfc, 0x8c, 0x65, 0x83, 0xb8,
-	0x8b, 0x58, 0x7f, 0x53, 0x9a, 0xcc, 0x35, 0x60, 0x79, 0xa6, 0xfd, 0xa1, 0x00, 0x8d, 0x39, 0xab,
-	0x9e, 0x63, 0xea, 0x2c, 0x7e, 0x40, 0xcd, 0x21, 0x28, 0x5f, 0x50, 0x67, 0xc1, 0x8b, 0xad, 0x81,
-	0xf9, 0x1a, 0x7d, 0x1e, 0x1b, 0x16, 0x98, 0x57, 0x51, 0xbb, 0xcc, 0x6f, 0xcf, 0xe5, 0x97, 0x17,
-	0xe2, 0xd8, 0x8a, 0xe8, 0xe9, 0x09, 0xae, 0x73, 0xd6, 0xd3, 0x93, 0x48, 0xfb, 0x0a, 0x2a, 0x67,
-	0xdc, 0x8a, 0x27, 0xa0, 0x70, 0xe5, 0x26, 0xd3, 0x16, 0x63, 0x37, 0x17, 0x9e, 0xc4, 0x62, 0x0c,
-	0x51, 0xbc, 0x8c, 0xb4, 0x1e, 0x6c, 0x9c, 0x48, 0x6b, 0x39, 0xc3, 0xfb, 0xbb, 0xa3, 0xfd, 0xb5,
-	0x08, 0xb5, 0xe7, 0xfe, 0x8a, 0x15, 0x14, 0x6a, 0x41, 0xd1, 0x59, 0x70, 0xb9, 0x12, 0x2e, 0x3a,
-	0x0b, 0xf4, 0x5b, 0x68, 0x79, 0xce, 0x45, 0x68, 0xb1, 0xb2, 0x14, 0x08, 0x13, 0x4d, 0xe2, 0xa7,
-	0x59, 0xcb, 0x26, 0x31, 0x07, 0x87, 0xd9, 0x86, 0x97, 0xdd, 0x66, 0x80, 0x53, 0xca, 0x01, 0xe7,
-	0x11, 0xb4, 0x5c, 0xdf, 0xb6, 0x5c, 0x33, 0x69, 0xdb, 0x65, 0x51, 0xdc, 0x9c, 0x7a, 0x1a, 0xf7,
-	0xee, 0x5b, 0x71, 0xa9, 0xdc, 0x33, 0x2e, 0xe8, 0x0b, 0x68, 0x06, 0x56, 0x48, 0x1d, 0xdb, 0x09,
-	0x2c, 0x36, 0xf8, 0x54, 0xb9, 0x60, 0xce, 0xec, 0x5c, 0xdc, 0x70, 0x8e, 0x1d, 0x7d, 0x0a, 0x6a,
-	0xc4, 0x5b, 0x92, 0x79, 0xe3, 0x87, 0x57, 0xe7, 0xae, 0x7f, 0x13, 0xb5, 0x6b, 0xdc, 0xfe, 0x4d,
-	0x41, 0x7f, 0x15, 0x93, 0xb5, 0xbf, 0x94, 0xa0, 0x7a, 0x26, 0xaa, 0xf3, 0x10, 0xca, 0x3c, 0x46,
-	0x62, 0xb8, 0xd9, 0xcb, 0x5e, 0x26, 0x38, 0x78, 0x80, 0x38, 0x0f, 0xfa, 0x00, 0x1a, 0xd4, 0xf1,
-	0x48, 0x44, 0x2d, 0x2f, 0xe0, 0x41, 0x2d, 0xe1, 0x94, 0xf0, 0x9d, 0x25, 0xf6, 0x01, 0x34, 0x92,
-	0x71, 0x4c, 0x06, 0x2b, 0x25, 0xa0, 0x5f, 0x40, 0x83, 0xe1, 0x8b, 0x0f, 0x5f, 0xed, 0x0a, 0x07,
-	0xec, 0xce, 0x2d, 0x74, 0x71, 0x13, 0x70, 0x3d, 0x8c, 0x11, 0xfb, 0x6b, 0x50, 0x38, 0x22, 0xa4,
-	0x90, 0x68, 0x60, 0x7b, 0xf9, 0x06, 0x16, 0x23, 0x0f, 0x43, 0xda, 0xf3, 0xd1, 0x63, 0xa8, 0x5c,
-	0x73, 0xf3, 0x6a, 0x72, 0x08, 0xcc, 0x3a, 0xca, 0x53, 0x21, 0xce, 0xd9, 0x17, 0xf6, 0x77, 0xa2,
-	0xb2, 0x78, 0xeb, 0xba, 0xf5, 0x85, 0x95, 0x45, 0x87, 0x63, 0x1e, 0x36, 0xa3, 0x2d, 0x3c, 0x97,
-	0x77, 0xaf, 0x06, 0x66, 0x4b, 0xf4, 0x31, 0x34, 0xed, 0x55, 0x18, 0xf2, 0xb1, 0xd3, 0xf1, 0x48,
-	0x7b, 0x87, 0x07, 0x4a, 0x91, 0x34, 0xc3, 0xf1, 0x08, 0xfa, 0x0d, 0xb4, 0x5c, 0x2b, 0xa2, 0x0c,
-	0x78, 0xd2, 0x91, 0x5d, 0x7e, 0x55, 0x0e, 0x7d, 0x02, 0x78, 0xc2, 0x13, 0xc5, 0x4d, 0x37, 0xda,
-	0x25, 0x34, 0x27, 0xce, 0xd2, 0xf1, 0x2c, 0x97, 0x03, 0x94, 0x05, 0x3e, 0xd3, 0x5a, 0xf8, 0xfa,
-	0x7e, 0x5d, 0x05, 0x7d, 0x04, 0x0a, 0x33, 0xc1, 0xf6, 0xdd, 0x95, 0xb7, 0x14, 0xd5, 0x5e, 0xc2,
-	0x8d, 0xe0, 0x64, 0x20, 0x08, 0x0c, 0xa9, 0xf2, 0xa6, 0xb9, 0x7d, 0x49, 0x3c, 0x0b, 0x7d, 0x96,
-	0x20, 0x43, 0xa0, 0xbd, 0x9d, 0xc7, 0x54, 0x6a, 0x54, 0x8c, 0x19, 0xed, 0x1f, 0x45, 0x68, 0x9d,
-	0x89, 0x19, 0x24, 0x9e, 0x7b, 0xbe, 0x82, 0x6d, 0x72, 0x7e, 0x4e, 0x6c, 0xea, 0x5c, 0x13, 0xd3,
-	0xb6, 0x5c, 0x97, 0x84, 0xa6, 0x44, 0xb0, 0xd2, 0xdd, 0xec, 0x88, 0xff, 0x22, 0x03, 0x4e, 0x1f,
-	0x0d, 0xf1, 0x56, 0xc2, 0x2b, 0x49, 0x0b, 0xa4, 0xc3, 0xb6, 0xe3, 0x79, 0x64, 0xe1, 0x58, 0x34,
-	0xab, 0x40, 0xb4, 0xfc, 0x5d, 0xe9, 0xe9, 0x99, 0x71, 0x6c, 0x51, 0x92, 0xaa, 0x49, 0x24, 0x12,
-	0x35, 0x8f, 0x98, 0x33, 0xe1, 0x45, 0x32, 0x4a, 0x6d, 0x48, 0x49, 0x83, 0x13, 0xb1, 0x3c, 0xcc,
-	0x8d, 0x69, 0xe5, 0x5b, 0x63, 0x5a, 0xfa, 0x29, 0xad, 0xdc, 0xf9, 0x29, 0xfd, 0x12, 0x36, 0x45,
-	0xbb, 0x8d, 0x53, 0x1f, 0x23, 0xfc, 0x7b, 0x7b, 0x6e, 0x93, 0xa6, 0x9b, 0x48, 0xfb, 0x02, 0x36,
-	0x93, 0x40, 0xca, 0x31, 0xee, 0x10, 0xaa, 0xbc, 0x7c, 0xe2, 0x74, 0xa0, 0xb7, 0xe1, 0x8b, 0x25,
-	0x87, 0xf6, 0xfb, 0x22, 0xa0, 0x58, 0xde, 0xbf, 0x89, 0xfe, 0x4f, 0x93, 0xb1, 0x03, 0x15, 0x4e,
-	0x97, 0x99, 0x10, 0x1b, 0x16, 0x07, 0x16, 0xd4, 0xe0, 0x2a, 0x49, 0x83, 0x10, 0x7e, 0xc1, 0x7e,
-	0x31, 0x89, 0x56, 0x2e, 0xc5, 0x92, 0x43, 0xfb, 0x5b, 0x01, 0xb6, 0x73, 0x71, 0x90, 0xb1, 0x4c,
-	0x11, 0x53, 0x78, 0x07, 0x62, 0x0e, 0xa0, 0x1e, 0x5c, 0xbd, 0x03, 0x59, 0xc9, 0xe9, 0x77, 0xb6,
-	0xc3, 0x8f, 0xa0, 0x1c, 0xb2, 0xb6, 0x2c, 0xbe, 0xb5, 0xd9, 0xe1, 0x84, 0xd3, 0xd9, 0x84, 0x93,
-	0xf3, 0x23, 0x37, 0xe1, 0x48, 0xfb, 0x1d, 0x50, 0x32, 0x9d, 0x81, 0xb5, 0x92, 0x7c, 0x55, 0xc9,
-	0xd4, 0x7d, 0x6f, 0x51, 0x29, 0x99, 0xa2, 0x62, 0xfd, 0xd9, 0xf6, 0xbd, 0xc0, 0x25, 0x94, 0x88,
-	0x94, 0xd5, 0x71, 0x4a, 0xd0, 0xbe, 0x06, 0x25, 0x23, 0x79, 0xd7, 0x20, 0x93, 0x26, 0xa1, 0x74,
-	0x67, 0x12, 0xfe, 0x59, 0x80, 0xdd, 0xb4, 0x98, 0x57, 0x2e, 0xfd, 0x51, 0xd5, 0xa3, 0x16, 0xc2,
-	0xde, 0x6d, 0xef, 0xde, 0xab, 0xca, 0x7e, 0x40, 0xed, 0x1c, 0x7e, 0x09, 0x4a, 0x66, 0x1e, 0x67,
-	0x7f, 0xdb, 0x47, 0xc7, 0xd3, 0x19, 0xd6, 0xd5, 0x07, 0xa8, 0x0e, 0xe5, 0xb9, 0x31, 0x3b, 0x55,
-	0x0b, 0x6c, 0xa5, 0x7f, 0xad, 0x0f, 0xc4, 0x53, 0x00, 0x5b, 0x99, 0x92, 0xa9, 0x74, 0xf8, 0x9f,
-	0x02, 0x40, 0xfa, 0xc5, 0x47, 0x0a, 0xd4, 0x5e, 0x4e, 0x4f, 0xa6, 0xb3, 0x57, 0x53, 0xa1, 0xe0,
-	0xd8, 0x18, 0x0d, 0xd5, 0x02, 0x6a, 0x40, 0x45, 0xbc, 0x2d, 0x14, 0xd9, 0x0d, 0xf2, 0x61, 0xa1,
-	0x84, 0x9a, 0x50, 0x4f, 0x5e, 0x15, 0xca, 0xa8, 0x06, 0xa5, 0xe4, 0xed, 0x40, 0x3e, 0x16, 0x54,
-	0x99, 0x42, 0xac, 0x9f, 0x8e, 0x7b, 0x03, 0x5d, 0xad, 0xb1, 0x83, 0xe4, 0xd9, 0x00, 0xa0, 0x1a,
-	0xbf, 0x19, 0x30, 0xc9, 0xb9, 0x6e, 0xa8, 0xc0, 0xee, 0x99, 0x19, 0xcf, 0x74, 0xac, 0x2a, 0x8c,
-	0x86, 0x67, 0xaf, 0xd4, 0x26, 0xa3, 0x3d, 0x1d, 0xe9, 0xe3, 0xa1, 0xba, 0x81, 0x36, 0xa0, 0xf1,
-	0x4c, 0xef, 0x61, 0xa3, 0xaf, 0xf7, 0x0c, 0xb5, 0xc5, 0x4e, 0xce, 0xb8, 0x81, 0x9b, 0xec, 0x9a,
-	0xe7, 0xb3, 0x97, 0x78, 0xda, 0x1b, 0xab, 0x2a, 0xdb, 0x9c, 0xe9, 0x78, 0x3e, 0x9a, 0x4d, 0xd5,
-	0x2d, 0x76, 0xcf, 0xb8, 0x37, 0x37, 0x4e, 0x4f, 0x54, 0xc4, 0xe4, 0xe7, 0xbd, 0x33, 0xfd, 0x74,
-	0x36, 0x9a, 0x1a, 0xea, 0xf6, 0xe1, 0x63, 0xf6, 0x9d, 0xcb, 0x4e, 0x80, 0x00, 0x55, 0xa3, 0xd7,
-	0x1f, 0xeb, 0x73, 0xf5, 0x01, 0x5b, 0xcf, 0x9f, 0xf5, 0xf0, 0x70, 0xae, 0x16, 0xfa, 0x9f, 0x7e,
-	0xf3, 0xf8, 0xda, 0xa1, 0x24, 0x8a, 0x3a, 0x8e, 0x7f, 0x24, 0x56, 0x47, 0x17, 0xfe, 0xd1, 0x35,
-	0x3d, 0xe2, 0xcf, 0x63, 0x47, 0x29, 0xe6, 0x5e, 0x57, 0x39, 0xe5, 0x97, 0xff, 0x0d, 0x00, 0x00,
-	0xff, 0xff, 0x23, 0xf6, 0xf5, 0x62, 0x7a, 0x13, 0x00, 0x00,
+	// 1938 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x49, 0x73, 0xe3, 0xc6,
+	0x15, 0x1e, 0xee, 0xe4, 0x83, 0x44, 0x41, 0xad, 0x25, 0xcc, 0x94, 0xed, 0x92, 0x51, 0xb1, 0x67,
+	0xac, 0xaa, 0x50, 0x0e, 0x13, 0x4f, 0x2e, 0x71, 0x1c, 0x2e, 0x90, 0xc4, 0x11, 0x17, 0x4d, 0x13,
+	0xa3, 0x71, 0xf9, 0x82, 0x82, 0xc0, 0x96, 0x84, 0x08, 0xdb, 0x00, 0x4d, 0xc9, 0xfc, 0x01, 0xa9,
+	0xca, 0x3d, 0xbf, 0x22, 0xbf, 0x21, 0xc9, 0x35, 0xf9, 0x13, 0x39, 0x26, 0xa7, 0xfc, 0x82, 0xdc,
+	0x52, 0xbd, 0x60, 0xa1, 0x64, 0x8f, 0x34, 0xae, 0xca, 0x21, 0xb9, 0xb0, 0xba, 0x5f, 0xbf, 0xf7,
+	0xfa, 0x6d, 0xdf, 0xc3, 0x63, 0x83, 0x7a, 0xee, 0xf8, 0x6e, 0x70, 0x39, 0xb7, 0xa8, 0xd5, 0x0e,
+	0xa3, 0x80, 0x06, 0x08, 0x32, 0xca, 0x53, 0xe5, 0x86, 0x46, 0xa1, 0x2d, 0x0e, 0x9e, 0x2a, 0x6f,
+	0x17, 0x24, 0x5a, 0xca, 0x4d, 0x93, 0x06, 0x61, 0x90, 0x49, 0x69, 0x63, 0xa8, 0xf5, 0xaf, 0xac,
+	0x28, 0x26, 0x14, 0xed, 0x42, 0xd5, 0x76, 0x1d, 0xe2, 0xd3, 0x56, 0x61, 0xaf, 0xf0, 0xbc, 0x82,
+	0xe5, 0x0e, 0x21, 0x28, 0xdb, 0x81, 0xef, 0xb7, 0x8a, 0x9c, 0xca, 0xd7, 0x8c, 0x37, 0x26, 0xd1,
+	0x0d, 0x89, 0x5a, 0x25, 0xc1, 0x2b, 0x76, 0xda, 0x3f, 0x4b, 0xb0, 0xd9, 0xe3, 0x76, 0x18, 0x91,
+	0xe5, 0xc7, 0x96, 0x4d, 0x9d, 0xc0, 0x47, 0x47, 0x00, 0x31, 0xb5, 0x28, 0xf1, 0x88, 0x4f, 0xe3,
+	0x56, 0x61, 0xaf, 0xf4, 0x5c, 0xe9, 0x3c, 0x6b, 0xe7, 0x3c, 0xb8, 0x27, 0xd2, 0x9e, 0x25, 0xfc,
+	0x38, 0x27, 0x8a, 0x3a, 0xa0, 0x90, 0x1b, 0xe2, 0x53, 0x93, 0x06, 0xd7, 0xc4, 0x6f, 0x95, 0xf7,
+	0x0a, 0xcf, 0x95, 0xce, 0x66, 0x5b, 0x38, 0xa8, 0xb3, 0x13, 0x83, 0x1d, 0x60, 0x20, 0xe9, 0xfa,
+	0xe9, 0x5f, 0x8b, 0xd0, 0x48, 0xb5, 0xa1, 0x11, 0xd4, 0x6d, 0x8b, 0x92, 0xcb, 0x20, 0x5a, 0x72,
+	0x37, 0x9b, 0x9d, 0xcf, 0x1f, 0x69, 0x48, 0xbb, 0x2f, 0xe5, 0x70, 0xaa, 0x01, 0xfd, 0x14, 0x6a,
+	0xb6, 0x88, 0x1e, 0x8f, 0x8e, 0xd2, 0xd9, 0xca, 0x2b, 0x93, 0x81, 0xc5, 0x09, 0x0f, 0x52, 0xa1,
+	0x14, 0xbf, 0x75, 0x79, 0xc8, 0xd6, 0x30, 0x5b, 0x6a, 0x7f, 0x2c, 0x40, 0x3d, 0xd1, 0x8b, 0xb6,
+	0x60, 0xa3, 0x37, 0x32, 0x5f, 0x4f, 0xb0, 0xde, 0x9f, 0x1e, 0x4d, 0x86, 0xdf, 0xe8, 0x03, 0xf5,
+	0x09, 0x5a, 0x83, 0x7a, 0x6f, 0x64, 0xf6, 0xf4, 0xa3, 0xe1, 0x44, 0x2d, 0xa0, 0x75, 0x68, 0xf4,
+	0x46, 0x66, 0x7f, 0x3a, 0x1e, 0x0f, 0x0d, 0xb5, 0x88, 0x36, 0x40, 0xe9, 0x8d, 0x4c, 0x3c, 0x1d,
+	0x8d, 0x7a, 0xdd, 0xfe, 0x89, 0x5a, 0x42, 0x3b, 0xb0, 0xd9, 0x1b, 0x99, 0x83, 0xf1, 0xc8, 0x1c,
+	0xe8, 0xa7, 0x58, 0xef, 0x77, 0x0d, 0x7d, 0xa0, 0x96, 0x11, 0x40, 0x95, 0x91, 0x07, 0x23, 0xb5,
+	0x22, 0xd7, 0x33, 0xdd, 0x50, 0xab, 0x52, 0xdd, 0x70, 0x32, 0xd3, 0xb1, 0xa1, 0xd6, 0xe4, 0xf6,
+	0xf5, 0xe9, 0xa0, 0x6b, 0xe8, 0x6a, 0x5d, 0x6e, 0x07, 0xfa, 0x48, 0x37, 0x74, 0xb5, 0xf1, 0xb2,
+	0x5c, 0x2f, 0xaa, 0xa5, 0x97, 0xe5, 0x7a, 0x49, 0x2d, 0x6b, 0x7f, 0x28, 0xc0, 0xce, 0x8c, 0x46,
+	0xc4, 0xf2, 0x4e, 0xc8, 0x12, 0x5b, 0xfe, 0x25, 0xc1, 0xe4, 0xed, 0x82, 0xc4, 0x14, 0x3d, 0x85,
+	0x7a, 0x18, 0xc4, 0x0e, 0x8b, 0x1d, 0x0f, 0x70, 0x03, 0xa7, 0x7b, 0x74, 0x00, 0x8d, 0x6b, 0xb2,
+	0x34, 0x23, 0xc6, 0x2f, 0x03, 0x86, 0xda, 0x69, 0x41, 0xa6, 0x9a, 0xea, 0xd7, 0x72, 0x95, 0x8f,
+	0x6f, 0xe9, 0xe1, 0xf8, 0x6a, 0x17, 0xb0, 0x7b, 0xd7, 0xa8, 0x38, 0x0c, 0xfc, 0x98, 0xa0, 0x11,
+	0x20, 0x21, 0x68, 0xd2, 0x2c, 0xb7, 0xdc, 0x3e, 0xa5, 0xf3, 0xe1, 0x3b, 0x0b, 0x00, 0x6f, 0x9e,
+	0xdf, 0x25, 0x69, 0xdf, 0xc2, 0x96, 0xb8, 0xc7, 0xb0, 0xce, 0x5d, 0x12, 0x3f, 0xc6, 0xf5, 0x5d,
+	0xa8, 0x52, 0xce, 0xdc, 0x2a, 0xee, 0x95, 0x9e, 0x37, 0xb0, 0xdc, 0xbd, 0xaf, 0x87, 0x73, 0xd8,
+	0x5e, 0xbd, 0xf9, 0xbf, 0xe2, 0xdf, 0x2f, 0xa0, 0x8c, 0x17, 0x2e, 0x41, 0xdb, 0x50, 0xf1, 0x2c,
+	0x6a, 0x5f, 0x49, 0x6f, 0xc4, 0x86, 0xb9, 0x72, 0xe1, 0xb8, 0x94, 0x44, 0x3c, 0x85, 0x0d, 0x2c,
+	0x77, 0xda, 0x3f, 0x0a, 0x50, 0x3d, 0xe4, 0x4b, 0xf4, 0x29, 0x54, 0xa2, 0x85, 0x4b, 0x12, 0xac,
+	0xab, 0x79, 0x0b, 0x98, 0x66, 0x2c, 0x8e, 0xd1, 0x10, 0x9a, 0x17, 0x0e, 0x71, 0xe7, 0x1c, 0xba,
+	0xe3, 0x60, 0x2e, 0xaa, 0xa2, 0xd9, 0xf9, 0x38, 0x2f, 0x20, 0x74, 0xb6, 0x0f, 0x57, 0x18, 0xf1,
+	0x1d, 0x41, 0x74, 0x00, 0xdb, 0x31, 0xf1, 0xe7, 0x26, 0x27, 0xc7, 0x66, 0xe0, 0x9b, 0x31, 0xb5,
+	0x22, 0x11, 0xd5, 0x3a, 0xde, 0x64, 0x67, 0x5c, 0x43, 0x3c, 0xf5, 0x67, 0xec, 0x40, 0x7b, 0x01,
+	0xcd, 0x55, 0x95, 0x0c, 0x7f, 0x3a, 0xc6, 0xe6, 0x74, 0x62, 0x8e, 0x87, 0xb3, 0x71, 0xd7, 0xe8,
+	0x1f, 0xab, 0x4f, 0x38, 0xc4, 0xf4, 0x99, 0x61, 0xea, 0x87, 0x87, 0x53, 0x6c, 0xa8, 0x05, 0xed,
+	0x5f, 0x45, 0x58, 0x13, 0x51, 0x9c, 0x05, 0x8b, 0xc8, 0x26, 0x2c, 0xed, 0xd7, 0x64, 0x19, 0x87,
+	0x96, 0x4d, 0x92, 0xb4, 0x27, 0x7b, 0x16, 0xc1, 0xf8, 0xca, 0x8a, 0xe6, 0x32, 0x54, 0x62, 0x83,
+	0xbe, 0x00, 0x85, 0xa7, 0x9f, 0x9a, 0x74, 0x19, 0x12, 0x6e, 0x62, 0xb3, 0xb3, 0x9d, 0x21, 0x81,
+	0x27, 0x97, 0x1a, 0xcb, 0x90, 0x60, 0xa0, 0xe9, 0x7a, 0x15, 0x3e, 0xe5, 0x47, 0xc0, 0x27, 0x2b,
+	0xba, 0xca, 0x4a, 0xd1, 0xed, 0xa7, 0x19, 0xac, 0x4a, 0x2d, 0xf7, 0xc2, 0x9d, 0x64, 0x15, 0xb5,
+	0xa1, 0x1a, 0xf8, 0xe6, 0x7c, 0xee, 0xb6, 0x6a, 0xdc, 0xcc, 0x1f, 0xe5, 0x79, 0xa7, 0xfe, 0x60,
+	0x30, 0xea, 0x8a, 0x3a, 0xaa, 0x04, 0xfe, 0x60, 0xee, 0xa2, 0x4f, 0xa0, 0x49, 0xbe, 0xa5, 0x24,
+	0xf2, 0x2d, 0xd7, 0xf4, 0x96, 0xac, 0xdd, 0xd5, 0xb9, 0xeb, 0xeb, 0x09, 0x75, 0xcc, 0x88, 0xe8,
+	0x53, 0xd8, 0x88, 0x69, 0x10, 0x9a, 0xd6, 0x05, 0x25, 0x91, 0x69, 0x07, 0xe1, 0xb2, 0xd5, 0xe0,
+	0x99, 0x5a, 0x67, 0xe4, 0x2e, 0xa3, 0xf6, 0x83, 0x70, 0xa9, 0xbd, 0x82, 0x06, 0x0e, 0x6e, 0xfb,
+	0x57, 0xdc, 0x1f, 0x0d, 0xaa, 0xe7, 0xe4, 0x22, 0x88, 0x88, 0xac, 0x6c, 0x90, 0x9d, 0x1f, 0x07,
+	0xb7, 0x58, 0x9e, 0xa0, 0x3d, 0xa8, 0x70, 0x9d, 0xad, 0xe2, 0x3d, 0x16, 0x71, 0xa0, 0x59, 0x50,
+	0xc7, 0xc1, 0x2d, 0x4f, 0x3b, 0xfa, 0x10, 0x44, 0x80, 0x4d, 0xdf, 0xf2, 0x92, 0xec, 0x35, 0x38,
+	0x65, 0x62, 0x79, 0x04, 0xbd, 0x00, 0x25, 0x0a, 0x6e, 0x4d, 0x9b, 0x5f, 0x2f, 0xa0, 0xab, 0x74,
+	0x76, 0x56, 0xaa, 0x39, 0x31, 0x0e, 0x43, 0x94, 0x2c, 0x63, 0xed, 0x15, 0x40, 0x56, 0x5b, 0x0f,
+	0x5d, 0xf2, 0x13, 0x96, 0x0d, 0x56, 0x99, 0x52, 0xff, 0x9a, 0x34, 0x99, 0x6b, 0xc0, 0xf2, 0x4c,
+	0xfb, 0x7d, 0x01, 0x1a, 0x33, 0x56, 0x3d, 0x47, 0xd4, 0x99, 0xff, 0x80, 0x9a, 0x43, 0x50, 0xbe,
+	0xa4, 0xce, 0x9c, 0x17, 0x5b, 0x03, 0xf3, 0x35, 0xfa, 0x22, 0x31, 0x2c, 0x34, 0xaf, 0xe3, 0x56,
+	0x99, 0xdf, 0xbe, 0x92, 0x5f, 0x5e, 0x88, 0x23, 0x2b, 0xa6, 0xa7, 0x27, 0xb8, 0xce, 0x59, 0x4f,
+	0x4f, 0x62, 0xed, 0x2b, 0xa8, 0x9c, 0x71, 0x2b, 0x5e, 0x80, 0xc2, 0x95, 0x9b, 0x4c, 0x5b, 0x02,
+	0xf6, 0x95, 0xf0, 0xa4, 0x16, 0x63, 0x88, 0x93, 0x65, 0xac, 0x75, 0x61, 0xfd, 0x44, 0x5a, 0xcb,
+	0x19, 0xde, 0xdf, 0x1d, 0xed, 0xcf, 0x45, 0xa8, 0xbd, 0x0c, 0x16, 0xac, 0xa0, 0x50, 0x13, 0x8a,
+	0xce, 0x9c, 0xcb, 0x95, 0x70, 0xd1, 0x99, 0xa3, 0xdf, 0x40, 0xd3, 0x73, 0x2e, 0x23, 0x8b, 0x95,
+	0xa5, 0x40, 0x98, 0xe8, 0x2a, 0x3f, 0xce, 0x5b, 0x36, 0x4e, 0x38, 0x38, 0xcc, 0xd6, 0xbd, 0xfc,
+	0x36, 0x07, 0x9c, 0xd2, 0x0a, 0x70, 0x3e, 0x81, 0xa6, 0x1b, 0xd8, 0x96, 0x6b, 0xa6, 0x7d, 0xbe,
+	0x2c, 0x8a, 0x9b, 0x53, 0x4f, 0x25, 0xf1, 0x6e, 0x5c, 0x2a, 0x8f, 0x8c, 0x0b, 0xfa, 0x12, 0xd6,
+	0x42, 0x2b, 0xa2, 0x8e, 0xed, 0x84, 0x16, 0x9b, 0x94, 0xaa, 0x5c, 0x70, 0xc5, 0xec, 0x95, 0xb8,
+	0xe1, 0x15, 0x76, 0xf4, 0x19, 0xa8, 0x31, 0x6f, 0x49, 0xe6, 0x6d, 0x10, 0x5d, 0x5f, 0xb8, 0xc1,
+	0x6d, 0xdc, 0xaa, 0x71, 0xfb, 0x37, 0x04, 0xfd, 0x4d, 0x42, 0xd6, 0xfe, 0x54, 0x82, 0xea, 0x99,
+	0xa8, 0xce, 0x7d, 0x28, 0xf3, 0x18, 0x89, 0x69, 0x68, 0x37, 0x7f, 0x99, 0xe0, 0xe0, 0x01, 0xe2,
+	0x3c, 0xe8, 0x03, 0x68, 0x50, 0xc7, 0x23, 0x31, 0xb5, 0xbc, 0x90, 0x07, 0xb5, 0x84, 0x33, 0xc2,
+	0x77, 0x96, 0xd8, 0x07, 0xd0, 0x48, 0xe7, 0x37, 0x19, 0xac, 0x8c, 0x80, 0x7e, 0x06, 0x0d, 0x86,
+	0x2f, 0x3e, 0xad, 0xb5, 0x2a, 0x1c, 0xb0, 0xdb, 0x77, 0xd0, 0xc5, 0x4d, 0xc0, 0xf5, 0x48, 0xae,
+	0xd0, 0x2f, 0x41, 0xe1, 0x88, 0x90, 0x42, 0xa2, 0x81, 0xed, 0xae, 0x36, 0xb0, 0x04, 0x79, 0x18,
+	0xb2, 0x8f, 0x04, 0x7a, 0x06, 0x95, 0x1b, 0x6e, 0x5e, 0x4d, 0x4e, 0x8d, 0x79, 0x47, 0x79, 0x2a,
+	0xc4, 0x39, 0xfb, 0x24, 0xff, 0x56, 0x54, 0x56, 0xab, 0x7e, 0xff, 0x93, 0x2c, 0x8b, 0x0e, 0x27,
+	0x3c, 0x6c, 0xa8, 0x9b, 0x7b, 0x2e, 0xef, 0x5e, 0x0d, 0xcc, 0x96, 0xe8, 0x63, 0x58, 0xb3, 0x17,
+	0x51, 0xc4, 0xe7, 0x54, 0xc7, 0x23, 0xad, 0x6d, 0x1e, 0x28, 0x45, 0xd2, 0x0c, 0xc7, 0x23, 0xe8,
+	0x57, 0xd0, 0x74, 0xad, 0x98, 0x32, 0xe0, 0x49, 0x47, 0x76, 0xf6, 0x0a, 0x77, 0xd1, 0x27, 0x80,
+	0x27, 0x3c, 0x51, 0xdc, 0x6c, 0xa3, 0x5d, 0xc1, 0xda, 0xd8, 0xf1, 0x1d, 0xcf, 0x72, 0x39, 0x40,
+	0x59, 0xe0, 0x73, 0xad, 0xa5, 0xec, 0x3f, 0xba, 0xab, 0xa0, 0x8f, 0x40, 0x61, 0x26, 0xd8, 0x81,
+	0xbb, 0xf0, 0x7c, 0x51, 0xed, 0x25, 0xdc, 0x08, 0x4f, 0xfa, 0x82, 0xc0, 0x90, 0x2a, 0x6f, 0x9a,
+	0xd9, 0x57, 0xc4, 0xb3, 0xd0, 0xe7, 0x29, 0x32, 0x04, 0xda, 0x5b, 0xab, 0x98, 0xca, 0x8c, 0x4a,
+	0x30, 0xa3, 0xfd, 0xad, 0x08, 0xcd, 0x33, 0x31, 0xb4, 0x24, 0x83, 0xd2, 0x57, 0xb0, 0x45, 0x2e,
+	0x2e, 0x88, 0x4d, 0x9d, 0x1b, 0x62, 0xda, 0x96, 0xeb, 0x92, 0xc8, 0x94, 0x08, 0x56, 0x3a, 0x1b,
+	0x6d, 0xf1, 0xe7, 0xa5, 0xcf, 0xe9, 0xc3, 0x01, 0xde, 0x4c, 0x79, 0x25, 0x69, 0x8e, 0x74, 0xd8,
+	0x72, 0x3c, 0x8f, 0xcc, 0x1d, 0x8b, 0xe6, 0x15, 0x88, 0x96, 0xbf, 0x23, 0x3d, 0x3d, 0x33, 0x8e,
+	0x2c, 0x4a, 0x32, 0x35, 0xa9, 0x44, 0xaa, 0xe6, 0x13, 0xe6, 0x4c, 0x74, 0x99, 0xce, 0x5e, 0xeb,
+	0x52, 0xd2, 0xe0, 0x44, 0x2c, 0x0f, 0x57, 0xe6, 0xba, 0xf2, 0x9d, 0xb9, 0x2e, 0xfb, 0x94, 0x56,
+	0x1e, 0xfc, 0x94, 0xfe, 0x1a, 0x36, 0x44, 0xbb, 0x4d, 0x52, 0x9f, 0x20, 0xfc, 0x7b, 0x7b, 0xee,
+	0x1a, 0xcd, 0x36, 0xb1, 0xf6, 0x25, 0x6c, 0xa4, 0x81, 0x94, 0x73, 0xdf, 0x3e, 0x54, 0x79, 0xf9,
+	0x24, 0xe9, 0x40, 0xf7, 0xe1, 0x8b, 0x25, 0x87, 0xf6, 0xbb, 0x22, 0xa0, 0x44, 0x3e, 0xb8, 0x8d,
+	0xff, 0x47, 0x93, 0xb1, 0x0d, 0x15, 0x4e, 0x97, 0x99, 0x10, 0x1b, 0x16, 0x07, 0x16, 0xd4, 0xf0,
+	0x3a, 0x4d, 0x83, 0x10, 0x7e, 0xc5, 0x7e, 0x31, 0x89, 0x17, 0x2e, 0xc5, 0x92, 0x43, 0xfb, 0x4b,
+	0x01, 0xb6, 0x56, 0xe2, 0x20, 0x63, 0x99, 0x21, 0xa6, 0xf0, 0x0e, 0xc4, 0x3c, 0x87, 0x7a, 0x78,
+	0xfd, 0x0e, 0x64, 0xa5, 0xa7, 0xdf, 0xd9, 0x0e, 0x3f, 0x82, 0x72, 0x14, 0xdc, 0x26, 0xdf, 0xda,
+	0xfc, 0x70, 0xc2, 0xe9, 0x6c, 0xc2, 0x59, 0xf1, 0x23, 0xcf, 0x91, 0xd8, 0xef, 0x80, 0x92, 0xeb,
+	0x0c, 0xac, 0x95, 0xac, 0x56, 0x95, 0x4c, 0xdd, 0xf7, 0x16, 0x95, 0x92, 0x2b, 0x2a, 0xd6, 0x9f,
+	0xed, 0xc0, 0x0b, 0x5d, 0x42, 0x89, 0x48, 0x59, 0x1d, 0x67, 0x04, 0xed, 0x6b, 0x50, 0x72, 0x92,
+	0x0f, 0x0d, 0x32, 0x59, 0x12, 0x4a, 0x0f, 0x26, 0xe1, 0xef, 0x05, 0xd8, 0xc9, 0x8a, 0x79, 0xe1,
+	0xd2, 0xff, 0xab, 0x7a, 0xd4, 0x22, 0xd8, 0xbd, 0xeb, 0xdd, 0x7b, 0x55, 0xd9, 0x0f, 0xa8, 0x9d,
+	0xfd, 0x63, 0x50, 0x72, 0xf3, 0x38, 0xfb, 0x9f, 0x3f, 0x3c, 0x9a, 0x4c, 0xb1, 0xae, 0x3e, 0x41,
+	0x75, 0x28, 0xcf, 0x8c, 0xe9, 0xa9, 0x5a, 0x60, 0x2b, 0xfd, 0x6b, 0xbd, 0x2f, 0xde, 0x0e, 0xd8,
+	0xca, 0x94, 0x4c, 0x25, 0xce, 0xd4, 0x3d, 0xd4, 0xd5, 0xf2, 0xfe, 0xbf, 0x0b, 0x00, 0xd9, 0xb7,
+	0x1f, 0x29, 0x50, 0x7b, 0x3d, 0x39, 0x99, 0x4c, 0xdf, 0x4c, 0x84, 0xaa, 0x23, 0x63, 0x38, 0x50,
+	0x0b, 0xa8, 0x01, 0x15, 0xf1, 0x2c, 0x51, 0x64, 0x77, 0xc9, 0x37, 0x89, 0x12, 0x7b, 0xb0, 0x48,
+	0x1f, 0x24, 0xca, 0xa8, 0x06, 0xa5, 0xf4, 0xd9, 0x41, 0xbe, 0x33, 0x54, 0x99, 0x42, 0xac, 0x9f,
+	0x8e, 0xba, 0x7d, 0x5d, 0xad, 0xb1, 0x83, 0xf4, 0xc5, 0x01, 0xa0, 0x9a, 0x3c, 0x37, 0x30, 0x49,
+	0xf6, 0x48, 0x01, 0xec, 0x9e, 0xa9, 0x71, 0xac, 0x63, 0x55, 0x61, 0x34, 0x3c, 0x7d, 0xa3, 0xae,
+	0x31, 0xda, 0xe1, 0x50, 0x1f, 0x0d, 0xd4, 0x75, 0xf6, 0x4a, 0x71, 0xac, 0x77, 0xb1, 0xd1, 0xd3,
+	0xbb, 0x86, 0xda, 0x64, 0x27, 0x67, 0xdc, 0xc0, 0x0d, 0x76, 0xcd, 0xcb, 0xe9, 0x6b, 0x3c, 0xe9,
+	0x8e, 0x54, 0x95, 0x6d, 0xce, 0x74, 0x3c, 0x1b, 0x4e, 0x27, 0xea, 0x26, 0xbb, 0x67, 0xd4, 0x9d,
+	0x19, 0xa7, 0x27, 0x2a, 0x62, 0xf2, 0xb3, 0xee, 0x99, 0x7e, 0x3a, 0x1d, 0x4e, 0x0c, 0x75, 0x6b,
+	0xff, 0x19, 0xfb, 0xe2, 0xe5, 0x67, 0x41, 0x80, 0xaa, 0xd1, 0xed, 0x8d, 0xf4, 0x99, 0xfa, 0x84,
+	0xad, 0x67, 0xc7, 0x5d, 0x3c, 0x98, 0xa9, 0x85, 0xde, 0x67, 0xdf, 0x3c, 0xbb, 0x71, 0x28, 0x89,
+	0xe3, 0xb6, 0x13, 0x1c, 0x88, 0xd5, 0xc1, 0x65, 0x70, 0x70, 0x43, 0x0f, 0xf8, 0xcb, 0xda, 0x41,
+	0x86, 0xbe, 0xf3, 0x2a, 0xa7, 0xfc, 0xfc, 0x3f, 0x03, 0x00, 0x15, 0xc6, 0xbf, 0x76, 0xb5, 0x13,
+	0x00, 0x00,
 }
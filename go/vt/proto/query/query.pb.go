@@ -496,6 +496,31 @@ func (ExecuteOptions_PlannerVersion) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_5c6ac9b241082464, []int{6, 3}
 }
 
+type ExecuteOptions_Priority int32
+
+const (
+	ExecuteOptions_NORMAL ExecuteOptions_Priority = 0
+	ExecuteOptions_LOW    ExecuteOptions_Priority = 1
+)
+
+var ExecuteOptions_Priority_name = map[int32]string{
+	0: "NORMAL",
+	1: "LOW",
+}
+
+var ExecuteOptions_Priority_value = map[string]int32{
+	"NORMAL": 0,
+	"LOW":    1,
+}
+
+func (x ExecuteOptions_Priority) String() string {
+	return proto.EnumName(ExecuteOptions_Priority_name, int32(x))
+}
+
+func (ExecuteOptions_Priority) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{6, 4}
+}
+
 // The category of one statement.
 type StreamEvent_Statement_Category int32
 
@@ -894,10 +919,15 @@ type ExecuteOptions struct {
 	SkipQueryPlanCache bool `protobuf:"varint,10,opt,name=skip_query_plan_cache,json=skipQueryPlanCache,proto3" json:"skip_query_plan_cache,omitempty"`
 	// PlannerVersion specifies which planner to use.
 	// If DEFAULT is chosen, whatever vtgate was started with will be used
-	PlannerVersion       ExecuteOptions_PlannerVersion `protobuf:"varint,11,opt,name=planner_version,json=plannerVersion,proto3,enum=query.ExecuteOptions_PlannerVersion" json:"planner_version,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+	PlannerVersion ExecuteOptions_PlannerVersion `protobuf:"varint,11,opt,name=planner_version,json=plannerVersion,proto3,enum=query.ExecuteOptions_PlannerVersion" json:"planner_version,omitempty"`
+	// priority of the query, as set by the PRIORITY query comment directive.
+	// When vttablet is under pressure, LOW priority queries are shed first:
+	// they don't wait for a connection of an exhausted pool, and they are
+	// rejected while the tablet throttler throttles.
+	Priority             ExecuteOptions_Priority `protobuf:"varint,12,opt,name=priority,proto3,enum=query.ExecuteOptions_Priority" json:"priority,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *ExecuteOptions) Reset()         { *m = ExecuteOptions{} }
@@ -974,6 +1004,13 @@ func (m *ExecuteOptions) GetPlannerVersion() ExecuteOptions_PlannerVersion {
 	return ExecuteOptions_DEFAULT_PLANNER
 }
 
+func (m *ExecuteOptions) GetPriority() ExecuteOptions_Priority {
+	if m != nil {
+		return m.Priority
+	}
+	return ExecuteOptions_NORMAL
+}
+
 // Field describes a single column returned by a query
 type Field struct {
 	// name of the field as returned by mysql C API
@@ -3968,7 +4005,10 @@ type RealtimeStats struct {
 	CpuUsage float64 `protobuf:"fixed64,5,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`
 	// qps is the average QPS (queries per second) rate in the last XX seconds
 	// where XX is usually 60 (See query_service_stats.go).
-	Qps                  float64  `protobuf:"fixed64,6,opt,name=qps,proto3" json:"qps,omitempty"`
+	Qps float64 `protobuf:"fixed64,6,opt,name=qps,proto3" json:"qps,omitempty"`
+	// error_rate is the rate of errors per second returned to the clients,
+	// sampled like qps.
+	ErrorRate            float64  `protobuf:"fixed64,7,opt,name=error_rate,json=errorRate,proto3" json:"error_rate,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4041,6 +4081,13 @@ func (m *RealtimeStats) GetQps() float64 {
 	return 0
 }
 
+func (m *RealtimeStats) GetErrorRate() float64 {
+	if m != nil {
+		return m.ErrorRate
+	}
+	return 0
+}
+
 // AggregateStats contains information about the health of a group of
 // tablets for a Target.  It is used to propagate stats from a vtgate
 // to another, or from the Gateway layer of a vtgate to the routing
@@ -4302,6 +4349,7 @@ func init() {
 	proto.RegisterEnum("query.ExecuteOptions_Workload", ExecuteOptions_Workload_name, ExecuteOptions_Workload_value)
 	proto.RegisterEnum("query.ExecuteOptions_TransactionIsolation", ExecuteOptions_TransactionIsolation_name, ExecuteOptions_TransactionIsolation_value)
 	proto.RegisterEnum("query.ExecuteOptions_PlannerVersion", ExecuteOptions_PlannerVersion_name, ExecuteOptions_PlannerVersion_value)
+	proto.RegisterEnum("query.ExecuteOptions_Priority", ExecuteOptions_Priority_name, ExecuteOptions_Priority_value)
 	proto.RegisterEnum("query.StreamEvent_Statement_Category", StreamEvent_Statement_Category_name, StreamEvent_Statement_Category_value)
 	proto.RegisterType((*Target)(nil), "query.Target")
 	proto.RegisterType((*VTGateCallerID)(nil), "query.VTGateCallerID")
@@ -4370,208 +4418,211 @@ func init() {
 func init() { proto.RegisterFile("query.proto", fileDescriptor_5c6ac9b241082464) }
 
 var fileDescriptor_5c6ac9b241082464 = []byte{
-	// 3235 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4b, 0x70, 0x1b, 0x5b,
-	0x5a, 0x76, 0xb7, 0x1e, 0x96, 0x7e, 0x59, 0xf2, 0xf1, 0xb1, 0x9d, 0xab, 0xeb, 0xdc, 0x87, 0xa7,
-	0x67, 0x32, 0x63, 0x0c, 0x38, 0x89, 0xe3, 0x09, 0xe1, 0xce, 0x00, 0x69, 0xcb, 0xed, 0x5c, 0x25,
-	0x52, 0x4b, 0x39, 0x6a, 0x39, 0x93, 0x14, 0x55, 0x5d, 0x6d, 0xe9, 0x44, 0xee, 0x72, 0x4b, 0xad,
-	0x74, 0xb7, 0x9d, 0x68, 0x17, 0x18, 0x86, 0xe1, 0xcd, 0xf0, 0x66, 0x98, 0x62, 0x8a, 0x2a, 0x16,
-	0x14, 0x1b, 0xd6, 0xac, 0x59, 0xdc, 0x05, 0x0b, 0xaa, 0x58, 0x02, 0x55, 0x3c, 0x16, 0x14, 0xac,
-	0xa6, 0x28, 0x16, 0x2c, 0x58, 0x50, 0xd4, 0x79, 0x74, 0x4b, 0xb2, 0x75, 0x13, 0x4f, 0x86, 0x29,
-	0x2a, 0xb9, 0x59, 0xe9, 0xfc, 0x8f, 0xf3, 0xf8, 0xbf, 0xf3, 0x9f, 0xff, 0x3f, 0xfd, 0xeb, 0x40,
-	0xe1, 0xc9, 0x09, 0x0d, 0x46, 0x5b, 0xc3, 0xc0, 0x8f, 0x7c, 0x9c, 0xe1, 0xc4, 0x5a, 0x29, 0xf2,
-	0x87, 0x7e, 0xd7, 0x89, 0x1c, 0xc1, 0x5e, 0x2b, 0x9c, 0x46, 0xc1, 0xb0, 0x23, 0x08, 0xed, 0x1b,
-	0x0a, 0x64, 0x2d, 0x27, 0xe8, 0xd1, 0x08, 0xaf, 0x41, 0xee, 0x98, 0x8e, 0xc2, 0xa1, 0xd3, 0xa1,
-	0x65, 0x65, 0x5d, 0xd9, 0xc8, 0x93, 0x84, 0xc6, 0x2b, 0x90, 0x09, 0x8f, 0x9c, 0xa0, 0x5b, 0x56,
-	0xb9, 0x40, 0x10, 0xf8, 0xcb, 0x50, 0x88, 0x9c, 0x43, 0x8f, 0x46, 0x76, 0x34, 0x1a, 0xd2, 0x72,
-	0x6a, 0x5d, 0xd9, 0x28, 0x6d, 0xaf, 0x6c, 0x25, 0xf3, 0x59, 0x5c, 0x68, 0x8d, 0x86, 0x94, 0x40,
-	0x94, 0xb4, 0x31, 0x86, 0x74, 0x87, 0x7a, 0x5e, 0x39, 0xcd, 0xc7, 0xe2, 0x6d, 0x6d, 0x0f, 0x4a,
-	0x07, 0xd6, 0x1d, 0x27, 0xa2, 0x15, 0xc7, 0xf3, 0x68, 0x50, 0xdd, 0x63, 0xcb, 0x39, 0x09, 0x69,
-	0x30, 0x70, 0xfa, 0xc9, 0x72, 0x62, 0x1a, 0x5f, 0x82, 0x6c, 0x2f, 0xf0, 0x4f, 0x86, 0x61, 0x59,
-	0x5d, 0x4f, 0x6d, 0xe4, 0x89, 0xa4, 0xb4, 0x9f, 0x05, 0x30, 0x4e, 0xe9, 0x20, 0xb2, 0xfc, 0x63,
-	0x3a, 0xc0, 0xef, 0x41, 0x3e, 0x72, 0xfb, 0x34, 0x8c, 0x9c, 0xfe, 0x90, 0x0f, 0x91, 0x22, 0x63,
-	0xc6, 0xa7, 0x98, 0xb4, 0x06, 0xb9, 0xa1, 0x1f, 0xba, 0x91, 0xeb, 0x0f, 0xb8, 0x3d, 0x79, 0x92,
-	0xd0, 0xda, 0x4f, 0x43, 0xe6, 0xc0, 0xf1, 0x4e, 0x28, 0xfe, 0x10, 0xd2, 0xdc, 0x60, 0x85, 0x1b,
-	0x5c, 0xd8, 0x12, 0xa0, 0x73, 0x3b, 0xb9, 0x80, 0x8d, 0x7d, 0xca, 0x34, 0xf9, 0xd8, 0x0b, 0x44,
-	0x10, 0xda, 0x31, 0x2c, 0xec, 0xba, 0x83, 0xee, 0x81, 0x13, 0xb8, 0x0c, 0x8c, 0x57, 0x1c, 0x06,
-	0x7f, 0x01, 0xb2, 0xbc, 0x11, 0x96, 0x53, 0xeb, 0xa9, 0x8d, 0xc2, 0xf6, 0x82, 0xec, 0xc8, 0xd7,
-	0x46, 0xa4, 0x4c, 0xfb, 0x2b, 0x05, 0x60, 0xd7, 0x3f, 0x19, 0x74, 0xef, 0x33, 0x21, 0x46, 0x90,
-	0x0a, 0x9f, 0x78, 0x12, 0x48, 0xd6, 0xc4, 0xf7, 0xa0, 0x74, 0xe8, 0x0e, 0xba, 0xf6, 0xa9, 0x5c,
-	0x8e, 0xc0, 0xb2, 0xb0, 0xfd, 0x05, 0x39, 0xdc, 0xb8, 0xf3, 0xd6, 0xe4, 0xaa, 0x43, 0x63, 0x10,
-	0x05, 0x23, 0x52, 0x3c, 0x9c, 0xe4, 0xad, 0xb5, 0x01, 0x9f, 0x57, 0x62, 0x93, 0x1e, 0xd3, 0x51,
-	0x3c, 0xe9, 0x31, 0x1d, 0xe1, 0x1f, 0x99, 0xb4, 0xa8, 0xb0, 0xbd, 0x1c, 0xcf, 0x35, 0xd1, 0x57,
-	0x9a, 0xf9, 0x91, 0x7a, 0x4b, 0xd1, 0xfe, 0x31, 0x0b, 0x25, 0xe3, 0x19, 0xed, 0x9c, 0x44, 0xb4,
-	0x31, 0x64, 0x7b, 0x10, 0xe2, 0x3a, 0x2c, 0xba, 0x83, 0x8e, 0x77, 0xd2, 0xa5, 0x5d, 0xfb, 0xb1,
-	0x4b, 0xbd, 0x6e, 0xc8, 0xfd, 0xa8, 0x94, 0xac, 0x7b, 0x5a, 0x7f, 0xab, 0x2a, 0x95, 0xf7, 0xb9,
-	0x2e, 0x29, 0xb9, 0x53, 0x34, 0xde, 0x84, 0xa5, 0x8e, 0xe7, 0xd2, 0x41, 0x64, 0x3f, 0x66, 0xf6,
-	0xda, 0x81, 0xff, 0x34, 0x2c, 0x67, 0xd6, 0x95, 0x8d, 0x1c, 0x59, 0x14, 0x82, 0x7d, 0xc6, 0x27,
-	0xfe, 0xd3, 0x10, 0x7f, 0x04, 0xb9, 0xa7, 0x7e, 0x70, 0xec, 0xf9, 0x4e, 0xb7, 0x9c, 0xe5, 0x73,
-	0x7e, 0x30, 0x7b, 0xce, 0x07, 0x52, 0x8b, 0x24, 0xfa, 0x78, 0x03, 0x50, 0xf8, 0xc4, 0xb3, 0x43,
-	0xea, 0xd1, 0x4e, 0x64, 0x7b, 0x6e, 0xdf, 0x8d, 0xca, 0x39, 0xee, 0x92, 0xa5, 0xf0, 0x89, 0xd7,
-	0xe2, 0xec, 0x1a, 0xe3, 0x62, 0x1b, 0x56, 0xa3, 0xc0, 0x19, 0x84, 0x4e, 0x87, 0x0d, 0x66, 0xbb,
-	0xa1, 0xef, 0x39, 0xdc, 0x1d, 0xf3, 0x7c, 0xca, 0xcd, 0xd9, 0x53, 0x5a, 0xe3, 0x2e, 0xd5, 0xb8,
-	0x07, 0x59, 0x89, 0x66, 0x70, 0xf1, 0x75, 0x58, 0x0d, 0x8f, 0xdd, 0xa1, 0xcd, 0xc7, 0xb1, 0x87,
-	0x9e, 0x33, 0xb0, 0x3b, 0x4e, 0xe7, 0x88, 0x96, 0x81, 0x9b, 0x8d, 0x99, 0x90, 0xef, 0x7b, 0xd3,
-	0x73, 0x06, 0x15, 0x26, 0x61, 0xa0, 0x33, 0xbd, 0x01, 0x0d, 0xec, 0x53, 0x1a, 0x84, 0x6c, 0x35,
-	0x85, 0x17, 0x81, 0xde, 0x14, 0xca, 0x07, 0x42, 0x97, 0x94, 0x86, 0x53, 0xb4, 0xf6, 0x15, 0x28,
-	0x4d, 0x6f, 0x0b, 0x5e, 0x82, 0xa2, 0xf5, 0xb0, 0x69, 0xd8, 0xba, 0xb9, 0x67, 0x9b, 0x7a, 0xdd,
-	0x40, 0x73, 0xb8, 0x08, 0x79, 0xce, 0x6a, 0x98, 0xb5, 0x87, 0x48, 0xc1, 0xf3, 0x90, 0xd2, 0x6b,
-	0x35, 0xa4, 0x6a, 0xb7, 0x20, 0x17, 0xe3, 0x8b, 0x17, 0xa1, 0xd0, 0x36, 0x5b, 0x4d, 0xa3, 0x52,
-	0xdd, 0xaf, 0x1a, 0x7b, 0x68, 0x0e, 0xe7, 0x20, 0xdd, 0xa8, 0x59, 0x4d, 0xa4, 0x88, 0x96, 0xde,
-	0x44, 0x2a, 0xeb, 0xb9, 0xb7, 0xab, 0xa3, 0x94, 0xf6, 0x67, 0x0a, 0xac, 0xcc, 0xc2, 0x09, 0x17,
-	0x60, 0x7e, 0xcf, 0xd8, 0xd7, 0xdb, 0x35, 0x0b, 0xcd, 0xe1, 0x65, 0x58, 0x24, 0x46, 0xd3, 0xd0,
-	0x2d, 0x7d, 0xb7, 0x66, 0xd8, 0xc4, 0xd0, 0xf7, 0x90, 0x82, 0x31, 0x94, 0x58, 0xcb, 0xae, 0x34,
-	0xea, 0xf5, 0xaa, 0x65, 0x19, 0x7b, 0x48, 0xc5, 0x2b, 0x80, 0x38, 0xaf, 0x6d, 0x8e, 0xb9, 0x29,
-	0x8c, 0x60, 0xa1, 0x65, 0x90, 0xaa, 0x5e, 0xab, 0x3e, 0x62, 0x03, 0xa0, 0x34, 0xfe, 0x1c, 0xbc,
-	0x5f, 0x69, 0x98, 0xad, 0x6a, 0xcb, 0x32, 0x4c, 0xcb, 0x6e, 0x99, 0x7a, 0xb3, 0xf5, 0x71, 0xc3,
-	0xe2, 0x23, 0x0b, 0xe3, 0x32, 0xb8, 0x04, 0xa0, 0xb7, 0xad, 0x86, 0x18, 0x07, 0x65, 0xb5, 0x36,
-	0x94, 0xa6, 0x21, 0x64, 0xab, 0x92, 0x4b, 0xb4, 0x9b, 0x35, 0xdd, 0x34, 0x0d, 0x82, 0xe6, 0x70,
-	0x16, 0xd4, 0x83, 0x1b, 0x48, 0xe1, 0xbf, 0x3b, 0x48, 0xc5, 0x0b, 0x90, 0x3b, 0xd8, 0xb9, 0x13,
-	0x50, 0xda, 0x1d, 0x89, 0x95, 0x1c, 0xec, 0xd4, 0xe8, 0xe3, 0x68, 0x9b, 0xb8, 0xbd, 0xa3, 0x08,
-	0xa5, 0xef, 0xa6, 0x73, 0x0a, 0x52, 0xef, 0xa6, 0x73, 0x2a, 0x4a, 0xdd, 0x4d, 0xe7, 0x52, 0x28,
-	0xad, 0xfd, 0xa5, 0x0a, 0x19, 0xbe, 0x05, 0x2c, 0x28, 0x4f, 0x84, 0x5a, 0xde, 0x4e, 0x02, 0x94,
-	0xfa, 0x82, 0x00, 0xc5, 0xe3, 0xba, 0x0c, 0x95, 0x82, 0xc0, 0x97, 0x21, 0xef, 0x07, 0x3d, 0x5b,
-	0x48, 0x44, 0x90, 0xcf, 0xf9, 0x41, 0x8f, 0x67, 0x03, 0x16, 0x60, 0x59, 0x6e, 0x38, 0x74, 0x42,
-	0xca, 0xcf, 0x59, 0x9e, 0x24, 0x34, 0x7e, 0x17, 0x98, 0x9e, 0xcd, 0xd7, 0x91, 0xe5, 0xb2, 0x79,
-	0x3f, 0xe8, 0x99, 0x6c, 0x29, 0x9f, 0x87, 0x62, 0xc7, 0xf7, 0x4e, 0xfa, 0x03, 0xdb, 0xa3, 0x83,
-	0x5e, 0x74, 0x54, 0x9e, 0x5f, 0x57, 0x36, 0x8a, 0x64, 0x41, 0x30, 0x6b, 0x9c, 0x87, 0xcb, 0x30,
-	0xdf, 0x39, 0x72, 0x82, 0x90, 0x8a, 0xb3, 0x55, 0x24, 0x31, 0xc9, 0x67, 0xa5, 0x1d, 0xb7, 0xef,
-	0x78, 0x21, 0x3f, 0x47, 0x45, 0x92, 0xd0, 0xcc, 0x88, 0xc7, 0x9e, 0xd3, 0x0b, 0xb9, 0xff, 0x17,
-	0x89, 0x20, 0xf0, 0x87, 0x50, 0x90, 0x13, 0x72, 0x08, 0x0a, 0x7c, 0x39, 0x20, 0x58, 0x0c, 0x01,
-	0xed, 0x27, 0x20, 0x45, 0xfc, 0xa7, 0x6c, 0x4e, 0xb1, 0xa2, 0xb0, 0xac, 0xac, 0xa7, 0x36, 0x30,
-	0x89, 0x49, 0x96, 0xa4, 0x64, 0x9c, 0x16, 0xe1, 0x3b, 0x8e, 0xcc, 0xdf, 0x51, 0xa0, 0xc0, 0xcf,
-	0x17, 0xa1, 0xe1, 0x89, 0x17, 0xb1, 0x78, 0x2e, 0x03, 0x99, 0x32, 0x15, 0xcf, 0xf9, 0xbe, 0x10,
-	0x29, 0x63, 0x00, 0xb0, 0xd8, 0x64, 0x3b, 0x8f, 0x1f, 0xd3, 0x4e, 0x44, 0x45, 0xda, 0x4a, 0x93,
-	0x05, 0xc6, 0xd4, 0x25, 0x8f, 0x21, 0xef, 0x0e, 0x42, 0x1a, 0x44, 0xb6, 0xdb, 0xe5, 0x7b, 0x92,
-	0x26, 0x39, 0xc1, 0xa8, 0x76, 0xf1, 0x07, 0x90, 0xe6, 0xd1, 0x2d, 0xcd, 0x67, 0x01, 0x39, 0x0b,
-	0xf1, 0x9f, 0x12, 0xce, 0xbf, 0x9b, 0xce, 0x65, 0x50, 0x56, 0xfb, 0x2a, 0x2c, 0xf0, 0xc5, 0x3d,
-	0x70, 0x82, 0x81, 0x3b, 0xe8, 0xf1, 0x64, 0xed, 0x77, 0x85, 0x5f, 0x14, 0x09, 0x6f, 0x33, 0x9b,
-	0xfb, 0x34, 0x0c, 0x9d, 0x1e, 0x95, 0xc9, 0x33, 0x26, 0xb5, 0x3f, 0x49, 0x41, 0xa1, 0x15, 0x05,
-	0xd4, 0xe9, 0xf3, 0x3c, 0x8c, 0xbf, 0x0a, 0x10, 0x46, 0x4e, 0x44, 0xfb, 0x74, 0x10, 0xc5, 0xf6,
-	0xbd, 0x27, 0x67, 0x9e, 0xd0, 0xdb, 0x6a, 0xc5, 0x4a, 0x64, 0x42, 0x1f, 0x6f, 0x43, 0x81, 0x32,
-	0xb1, 0x1d, 0xb1, 0x7c, 0x2e, 0x73, 0xc6, 0x52, 0x1c, 0x72, 0x92, 0x44, 0x4f, 0x80, 0x26, 0xed,
-	0xb5, 0xef, 0xaa, 0x90, 0x4f, 0x46, 0xc3, 0x3a, 0xe4, 0x3a, 0x4e, 0x44, 0x7b, 0x7e, 0x30, 0x92,
-	0x69, 0xf6, 0xca, 0x8b, 0x66, 0xdf, 0xaa, 0x48, 0x65, 0x92, 0x74, 0xc3, 0xef, 0x83, 0xb8, 0xbb,
-	0x08, 0xb7, 0x14, 0xf6, 0xe6, 0x39, 0x87, 0x3b, 0xe6, 0x47, 0x80, 0x87, 0x81, 0xdb, 0x77, 0x82,
-	0x91, 0x7d, 0x4c, 0x47, 0x71, 0x4a, 0x4a, 0xcd, 0xd8, 0x49, 0x24, 0xf5, 0xee, 0xd1, 0x91, 0x8c,
-	0x7a, 0xb7, 0xa6, 0xfb, 0x4a, 0x6f, 0x39, 0xbf, 0x3f, 0x13, 0x3d, 0x79, 0x92, 0x0f, 0xe3, 0x74,
-	0x9e, 0xe1, 0x8e, 0xc5, 0x9a, 0xda, 0x97, 0x20, 0x17, 0x2f, 0x1e, 0xe7, 0x21, 0x63, 0x04, 0x81,
-	0x1f, 0xa0, 0x39, 0x1e, 0xfc, 0xea, 0x35, 0x11, 0x3f, 0xf7, 0xf6, 0x58, 0xfc, 0xfc, 0x17, 0x35,
-	0xc9, 0xa9, 0x84, 0x3e, 0x39, 0xa1, 0x61, 0x84, 0x7f, 0x06, 0x96, 0x29, 0x77, 0x21, 0xf7, 0x94,
-	0xda, 0x1d, 0x7e, 0x01, 0x63, 0x0e, 0xa4, 0x70, 0xbc, 0x17, 0xb7, 0xc4, 0x7d, 0x31, 0xbe, 0x98,
-	0x91, 0xa5, 0x44, 0x57, 0xb2, 0xba, 0xd8, 0x80, 0x65, 0xb7, 0xdf, 0xa7, 0x5d, 0xd7, 0x89, 0x26,
-	0x07, 0x10, 0x1b, 0xb6, 0x1a, 0xdf, 0x4f, 0xa6, 0xee, 0x77, 0x64, 0x29, 0xe9, 0x91, 0x0c, 0x73,
-	0x05, 0xb2, 0x11, 0xbf, 0x8b, 0x72, 0xdf, 0x2d, 0x6c, 0x17, 0xe3, 0x88, 0xc3, 0x99, 0x44, 0x0a,
-	0xf1, 0x97, 0x40, 0xdc, 0x6c, 0x79, 0x6c, 0x19, 0x3b, 0xc4, 0xf8, 0xc2, 0x42, 0x84, 0x1c, 0x5f,
-	0x81, 0xd2, 0x54, 0x2a, 0xed, 0x72, 0xc0, 0x52, 0xa4, 0x38, 0x99, 0x17, 0xbb, 0xf8, 0x2a, 0xcc,
-	0xfb, 0x22, 0x71, 0xf1, 0xa8, 0x33, 0x5e, 0xf1, 0x74, 0x56, 0x23, 0xb1, 0x16, 0x8b, 0x0d, 0x01,
-	0x0d, 0x69, 0x70, 0x4a, 0xbb, 0x6c, 0xd0, 0x79, 0x3e, 0x28, 0xc4, 0xac, 0x6a, 0x57, 0xfb, 0x29,
-	0x58, 0x4c, 0x20, 0x0e, 0x87, 0xfe, 0x20, 0xa4, 0x78, 0x13, 0xb2, 0x01, 0x3f, 0xef, 0x12, 0x56,
-	0x2c, 0xe7, 0x98, 0x88, 0x04, 0x44, 0x6a, 0x68, 0x5d, 0x58, 0x14, 0x9c, 0x07, 0x6e, 0x74, 0xc4,
-	0x77, 0x12, 0x5f, 0x81, 0x0c, 0x65, 0x8d, 0x33, 0x9b, 0x42, 0x9a, 0x15, 0x2e, 0x27, 0x42, 0x3a,
-	0x31, 0x8b, 0xfa, 0xd2, 0x59, 0xfe, 0x43, 0x85, 0x65, 0xb9, 0xca, 0x5d, 0x27, 0xea, 0x1c, 0xbd,
-	0xa6, 0xde, 0xf0, 0xa3, 0x30, 0xcf, 0xf8, 0x6e, 0x72, 0x72, 0x66, 0xf8, 0x43, 0xac, 0xc1, 0x3c,
-	0xc2, 0x09, 0xed, 0x89, 0xed, 0x97, 0x77, 0xbd, 0xa2, 0x13, 0x4e, 0xdc, 0x0c, 0x66, 0x38, 0x4e,
-	0xf6, 0x25, 0x8e, 0x33, 0x7f, 0x11, 0xc7, 0xd1, 0xf6, 0x60, 0x65, 0x1a, 0x71, 0xe9, 0x1c, 0x3f,
-	0x06, 0xf3, 0x62, 0x53, 0xe2, 0x18, 0x39, 0x6b, 0xdf, 0x62, 0x15, 0xed, 0x13, 0x15, 0x56, 0x64,
-	0xf8, 0xfa, 0x6c, 0x9c, 0xe3, 0x09, 0x9c, 0x33, 0x17, 0x3a, 0xa0, 0x17, 0xdb, 0x3f, 0xad, 0x02,
-	0xab, 0x67, 0x70, 0x7c, 0x85, 0xc3, 0xfa, 0x3d, 0x05, 0x16, 0x76, 0x69, 0xcf, 0x1d, 0xbc, 0xa6,
-	0xbb, 0x30, 0x01, 0x6e, 0xfa, 0x42, 0x4e, 0x3c, 0x84, 0xa2, 0xb4, 0x57, 0xa2, 0x75, 0x1e, 0x6d,
-	0x65, 0xd6, 0x69, 0xb9, 0x05, 0x0b, 0xb2, 0x5a, 0xe0, 0x78, 0xae, 0x13, 0x26, 0xf6, 0x9c, 0x29,
-	0x17, 0xe8, 0x4c, 0x48, 0x64, 0x61, 0x81, 0x13, 0xda, 0xbf, 0x2a, 0x50, 0xac, 0xf8, 0xfd, 0xbe,
-	0x1b, 0xbd, 0xa6, 0x18, 0x9f, 0x47, 0x28, 0x3d, 0xcb, 0x1f, 0xaf, 0x43, 0x29, 0x36, 0x53, 0x42,
-	0x7b, 0x26, 0xd3, 0x28, 0xe7, 0x32, 0xcd, 0xbf, 0x29, 0xb0, 0x48, 0x7c, 0xcf, 0x3b, 0x74, 0x3a,
-	0xc7, 0x6f, 0x36, 0x38, 0x37, 0x00, 0x8d, 0x0d, 0xbd, 0x28, 0x3c, 0xff, 0xad, 0x40, 0xa9, 0x19,
-	0xd0, 0xa1, 0x13, 0xd0, 0x37, 0x1a, 0x1d, 0x76, 0x4d, 0xef, 0x46, 0xf2, 0x82, 0x93, 0x27, 0xbc,
-	0xad, 0x2d, 0xc1, 0x62, 0x62, 0xbb, 0x00, 0x4c, 0xfb, 0x7b, 0x05, 0x56, 0x85, 0x8b, 0x49, 0x49,
-	0xf7, 0x35, 0x85, 0x25, 0xb6, 0x37, 0x3d, 0x61, 0x6f, 0x19, 0x2e, 0x9d, 0xb5, 0x4d, 0x9a, 0xfd,
-	0x75, 0x15, 0xde, 0x89, 0x9d, 0xe7, 0x35, 0x37, 0xfc, 0x07, 0xf0, 0x87, 0x35, 0x28, 0x9f, 0x07,
-	0x41, 0x22, 0xf4, 0x2d, 0x15, 0xca, 0x95, 0x80, 0x3a, 0x11, 0x9d, 0xb8, 0x07, 0xbd, 0x39, 0xbe,
-	0x81, 0xaf, 0xc3, 0xc2, 0xd0, 0x09, 0x22, 0xb7, 0xe3, 0x0e, 0x1d, 0xf6, 0x29, 0x9a, 0xe1, 0xd7,
-	0xac, 0x33, 0x03, 0x4c, 0xa9, 0x68, 0x97, 0xe1, 0xdd, 0x19, 0x88, 0x48, 0xbc, 0xfe, 0x47, 0x01,
-	0xdc, 0x8a, 0x9c, 0x20, 0xfa, 0x0c, 0xe4, 0xa5, 0x99, 0xce, 0xb4, 0x0a, 0xcb, 0x53, 0xf6, 0x4f,
-	0xe2, 0x42, 0xa3, 0xcf, 0x44, 0x4a, 0xfa, 0x54, 0x5c, 0x26, 0xed, 0x97, 0xb8, 0xfc, 0x93, 0x02,
-	0x6b, 0x15, 0x5f, 0x14, 0x3d, 0xdf, 0xc8, 0x13, 0xa6, 0xbd, 0x0f, 0x97, 0x67, 0x1a, 0x28, 0x01,
-	0xf8, 0x07, 0x05, 0x2e, 0x11, 0xea, 0x74, 0xdf, 0x4c, 0xe3, 0xef, 0xc3, 0x3b, 0xe7, 0x8c, 0x93,
-	0x77, 0x94, 0x9b, 0x90, 0xeb, 0xd3, 0xc8, 0x61, 0x37, 0x5c, 0x69, 0xd2, 0x5a, 0x3c, 0xee, 0x58,
-	0xbb, 0x2e, 0x35, 0x48, 0xa2, 0xab, 0xfd, 0xb3, 0x0a, 0xcb, 0xfc, 0x9e, 0xfd, 0xf6, 0x23, 0xef,
-	0x42, 0x55, 0x98, 0xec, 0xd9, 0xcb, 0x1f, 0x53, 0x18, 0x06, 0xd4, 0x8e, 0xab, 0x03, 0xf3, 0xfc,
-	0xaf, 0x42, 0x18, 0x06, 0xf4, 0xbe, 0xe0, 0x68, 0x7f, 0xad, 0xc0, 0xca, 0x34, 0xc4, 0xc9, 0x17,
-	0xcd, 0xff, 0x75, 0xb5, 0x65, 0x46, 0x48, 0x49, 0x5d, 0xe4, 0x23, 0x29, 0x7d, 0xe1, 0x8f, 0xa4,
-	0xbf, 0x51, 0xa1, 0x3c, 0x69, 0xcc, 0xdb, 0x9a, 0xce, 0x74, 0x4d, 0xe7, 0xfb, 0xad, 0xf2, 0x69,
-	0x7f, 0xab, 0xc0, 0xbb, 0x33, 0x00, 0xfd, 0xfe, 0x5c, 0x64, 0xa2, 0xb2, 0xa3, 0xbe, 0xb4, 0xb2,
-	0xf3, 0xc3, 0x77, 0x92, 0xbf, 0x53, 0x60, 0xa5, 0x2e, 0x6a, 0xf5, 0xa2, 0xf2, 0xf1, 0xfa, 0xc6,
-	0x60, 0x5e, 0x8e, 0x4f, 0x8f, 0xff, 0xad, 0xd2, 0x2a, 0xb0, 0x7a, 0xc6, 0xb4, 0x57, 0xa8, 0xe6,
-	0xfc, 0x97, 0x02, 0x4b, 0x72, 0x14, 0xfd, 0xb5, 0xbd, 0xbe, 0xcc, 0x40, 0x07, 0x7f, 0x00, 0x29,
-	0xb7, 0x1b, 0xdf, 0x7b, 0xa7, 0x9f, 0x0c, 0x30, 0x81, 0x76, 0x1b, 0xf0, 0xa4, 0xdd, 0xaf, 0x00,
-	0xdd, 0xbf, 0xab, 0xb0, 0x4a, 0x44, 0xf4, 0x7d, 0xfb, 0xff, 0xc2, 0x0f, 0xfa, 0xff, 0xc2, 0x8b,
-	0x13, 0xd7, 0x27, 0xfc, 0x32, 0x35, 0x0d, 0xf5, 0x0f, 0x2f, 0x75, 0x9d, 0x49, 0xb4, 0xa9, 0x73,
-	0x89, 0xf6, 0xd5, 0xe3, 0xd1, 0x27, 0x2a, 0xac, 0x49, 0x43, 0xde, 0xde, 0x75, 0x2e, 0xee, 0x11,
-	0xd9, 0x73, 0x1e, 0xf1, 0x9f, 0x0a, 0x5c, 0x9e, 0x09, 0xe4, 0xff, 0xfb, 0x8d, 0xe6, 0x8c, 0xf7,
-	0xa4, 0x5f, 0xea, 0x3d, 0x99, 0x0b, 0x7b, 0xcf, 0x37, 0x55, 0x28, 0x11, 0xea, 0x51, 0x27, 0x7c,
-	0xc3, 0xab, 0x7b, 0x67, 0x30, 0xcc, 0x9c, 0xab, 0x73, 0x2e, 0xc1, 0x62, 0x02, 0x84, 0xfc, 0xe0,
-	0xe2, 0x1f, 0xe8, 0x2c, 0x0f, 0x7e, 0x4c, 0x1d, 0x2f, 0x8a, 0x6f, 0x82, 0xda, 0x9f, 0xaa, 0x50,
-	0x24, 0x8c, 0xe3, 0xf6, 0x69, 0x2b, 0x72, 0xa2, 0x10, 0x7f, 0x0e, 0x16, 0x8e, 0xb8, 0x8a, 0x3d,
-	0xf6, 0x90, 0x3c, 0x29, 0x08, 0x9e, 0xf8, 0xf7, 0x71, 0x1b, 0x56, 0x43, 0xda, 0xf1, 0x07, 0xdd,
-	0xd0, 0x3e, 0xa4, 0x47, 0xee, 0xa0, 0x6b, 0xf7, 0x9d, 0x30, 0xa2, 0x01, 0x87, 0xa5, 0x48, 0x96,
-	0xa5, 0x70, 0x97, 0xcb, 0xea, 0x5c, 0x84, 0xaf, 0xc1, 0xca, 0xa1, 0x3b, 0xf0, 0xfc, 0x9e, 0x3d,
-	0xf4, 0x9c, 0x11, 0x0d, 0x42, 0xbb, 0xe3, 0x9f, 0x0c, 0x04, 0x1e, 0x19, 0x82, 0x85, 0xac, 0x29,
-	0x44, 0x15, 0x26, 0xc1, 0x8f, 0x60, 0x73, 0xe6, 0x2c, 0xf6, 0x63, 0xd7, 0x8b, 0x68, 0x40, 0xbb,
-	0x76, 0x40, 0x87, 0x9e, 0xdb, 0x11, 0xcf, 0xa1, 0x04, 0x50, 0x5f, 0x9c, 0x31, 0xf5, 0xbe, 0x54,
-	0x27, 0x63, 0x6d, 0x7c, 0x19, 0xf2, 0x9d, 0xe1, 0x89, 0x7d, 0xc2, 0x1f, 0x2d, 0x30, 0xfc, 0x14,
-	0x92, 0xeb, 0x0c, 0x4f, 0xda, 0x8c, 0xc6, 0x08, 0x52, 0x4f, 0x86, 0x22, 0x38, 0x2b, 0x84, 0x35,
-	0xb5, 0xef, 0x29, 0x50, 0xd2, 0x7b, 0xbd, 0x80, 0xf6, 0x9c, 0x48, 0xc2, 0x74, 0x0d, 0x56, 0x04,
-	0x24, 0x23, 0x5b, 0xba, 0xab, 0xb0, 0x47, 0x11, 0xf6, 0x48, 0x99, 0xf0, 0x55, 0x61, 0xcf, 0x0e,
-	0x5c, 0x3a, 0x19, 0xcc, 0xec, 0xa3, 0xf2, 0x3e, 0x2b, 0x89, 0x74, 0xb2, 0xd7, 0x4f, 0xc2, 0xbb,
-	0xb3, 0x51, 0xe8, 0xbb, 0xe2, 0x49, 0x62, 0x91, 0x5c, 0x9a, 0x61, 0x74, 0xdd, 0x1d, 0xbc, 0xa0,
-	0xab, 0xf3, 0x8c, 0xe3, 0xf5, 0x29, 0x5d, 0x9d, 0x67, 0xda, 0x9f, 0x27, 0xff, 0x29, 0xc6, 0xee,
-	0x92, 0x04, 0x8e, 0xd8, 0x91, 0x95, 0x17, 0x39, 0x72, 0x19, 0xe6, 0x99, 0x33, 0xba, 0x83, 0x1e,
-	0x37, 0x2e, 0x47, 0x62, 0x12, 0xb7, 0xe0, 0x8b, 0xd2, 0x76, 0xfa, 0x2c, 0xa2, 0xc1, 0xc0, 0xf1,
-	0xbc, 0x91, 0x2d, 0xca, 0x8f, 0x83, 0x88, 0x76, 0xed, 0xf1, 0x13, 0x4d, 0x11, 0x3e, 0x3e, 0x2f,
-	0xb4, 0x8d, 0x44, 0x99, 0x24, 0xba, 0x56, 0xf2, 0x78, 0xf3, 0x2b, 0x50, 0x0a, 0xa4, 0x13, 0xdb,
-	0x21, 0xdb, 0x1e, 0x19, 0x72, 0x57, 0xe2, 0x57, 0x13, 0x93, 0x1e, 0x4e, 0x8a, 0xc1, 0x94, 0xc3,
-	0xbf, 0x72, 0xc0, 0xb9, 0x9b, 0xce, 0x65, 0xd1, 0xbc, 0xf6, 0x17, 0x0a, 0x2c, 0xcf, 0xf8, 0x76,
-	0x4f, 0x0a, 0x03, 0xca, 0x44, 0xdd, 0xf1, 0xc7, 0x21, 0xc3, 0x1f, 0xb4, 0xc8, 0x37, 0x54, 0xef,
-	0x9c, 0xff, 0xf4, 0xe7, 0x8f, 0x4f, 0x88, 0xd0, 0x62, 0x67, 0x91, 0xdb, 0xd4, 0xe1, 0x85, 0xc7,
-	0x38, 0xa2, 0x16, 0x18, 0x4f, 0xd4, 0x22, 0xcf, 0x57, 0x32, 0xd3, 0x2f, 0xad, 0x64, 0x6e, 0xfe,
-	0x76, 0x0a, 0xf2, 0xf5, 0x51, 0xeb, 0x89, 0xb7, 0xef, 0x39, 0x3d, 0xfe, 0x3a, 0xa4, 0xde, 0xb4,
-	0x1e, 0xa2, 0x39, 0xbc, 0x04, 0x45, 0xb3, 0x61, 0xd9, 0x66, 0xbb, 0x56, 0xb3, 0xf7, 0x6b, 0xfa,
-	0x1d, 0xa4, 0x60, 0x04, 0x0b, 0x4d, 0x52, 0xb5, 0xef, 0x19, 0x0f, 0x05, 0x47, 0xc5, 0xcb, 0xb0,
-	0xd8, 0x36, 0xab, 0xf7, 0xdb, 0xc6, 0x98, 0x99, 0xc6, 0xab, 0xb0, 0x54, 0x6f, 0xd7, 0xac, 0x6a,
-	0xb3, 0x36, 0xc1, 0xce, 0xe1, 0x22, 0xe4, 0x77, 0x6b, 0x8d, 0x5d, 0x41, 0x22, 0x36, 0x7e, 0xdb,
-	0x6c, 0x55, 0xef, 0x98, 0xc6, 0x9e, 0x60, 0xad, 0x33, 0xd6, 0x23, 0x83, 0x34, 0xf6, 0xab, 0xf1,
-	0x94, 0xb7, 0x31, 0x82, 0xc2, 0x6e, 0xd5, 0xd4, 0x89, 0x1c, 0xe5, 0xb9, 0x82, 0x4b, 0x90, 0x37,
-	0xcc, 0x76, 0x5d, 0xd2, 0x2a, 0x2e, 0xc3, 0xb2, 0xde, 0xb6, 0x1a, 0x76, 0xd5, 0xac, 0x10, 0xa3,
-	0x6e, 0x98, 0x96, 0x94, 0xa4, 0xf1, 0x32, 0x94, 0xac, 0x6a, 0xdd, 0x68, 0x59, 0x7a, 0xbd, 0x29,
-	0x99, 0x6c, 0x15, 0xb9, 0x96, 0x11, 0xeb, 0x20, 0xbc, 0x06, 0xab, 0x66, 0xc3, 0x8e, 0x9f, 0xcf,
-	0x1d, 0xe8, 0xb5, 0xb6, 0x21, 0x65, 0xeb, 0xf8, 0x1d, 0xc0, 0x0d, 0xd3, 0x6e, 0x37, 0xf7, 0x74,
-	0xcb, 0xb0, 0xcd, 0xc6, 0x03, 0x29, 0xb8, 0x8d, 0x4b, 0x90, 0x1b, 0xaf, 0xe0, 0x39, 0x43, 0xa1,
-	0xd8, 0xd4, 0x89, 0x35, 0x36, 0xf6, 0xf9, 0x73, 0x06, 0x16, 0xdc, 0x21, 0x8d, 0x76, 0x73, 0xac,
-	0xb6, 0x04, 0x05, 0x09, 0x96, 0x64, 0xa5, 0x19, 0x6b, 0xb7, 0x6a, 0x56, 0x92, 0xf5, 0x3d, 0xcf,
-	0xad, 0xa9, 0x48, 0xd9, 0x3c, 0x86, 0x34, 0xdf, 0x8e, 0x1c, 0xa4, 0xcd, 0x86, 0x69, 0xa0, 0x39,
-	0xbc, 0x08, 0x50, 0x6d, 0x55, 0x4d, 0xcb, 0xb8, 0x43, 0xf4, 0x1a, 0x33, 0x9b, 0x33, 0x62, 0x00,
-	0x99, 0xb5, 0x0b, 0x30, 0x5f, 0x6d, 0xed, 0xd7, 0x1a, 0xba, 0x25, 0xcd, 0xac, 0xb6, 0xee, 0xb7,
-	0x1b, 0x16, 0x13, 0x22, 0x5c, 0x80, 0x6c, 0xb5, 0x65, 0x19, 0x5f, 0xb3, 0x98, 0x5d, 0x5c, 0x26,
-	0x50, 0x45, 0xcf, 0x6f, 0x6f, 0x7e, 0x3b, 0x05, 0x69, 0xfe, 0xf6, 0xba, 0x08, 0x79, 0xbe, 0xdb,
-	0xd6, 0xc3, 0x26, 0x9b, 0x32, 0x0f, 0xe9, 0xaa, 0x69, 0xdd, 0x42, 0x3f, 0xa7, 0x62, 0x80, 0x4c,
-	0x9b, 0xb7, 0x7f, 0x3e, 0xcb, 0xda, 0x55, 0xd3, 0xba, 0x7e, 0x13, 0x7d, 0x5d, 0x65, 0xc3, 0xb6,
-	0x05, 0xf1, 0x0b, 0xb1, 0x60, 0x7b, 0x07, 0x7d, 0x23, 0x11, 0x6c, 0xef, 0xa0, 0x5f, 0x8c, 0x05,
-	0x37, 0xb6, 0xd1, 0x37, 0x13, 0xc1, 0x8d, 0x6d, 0xf4, 0x4b, 0xb1, 0xe0, 0xe6, 0x0e, 0xfa, 0xe5,
-	0x44, 0x70, 0x73, 0x07, 0xfd, 0x4a, 0x96, 0xd9, 0xc2, 0x2d, 0xb9, 0xb1, 0x8d, 0x7e, 0x35, 0x97,
-	0x50, 0x37, 0x77, 0xd0, 0xaf, 0xe5, 0xd8, 0xfe, 0x27, 0xbb, 0x8a, 0x7e, 0x1d, 0xb1, 0x65, 0xb2,
-	0x0d, 0x42, 0xbf, 0xc1, 0x9b, 0x4c, 0x84, 0x7e, 0x13, 0x31, 0x1b, 0x19, 0x97, 0x93, 0xdf, 0xe2,
-	0x92, 0x87, 0x86, 0x4e, 0xd0, 0x6f, 0x65, 0xc5, 0x83, 0xce, 0x4a, 0xb5, 0xae, 0xd7, 0x10, 0xe6,
-	0x3d, 0x18, 0x2a, 0xbf, 0x73, 0x8d, 0x35, 0x99, 0x7b, 0xa2, 0xdf, 0x6d, 0xb2, 0x09, 0x0f, 0x74,
-	0x52, 0xf9, 0x58, 0x27, 0xe8, 0xf7, 0xae, 0xb1, 0x09, 0x0f, 0x74, 0x22, 0xf1, 0xfa, 0xfd, 0x26,
-	0x53, 0xe4, 0xa2, 0x3f, 0xb8, 0xc6, 0x16, 0x2d, 0xf9, 0x7f, 0xd8, 0xc4, 0x39, 0x48, 0xed, 0x56,
-	0x2d, 0xf4, 0x6d, 0x3e, 0x1b, 0x73, 0x51, 0xf4, 0x47, 0x88, 0x31, 0x5b, 0x86, 0x85, 0xbe, 0xc3,
-	0x98, 0x19, 0xab, 0xdd, 0xac, 0x19, 0xe8, 0x3d, 0xb6, 0xb8, 0x3b, 0x46, 0xa3, 0x6e, 0x58, 0xe4,
-	0x21, 0xfa, 0x63, 0xae, 0x7e, 0xb7, 0xd5, 0x30, 0xd1, 0x77, 0x11, 0x2e, 0x01, 0x18, 0x5f, 0x6b,
-	0x12, 0xa3, 0xd5, 0xaa, 0x36, 0x4c, 0xf4, 0xe1, 0xe6, 0x3e, 0xa0, 0xb3, 0xe1, 0x80, 0x19, 0xd0,
-	0x36, 0xef, 0x99, 0x8d, 0x07, 0x26, 0x9a, 0x63, 0x44, 0x93, 0x18, 0x4d, 0x9d, 0x18, 0x48, 0xc1,
-	0x00, 0x59, 0xf9, 0x4c, 0x94, 0xbf, 0xf7, 0x24, 0x8d, 0x5a, 0x6d, 0x57, 0xaf, 0xdc, 0x43, 0xa9,
-	0xdd, 0x2f, 0xc3, 0xa2, 0xeb, 0x6f, 0x9d, 0xba, 0x11, 0x0d, 0x43, 0xf1, 0xba, 0xff, 0x91, 0x26,
-	0x29, 0xd7, 0xbf, 0x2a, 0x5a, 0x57, 0x7b, 0xfe, 0xd5, 0xd3, 0xe8, 0x2a, 0x97, 0x5e, 0xe5, 0x11,
-	0xe3, 0x30, 0xcb, 0x89, 0x1b, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xbd, 0xb2, 0x28, 0xeb, 0x3b,
+	// 3283 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x4b, 0x70, 0x1b, 0x5b,
+	0x5a, 0x76, 0xb7, 0x1e, 0x96, 0x7e, 0x59, 0xf2, 0xf1, 0xb1, 0x9d, 0xab, 0xeb, 0xfb, 0xf2, 0xf4,
+	0xcc, 0x9d, 0x31, 0x06, 0x9c, 0x5c, 0xc7, 0x13, 0xc2, 0x9d, 0x01, 0x6e, 0x5b, 0x6e, 0xe7, 0x2a,
+	0x91, 0x5a, 0xca, 0x51, 0xcb, 0x99, 0xa4, 0xa8, 0xea, 0x6a, 0x4b, 0x27, 0x72, 0x97, 0x5b, 0x6a,
+	0xa5, 0xbb, 0xe5, 0x44, 0xbb, 0xc0, 0x30, 0x0c, 0x6f, 0x86, 0x37, 0xc3, 0x14, 0x53, 0xec, 0x28,
+	0x36, 0xac, 0xa9, 0x62, 0x37, 0x8b, 0xbb, 0x60, 0x41, 0x15, 0x4b, 0x60, 0x01, 0x2c, 0x28, 0x58,
+	0x51, 0x14, 0x0b, 0x16, 0x2c, 0x28, 0xea, 0x3c, 0xba, 0x25, 0xd9, 0xba, 0x89, 0x27, 0xc3, 0xd4,
+	0x54, 0x72, 0xb3, 0xf2, 0xf9, 0x1f, 0xe7, 0xf1, 0x7f, 0xe7, 0x3f, 0xff, 0x7f, 0x74, 0xfa, 0x37,
+	0x14, 0x1e, 0x8d, 0x68, 0x30, 0xde, 0x19, 0x06, 0x7e, 0xe4, 0xe3, 0x0c, 0x27, 0x36, 0x4a, 0x91,
+	0x3f, 0xf4, 0xbb, 0x4e, 0xe4, 0x08, 0xf6, 0x46, 0xe1, 0x2c, 0x0a, 0x86, 0x1d, 0x41, 0x68, 0xdf,
+	0x50, 0x20, 0x6b, 0x39, 0x41, 0x8f, 0x46, 0x78, 0x03, 0x72, 0xa7, 0x74, 0x1c, 0x0e, 0x9d, 0x0e,
+	0x2d, 0x2b, 0x9b, 0xca, 0x56, 0x9e, 0x24, 0x34, 0x5e, 0x83, 0x4c, 0x78, 0xe2, 0x04, 0xdd, 0xb2,
+	0xca, 0x05, 0x82, 0xc0, 0x5f, 0x86, 0x42, 0xe4, 0x1c, 0x7b, 0x34, 0xb2, 0xa3, 0xf1, 0x90, 0x96,
+	0x53, 0x9b, 0xca, 0x56, 0x69, 0x77, 0x6d, 0x27, 0x99, 0xcf, 0xe2, 0x42, 0x6b, 0x3c, 0xa4, 0x04,
+	0xa2, 0xa4, 0x8d, 0x31, 0xa4, 0x3b, 0xd4, 0xf3, 0xca, 0x69, 0x3e, 0x16, 0x6f, 0x6b, 0x07, 0x50,
+	0x3a, 0xb2, 0x6e, 0x39, 0x11, 0xad, 0x38, 0x9e, 0x47, 0x83, 0xea, 0x01, 0x5b, 0xce, 0x28, 0xa4,
+	0xc1, 0xc0, 0xe9, 0x27, 0xcb, 0x89, 0x69, 0x7c, 0x05, 0xb2, 0xbd, 0xc0, 0x1f, 0x0d, 0xc3, 0xb2,
+	0xba, 0x99, 0xda, 0xca, 0x13, 0x49, 0x69, 0x3f, 0x0f, 0x60, 0x9c, 0xd1, 0x41, 0x64, 0xf9, 0xa7,
+	0x74, 0x80, 0xdf, 0x86, 0x7c, 0xe4, 0xf6, 0x69, 0x18, 0x39, 0xfd, 0x21, 0x1f, 0x22, 0x45, 0x26,
+	0x8c, 0x4f, 0x31, 0x69, 0x03, 0x72, 0x43, 0x3f, 0x74, 0x23, 0xd7, 0x1f, 0x70, 0x7b, 0xf2, 0x24,
+	0xa1, 0xb5, 0x9f, 0x85, 0xcc, 0x91, 0xe3, 0x8d, 0x28, 0x7e, 0x0f, 0xd2, 0xdc, 0x60, 0x85, 0x1b,
+	0x5c, 0xd8, 0x11, 0xa0, 0x73, 0x3b, 0xb9, 0x80, 0x8d, 0x7d, 0xc6, 0x34, 0xf9, 0xd8, 0x4b, 0x44,
+	0x10, 0xda, 0x29, 0x2c, 0xed, 0xbb, 0x83, 0xee, 0x91, 0x13, 0xb8, 0x0c, 0x8c, 0x17, 0x1c, 0x06,
+	0x7f, 0x01, 0xb2, 0xbc, 0x11, 0x96, 0x53, 0x9b, 0xa9, 0xad, 0xc2, 0xee, 0x92, 0xec, 0xc8, 0xd7,
+	0x46, 0xa4, 0x4c, 0xfb, 0x9e, 0x02, 0xb0, 0xef, 0x8f, 0x06, 0xdd, 0xbb, 0x4c, 0x88, 0x11, 0xa4,
+	0xc2, 0x47, 0x9e, 0x04, 0x92, 0x35, 0xf1, 0x1d, 0x28, 0x1d, 0xbb, 0x83, 0xae, 0x7d, 0x26, 0x97,
+	0x23, 0xb0, 0x2c, 0xec, 0x7e, 0x41, 0x0e, 0x37, 0xe9, 0xbc, 0x33, 0xbd, 0xea, 0xd0, 0x18, 0x44,
+	0xc1, 0x98, 0x14, 0x8f, 0xa7, 0x79, 0x1b, 0x6d, 0xc0, 0x17, 0x95, 0xd8, 0xa4, 0xa7, 0x74, 0x1c,
+	0x4f, 0x7a, 0x4a, 0xc7, 0xf8, 0xc7, 0xa6, 0x2d, 0x2a, 0xec, 0xae, 0xc6, 0x73, 0x4d, 0xf5, 0x95,
+	0x66, 0x7e, 0xa8, 0xde, 0x54, 0xb4, 0xbf, 0x5e, 0x84, 0x92, 0xf1, 0x84, 0x76, 0x46, 0x11, 0x6d,
+	0x0c, 0xd9, 0x1e, 0x84, 0xb8, 0x0e, 0xcb, 0xee, 0xa0, 0xe3, 0x8d, 0xba, 0xb4, 0x6b, 0x3f, 0x74,
+	0xa9, 0xd7, 0x0d, 0xb9, 0x1f, 0x95, 0x92, 0x75, 0xcf, 0xea, 0xef, 0x54, 0xa5, 0xf2, 0x21, 0xd7,
+	0x25, 0x25, 0x77, 0x86, 0xc6, 0xdb, 0xb0, 0xd2, 0xf1, 0x5c, 0x3a, 0x88, 0xec, 0x87, 0xcc, 0x5e,
+	0x3b, 0xf0, 0x1f, 0x87, 0xe5, 0xcc, 0xa6, 0xb2, 0x95, 0x23, 0xcb, 0x42, 0x70, 0xc8, 0xf8, 0xc4,
+	0x7f, 0x1c, 0xe2, 0x0f, 0x21, 0xf7, 0xd8, 0x0f, 0x4e, 0x3d, 0xdf, 0xe9, 0x96, 0xb3, 0x7c, 0xce,
+	0x77, 0xe7, 0xcf, 0x79, 0x4f, 0x6a, 0x91, 0x44, 0x1f, 0x6f, 0x01, 0x0a, 0x1f, 0x79, 0x76, 0x48,
+	0x3d, 0xda, 0x89, 0x6c, 0xcf, 0xed, 0xbb, 0x51, 0x39, 0xc7, 0x5d, 0xb2, 0x14, 0x3e, 0xf2, 0x5a,
+	0x9c, 0x5d, 0x63, 0x5c, 0x6c, 0xc3, 0x7a, 0x14, 0x38, 0x83, 0xd0, 0xe9, 0xb0, 0xc1, 0x6c, 0x37,
+	0xf4, 0x3d, 0x87, 0xb5, 0xca, 0x79, 0x3e, 0xe5, 0xf6, 0xfc, 0x29, 0xad, 0x49, 0x97, 0x6a, 0xdc,
+	0x83, 0xac, 0x45, 0x73, 0xb8, 0xf8, 0x03, 0x58, 0x0f, 0x4f, 0xdd, 0xa1, 0xcd, 0xc7, 0xb1, 0x87,
+	0x9e, 0x33, 0xb0, 0x3b, 0x4e, 0xe7, 0x84, 0x96, 0x81, 0x9b, 0x8d, 0x99, 0x90, 0xef, 0x7b, 0xd3,
+	0x73, 0x06, 0x15, 0x26, 0x61, 0xa0, 0x33, 0xbd, 0x01, 0x0d, 0xec, 0x33, 0x1a, 0x84, 0x6c, 0x35,
+	0x85, 0x67, 0x81, 0xde, 0x14, 0xca, 0x47, 0x42, 0x97, 0x94, 0x86, 0x33, 0x34, 0x03, 0x72, 0x18,
+	0xb8, 0x7e, 0xe0, 0x46, 0xe3, 0xf2, 0xd2, 0xb3, 0x80, 0x6c, 0x4a, 0x2d, 0x92, 0xe8, 0x6b, 0x5f,
+	0x81, 0xd2, 0xec, 0x96, 0xe2, 0x15, 0x28, 0x5a, 0xf7, 0x9b, 0x86, 0xad, 0x9b, 0x07, 0xb6, 0xa9,
+	0xd7, 0x0d, 0xb4, 0x80, 0x8b, 0x90, 0xe7, 0xac, 0x86, 0x59, 0xbb, 0x8f, 0x14, 0xbc, 0x08, 0x29,
+	0xbd, 0x56, 0x43, 0xaa, 0x76, 0x13, 0x72, 0xf1, 0xde, 0xe0, 0x65, 0x28, 0xb4, 0xcd, 0x56, 0xd3,
+	0xa8, 0x54, 0x0f, 0xab, 0xc6, 0x01, 0x5a, 0xc0, 0x39, 0x48, 0x37, 0x6a, 0x56, 0x13, 0x29, 0xa2,
+	0xa5, 0x37, 0x91, 0xca, 0x7a, 0x1e, 0xec, 0xeb, 0x28, 0xa5, 0xfd, 0xb9, 0x02, 0x6b, 0xf3, 0x30,
+	0xc6, 0x05, 0x58, 0x3c, 0x30, 0x0e, 0xf5, 0x76, 0xcd, 0x42, 0x0b, 0x78, 0x15, 0x96, 0x89, 0xd1,
+	0x34, 0x74, 0x4b, 0xdf, 0xaf, 0x19, 0x36, 0x31, 0xf4, 0x03, 0xa4, 0x60, 0x0c, 0x25, 0xd6, 0xb2,
+	0x2b, 0x8d, 0x7a, 0xbd, 0x6a, 0x59, 0xc6, 0x01, 0x52, 0xf1, 0x1a, 0x20, 0xce, 0x6b, 0x9b, 0x13,
+	0x6e, 0x0a, 0x23, 0x58, 0x6a, 0x19, 0xa4, 0xaa, 0xd7, 0xaa, 0x0f, 0xd8, 0x00, 0x28, 0x8d, 0x3f,
+	0x07, 0xef, 0x54, 0x1a, 0x66, 0xab, 0xda, 0xb2, 0x0c, 0xd3, 0xb2, 0x5b, 0xa6, 0xde, 0x6c, 0x7d,
+	0xdc, 0xb0, 0xf8, 0xc8, 0xc2, 0xb8, 0x0c, 0x2e, 0x01, 0xe8, 0x6d, 0xab, 0x21, 0xc6, 0x41, 0x59,
+	0xad, 0x0d, 0xa5, 0x59, 0xf8, 0xd9, 0xaa, 0xe4, 0x12, 0xed, 0x66, 0x4d, 0x37, 0x4d, 0x83, 0xa0,
+	0x05, 0x9c, 0x05, 0xf5, 0xe8, 0x3a, 0x52, 0xf8, 0xdf, 0x3d, 0xa4, 0xe2, 0x25, 0xc8, 0x1d, 0xed,
+	0xdd, 0x0a, 0x28, 0xed, 0x8e, 0xc5, 0x4a, 0x8e, 0xf6, 0x6a, 0xf4, 0x61, 0xb4, 0x4b, 0xdc, 0xde,
+	0x49, 0x84, 0xd2, 0xda, 0x7b, 0x90, 0x8b, 0x77, 0x03, 0x03, 0x64, 0xcd, 0x06, 0xa9, 0xeb, 0x35,
+	0xb4, 0xc0, 0x10, 0xaa, 0x35, 0xee, 0x21, 0xe5, 0x76, 0x3a, 0xa7, 0x20, 0xf5, 0x76, 0x3a, 0xa7,
+	0xa2, 0xd4, 0xed, 0x74, 0x2e, 0x85, 0xd2, 0xda, 0x5f, 0xa9, 0x90, 0xe1, 0x7b, 0xc4, 0x22, 0xfe,
+	0x54, 0x1c, 0xe7, 0xed, 0x24, 0xfa, 0xa9, 0xcf, 0x88, 0x7e, 0x3c, 0x69, 0xc8, 0x38, 0x2c, 0x08,
+	0xfc, 0x16, 0xe4, 0xfd, 0xa0, 0x67, 0x0b, 0x89, 0xc8, 0x20, 0x39, 0x3f, 0xe8, 0xf1, 0x54, 0xc3,
+	0xa2, 0x37, 0x4b, 0x3c, 0xc7, 0x4e, 0x48, 0xf9, 0x21, 0xce, 0x93, 0x84, 0xc6, 0x6f, 0x02, 0xd3,
+	0xb3, 0xf9, 0x3a, 0xb2, 0x5c, 0xb6, 0xe8, 0x07, 0x3d, 0x93, 0x2d, 0xe5, 0xf3, 0x50, 0xec, 0xf8,
+	0xde, 0xa8, 0x3f, 0xb0, 0x3d, 0x3a, 0xe8, 0x45, 0x27, 0xe5, 0xc5, 0x4d, 0x65, 0xab, 0x48, 0x96,
+	0x04, 0xb3, 0xc6, 0x79, 0xb8, 0x0c, 0x8b, 0x9d, 0x13, 0x27, 0x08, 0xa9, 0x38, 0xb8, 0x45, 0x12,
+	0x93, 0x7c, 0x56, 0xda, 0x71, 0xfb, 0x8e, 0x17, 0xf2, 0x43, 0x5a, 0x24, 0x09, 0xcd, 0x8c, 0x78,
+	0xe8, 0x39, 0xbd, 0x90, 0x1f, 0xae, 0x22, 0x11, 0x04, 0x7e, 0x0f, 0x0a, 0x72, 0x42, 0x0e, 0x41,
+	0x81, 0x2f, 0x07, 0x04, 0x8b, 0x21, 0xa0, 0xfd, 0x14, 0xa4, 0x88, 0xff, 0x98, 0xcd, 0x29, 0x56,
+	0x14, 0x96, 0x95, 0xcd, 0xd4, 0x16, 0x26, 0x31, 0xc9, 0x32, 0xa0, 0x4c, 0x02, 0x22, 0x37, 0xc4,
+	0x61, 0xff, 0x3b, 0x0a, 0x14, 0xf8, 0xe1, 0x25, 0x34, 0x1c, 0x79, 0x11, 0x4b, 0x16, 0x32, 0x4a,
+	0x2a, 0x33, 0xc9, 0x82, 0xef, 0x0b, 0x91, 0x32, 0x06, 0x00, 0x0b, 0x7c, 0xb6, 0xf3, 0xf0, 0x21,
+	0xed, 0x44, 0x54, 0xe4, 0xc4, 0x34, 0x59, 0x62, 0x4c, 0x5d, 0xf2, 0x18, 0xf2, 0xee, 0x20, 0xa4,
+	0x41, 0x64, 0xbb, 0x5d, 0xbe, 0x27, 0x69, 0x92, 0x13, 0x8c, 0x6a, 0x17, 0xbf, 0x0b, 0x69, 0x1e,
+	0x3a, 0xd3, 0x7c, 0x16, 0x90, 0xb3, 0x10, 0xff, 0x31, 0xe1, 0xfc, 0xdb, 0xe9, 0x5c, 0x06, 0x65,
+	0xb5, 0xaf, 0xc2, 0x12, 0x5f, 0xdc, 0x3d, 0x27, 0x18, 0xb8, 0x83, 0x1e, 0xbf, 0x09, 0xf8, 0x5d,
+	0xe1, 0x17, 0x45, 0xc2, 0xdb, 0xcc, 0xe6, 0x3e, 0x0d, 0x43, 0xa7, 0x47, 0x65, 0x66, 0x8e, 0x49,
+	0xed, 0xcf, 0x52, 0x50, 0x68, 0x45, 0x01, 0x75, 0xfa, 0x3c, 0xc9, 0xe3, 0xaf, 0x02, 0x84, 0x91,
+	0x13, 0xd1, 0x3e, 0x1d, 0x44, 0xb1, 0x7d, 0x6f, 0xcb, 0x99, 0xa7, 0xf4, 0x76, 0x5a, 0xb1, 0x12,
+	0x99, 0xd2, 0xc7, 0xbb, 0x50, 0xa0, 0x4c, 0x6c, 0x47, 0xec, 0xb2, 0x20, 0x13, 0xd2, 0x4a, 0x1c,
+	0x87, 0x92, 0x5b, 0x04, 0x01, 0x9a, 0xb4, 0x37, 0xbe, 0xab, 0x42, 0x3e, 0x19, 0x0d, 0xeb, 0x90,
+	0xeb, 0x38, 0x11, 0xed, 0xf9, 0xc1, 0x58, 0xe6, 0xf0, 0xf7, 0x9f, 0x35, 0xfb, 0x4e, 0x45, 0x2a,
+	0x93, 0xa4, 0x1b, 0x7e, 0x07, 0xc4, 0xc5, 0x48, 0xb8, 0xa5, 0xb0, 0x37, 0xcf, 0x39, 0xdc, 0x31,
+	0x3f, 0x04, 0x3c, 0x0c, 0xdc, 0xbe, 0x13, 0x8c, 0xed, 0x53, 0x3a, 0x8e, 0xf3, 0x5d, 0x6a, 0xce,
+	0x4e, 0x22, 0xa9, 0x77, 0x87, 0x8e, 0x65, 0x58, 0xbc, 0x39, 0xdb, 0x57, 0x7a, 0xcb, 0xc5, 0xfd,
+	0x99, 0xea, 0xc9, 0x6f, 0x10, 0x61, 0x7c, 0x57, 0xc8, 0x70, 0xc7, 0x62, 0x4d, 0xed, 0x4b, 0x90,
+	0x8b, 0x17, 0x8f, 0xf3, 0x90, 0x31, 0x82, 0xc0, 0x0f, 0xc4, 0xd9, 0x3f, 0xa8, 0xd7, 0x44, 0x80,
+	0x3d, 0x38, 0x60, 0x01, 0xf6, 0x5f, 0xd4, 0x24, 0x61, 0x13, 0xfa, 0x68, 0x44, 0xc3, 0x08, 0xff,
+	0x1c, 0xac, 0x52, 0xee, 0x42, 0xee, 0x19, 0xb5, 0x3b, 0xfc, 0x76, 0xc7, 0x1c, 0x48, 0xe1, 0x78,
+	0x2f, 0xef, 0x88, 0xcb, 0x68, 0x7c, 0xeb, 0x23, 0x2b, 0x89, 0xae, 0x64, 0x75, 0xb1, 0x01, 0xab,
+	0x6e, 0xbf, 0x4f, 0xbb, 0xae, 0x13, 0x4d, 0x0f, 0x20, 0x36, 0x6c, 0x3d, 0xbe, 0xfc, 0xcc, 0x5c,
+	0x1e, 0xc9, 0x4a, 0xd2, 0x23, 0x19, 0xe6, 0x7d, 0xc8, 0x46, 0xfc, 0xa2, 0xcb, 0x7d, 0xb7, 0xb0,
+	0x5b, 0x8c, 0x23, 0x0e, 0x67, 0x12, 0x29, 0xc4, 0x5f, 0x02, 0x71, 0x6d, 0xe6, 0xb1, 0x65, 0xe2,
+	0x10, 0x93, 0xdb, 0x10, 0x11, 0x72, 0xfc, 0x3e, 0x94, 0x66, 0xf2, 0x74, 0x97, 0x03, 0x96, 0x22,
+	0xc5, 0x29, 0x6e, 0xb5, 0x8b, 0xaf, 0xc2, 0xa2, 0x2f, 0xb2, 0x59, 0x39, 0x3b, 0xb3, 0xe2, 0xd9,
+	0x54, 0x47, 0x62, 0x2d, 0x16, 0x1b, 0x02, 0x1a, 0xd2, 0xe0, 0x8c, 0x76, 0xd9, 0xa0, 0x8b, 0x7c,
+	0x50, 0x88, 0x59, 0xd5, 0xae, 0xf6, 0x33, 0xb0, 0x9c, 0x40, 0x1c, 0x0e, 0xfd, 0x41, 0x48, 0xf1,
+	0x36, 0x64, 0x03, 0x7e, 0xde, 0x25, 0xac, 0x58, 0xce, 0x31, 0x15, 0x09, 0x88, 0xd4, 0xd0, 0xba,
+	0xb0, 0x2c, 0x38, 0xf7, 0xdc, 0xe8, 0x84, 0xef, 0x24, 0x7e, 0x1f, 0x32, 0x94, 0x35, 0xce, 0x6d,
+	0x0a, 0x69, 0x56, 0xb8, 0x9c, 0x08, 0xe9, 0xd4, 0x2c, 0xea, 0x73, 0x67, 0xf9, 0x4f, 0x15, 0x56,
+	0xe5, 0x2a, 0xf7, 0x9d, 0xa8, 0x73, 0xf2, 0x92, 0x7a, 0xc3, 0x8f, 0xc3, 0x22, 0xe3, 0xbb, 0xc9,
+	0xc9, 0x99, 0xe3, 0x0f, 0xb1, 0x06, 0xf3, 0x08, 0x27, 0xb4, 0xa7, 0xb6, 0x5f, 0x5e, 0x24, 0x8b,
+	0x4e, 0x38, 0x75, 0x75, 0x98, 0xe3, 0x38, 0xd9, 0xe7, 0x38, 0xce, 0xe2, 0x65, 0x1c, 0x47, 0x3b,
+	0x80, 0xb5, 0x59, 0xc4, 0xa5, 0x73, 0xfc, 0x04, 0x2c, 0x8a, 0x4d, 0x89, 0x63, 0xe4, 0xbc, 0x7d,
+	0x8b, 0x55, 0xb4, 0x4f, 0x54, 0x58, 0x93, 0xe1, 0xeb, 0xb3, 0x71, 0x8e, 0xa7, 0x70, 0xce, 0x5c,
+	0xea, 0x80, 0x5e, 0x6e, 0xff, 0xb4, 0x0a, 0xac, 0x9f, 0xc3, 0xf1, 0x05, 0x0e, 0xeb, 0x7f, 0x28,
+	0xb0, 0xb4, 0x4f, 0x7b, 0xee, 0xe0, 0x25, 0xdd, 0x85, 0x29, 0x70, 0xd3, 0x97, 0x72, 0xe2, 0x21,
+	0x14, 0xa5, 0xbd, 0x12, 0xad, 0x8b, 0x68, 0x2b, 0xf3, 0x4e, 0xcb, 0x4d, 0x58, 0x92, 0x4f, 0x11,
+	0x8e, 0xe7, 0x3a, 0x61, 0x62, 0xcf, 0xb9, 0xb7, 0x08, 0x9d, 0x09, 0x49, 0x21, 0x9a, 0x10, 0xda,
+	0xbf, 0x2a, 0x50, 0xac, 0xf8, 0xfd, 0xbe, 0x1b, 0xbd, 0xa4, 0x18, 0x5f, 0x44, 0x28, 0x3d, 0xcf,
+	0x1f, 0x3f, 0x80, 0x52, 0x6c, 0xa6, 0x84, 0xf6, 0x5c, 0xa6, 0x51, 0x2e, 0x64, 0x9a, 0x7f, 0x53,
+	0x60, 0x99, 0xf8, 0x9e, 0x77, 0xec, 0x74, 0x4e, 0x5f, 0x6d, 0x70, 0xae, 0x03, 0x9a, 0x18, 0x7a,
+	0x59, 0x78, 0xfe, 0x47, 0x81, 0x52, 0x33, 0xa0, 0x43, 0x27, 0xa0, 0xaf, 0x34, 0x3a, 0xec, 0x9a,
+	0xde, 0x8d, 0xe4, 0x05, 0x27, 0x4f, 0x78, 0x5b, 0x5b, 0x81, 0xe5, 0xc4, 0x76, 0x01, 0x98, 0xf6,
+	0x0f, 0x0a, 0xac, 0x0b, 0x17, 0x93, 0x92, 0xee, 0x4b, 0x0a, 0x4b, 0x6c, 0x6f, 0x7a, 0xca, 0xde,
+	0x32, 0x5c, 0x39, 0x6f, 0x9b, 0x34, 0xfb, 0xeb, 0x2a, 0xbc, 0x11, 0x3b, 0xcf, 0x4b, 0x6e, 0xf8,
+	0x0f, 0xe0, 0x0f, 0x1b, 0x50, 0xbe, 0x08, 0x82, 0x44, 0xe8, 0x5b, 0x2a, 0x94, 0x2b, 0x01, 0x75,
+	0x22, 0x3a, 0x75, 0x0f, 0x7a, 0x75, 0x7c, 0x03, 0x7f, 0x00, 0x4b, 0x43, 0x27, 0x88, 0xdc, 0x8e,
+	0x3b, 0x74, 0xd8, 0x4f, 0xd1, 0xcc, 0x66, 0xea, 0xe2, 0x00, 0x33, 0x2a, 0xda, 0x5b, 0xf0, 0xe6,
+	0x1c, 0x44, 0x24, 0x5e, 0xff, 0xab, 0x00, 0x6e, 0x45, 0x4e, 0x10, 0x7d, 0x06, 0xf2, 0xd2, 0x5c,
+	0x67, 0x5a, 0x87, 0xd5, 0x19, 0xfb, 0xa7, 0x71, 0xa1, 0xd1, 0x67, 0x22, 0x25, 0x7d, 0x2a, 0x2e,
+	0xd3, 0xf6, 0x4b, 0x5c, 0xfe, 0x49, 0x81, 0x8d, 0x8a, 0x2f, 0x5e, 0x45, 0x5f, 0xc9, 0x13, 0xa6,
+	0xbd, 0x03, 0x6f, 0xcd, 0x35, 0x50, 0x02, 0xf0, 0x8f, 0x0a, 0x5c, 0x21, 0xd4, 0xe9, 0xbe, 0x9a,
+	0xc6, 0xdf, 0x85, 0x37, 0x2e, 0x18, 0x27, 0xef, 0x28, 0x37, 0x20, 0xd7, 0xa7, 0x91, 0xd3, 0x75,
+	0x22, 0x47, 0x9a, 0xb4, 0x11, 0x8f, 0x3b, 0xd1, 0xae, 0x4b, 0x0d, 0x92, 0xe8, 0x6a, 0xff, 0xac,
+	0xc2, 0x2a, 0xbf, 0x67, 0xbf, 0xfe, 0x91, 0x77, 0xa9, 0x57, 0x98, 0xec, 0xf9, 0xcb, 0x1f, 0x53,
+	0x18, 0x06, 0xd4, 0x8e, 0x5f, 0x07, 0x16, 0xf9, 0x77, 0x48, 0x18, 0x06, 0xf4, 0xae, 0xe0, 0x68,
+	0x7f, 0xa3, 0xc0, 0xda, 0x2c, 0xc4, 0xc9, 0x2f, 0x9a, 0xff, 0xef, 0xd7, 0x96, 0x39, 0x21, 0x25,
+	0x75, 0x99, 0x1f, 0x49, 0xe9, 0x4b, 0xff, 0x48, 0xfa, 0x5b, 0x15, 0xca, 0xd3, 0xc6, 0xbc, 0x7e,
+	0xd3, 0x99, 0x7d, 0xd3, 0xf9, 0x7e, 0x5f, 0xf9, 0xb4, 0xbf, 0x53, 0xe0, 0xcd, 0x39, 0x80, 0x7e,
+	0x7f, 0x2e, 0x32, 0xf5, 0xb2, 0xa3, 0x3e, 0xf7, 0x65, 0xe7, 0x87, 0xef, 0x24, 0x7f, 0xaf, 0xc0,
+	0x5a, 0x5d, 0xbc, 0xd5, 0x8b, 0x97, 0x8f, 0x97, 0x37, 0x06, 0xf3, 0xe7, 0xf8, 0xf4, 0xe4, 0x6b,
+	0x15, 0x7b, 0xcd, 0x39, 0x67, 0xda, 0x0b, 0xbc, 0xe6, 0xfc, 0xb7, 0x02, 0x2b, 0x72, 0x14, 0xbd,
+	0x73, 0xfa, 0xea, 0xa0, 0x83, 0xdf, 0x85, 0x94, 0xdb, 0x8d, 0xef, 0xbd, 0xb3, 0xf5, 0x08, 0x4c,
+	0xa0, 0x7d, 0x04, 0x78, 0xda, 0xee, 0x17, 0x80, 0xee, 0xdf, 0x55, 0x58, 0x27, 0x22, 0xfa, 0xbe,
+	0xfe, 0xbe, 0xf0, 0x83, 0x7e, 0x5f, 0x78, 0x76, 0xe2, 0xfa, 0x84, 0x5f, 0xa6, 0x66, 0xa1, 0xfe,
+	0xe1, 0xa5, 0xae, 0x73, 0x89, 0x36, 0x75, 0x21, 0xd1, 0xbe, 0x78, 0x3c, 0xfa, 0x44, 0x85, 0x0d,
+	0x69, 0xc8, 0xeb, 0xbb, 0xce, 0xe5, 0x3d, 0x22, 0x7b, 0xc1, 0x23, 0xfe, 0x4b, 0x81, 0xb7, 0xe6,
+	0x02, 0xf9, 0x23, 0xbf, 0xd1, 0x9c, 0xf3, 0x9e, 0xf4, 0x73, 0xbd, 0x27, 0x73, 0x69, 0xef, 0xf9,
+	0xa6, 0x0a, 0x25, 0x42, 0x3d, 0xea, 0x84, 0xaf, 0xf8, 0xeb, 0xde, 0x39, 0x0c, 0x33, 0x17, 0xde,
+	0x39, 0x57, 0x60, 0x39, 0x01, 0x42, 0xfe, 0xe0, 0xe2, 0x3f, 0xd0, 0x59, 0x1e, 0xfc, 0x98, 0x3a,
+	0x5e, 0x14, 0xdf, 0x04, 0xb5, 0xef, 0xa9, 0x50, 0x24, 0x8c, 0xe3, 0xf6, 0x29, 0xfb, 0xee, 0x1d,
+	0xe2, 0xcf, 0xc1, 0xd2, 0x09, 0x57, 0xb1, 0x27, 0x1e, 0x92, 0x27, 0x05, 0xc1, 0x13, 0x5f, 0x1f,
+	0x77, 0x61, 0x3d, 0xa4, 0x1d, 0x7f, 0xd0, 0x0d, 0xed, 0x63, 0x7a, 0xc2, 0x4a, 0xd2, 0xfa, 0x4e,
+	0x18, 0xd1, 0x80, 0xc3, 0x52, 0x24, 0xab, 0x52, 0xb8, 0xcf, 0x65, 0x75, 0x2e, 0xc2, 0xd7, 0x60,
+	0xed, 0xd8, 0x1d, 0x78, 0x7e, 0x8f, 0xd5, 0x2f, 0x8d, 0x69, 0x10, 0xda, 0x1d, 0x7f, 0x34, 0x10,
+	0x78, 0x64, 0x08, 0x16, 0xb2, 0xa6, 0x10, 0x55, 0x98, 0x04, 0x3f, 0x80, 0xed, 0xb9, 0xb3, 0xd8,
+	0x0f, 0x5d, 0x2f, 0xa2, 0x01, 0xed, 0xda, 0x01, 0x1d, 0x7a, 0x6e, 0x47, 0xd4, 0x5a, 0x09, 0xa0,
+	0xbe, 0x38, 0x67, 0xea, 0x43, 0xa9, 0x4e, 0x26, 0xda, 0xac, 0x32, 0xa2, 0x33, 0x1c, 0xd9, 0x23,
+	0x5e, 0xb4, 0xc0, 0xf0, 0x53, 0x48, 0xae, 0x33, 0x1c, 0xb5, 0x19, 0xcd, 0xbe, 0xa6, 0x3f, 0x1a,
+	0x8a, 0xe0, 0xac, 0x10, 0xd6, 0x64, 0x1f, 0xfd, 0x39, 0x18, 0x76, 0xe0, 0x44, 0x94, 0x7f, 0xdc,
+	0x53, 0x48, 0x9e, 0x73, 0x88, 0x13, 0x51, 0xf6, 0xcd, 0xa7, 0xa4, 0xf7, 0x7a, 0x01, 0xed, 0x39,
+	0x91, 0x44, 0xf1, 0x1a, 0xac, 0x09, 0xc4, 0xc6, 0xb6, 0xf4, 0x66, 0x61, 0xae, 0x22, 0xcc, 0x95,
+	0x32, 0xe1, 0xca, 0xc2, 0xdc, 0x3d, 0xb8, 0x32, 0x1a, 0xcc, 0xed, 0xa3, 0xf2, 0x3e, 0x6b, 0xa3,
+	0xc1, 0x9c, 0x5e, 0x3f, 0x0d, 0x6f, 0xce, 0x07, 0xa9, 0xef, 0x8a, 0x72, 0xc8, 0x22, 0xb9, 0x32,
+	0x07, 0x93, 0xba, 0x3b, 0x78, 0x46, 0x57, 0xe7, 0x49, 0x39, 0xfd, 0xe9, 0x5d, 0x9d, 0x27, 0xda,
+	0x5f, 0x24, 0x9f, 0x1c, 0x63, 0x6f, 0x4a, 0xe2, 0x4a, 0xec, 0xe7, 0xca, 0xb3, 0xfc, 0xbc, 0x0c,
+	0x8b, 0xcc, 0x57, 0xdd, 0x41, 0x8f, 0x1b, 0x97, 0x23, 0x31, 0x89, 0x5b, 0xf0, 0x45, 0x69, 0x3b,
+	0x7d, 0x12, 0xd1, 0x60, 0xe0, 0x78, 0xde, 0xd8, 0x16, 0xaf, 0x93, 0x83, 0x88, 0x76, 0xed, 0x49,
+	0x79, 0xa8, 0x88, 0x2e, 0x9f, 0x17, 0xda, 0x46, 0xa2, 0x4c, 0x12, 0x5d, 0x2b, 0x56, 0xc5, 0x5f,
+	0x81, 0x52, 0x20, 0x7d, 0xdc, 0x0e, 0xd9, 0xf6, 0xc8, 0x88, 0xbc, 0x26, 0x57, 0x37, 0x73, 0x00,
+	0x48, 0x31, 0x98, 0x26, 0x5f, 0x3c, 0x1e, 0xdd, 0x4e, 0xe7, 0xb2, 0x68, 0x51, 0xfb, 0x4b, 0x05,
+	0x56, 0xe7, 0xfc, 0xb4, 0x4f, 0xde, 0x0d, 0x94, 0xa9, 0x67, 0xc9, 0x9f, 0x84, 0x0c, 0x5b, 0x5f,
+	0x5c, 0x62, 0xf5, 0xc6, 0xc5, 0x97, 0x01, 0xb6, 0x26, 0x4a, 0x84, 0x16, 0x3b, 0xaa, 0xdc, 0xa6,
+	0x0e, 0x7f, 0x97, 0x8c, 0x03, 0x6e, 0x81, 0xf1, 0xc4, 0x53, 0xe5, 0xc5, 0x87, 0xce, 0xf4, 0x73,
+	0x1f, 0x3a, 0xb7, 0x7f, 0x37, 0x05, 0xf9, 0xfa, 0xb8, 0xf5, 0xc8, 0x3b, 0xf4, 0x9c, 0x1e, 0x2f,
+	0x1e, 0xa9, 0x37, 0xad, 0xfb, 0x68, 0x81, 0x95, 0xed, 0x99, 0x0d, 0xcb, 0x36, 0xdb, 0xb5, 0x9a,
+	0x7d, 0x58, 0xd3, 0x6f, 0x21, 0x85, 0x55, 0x9d, 0x35, 0x49, 0xd5, 0xbe, 0x63, 0xdc, 0x17, 0x1c,
+	0x95, 0x95, 0xae, 0xb5, 0xcd, 0xea, 0xdd, 0xb6, 0x31, 0x61, 0xa6, 0xf1, 0x3a, 0xac, 0xd4, 0xdb,
+	0x35, 0xab, 0xda, 0xac, 0x4d, 0xb1, 0x73, 0xac, 0xe8, 0x6f, 0xbf, 0xd6, 0xd8, 0x17, 0x24, 0x62,
+	0xe3, 0xb7, 0xcd, 0x56, 0xf5, 0x96, 0x69, 0x1c, 0x08, 0xd6, 0x26, 0x63, 0x3d, 0x30, 0x48, 0xe3,
+	0xb0, 0x1a, 0x4f, 0xf9, 0x11, 0x46, 0x50, 0xd8, 0xaf, 0x9a, 0x3a, 0x91, 0xa3, 0x3c, 0x55, 0x70,
+	0x09, 0xf2, 0x86, 0xd9, 0xae, 0x4b, 0x5a, 0xc5, 0x65, 0x58, 0x65, 0xf5, 0x75, 0x76, 0xd5, 0xac,
+	0x10, 0xa3, 0xce, 0xca, 0xf0, 0x84, 0x24, 0x8d, 0x57, 0xa1, 0x64, 0x55, 0xeb, 0x46, 0xcb, 0xd2,
+	0xeb, 0x4d, 0xc9, 0x64, 0xab, 0xc8, 0xb5, 0x8c, 0x58, 0x07, 0xe1, 0x0d, 0x58, 0x37, 0x1b, 0x76,
+	0x5c, 0x7e, 0x77, 0xa4, 0xd7, 0xda, 0x86, 0x94, 0x6d, 0xe2, 0x37, 0x00, 0x37, 0x4c, 0xbb, 0xdd,
+	0x3c, 0xd0, 0x2d, 0xc3, 0x36, 0x1b, 0xf7, 0xa4, 0xe0, 0x23, 0x5c, 0x82, 0xdc, 0x64, 0x05, 0x4f,
+	0x19, 0x0a, 0xc5, 0xa6, 0x4e, 0xac, 0x89, 0xb1, 0x4f, 0x9f, 0x32, 0xb0, 0xe0, 0x16, 0x69, 0xb4,
+	0x9b, 0x13, 0xb5, 0x15, 0x28, 0x48, 0xb0, 0x24, 0x2b, 0xcd, 0x58, 0xfb, 0x55, 0xb3, 0x92, 0xac,
+	0xef, 0x69, 0x6e, 0x43, 0x45, 0xca, 0xf6, 0x29, 0xa4, 0xf9, 0x76, 0xe4, 0x20, 0x6d, 0x36, 0x4c,
+	0x56, 0x31, 0xb9, 0x0c, 0x50, 0x6d, 0x55, 0x4d, 0xcb, 0xb8, 0x45, 0xf4, 0x1a, 0x33, 0x9b, 0x33,
+	0x62, 0x00, 0x99, 0xb5, 0x4b, 0xb0, 0x58, 0x6d, 0x1d, 0xd6, 0x1a, 0xba, 0x25, 0xcd, 0xac, 0xb6,
+	0xee, 0xb6, 0x1b, 0xac, 0x70, 0xf1, 0x29, 0xc2, 0x05, 0xc8, 0xb2, 0x1a, 0xc5, 0xaf, 0x59, 0xcc,
+	0x2e, 0x2e, 0x13, 0xa8, 0xa2, 0xa7, 0x1f, 0x6d, 0x7f, 0x3b, 0x05, 0x69, 0x5e, 0xf7, 0x5d, 0x84,
+	0x3c, 0xdf, 0x6d, 0x56, 0x9a, 0x89, 0x16, 0x70, 0x1e, 0xd2, 0x55, 0xd3, 0xba, 0x89, 0x7e, 0x41,
+	0xc5, 0x00, 0x99, 0x36, 0x6f, 0xff, 0x62, 0x96, 0xb5, 0xab, 0xa6, 0xf5, 0xc1, 0x0d, 0xf4, 0x75,
+	0x95, 0x0d, 0xdb, 0x16, 0xc4, 0x2f, 0xc5, 0x82, 0xdd, 0x3d, 0xf4, 0x8d, 0x44, 0xb0, 0xbb, 0x87,
+	0x7e, 0x39, 0x16, 0x5c, 0xdf, 0x45, 0xdf, 0x4c, 0x04, 0xd7, 0x77, 0xd1, 0xaf, 0xc4, 0x82, 0x1b,
+	0x7b, 0xe8, 0x57, 0x13, 0xc1, 0x8d, 0x3d, 0xf4, 0x6b, 0x59, 0x66, 0x0b, 0xb7, 0xe4, 0xfa, 0x2e,
+	0xfa, 0xf5, 0x5c, 0x42, 0xdd, 0xd8, 0x43, 0xbf, 0x91, 0x63, 0xfb, 0x9f, 0xec, 0x2a, 0xfa, 0x4d,
+	0xc4, 0x96, 0xc9, 0x36, 0x08, 0xfd, 0x16, 0x6f, 0x32, 0x11, 0xfa, 0x6d, 0xc4, 0x6c, 0x64, 0x5c,
+	0x4e, 0x7e, 0x8b, 0x4b, 0xee, 0x1b, 0x3a, 0x41, 0xbf, 0x93, 0x15, 0x05, 0xa1, 0x95, 0x2a, 0xab,
+	0x8e, 0xc4, 0xbc, 0x07, 0x43, 0xe5, 0xf7, 0xae, 0xb1, 0x26, 0x73, 0x4f, 0xf4, 0xfb, 0x4d, 0x36,
+	0xe1, 0x91, 0x4e, 0x2a, 0x1f, 0xeb, 0x04, 0xfd, 0xc1, 0x35, 0x36, 0xe1, 0x91, 0x4e, 0x24, 0x5e,
+	0x7f, 0xd8, 0x64, 0x8a, 0x5c, 0xf4, 0x47, 0xd7, 0xd8, 0xa2, 0x25, 0xff, 0x8f, 0x9b, 0x38, 0x07,
+	0xa9, 0xfd, 0xaa, 0x85, 0xbe, 0xcd, 0x67, 0x63, 0x2e, 0x8a, 0xfe, 0x04, 0x31, 0x66, 0xcb, 0xb0,
+	0xd0, 0x77, 0x18, 0x33, 0x63, 0xb5, 0x9b, 0x35, 0x03, 0xbd, 0xcd, 0x16, 0x77, 0xcb, 0x68, 0xd4,
+	0x0d, 0x8b, 0xdc, 0x47, 0x7f, 0xca, 0xd5, 0x6f, 0xb7, 0x1a, 0x26, 0xfa, 0x2e, 0x62, 0xc5, 0xa2,
+	0xc6, 0xd7, 0x9a, 0xc4, 0x68, 0xb5, 0xaa, 0x0d, 0x13, 0xbd, 0xb7, 0x7d, 0x08, 0xe8, 0x7c, 0x38,
+	0x60, 0x06, 0xb4, 0xcd, 0x3b, 0x66, 0xe3, 0x9e, 0x89, 0x16, 0x18, 0xd1, 0x24, 0x46, 0x53, 0x27,
+	0x06, 0x52, 0x58, 0xdd, 0xa7, 0x2c, 0x33, 0xe5, 0xf5, 0xa2, 0xa4, 0x51, 0xab, 0xed, 0xeb, 0x95,
+	0x3b, 0x28, 0xb5, 0xff, 0x65, 0x58, 0x76, 0xfd, 0x9d, 0x33, 0x37, 0xa2, 0x61, 0x28, 0xfe, 0xb3,
+	0xe0, 0x81, 0x26, 0x29, 0xd7, 0xbf, 0x2a, 0x5a, 0x57, 0x7b, 0xfe, 0xd5, 0xb3, 0xe8, 0x2a, 0x97,
+	0x5e, 0xe5, 0x11, 0xe3, 0x38, 0xcb, 0x89, 0xeb, 0xff, 0x37, 0x00, 0xff, 0x06, 0x53, 0x4f, 0xb7,
 	0x30, 0x00, 0x00,
 }
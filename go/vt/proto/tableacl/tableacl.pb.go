@@ -29,9 +29,14 @@ type TableGroupSpec struct {
 	Readers              []string `protobuf:"bytes,3,rep,name=readers,proto3" json:"readers,omitempty"`
 	Writers              []string `protobuf:"bytes,4,rep,name=writers,proto3" json:"writers,omitempty"`
 	Admins               []string `protobuf:"bytes,5,rep,name=admins,proto3" json:"admins,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// column_acls restrict the reads of some columns to a subset
+	// of the readers.
+	ColumnAcls []*ColumnACL `protobuf:"bytes,6,rep,name=column_acls,json=columnAcls,proto3" json:"column_acls,omitempty"`
+	// row_filters restrict the rows that some principals can access.
+	RowFilters           []*RowFilter `protobuf:"bytes,7,rep,name=row_filters,json=rowFilters,proto3" json:"row_filters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *TableGroupSpec) Reset()         { *m = TableGroupSpec{} }
@@ -94,6 +99,119 @@ func (m *TableGroupSpec) GetAdmins() []string {
 	return nil
 }
 
+func (m *TableGroupSpec) GetColumnAcls() []*ColumnACL {
+	if m != nil {
+		return m.ColumnAcls
+	}
+	return nil
+}
+
+func (m *TableGroupSpec) GetRowFilters() []*RowFilter {
+	if m != nil {
+		return m.RowFilters
+	}
+	return nil
+}
+
+// ColumnACL defines the principals who can read some columns of
+// the tables. The admins of the group can read them too.
+type ColumnACL struct {
+	Columns              []string `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	Readers              []string `protobuf:"bytes,2,rep,name=readers,proto3" json:"readers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ColumnACL) Reset()         { *m = ColumnACL{} }
+func (m *ColumnACL) String() string { return proto.CompactTextString(m) }
+func (*ColumnACL) ProtoMessage()    {}
+func (*ColumnACL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d0bedb248a1632e, []int{1}
+}
+
+func (m *ColumnACL) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ColumnACL.Unmarshal(m, b)
+}
+func (m *ColumnACL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ColumnACL.Marshal(b, m, deterministic)
+}
+func (m *ColumnACL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ColumnACL.Merge(m, src)
+}
+func (m *ColumnACL) XXX_Size() int {
+	return xxx_messageInfo_ColumnACL.Size(m)
+}
+func (m *ColumnACL) XXX_DiscardUnknown() {
+	xxx_messageInfo_ColumnACL.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ColumnACL proto.InternalMessageInfo
+
+func (m *ColumnACL) GetColumns() []string {
+	if m != nil {
+		return m.Columns
+	}
+	return nil
+}
+
+func (m *ColumnACL) GetReaders() []string {
+	if m != nil {
+		return m.Readers
+	}
+	return nil
+}
+
+// RowFilter defines a predicate on the columns of the tables, which
+// is added to the queries of some principals. Only the rows that match
+// it are read, updated or deleted by them.
+type RowFilter struct {
+	Principals           []string `protobuf:"bytes,1,rep,name=principals,proto3" json:"principals,omitempty"`
+	Predicate            string   `protobuf:"bytes,2,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RowFilter) Reset()         { *m = RowFilter{} }
+func (m *RowFilter) String() string { return proto.CompactTextString(m) }
+func (*RowFilter) ProtoMessage()    {}
+func (*RowFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d0bedb248a1632e, []int{2}
+}
+
+func (m *RowFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RowFilter.Unmarshal(m, b)
+}
+func (m *RowFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RowFilter.Marshal(b, m, deterministic)
+}
+func (m *RowFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RowFilter.Merge(m, src)
+}
+func (m *RowFilter) XXX_Size() int {
+	return xxx_messageInfo_RowFilter.Size(m)
+}
+func (m *RowFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_RowFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RowFilter proto.InternalMessageInfo
+
+func (m *RowFilter) GetPrincipals() []string {
+	if m != nil {
+		return m.Principals
+	}
+	return nil
+}
+
+func (m *RowFilter) GetPredicate() string {
+	if m != nil {
+		return m.Predicate
+	}
+	return ""
+}
+
 type Config struct {
 	TableGroups          []*TableGroupSpec `protobuf:"bytes,1,rep,name=table_groups,json=tableGroups,proto3" json:"table_groups,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
@@ -105,7 +223,7 @@ func (m *Config) Reset()         { *m = Config{} }
 func (m *Config) String() string { return proto.CompactTextString(m) }
 func (*Config) ProtoMessage()    {}
 func (*Config) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d0bedb248a1632e, []int{1}
+	return fileDescriptor_7d0bedb248a1632e, []int{3}
 }
 
 func (m *Config) XXX_Unmarshal(b []byte) error {
@@ -135,26 +253,34 @@ func (m *Config) GetTableGroups() []*TableGroupSpec {
 
 func init() {
 	proto.RegisterType((*TableGroupSpec)(nil), "tableacl.TableGroupSpec")
+	proto.RegisterType((*ColumnACL)(nil), "tableacl.ColumnACL")
+	proto.RegisterType((*RowFilter)(nil), "tableacl.RowFilter")
 	proto.RegisterType((*Config)(nil), "tableacl.Config")
 }
 
 func init() { proto.RegisterFile("tableacl.proto", fileDescriptor_7d0bedb248a1632e) }
 
 var fileDescriptor_7d0bedb248a1632e = []byte{
-	// 232 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xc1, 0x4b, 0xc3, 0x30,
-	0x14, 0xc6, 0x89, 0x9d, 0xd5, 0xbd, 0xc9, 0x0e, 0x41, 0x34, 0xc7, 0x32, 0x10, 0x7b, 0x6a, 0x40,
-	0xf1, 0xe4, 0x4d, 0x11, 0x6f, 0x2a, 0xd5, 0x93, 0x97, 0x92, 0x6d, 0x6f, 0x25, 0xb0, 0x35, 0xe1,
-	0xbd, 0x38, 0xfd, 0x8f, 0xfc, 0x37, 0x25, 0x69, 0x3b, 0xf0, 0xf6, 0xfd, 0xf8, 0x25, 0xe1, 0xfb,
-	0x02, 0xf3, 0x60, 0x96, 0x5b, 0x34, 0xab, 0x6d, 0xe5, 0xc9, 0x05, 0x27, 0x4f, 0x47, 0x5e, 0xfc,
-	0x0a, 0x98, 0x7f, 0x44, 0x78, 0x26, 0xf7, 0xe5, 0xdf, 0x3d, 0xae, 0xa4, 0x84, 0x49, 0x67, 0x76,
-	0xa8, 0x44, 0x21, 0xca, 0x69, 0x9d, 0xb2, 0xbc, 0x83, 0xcb, 0x74, 0xa5, 0x89, 0xc4, 0x8d, 0xa3,
-	0xc6, 0x13, 0x6e, 0xec, 0x0f, 0xb2, 0x3a, 0x2a, 0xb2, 0x72, 0x5a, 0x9f, 0x27, 0xfd, 0x12, 0xed,
-	0x2b, 0xbd, 0x0d, 0x4e, 0x2a, 0x38, 0x21, 0x34, 0x6b, 0x24, 0x56, 0x59, 0x3a, 0x36, 0x62, 0x34,
-	0xdf, 0x64, 0x43, 0x34, 0x93, 0xde, 0x0c, 0x28, 0x2f, 0x20, 0x37, 0xeb, 0x9d, 0xed, 0x58, 0x1d,
-	0x27, 0x31, 0xd0, 0xe2, 0x09, 0xf2, 0x47, 0xd7, 0x6d, 0x6c, 0x2b, 0xef, 0xe1, 0xac, 0x2f, 0xd3,
-	0xc6, 0xce, 0xac, 0x44, 0x91, 0x95, 0xb3, 0x1b, 0x55, 0x1d, 0x46, 0xfe, 0x1f, 0x54, 0xcf, 0xc2,
-	0x81, 0xf9, 0xe1, 0xfa, 0xf3, 0x6a, 0x6f, 0x03, 0x32, 0x57, 0xd6, 0xe9, 0x3e, 0xe9, 0xd6, 0xe9,
-	0x7d, 0xd0, 0xe9, 0x6b, 0xf4, 0xf8, 0xc8, 0x32, 0x4f, 0x7c, 0xfb, 0x17, 0x00, 0x00, 0xff, 0xff,
-	0x09, 0x82, 0xf5, 0x82, 0x3c, 0x01, 0x00, 0x00,
+	// 335 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x41, 0x4f, 0xc2, 0x30,
+	0x14, 0xc7, 0xc3, 0xc0, 0xe1, 0xde, 0x0c, 0x87, 0x6a, 0xb4, 0x07, 0x63, 0xc8, 0x12, 0x23, 0x27,
+	0x96, 0xa0, 0x9e, 0x3c, 0x18, 0x24, 0x6a, 0x4c, 0x8c, 0x9a, 0xe9, 0xc9, 0xcb, 0x52, 0x46, 0x21,
+	0x4d, 0xca, 0xda, 0xb4, 0x05, 0xfc, 0x1e, 0x7e, 0x61, 0xd3, 0x76, 0x1b, 0xe0, 0xed, 0xfd, 0xfa,
+	0xeb, 0xfb, 0xd3, 0xc7, 0x1b, 0xf4, 0x0c, 0x99, 0x72, 0x4a, 0x0a, 0x3e, 0x94, 0x4a, 0x18, 0x81,
+	0x0e, 0x6b, 0x4e, 0x7e, 0x03, 0xe8, 0x7d, 0x59, 0x78, 0x56, 0x62, 0x25, 0x3f, 0x25, 0x2d, 0x10,
+	0x82, 0x4e, 0x49, 0x96, 0x14, 0xb7, 0xfa, 0xad, 0x41, 0x94, 0xb9, 0x1a, 0xdd, 0xc2, 0x99, 0x6b,
+	0xc9, 0x2d, 0xe9, 0x5c, 0xa8, 0x5c, 0x2a, 0x3a, 0x67, 0x3f, 0x54, 0xe3, 0xa0, 0xdf, 0x1e, 0x44,
+	0xd9, 0x89, 0xd3, 0x6f, 0xd6, 0xbe, 0xab, 0x8f, 0xca, 0x21, 0x0c, 0x5d, 0x45, 0xc9, 0x8c, 0x2a,
+	0x8d, 0xdb, 0xee, 0x5a, 0x8d, 0xd6, 0x6c, 0x14, 0x33, 0xd6, 0x74, 0xbc, 0xa9, 0x10, 0x9d, 0x42,
+	0x48, 0x66, 0x4b, 0x56, 0x6a, 0x7c, 0xe0, 0x44, 0x45, 0xe8, 0x06, 0xe2, 0x42, 0xf0, 0xd5, 0xb2,
+	0xcc, 0x49, 0xc1, 0x35, 0x0e, 0xfb, 0xed, 0x41, 0x3c, 0x3a, 0x1e, 0x36, 0x93, 0x4d, 0x9c, 0x1c,
+	0x4f, 0x5e, 0x33, 0xf0, 0xf7, 0xc6, 0x05, 0x77, 0x5d, 0x4a, 0x6c, 0xf2, 0x39, 0xe3, 0xee, 0xb7,
+	0xba, 0xff, 0xbb, 0x32, 0xb1, 0x79, 0x72, 0x2e, 0x03, 0x55, 0x97, 0x3a, 0xb9, 0x87, 0xa8, 0x89,
+	0xb3, 0x4f, 0xf5, 0x81, 0x1a, 0xb7, 0xfc, 0x53, 0x2b, 0xdc, 0x1d, 0x2f, 0xd8, 0x1b, 0x2f, 0x79,
+	0x81, 0xa8, 0x49, 0x46, 0x17, 0x00, 0x52, 0xb1, 0xb2, 0x60, 0x92, 0xf0, 0x3a, 0x63, 0xe7, 0x04,
+	0x9d, 0x43, 0x24, 0x15, 0x9d, 0xb1, 0x82, 0x18, 0x8a, 0x03, 0xf7, 0xaf, 0x6f, 0x0f, 0x92, 0x47,
+	0x08, 0x27, 0xa2, 0x9c, 0xb3, 0x05, 0xba, 0x83, 0x23, 0xbf, 0x84, 0x85, 0xdd, 0x95, 0x4f, 0x8a,
+	0x47, 0x78, 0x3b, 0xcc, 0xfe, 0x22, 0xb3, 0xd8, 0x34, 0xac, 0x1f, 0xae, 0xbe, 0x2f, 0xd7, 0xcc,
+	0x50, 0xad, 0x87, 0x4c, 0xa4, 0xbe, 0x4a, 0x17, 0x22, 0x5d, 0x9b, 0xd4, 0x7d, 0x12, 0x69, 0x1d,
+	0x32, 0x0d, 0x1d, 0x5f, 0xff, 0x0d, 0x00, 0xe1, 0x22, 0x3d, 0x80, 0x34, 0x02, 0x00, 0x00,
 }
@@ -12,6 +12,7 @@ import (
 	query "vitess.io/vitess/go/vt/proto/query"
 	replicationdata "vitess.io/vitess/go/vt/proto/replicationdata"
 	topodata "vitess.io/vitess/go/vt/proto/topodata"
+	vttime "vitess.io/vitess/go/vt/proto/vttime"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3555,8 +3556,11 @@ func (m *PromoteReplicaResponse) GetPosition() string {
 }
 
 type BackupRequest struct {
-	Concurrency          int64    `protobuf:"varint,1,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
-	AllowMaster          bool     `protobuf:"varint,2,opt,name=allowMaster,proto3" json:"allowMaster,omitempty"`
+	Concurrency int64 `protobuf:"varint,1,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	AllowMaster bool  `protobuf:"varint,2,opt,name=allowMaster,proto3" json:"allowMaster,omitempty"`
+	// incremental_from_pos, if set, takes an incremental backup of the binary
+	// logs since this position. "auto" means the position of the last backup.
+	IncrementalFromPos   string   `protobuf:"bytes,3,opt,name=incremental_from_pos,json=incrementalFromPos,proto3" json:"incremental_from_pos,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3601,6 +3605,13 @@ func (m *BackupRequest) GetAllowMaster() bool {
 	return false
 }
 
+func (m *BackupRequest) GetIncrementalFromPos() string {
+	if m != nil {
+		return m.IncrementalFromPos
+	}
+	return ""
+}
+
 type BackupResponse struct {
 	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -3641,9 +3652,15 @@ func (m *BackupResponse) GetEvent() *logutil.Event {
 }
 
 type RestoreFromBackupRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// restore_to_pos, if set, restores the last backup before this position,
+	// then applies the archived binary logs up to it.
+	RestoreToPos string `protobuf:"bytes,1,opt,name=restore_to_pos,json=restoreToPos,proto3" json:"restore_to_pos,omitempty"`
+	// restore_to_timestamp, if set, restores the last backup before this time,
+	// then applies the archived binary logs up to it.
+	RestoreToTimestamp   *vttime.Time `protobuf:"bytes,2,opt,name=restore_to_timestamp,json=restoreToTimestamp,proto3" json:"restore_to_timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *RestoreFromBackupRequest) Reset()         { *m = RestoreFromBackupRequest{} }
@@ -3671,6 +3688,20 @@ func (m *RestoreFromBackupRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RestoreFromBackupRequest proto.InternalMessageInfo
 
+func (m *RestoreFromBackupRequest) GetRestoreToPos() string {
+	if m != nil {
+		return m.RestoreToPos
+	}
+	return ""
+}
+
+func (m *RestoreFromBackupRequest) GetRestoreToTimestamp() *vttime.Time {
+	if m != nil {
+		return m.RestoreToTimestamp
+	}
+	return nil
+}
+
 type RestoreFromBackupResponse struct {
 	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -3804,6 +3835,206 @@ func (m *VExecResponse) GetResult() *query.QueryResult {
 	return nil
 }
 
+type VDiffRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Workflow string `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	// action is one of create, show, stop or resume.
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// vdiff_uuid identifies the vdiff. For show, it can also be "last"
+	// or "all".
+	VdiffUuid            string        `protobuf:"bytes,4,opt,name=vdiff_uuid,json=vdiffUuid,proto3" json:"vdiff_uuid,omitempty"`
+	Options              *VDiffOptions `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *VDiffRequest) Reset()         { *m = VDiffRequest{} }
+func (m *VDiffRequest) String() string { return proto.CompactTextString(m) }
+func (*VDiffRequest) ProtoMessage()    {}
+func (*VDiffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{94}
+}
+
+func (m *VDiffRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VDiffRequest.Unmarshal(m, b)
+}
+func (m *VDiffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VDiffRequest.Marshal(b, m, deterministic)
+}
+func (m *VDiffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VDiffRequest.Merge(m, src)
+}
+func (m *VDiffRequest) XXX_Size() int {
+	return xxx_messageInfo_VDiffRequest.Size(m)
+}
+func (m *VDiffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VDiffRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VDiffRequest proto.InternalMessageInfo
+
+func (m *VDiffRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *VDiffRequest) GetWorkflow() string {
+	if m != nil {
+		return m.Workflow
+	}
+	return ""
+}
+
+func (m *VDiffRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *VDiffRequest) GetVdiffUuid() string {
+	if m != nil {
+		return m.VdiffUuid
+	}
+	return ""
+}
+
+func (m *VDiffRequest) GetOptions() *VDiffOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type VDiffResponse struct {
+	Output               *query.QueryResult `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	VdiffUuid            string             `protobuf:"bytes,2,opt,name=vdiff_uuid,json=vdiffUuid,proto3" json:"vdiff_uuid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *VDiffResponse) Reset()         { *m = VDiffResponse{} }
+func (m *VDiffResponse) String() string { return proto.CompactTextString(m) }
+func (*VDiffResponse) ProtoMessage()    {}
+func (*VDiffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{95}
+}
+
+func (m *VDiffResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VDiffResponse.Unmarshal(m, b)
+}
+func (m *VDiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VDiffResponse.Marshal(b, m, deterministic)
+}
+func (m *VDiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VDiffResponse.Merge(m, src)
+}
+func (m *VDiffResponse) XXX_Size() int {
+	return xxx_messageInfo_VDiffResponse.Size(m)
+}
+func (m *VDiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VDiffResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VDiffResponse proto.InternalMessageInfo
+
+func (m *VDiffResponse) GetOutput() *query.QueryResult {
+	if m != nil {
+		return m.Output
+	}
+	return nil
+}
+
+func (m *VDiffResponse) GetVdiffUuid() string {
+	if m != nil {
+		return m.VdiffUuid
+	}
+	return ""
+}
+
+type VDiffOptions struct {
+	// source_cell is the cell to pick the source tablets from.
+	// It defaults to the cell of the target tablet.
+	SourceCell string `protobuf:"bytes,1,opt,name=source_cell,json=sourceCell,proto3" json:"source_cell,omitempty"`
+	// tablet_types are the types of source tablets to compare against.
+	TabletTypes string `protobuf:"bytes,2,opt,name=tablet_types,json=tabletTypes,proto3" json:"tablet_types,omitempty"`
+	// tables restricts the diff to these tables. All tables of the
+	// workflow are compared if empty.
+	Tables []string `protobuf:"bytes,3,rep,name=tables,proto3" json:"tables,omitempty"`
+	// max_rows stops the diff after comparing this many rows per table.
+	MaxRows int64 `protobuf:"varint,4,opt,name=max_rows,json=maxRows,proto3" json:"max_rows,omitempty"`
+	// filtered_replication_wait_time_seconds is how long to wait for the
+	// workflow streams to catch up with the sources.
+	FilteredReplicationWaitTimeSeconds int64    `protobuf:"varint,5,opt,name=filtered_replication_wait_time_seconds,json=filteredReplicationWaitTimeSeconds,proto3" json:"filtered_replication_wait_time_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral               struct{} `json:"-"`
+	XXX_unrecognized                   []byte   `json:"-"`
+	XXX_sizecache                      int32    `json:"-"`
+}
+
+func (m *VDiffOptions) Reset()         { *m = VDiffOptions{} }
+func (m *VDiffOptions) String() string { return proto.CompactTextString(m) }
+func (*VDiffOptions) ProtoMessage()    {}
+func (*VDiffOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{96}
+}
+
+func (m *VDiffOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VDiffOptions.Unmarshal(m, b)
+}
+func (m *VDiffOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VDiffOptions.Marshal(b, m, deterministic)
+}
+func (m *VDiffOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VDiffOptions.Merge(m, src)
+}
+func (m *VDiffOptions) XXX_Size() int {
+	return xxx_messageInfo_VDiffOptions.Size(m)
+}
+func (m *VDiffOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_VDiffOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VDiffOptions proto.InternalMessageInfo
+
+func (m *VDiffOptions) GetSourceCell() string {
+	if m != nil {
+		return m.SourceCell
+	}
+	return ""
+}
+
+func (m *VDiffOptions) GetTabletTypes() string {
+	if m != nil {
+		return m.TabletTypes
+	}
+	return ""
+}
+
+func (m *VDiffOptions) GetTables() []string {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+func (m *VDiffOptions) GetMaxRows() int64 {
+	if m != nil {
+		return m.MaxRows
+	}
+	return 0
+}
+
+func (m *VDiffOptions) GetFilteredReplicationWaitTimeSeconds() int64 {
+	if m != nil {
+		return m.FilteredReplicationWaitTimeSeconds
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*TableDefinition)(nil), "tabletmanagerdata.TableDefinition")
 	proto.RegisterType((*SchemaDefinition)(nil), "tabletmanagerdata.SchemaDefinition")
@@ -3902,147 +4133,165 @@ func init() {
 	proto.RegisterType((*RestoreFromBackupResponse)(nil), "tabletmanagerdata.RestoreFromBackupResponse")
 	proto.RegisterType((*VExecRequest)(nil), "tabletmanagerdata.VExecRequest")
 	proto.RegisterType((*VExecResponse)(nil), "tabletmanagerdata.VExecResponse")
+	proto.RegisterType((*VDiffRequest)(nil), "tabletmanagerdata.VDiffRequest")
+	proto.RegisterType((*VDiffResponse)(nil), "tabletmanagerdata.VDiffResponse")
+	proto.RegisterType((*VDiffOptions)(nil), "tabletmanagerdata.VDiffOptions")
 }
 
 func init() { proto.RegisterFile("tabletmanagerdata.proto", fileDescriptor_ff9ac4f89e61ffa4) }
 
 var fileDescriptor_ff9ac4f89e61ffa4 = []byte{
-	// 2181 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x19, 0xdb, 0x72, 0xdb, 0xc6,
-	0x75, 0x40, 0x4a, 0x32, 0x75, 0x78, 0x11, 0x05, 0x52, 0x22, 0x44, 0xd7, 0xb2, 0x0c, 0x3b, 0x89,
-	0x27, 0x99, 0x52, 0x89, 0x9c, 0x64, 0x32, 0x49, 0xdb, 0xa9, 0x6c, 0x4b, 0x76, 0x62, 0x39, 0x56,
-	0x20, 0x5f, 0x3a, 0x99, 0x4e, 0x31, 0x20, 0xb0, 0x22, 0x31, 0x02, 0xb1, 0xf0, 0xee, 0x82, 0x14,
-	0x5f, 0xfa, 0x09, 0xed, 0x1f, 0xf4, 0xa5, 0x33, 0xed, 0x7b, 0x3f, 0xa2, 0x9f, 0x90, 0x7e, 0x4a,
-	0x1f, 0xfa, 0xd0, 0xce, 0x5e, 0x40, 0x02, 0x04, 0x64, 0xcb, 0x1a, 0x77, 0x26, 0x2f, 0x1a, 0x9c,
-	0xfb, 0x65, 0xcf, 0x9e, 0x73, 0x96, 0x82, 0x0e, 0x73, 0xfa, 0x01, 0x62, 0x23, 0x27, 0x74, 0x06,
-	0x88, 0x78, 0x0e, 0x73, 0x7a, 0x11, 0xc1, 0x0c, 0xeb, 0xeb, 0x39, 0x42, 0xb7, 0xfa, 0x3a, 0x46,
-	0x64, 0x2a, 0xe9, 0xdd, 0x06, 0xc3, 0x11, 0x9e, 0xf3, 0x77, 0x37, 0x08, 0x8a, 0x02, 0xdf, 0x75,
-	0x98, 0x8f, 0xc3, 0x14, 0xba, 0x1e, 0xe0, 0x41, 0xcc, 0xfc, 0x40, 0x82, 0xe6, 0x7f, 0x35, 0x58,
-	0x7b, 0xce, 0x15, 0x3f, 0x44, 0xa7, 0x7e, 0xe8, 0x73, 0x66, 0x5d, 0x87, 0xa5, 0xd0, 0x19, 0x21,
-	0x43, 0xdb, 0xd1, 0xee, 0xae, 0x5a, 0xe2, 0x5b, 0xdf, 0x84, 0x15, 0xea, 0x0e, 0xd1, 0xc8, 0x31,
-	0x4a, 0x02, 0xab, 0x20, 0xdd, 0x80, 0x6b, 0x2e, 0x0e, 0xe2, 0x51, 0x48, 0x8d, 0xf2, 0x4e, 0xf9,
-	0xee, 0xaa, 0x95, 0x80, 0x7a, 0x0f, 0x5a, 0x11, 0xf1, 0x47, 0x0e, 0x99, 0xda, 0x67, 0x68, 0x6a,
-	0x27, 0x5c, 0x4b, 0x82, 0x6b, 0x5d, 0x91, 0x9e, 0xa0, 0xe9, 0x03, 0xc5, 0xaf, 0xc3, 0x12, 0x9b,
-	0x46, 0xc8, 0x58, 0x96, 0x56, 0xf9, 0xb7, 0x7e, 0x13, 0xaa, 0xdc, 0x75, 0x3b, 0x40, 0xe1, 0x80,
-	0x0d, 0x8d, 0x95, 0x1d, 0xed, 0xee, 0x92, 0x05, 0x1c, 0x75, 0x24, 0x30, 0xfa, 0x75, 0x58, 0x25,
-	0x78, 0x62, 0xbb, 0x38, 0x0e, 0x99, 0x71, 0x4d, 0x90, 0x2b, 0x04, 0x4f, 0x1e, 0x70, 0x58, 0xbf,
-	0x03, 0x2b, 0xa7, 0x3e, 0x0a, 0x3c, 0x6a, 0x54, 0x76, 0xca, 0x77, 0xab, 0x7b, 0xb5, 0x9e, 0xcc,
-	0xd7, 0x21, 0x47, 0x5a, 0x8a, 0x66, 0xfe, 0x4d, 0x83, 0xe6, 0x89, 0x08, 0x26, 0x95, 0x82, 0x8f,
-	0x60, 0x8d, 0x5b, 0xe9, 0x3b, 0x14, 0xd9, 0x2a, 0x6e, 0x99, 0x8d, 0x46, 0x82, 0x96, 0x22, 0xfa,
-	0x33, 0x90, 0xe7, 0x62, 0x7b, 0x33, 0x61, 0x6a, 0x94, 0x84, 0x39, 0xb3, 0x97, 0x3f, 0xca, 0x85,
-	0x54, 0x5b, 0x4d, 0x96, 0x45, 0x50, 0x9e, 0xd0, 0x31, 0x22, 0xd4, 0xc7, 0xa1, 0x51, 0x16, 0x16,
-	0x13, 0x90, 0x3b, 0xaa, 0x4b, 0xab, 0x0f, 0x86, 0x4e, 0x38, 0x40, 0x16, 0xa2, 0x71, 0xc0, 0xf4,
-	0xc7, 0x50, 0xef, 0xa3, 0x53, 0x4c, 0x32, 0x8e, 0x56, 0xf7, 0x6e, 0x17, 0x58, 0x5f, 0x0c, 0xd3,
-	0xaa, 0x49, 0x49, 0x15, 0xcb, 0x21, 0xd4, 0x9c, 0x53, 0x86, 0x88, 0x9d, 0x3a, 0xe9, 0x4b, 0x2a,
-	0xaa, 0x0a, 0x41, 0x89, 0x36, 0xff, 0xad, 0x41, 0xe3, 0x05, 0x45, 0xe4, 0x18, 0x91, 0x91, 0x4f,
-	0xa9, 0x2a, 0xa9, 0x21, 0xa6, 0x2c, 0x29, 0x29, 0xfe, 0xcd, 0x71, 0x31, 0x45, 0x44, 0x15, 0x94,
-	0xf8, 0xd6, 0x3f, 0x81, 0xf5, 0xc8, 0xa1, 0x74, 0x82, 0x89, 0x67, 0xbb, 0x43, 0xe4, 0x9e, 0xd1,
-	0x78, 0x24, 0xf2, 0xb0, 0x64, 0x35, 0x13, 0xc2, 0x03, 0x85, 0xd7, 0x7f, 0x00, 0x88, 0x88, 0x3f,
-	0xf6, 0x03, 0x34, 0x40, 0xb2, 0xb0, 0xaa, 0x7b, 0x9f, 0x15, 0x78, 0x9b, 0xf5, 0xa5, 0x77, 0x3c,
-	0x93, 0x39, 0x08, 0x19, 0x99, 0x5a, 0x29, 0x25, 0xdd, 0x5f, 0xc3, 0xda, 0x02, 0x59, 0x6f, 0x42,
-	0xf9, 0x0c, 0x4d, 0x95, 0xe7, 0xfc, 0x53, 0x6f, 0xc3, 0xf2, 0xd8, 0x09, 0x62, 0xa4, 0x3c, 0x97,
-	0xc0, 0xd7, 0xa5, 0xaf, 0x34, 0xf3, 0x27, 0x0d, 0x6a, 0x0f, 0xfb, 0x6f, 0x89, 0xbb, 0x01, 0x25,
-	0xaf, 0xaf, 0x64, 0x4b, 0x5e, 0x7f, 0x96, 0x87, 0x72, 0x2a, 0x0f, 0xcf, 0x0a, 0x42, 0xdb, 0x2d,
-	0x08, 0x2d, 0x6d, 0xec, 0xff, 0x19, 0xd8, 0x5f, 0x35, 0xa8, 0xce, 0x2d, 0x51, 0xfd, 0x08, 0x9a,
-	0xdc, 0x4f, 0x3b, 0x9a, 0xe3, 0x0c, 0x4d, 0x78, 0x79, 0xeb, 0xad, 0x07, 0x60, 0xad, 0xc5, 0x19,
-	0x98, 0xea, 0x87, 0xd0, 0xf0, 0xfa, 0x19, 0x5d, 0xf2, 0x06, 0xdd, 0x7c, 0x4b, 0xc4, 0x56, 0xdd,
-	0x4b, 0x41, 0xd4, 0xfc, 0x08, 0xaa, 0xc7, 0x7e, 0x38, 0xb0, 0xd0, 0xeb, 0x18, 0x51, 0xc6, 0xaf,
-	0x52, 0xe4, 0x4c, 0x03, 0xec, 0x78, 0x2a, 0xc8, 0x04, 0x34, 0xef, 0x42, 0x4d, 0x32, 0xd2, 0x08,
-	0x87, 0x14, 0xbd, 0x81, 0xf3, 0x63, 0xa8, 0x9d, 0x04, 0x08, 0x45, 0x89, 0xce, 0x2e, 0x54, 0xbc,
-	0x98, 0x88, 0xa6, 0x2a, 0x58, 0xcb, 0xd6, 0x0c, 0x36, 0xd7, 0xa0, 0xae, 0x78, 0xa5, 0x5a, 0xf3,
-	0x5f, 0x1a, 0xe8, 0x07, 0xe7, 0xc8, 0x8d, 0x19, 0x7a, 0x8c, 0xf1, 0x59, 0xa2, 0xa3, 0xa8, 0xbf,
-	0x6e, 0x03, 0x44, 0x0e, 0x71, 0x46, 0x88, 0x21, 0x22, 0xc3, 0x5f, 0xb5, 0x52, 0x18, 0xfd, 0x18,
-	0x56, 0xd1, 0x39, 0x23, 0x8e, 0x8d, 0xc2, 0xb1, 0xe8, 0xb4, 0xd5, 0xbd, 0x7b, 0x05, 0xd9, 0xc9,
-	0x5b, 0xeb, 0x1d, 0x70, 0xb1, 0x83, 0x70, 0x2c, 0x6b, 0xa2, 0x82, 0x14, 0xd8, 0xfd, 0x06, 0xea,
-	0x19, 0xd2, 0x3b, 0xd5, 0xc3, 0x29, 0xb4, 0x32, 0xa6, 0x54, 0x1e, 0x6f, 0x42, 0x15, 0x9d, 0xfb,
-	0xcc, 0xa6, 0xcc, 0x61, 0x31, 0x55, 0x09, 0x02, 0x8e, 0x3a, 0x11, 0x18, 0x31, 0x46, 0x98, 0x87,
-	0x63, 0x36, 0x1b, 0x23, 0x02, 0x52, 0x78, 0x44, 0x92, 0x5b, 0xa0, 0x20, 0x73, 0x0c, 0xcd, 0x47,
-	0x88, 0xc9, 0xbe, 0x92, 0xa4, 0x6f, 0x13, 0x56, 0x44, 0xe0, 0xb2, 0xe2, 0x56, 0x2d, 0x05, 0xe9,
-	0xb7, 0xa1, 0xee, 0x87, 0x6e, 0x10, 0x7b, 0xc8, 0x1e, 0xfb, 0x68, 0x42, 0x85, 0x89, 0x8a, 0x55,
-	0x53, 0xc8, 0x97, 0x1c, 0xa7, 0x7f, 0x00, 0x0d, 0x74, 0x2e, 0x99, 0x94, 0x12, 0x39, 0xb6, 0xea,
-	0x0a, 0x2b, 0x1a, 0x34, 0x35, 0x11, 0xac, 0xa7, 0xec, 0xaa, 0xe8, 0x8e, 0x61, 0x5d, 0x76, 0xc6,
-	0x54, 0xb3, 0x7f, 0x97, 0x6e, 0xdb, 0xa4, 0x0b, 0x18, 0xb3, 0x03, 0x1b, 0x8f, 0x10, 0x4b, 0x95,
-	0xb0, 0x8a, 0xd1, 0xfc, 0x11, 0x36, 0x17, 0x09, 0xca, 0x89, 0xdf, 0x42, 0x35, 0x7b, 0xe9, 0xb8,
-	0xf9, 0xed, 0x02, 0xf3, 0x69, 0xe1, 0xb4, 0x88, 0xd9, 0x06, 0xfd, 0x04, 0x31, 0x0b, 0x39, 0xde,
-	0xb3, 0x30, 0x98, 0x26, 0x16, 0x37, 0xa0, 0x95, 0xc1, 0xaa, 0x12, 0x9e, 0xa3, 0x5f, 0x11, 0x9f,
-	0xa1, 0x84, 0x7b, 0x13, 0xda, 0x59, 0xb4, 0x62, 0xff, 0x0e, 0xd6, 0xe5, 0x70, 0x7a, 0x3e, 0x8d,
-	0x12, 0x66, 0xfd, 0x0b, 0xa8, 0x4a, 0xf7, 0x6c, 0x31, 0xe0, 0xb9, 0xcb, 0x8d, 0xbd, 0x76, 0x6f,
-	0xb6, 0xaf, 0x88, 0x9c, 0x33, 0x21, 0x01, 0x6c, 0xf6, 0xcd, 0xfd, 0x4c, 0xeb, 0x9a, 0x3b, 0x64,
-	0xa1, 0x53, 0x82, 0xe8, 0x90, 0x97, 0x54, 0xda, 0xa1, 0x2c, 0x5a, 0xb1, 0x77, 0x60, 0xc3, 0x8a,
-	0xc3, 0xc7, 0xc8, 0x09, 0xd8, 0x50, 0x0c, 0x8e, 0x44, 0xc0, 0x80, 0xcd, 0x45, 0x82, 0x12, 0xf9,
-	0x1c, 0x8c, 0x6f, 0x07, 0x21, 0x26, 0x48, 0x12, 0x0f, 0x08, 0xc1, 0x24, 0xd3, 0x52, 0x18, 0x43,
-	0x24, 0x9c, 0x37, 0x0a, 0x01, 0x9a, 0xd7, 0x61, 0xab, 0x40, 0x4a, 0xa9, 0xfc, 0x9a, 0x3b, 0xcd,
-	0xfb, 0x49, 0xb6, 0x92, 0x6f, 0x43, 0x7d, 0xe2, 0xf8, 0xcc, 0x8e, 0x30, 0x9d, 0x17, 0xd3, 0xaa,
-	0x55, 0xe3, 0xc8, 0x63, 0x85, 0x93, 0x91, 0xa5, 0x65, 0x95, 0xce, 0x3d, 0xd8, 0x3c, 0x26, 0xe8,
-	0x34, 0xf0, 0x07, 0xc3, 0x85, 0x0b, 0xc2, 0x77, 0x32, 0x91, 0xb8, 0xe4, 0x86, 0x24, 0xa0, 0x39,
-	0x80, 0x4e, 0x4e, 0x46, 0xd5, 0xd5, 0x11, 0x34, 0x24, 0x97, 0x4d, 0xc4, 0x5e, 0x91, 0xf4, 0xf3,
-	0x0f, 0x2e, 0xac, 0xec, 0xf4, 0x16, 0x62, 0xd5, 0xdd, 0x14, 0x44, 0xcd, 0xff, 0x68, 0xa0, 0xef,
-	0x47, 0x51, 0x30, 0xcd, 0x7a, 0xd6, 0x84, 0x32, 0x7d, 0x1d, 0x24, 0x2d, 0x86, 0xbe, 0x0e, 0x78,
-	0x8b, 0x39, 0xc5, 0xc4, 0x45, 0xea, 0xb2, 0x4a, 0x80, 0xaf, 0x01, 0x4e, 0x10, 0xe0, 0x89, 0x9d,
-	0xda, 0x61, 0x45, 0x67, 0xa8, 0x58, 0x4d, 0x41, 0xb0, 0xe6, 0xf8, 0xfc, 0x02, 0xb4, 0xf4, 0xbe,
-	0x16, 0xa0, 0xe5, 0x2b, 0x2e, 0x40, 0x7f, 0xd7, 0xa0, 0x95, 0x89, 0x5e, 0xe5, 0xf8, 0xe7, 0xb7,
-	0xaa, 0xb5, 0x60, 0xfd, 0x08, 0xbb, 0x67, 0xb2, 0xeb, 0x25, 0x57, 0xa3, 0x0d, 0x7a, 0x1a, 0x39,
-	0xbf, 0x78, 0x2f, 0xc2, 0x20, 0xc7, 0xbc, 0x09, 0xed, 0x2c, 0x5a, 0xb1, 0xff, 0x43, 0x03, 0x43,
-	0x8d, 0x88, 0x43, 0xc4, 0xdc, 0xe1, 0x3e, 0x7d, 0xd8, 0x9f, 0xd5, 0x41, 0x1b, 0x96, 0xc5, 0x2a,
-	0x2e, 0x12, 0x50, 0xb3, 0x24, 0xa0, 0x77, 0xe0, 0x9a, 0xd7, 0xb7, 0xc5, 0x68, 0x54, 0xd3, 0xc1,
-	0xeb, 0x7f, 0xcf, 0x87, 0xe3, 0x16, 0x54, 0x46, 0xce, 0xb9, 0x4d, 0xf0, 0x84, 0xaa, 0x65, 0xf0,
-	0xda, 0xc8, 0x39, 0xb7, 0xf0, 0x84, 0x8a, 0x45, 0xdd, 0xa7, 0x62, 0x03, 0xef, 0xfb, 0x61, 0x80,
-	0x07, 0x54, 0x1c, 0x7f, 0xc5, 0x6a, 0x28, 0xf4, 0x7d, 0x89, 0xe5, 0x77, 0x8d, 0x88, 0x6b, 0x94,
-	0x3e, 0xdc, 0x8a, 0x55, 0x23, 0xa9, 0xbb, 0x65, 0x3e, 0x82, 0xad, 0x02, 0x9f, 0xd5, 0xe9, 0x7d,
-	0x0c, 0x2b, 0xf2, 0x6a, 0xa8, 0x63, 0xd3, 0xd5, 0x73, 0xe2, 0x07, 0xfe, 0x57, 0x5d, 0x03, 0xc5,
-	0x61, 0xfe, 0x49, 0x83, 0x1b, 0x59, 0x4d, 0xfb, 0x41, 0xc0, 0x17, 0x30, 0xfa, 0xfe, 0x53, 0x90,
-	0x8b, 0x6c, 0xa9, 0x20, 0xb2, 0x23, 0xd8, 0xbe, 0xc8, 0x9f, 0x2b, 0x84, 0xf7, 0x64, 0xf1, 0x6c,
-	0xf7, 0xa3, 0xe8, 0xcd, 0x81, 0xa5, 0xfd, 0x2f, 0x65, 0xfc, 0xcf, 0x27, 0x5d, 0x28, 0xbb, 0x82,
-	0x57, 0x5d, 0x30, 0x52, 0x7d, 0x41, 0x6e, 0x1c, 0x49, 0x99, 0x1e, 0xc1, 0x56, 0x01, 0x4d, 0x19,
-	0xd9, 0xe5, 0xdb, 0xc7, 0x6c, 0x63, 0xa9, 0xee, 0x75, 0x7a, 0x8b, 0x6f, 0x67, 0x25, 0xa0, 0xd8,
-	0xf8, 0x5d, 0x78, 0xea, 0x50, 0x7e, 0x8d, 0x32, 0x46, 0x9e, 0x42, 0x3b, 0x8b, 0x56, 0xfa, 0xbf,
-	0x58, 0xd0, 0x7f, 0x23, 0xa7, 0x3f, 0x23, 0x96, 0x58, 0xe9, 0xc0, 0x86, 0xc4, 0x27, 0xb3, 0x20,
-	0xb1, 0xf3, 0x39, 0x6c, 0x2e, 0x12, 0x94, 0xa5, 0x2e, 0x54, 0x16, 0x86, 0xc9, 0x0c, 0xe6, 0x52,
-	0xaf, 0x1c, 0x9f, 0x1d, 0xe2, 0x45, 0x7d, 0x6f, 0x94, 0xda, 0x82, 0x4e, 0x4e, 0x4a, 0x5d, 0x71,
-	0x03, 0x36, 0x4f, 0x18, 0x8e, 0x52, 0x79, 0x4d, 0x1c, 0xdc, 0x82, 0x4e, 0x8e, 0xa2, 0x84, 0xfe,
-	0x00, 0x37, 0x16, 0x48, 0x4f, 0xfd, 0xd0, 0x1f, 0xc5, 0xa3, 0x4b, 0x38, 0xa3, 0xdf, 0x02, 0x31,
-	0x1b, 0x6d, 0xe6, 0x8f, 0x50, 0xb2, 0x44, 0x96, 0xad, 0x2a, 0xc7, 0x3d, 0x97, 0x28, 0xf3, 0x57,
-	0xb0, 0x7d, 0x91, 0xfe, 0x4b, 0xe4, 0x48, 0x38, 0xee, 0x10, 0x56, 0x10, 0x53, 0x17, 0x8c, 0x3c,
-	0x49, 0x05, 0xd5, 0x87, 0x5b, 0x8b, 0xb4, 0x17, 0x21, 0xf3, 0x83, 0x7d, 0xde, 0x6a, 0xdf, 0x53,
-	0x60, 0x77, 0xc0, 0x7c, 0x93, 0x0d, 0xe5, 0x49, 0x1b, 0xf4, 0x47, 0x28, 0xe1, 0x99, 0x15, 0xe6,
-	0x27, 0xd0, 0xca, 0x60, 0x55, 0x26, 0xda, 0xb0, 0xec, 0x78, 0x1e, 0x49, 0xd6, 0x04, 0x09, 0xf0,
-	0x1c, 0x58, 0x88, 0xa2, 0x0b, 0x72, 0x90, 0x27, 0x29, 0xcb, 0xbb, 0xd0, 0x79, 0x99, 0xc2, 0xf3,
-	0x2b, 0x5d, 0xd8, 0x12, 0x56, 0x55, 0x4b, 0x30, 0x0f, 0xc1, 0xc8, 0x0b, 0x5c, 0xa9, 0x19, 0xdd,
-	0x48, 0xeb, 0x99, 0x57, 0x6b, 0x62, 0xbe, 0x01, 0x25, 0xdf, 0x53, 0x8f, 0x91, 0x92, 0xef, 0x65,
-	0x0e, 0xa2, 0xb4, 0x50, 0x00, 0x3b, 0xb0, 0x7d, 0x91, 0x32, 0x15, 0x67, 0x0b, 0xd6, 0xbf, 0x0d,
-	0x7d, 0x26, 0x2f, 0x60, 0x92, 0x98, 0x4f, 0x41, 0x4f, 0x23, 0x2f, 0x51, 0x69, 0x3f, 0x69, 0xb0,
-	0x7d, 0x8c, 0xa3, 0x38, 0x10, 0xdb, 0x6a, 0xe4, 0x10, 0x14, 0xb2, 0xef, 0x70, 0x4c, 0x42, 0x27,
-	0x48, 0xfc, 0xfe, 0x10, 0xd6, 0x78, 0x3d, 0xd8, 0x2e, 0x41, 0x0e, 0x43, 0x9e, 0x1d, 0x26, 0x2f,
-	0xaa, 0x3a, 0x47, 0x3f, 0x90, 0xd8, 0xef, 0x29, 0x7f, 0x75, 0x39, 0x2e, 0x57, 0x9a, 0x1e, 0x1c,
-	0x20, 0x51, 0x62, 0x78, 0x7c, 0x05, 0xb5, 0x91, 0xf0, 0xcc, 0x76, 0x02, 0xdf, 0x91, 0x03, 0xa4,
-	0xba, 0xb7, 0xb1, 0xb8, 0x81, 0xef, 0x73, 0xa2, 0x55, 0x95, 0xac, 0x02, 0xd0, 0x3f, 0x83, 0x76,
-	0xaa, 0x55, 0xcd, 0x17, 0xd5, 0x25, 0x61, 0xa3, 0x95, 0xa2, 0xcd, 0xf6, 0xd5, 0x5b, 0x70, 0xf3,
-	0xc2, 0xb8, 0x54, 0x0a, 0xff, 0xa2, 0xc9, 0x74, 0xa9, 0x44, 0x27, 0xf1, 0xfe, 0x12, 0x56, 0x24,
-	0xbf, 0x3a, 0xf4, 0x0b, 0x1c, 0x54, 0x4c, 0x17, 0xfa, 0x56, 0xba, 0xd0, 0xb7, 0xa2, 0x8c, 0x96,
-	0x0b, 0x32, 0xca, 0xfb, 0x7b, 0xc6, 0xbf, 0xf9, 0x0a, 0xf4, 0x10, 0x8d, 0x30, 0x43, 0xd9, 0xc3,
-	0xff, 0xb3, 0x06, 0xed, 0x2c, 0x5e, 0x9d, 0xff, 0x3d, 0x68, 0x79, 0x28, 0x22, 0xc8, 0x15, 0xc6,
-	0xb2, 0xa5, 0x70, 0xbf, 0x64, 0x68, 0x96, 0x3e, 0x27, 0xcf, 0x7c, 0xbc, 0x0f, 0x75, 0x75, 0x58,
-	0x6a, 0x66, 0x94, 0x2e, 0x33, 0x33, 0xd4, 0x01, 0x4b, 0x88, 0x5f, 0xe1, 0x17, 0xa1, 0x87, 0x8b,
-	0x9c, 0xed, 0x82, 0x91, 0x27, 0xa9, 0xf8, 0xae, 0xcf, 0x86, 0xe4, 0x2b, 0x87, 0x1e, 0x13, 0xcc,
-	0x59, 0xbc, 0x44, 0xf0, 0x17, 0xd0, 0x2d, 0x22, 0x2a, 0xd1, 0x7f, 0x6a, 0xd0, 0x3c, 0x41, 0xd9,
-	0x5b, 0xf1, 0xae, 0x07, 0x5a, 0x70, 0x3a, 0xa5, 0xa2, 0x7a, 0xff, 0x12, 0x3a, 0xe2, 0x99, 0xc0,
-	0x13, 0x44, 0x58, 0xc1, 0x1b, 0x61, 0x43, 0x90, 0x17, 0xbb, 0x65, 0xfe, 0xb9, 0xb5, 0x54, 0xf0,
-	0xdc, 0x6a, 0xc1, 0x7a, 0x2a, 0x0e, 0x15, 0xdd, 0x93, 0x74, 0xec, 0x16, 0x12, 0x76, 0x67, 0x99,
-	0x79, 0xc7, 0x30, 0xcd, 0x1b, 0x70, 0xbd, 0x50, 0x99, 0xb2, 0xf5, 0x47, 0xde, 0xe7, 0x33, 0x03,
-	0x6c, 0x3f, 0xf4, 0x1e, 0x21, 0x96, 0x59, 0x35, 0xf4, 0xdf, 0xc1, 0x06, 0x65, 0x38, 0x4a, 0x07,
-	0x6f, 0x8f, 0xb0, 0x97, 0xbc, 0xae, 0xef, 0x14, 0x6c, 0x30, 0xd9, 0xa1, 0x88, 0x3d, 0x64, 0xb5,
-	0x68, 0x1e, 0xc9, 0x1f, 0x2f, 0xb7, 0xdf, 0xe8, 0xc0, 0xec, 0x87, 0x88, 0xfa, 0x70, 0xda, 0x27,
-	0xbe, 0x67, 0x5f, 0x6a, 0x77, 0x12, 0xf5, 0x5e, 0x93, 0x12, 0xea, 0xc7, 0xa0, 0xdf, 0xcc, 0xd6,
-	0x22, 0x59, 0xe2, 0x1f, 0xbe, 0xcd, 0xe9, 0xfc, 0x7e, 0xa4, 0xea, 0x30, 0xdb, 0x48, 0xf8, 0xa6,
-	0xb3, 0x48, 0xb8, 0x44, 0x47, 0x3e, 0x81, 0xfa, 0x7d, 0xc7, 0x3d, 0x8b, 0x67, 0x9b, 0xec, 0x0e,
-	0x54, 0x5d, 0x1c, 0xba, 0x31, 0x21, 0x28, 0x74, 0xa7, 0xaa, 0xf7, 0xa6, 0x51, 0x9c, 0x43, 0x3c,
-	0x47, 0x65, 0xb9, 0xa8, 0x37, 0x6c, 0x1a, 0x65, 0x7e, 0x09, 0x8d, 0x44, 0xa9, 0x72, 0xe1, 0x0e,
-	0x2c, 0xa3, 0xf1, 0xbc, 0x58, 0x1a, 0xbd, 0xe4, 0x1f, 0x32, 0x07, 0x1c, 0x6b, 0x49, 0xa2, 0x9a,
-	0xb4, 0x0c, 0x13, 0x74, 0x48, 0xf0, 0x28, 0xe3, 0x97, 0xb9, 0xcf, 0xaf, 0x69, 0x8e, 0xf6, 0x4e,
-	0xea, 0x7f, 0x0f, 0xb5, 0x97, 0x6f, 0x9d, 0xd0, 0x3c, 0x5b, 0x13, 0x4c, 0xce, 0x4e, 0x03, 0x3c,
-	0x49, 0x06, 0x65, 0x02, 0x73, 0xda, 0x19, 0x9a, 0xd2, 0xc8, 0x71, 0x91, 0xfa, 0xcd, 0x6e, 0x06,
-	0x9b, 0xdf, 0x40, 0xfd, 0xe5, 0x55, 0xc7, 0xf9, 0xfd, 0x4f, 0x7f, 0xec, 0x8d, 0x7d, 0x86, 0x28,
-	0xed, 0xf9, 0x78, 0x57, 0x7e, 0xed, 0x0e, 0xf0, 0xee, 0x98, 0xed, 0x8a, 0xff, 0x58, 0xed, 0xe6,
-	0x9e, 0xb8, 0xfd, 0x15, 0x41, 0xb8, 0xf7, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x46, 0xf8,
-	0x40, 0x3b, 0x1b, 0x00, 0x00,
+	// 2431 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4b, 0x6f, 0xdc, 0xc8,
+	0x11, 0x06, 0x67, 0x24, 0x59, 0xaa, 0x79, 0x48, 0xa2, 0x46, 0xd2, 0x48, 0x8e, 0x64, 0x99, 0xf6,
+	0xee, 0x1a, 0xbb, 0xc8, 0xc8, 0x2b, 0xef, 0x2e, 0x36, 0xbb, 0x49, 0x10, 0x59, 0x0f, 0x7b, 0xd7,
+	0xf2, 0x5a, 0x4b, 0xf9, 0x11, 0x18, 0x41, 0x08, 0x0e, 0xd9, 0x23, 0x11, 0xe2, 0xb0, 0xe9, 0xee,
+	0xe6, 0x48, 0x73, 0x09, 0x72, 0xc9, 0x35, 0xf9, 0x07, 0xb9, 0x04, 0x48, 0xee, 0x39, 0xe5, 0x17,
+	0xe4, 0x27, 0x6c, 0x90, 0x5f, 0x92, 0x43, 0x0e, 0x09, 0xfa, 0xc5, 0x21, 0x39, 0x94, 0x2c, 0x0b,
+	0x46, 0x90, 0x8b, 0x30, 0xf5, 0x55, 0x55, 0xd7, 0xa3, 0xab, 0xab, 0xab, 0x29, 0x58, 0x66, 0x6e,
+	0x37, 0x44, 0xac, 0xef, 0x46, 0xee, 0x31, 0x22, 0xbe, 0xcb, 0xdc, 0x4e, 0x4c, 0x30, 0xc3, 0xe6,
+	0xfc, 0x18, 0x63, 0xb5, 0xf6, 0x26, 0x41, 0x64, 0x28, 0xf9, 0xab, 0x4d, 0x86, 0x63, 0x3c, 0x92,
+	0x5f, 0x5d, 0x24, 0x28, 0x0e, 0x03, 0xcf, 0x65, 0x01, 0x8e, 0x32, 0x70, 0x23, 0xc4, 0xc7, 0x09,
+	0x0b, 0x42, 0x45, 0xd6, 0x07, 0x8c, 0x05, 0x7d, 0x24, 0x29, 0xeb, 0x3f, 0x06, 0xcc, 0x3e, 0xe7,
+	0x66, 0x76, 0x51, 0x2f, 0x88, 0x02, 0xae, 0x6a, 0x9a, 0x30, 0x11, 0xb9, 0x7d, 0xd4, 0x36, 0x36,
+	0x8c, 0x7b, 0x33, 0xb6, 0xf8, 0x6d, 0x2e, 0xc1, 0x14, 0xf5, 0x4e, 0x50, 0xdf, 0x6d, 0x57, 0x04,
+	0xaa, 0x28, 0xb3, 0x0d, 0x37, 0x3c, 0x1c, 0x26, 0xfd, 0x88, 0xb6, 0xab, 0x1b, 0xd5, 0x7b, 0x33,
+	0xb6, 0x26, 0xcd, 0x0e, 0x2c, 0xc4, 0x24, 0xe8, 0xbb, 0x64, 0xe8, 0x9c, 0xa2, 0xa1, 0xa3, 0xa5,
+	0x26, 0x84, 0xd4, 0xbc, 0x62, 0x3d, 0x41, 0xc3, 0x1d, 0x25, 0x6f, 0xc2, 0x04, 0x1b, 0xc6, 0xa8,
+	0x3d, 0x29, 0xad, 0xf2, 0xdf, 0xe6, 0x2d, 0xa8, 0xf1, 0x40, 0x9c, 0x10, 0x45, 0xc7, 0xec, 0xa4,
+	0x3d, 0xb5, 0x61, 0xdc, 0x9b, 0xb0, 0x81, 0x43, 0x07, 0x02, 0x31, 0x6f, 0xc2, 0x0c, 0xc1, 0x67,
+	0x8e, 0x87, 0x93, 0x88, 0xb5, 0x6f, 0x08, 0xf6, 0x34, 0xc1, 0x67, 0x3b, 0x9c, 0x36, 0xef, 0xc2,
+	0x54, 0x2f, 0x40, 0xa1, 0x4f, 0xdb, 0xd3, 0x1b, 0xd5, 0x7b, 0xb5, 0xad, 0x7a, 0x47, 0x66, 0x6f,
+	0x9f, 0x83, 0xb6, 0xe2, 0x59, 0x7f, 0x36, 0x60, 0xee, 0x48, 0x04, 0x93, 0x49, 0xc1, 0x47, 0x30,
+	0xcb, 0xad, 0x74, 0x5d, 0x8a, 0x1c, 0x15, 0xb7, 0xcc, 0x46, 0x53, 0xc3, 0x52, 0xc5, 0x7c, 0x06,
+	0x72, 0x97, 0x1c, 0x3f, 0x55, 0xa6, 0xed, 0x8a, 0x30, 0x67, 0x75, 0xc6, 0x37, 0xb6, 0x90, 0x6a,
+	0x7b, 0x8e, 0xe5, 0x01, 0xca, 0x13, 0x3a, 0x40, 0x84, 0x06, 0x38, 0x6a, 0x57, 0x85, 0x45, 0x4d,
+	0x72, 0x47, 0x4d, 0x69, 0x75, 0xe7, 0xc4, 0x8d, 0x8e, 0x91, 0x8d, 0x68, 0x12, 0x32, 0xf3, 0x31,
+	0x34, 0xba, 0xa8, 0x87, 0x49, 0xce, 0xd1, 0xda, 0xd6, 0x9d, 0x12, 0xeb, 0xc5, 0x30, 0xed, 0xba,
+	0xd4, 0x54, 0xb1, 0xec, 0x43, 0xdd, 0xed, 0x31, 0x44, 0x9c, 0xcc, 0x4e, 0x5f, 0x71, 0xa1, 0x9a,
+	0x50, 0x94, 0xb0, 0xf5, 0x2f, 0x03, 0x9a, 0x2f, 0x28, 0x22, 0x87, 0x88, 0xf4, 0x03, 0x4a, 0x55,
+	0x49, 0x9d, 0x60, 0xca, 0x74, 0x49, 0xf1, 0xdf, 0x1c, 0x4b, 0x28, 0x22, 0xaa, 0xa0, 0xc4, 0x6f,
+	0xf3, 0x13, 0x98, 0x8f, 0x5d, 0x4a, 0xcf, 0x30, 0xf1, 0x1d, 0xef, 0x04, 0x79, 0xa7, 0x34, 0xe9,
+	0x8b, 0x3c, 0x4c, 0xd8, 0x73, 0x9a, 0xb1, 0xa3, 0x70, 0xf3, 0x7b, 0x80, 0x98, 0x04, 0x83, 0x20,
+	0x44, 0xc7, 0x48, 0x16, 0x56, 0x6d, 0xeb, 0xd3, 0x12, 0x6f, 0xf3, 0xbe, 0x74, 0x0e, 0x53, 0x9d,
+	0xbd, 0x88, 0x91, 0xa1, 0x9d, 0x59, 0x64, 0xf5, 0x67, 0x30, 0x5b, 0x60, 0x9b, 0x73, 0x50, 0x3d,
+	0x45, 0x43, 0xe5, 0x39, 0xff, 0x69, 0xb6, 0x60, 0x72, 0xe0, 0x86, 0x09, 0x52, 0x9e, 0x4b, 0xe2,
+	0xab, 0xca, 0x97, 0x86, 0xf5, 0x83, 0x01, 0xf5, 0xdd, 0xee, 0x5b, 0xe2, 0x6e, 0x42, 0xc5, 0xef,
+	0x2a, 0xdd, 0x8a, 0xdf, 0x4d, 0xf3, 0x50, 0xcd, 0xe4, 0xe1, 0x59, 0x49, 0x68, 0x9b, 0x25, 0xa1,
+	0xed, 0x76, 0xff, 0x37, 0x81, 0xfd, 0xc9, 0x80, 0xda, 0xc8, 0x12, 0x35, 0x0f, 0x60, 0x8e, 0xfb,
+	0xe9, 0xc4, 0x23, 0xac, 0x6d, 0x08, 0x2f, 0x6f, 0xbf, 0x75, 0x03, 0xec, 0xd9, 0x24, 0x47, 0x53,
+	0x73, 0x1f, 0x9a, 0x7e, 0x37, 0xb7, 0x96, 0x3c, 0x41, 0xb7, 0xde, 0x12, 0xb1, 0xdd, 0xf0, 0x33,
+	0x14, 0xb5, 0x3e, 0x82, 0xda, 0x61, 0x10, 0x1d, 0xdb, 0xe8, 0x4d, 0x82, 0x28, 0xe3, 0x47, 0x29,
+	0x76, 0x87, 0x21, 0x76, 0x7d, 0x15, 0xa4, 0x26, 0xad, 0x7b, 0x50, 0x97, 0x82, 0x34, 0xc6, 0x11,
+	0x45, 0x97, 0x48, 0x7e, 0x0c, 0xf5, 0xa3, 0x10, 0xa1, 0x58, 0xaf, 0xb9, 0x0a, 0xd3, 0x7e, 0x42,
+	0x44, 0x8b, 0x15, 0xa2, 0x55, 0x3b, 0xa5, 0xad, 0x59, 0x68, 0x28, 0x59, 0xb9, 0xac, 0xf5, 0x0f,
+	0x03, 0xcc, 0xbd, 0x73, 0xe4, 0x25, 0x0c, 0x3d, 0xc6, 0xf8, 0x54, 0xaf, 0x51, 0xd6, 0x5f, 0xd7,
+	0x01, 0x62, 0x97, 0xb8, 0x7d, 0xc4, 0x10, 0x91, 0xe1, 0xcf, 0xd8, 0x19, 0xc4, 0x3c, 0x84, 0x19,
+	0x74, 0xce, 0x88, 0xeb, 0xa0, 0x68, 0x20, 0x3a, 0x6d, 0x6d, 0xeb, 0x41, 0x49, 0x76, 0xc6, 0xad,
+	0x75, 0xf6, 0xb8, 0xda, 0x5e, 0x34, 0x90, 0x35, 0x31, 0x8d, 0x14, 0xb9, 0xfa, 0x35, 0x34, 0x72,
+	0xac, 0x77, 0xaa, 0x87, 0x1e, 0x2c, 0xe4, 0x4c, 0xa9, 0x3c, 0xde, 0x82, 0x1a, 0x3a, 0x0f, 0x98,
+	0x43, 0x99, 0xcb, 0x12, 0xaa, 0x12, 0x04, 0x1c, 0x3a, 0x12, 0x88, 0xb8, 0x46, 0x98, 0x8f, 0x13,
+	0x96, 0x5e, 0x23, 0x82, 0x52, 0x38, 0x22, 0xfa, 0x14, 0x28, 0xca, 0x1a, 0xc0, 0xdc, 0x23, 0xc4,
+	0x64, 0x5f, 0xd1, 0xe9, 0x5b, 0x82, 0x29, 0x11, 0xb8, 0xac, 0xb8, 0x19, 0x5b, 0x51, 0xe6, 0x1d,
+	0x68, 0x04, 0x91, 0x17, 0x26, 0x3e, 0x72, 0x06, 0x01, 0x3a, 0xa3, 0xc2, 0xc4, 0xb4, 0x5d, 0x57,
+	0xe0, 0x4b, 0x8e, 0x99, 0x1f, 0x40, 0x13, 0x9d, 0x4b, 0x21, 0xb5, 0x88, 0xbc, 0xb6, 0x1a, 0x0a,
+	0x15, 0x0d, 0x9a, 0x5a, 0x08, 0xe6, 0x33, 0x76, 0x55, 0x74, 0x87, 0x30, 0x2f, 0x3b, 0x63, 0xa6,
+	0xd9, 0xbf, 0x4b, 0xb7, 0x9d, 0xa3, 0x05, 0xc4, 0x5a, 0x86, 0xc5, 0x47, 0x88, 0x65, 0x4a, 0x58,
+	0xc5, 0x68, 0xbd, 0x86, 0xa5, 0x22, 0x43, 0x39, 0xf1, 0x0b, 0xa8, 0xe5, 0x0f, 0x1d, 0x37, 0xbf,
+	0x5e, 0x62, 0x3e, 0xab, 0x9c, 0x55, 0xb1, 0x5a, 0x60, 0x1e, 0x21, 0x66, 0x23, 0xd7, 0x7f, 0x16,
+	0x85, 0x43, 0x6d, 0x71, 0x11, 0x16, 0x72, 0xa8, 0x2a, 0xe1, 0x11, 0xfc, 0x8a, 0x04, 0x0c, 0x69,
+	0xe9, 0x25, 0x68, 0xe5, 0x61, 0x25, 0xfe, 0x2d, 0xcc, 0xcb, 0xcb, 0xe9, 0xf9, 0x30, 0xd6, 0xc2,
+	0xe6, 0xe7, 0x50, 0x93, 0xee, 0x39, 0xe2, 0x82, 0xe7, 0x2e, 0x37, 0xb7, 0x5a, 0x9d, 0x74, 0x7a,
+	0x11, 0x39, 0x67, 0x42, 0x03, 0x58, 0xfa, 0x9b, 0xfb, 0x99, 0x5d, 0x6b, 0xe4, 0x90, 0x8d, 0x7a,
+	0x04, 0xd1, 0x13, 0x5e, 0x52, 0x59, 0x87, 0xf2, 0xb0, 0x12, 0x5f, 0x86, 0x45, 0x3b, 0x89, 0x1e,
+	0x23, 0x37, 0x64, 0x27, 0xe2, 0xe2, 0xd0, 0x0a, 0x6d, 0x58, 0x2a, 0x32, 0x94, 0xca, 0x67, 0xd0,
+	0xfe, 0xe6, 0x38, 0xc2, 0x04, 0x49, 0xe6, 0x1e, 0x21, 0x98, 0xe4, 0x5a, 0x0a, 0x63, 0x88, 0x44,
+	0xa3, 0x46, 0x21, 0x48, 0xeb, 0x26, 0xac, 0x94, 0x68, 0xa9, 0x25, 0xbf, 0xe2, 0x4e, 0xf3, 0x7e,
+	0x92, 0xaf, 0xe4, 0x3b, 0xd0, 0x38, 0x73, 0x03, 0xe6, 0xc4, 0x98, 0x8e, 0x8a, 0x69, 0xc6, 0xae,
+	0x73, 0xf0, 0x50, 0x61, 0x32, 0xb2, 0xac, 0xae, 0x5a, 0x73, 0x0b, 0x96, 0x0e, 0x09, 0xea, 0x85,
+	0xc1, 0xf1, 0x49, 0xe1, 0x80, 0xf0, 0x99, 0x4c, 0x24, 0x4e, 0x9f, 0x10, 0x4d, 0x5a, 0xc7, 0xb0,
+	0x3c, 0xa6, 0xa3, 0xea, 0xea, 0x00, 0x9a, 0x52, 0xca, 0x21, 0x62, 0xae, 0xd0, 0xfd, 0xfc, 0x83,
+	0x0b, 0x2b, 0x3b, 0x3b, 0x85, 0xd8, 0x0d, 0x2f, 0x43, 0x51, 0xeb, 0xdf, 0x06, 0x98, 0xdb, 0x71,
+	0x1c, 0x0e, 0xf3, 0x9e, 0xcd, 0x41, 0x95, 0xbe, 0x09, 0x75, 0x8b, 0xa1, 0x6f, 0x42, 0xde, 0x62,
+	0x7a, 0x98, 0x78, 0x48, 0x1d, 0x56, 0x49, 0xf0, 0x31, 0xc0, 0x0d, 0x43, 0x7c, 0xe6, 0x64, 0x26,
+	0x5a, 0xd1, 0x19, 0xa6, 0xed, 0x39, 0xc1, 0xb0, 0x47, 0xf8, 0xf8, 0x00, 0x34, 0xf1, 0xbe, 0x06,
+	0xa0, 0xc9, 0x6b, 0x0e, 0x40, 0x7f, 0x31, 0x60, 0x21, 0x17, 0xbd, 0xca, 0xf1, 0xff, 0xdf, 0xa8,
+	0xb6, 0x00, 0xf3, 0x07, 0xd8, 0x3b, 0x95, 0x5d, 0x4f, 0x1f, 0x8d, 0x16, 0x98, 0x59, 0x70, 0x74,
+	0xf0, 0x5e, 0x44, 0xe1, 0x98, 0xf0, 0x12, 0xb4, 0xf2, 0xb0, 0x12, 0xff, 0xab, 0x01, 0x6d, 0x75,
+	0x45, 0xec, 0x23, 0xe6, 0x9d, 0x6c, 0xd3, 0xdd, 0x6e, 0x5a, 0x07, 0x2d, 0x98, 0x14, 0xa3, 0xb8,
+	0x48, 0x40, 0xdd, 0x96, 0x84, 0xb9, 0x0c, 0x37, 0xfc, 0xae, 0x23, 0xae, 0x46, 0x75, 0x3b, 0xf8,
+	0xdd, 0xef, 0xf8, 0xe5, 0xb8, 0x02, 0xd3, 0x7d, 0xf7, 0xdc, 0x21, 0xf8, 0x8c, 0xaa, 0x61, 0xf0,
+	0x46, 0xdf, 0x3d, 0xb7, 0xf1, 0x19, 0x15, 0x83, 0x7a, 0x40, 0xc5, 0x04, 0xde, 0x0d, 0xa2, 0x10,
+	0x1f, 0x53, 0xb1, 0xfd, 0xd3, 0x76, 0x53, 0xc1, 0x0f, 0x25, 0xca, 0xcf, 0x1a, 0x11, 0xc7, 0x28,
+	0xbb, 0xb9, 0xd3, 0x76, 0x9d, 0x64, 0xce, 0x96, 0xf5, 0x08, 0x56, 0x4a, 0x7c, 0x56, 0xbb, 0xf7,
+	0x31, 0x4c, 0xc9, 0xa3, 0xa1, 0xb6, 0xcd, 0x54, 0xcf, 0x89, 0xef, 0xf9, 0x5f, 0x75, 0x0c, 0x94,
+	0x84, 0xf5, 0x7b, 0x03, 0xd6, 0xf2, 0x2b, 0x6d, 0x87, 0x21, 0x1f, 0xc0, 0xe8, 0xfb, 0x4f, 0xc1,
+	0x58, 0x64, 0x13, 0x25, 0x91, 0x1d, 0xc0, 0xfa, 0x45, 0xfe, 0x5c, 0x23, 0xbc, 0x27, 0xc5, 0xbd,
+	0xdd, 0x8e, 0xe3, 0xcb, 0x03, 0xcb, 0xfa, 0x5f, 0xc9, 0xf9, 0x3f, 0x9e, 0x74, 0xb1, 0xd8, 0x35,
+	0xbc, 0x5a, 0x85, 0x76, 0xa6, 0x2f, 0xc8, 0x89, 0x43, 0x97, 0xe9, 0x01, 0xac, 0x94, 0xf0, 0x94,
+	0x91, 0x4d, 0x3e, 0x7d, 0xa4, 0x13, 0x4b, 0x6d, 0x6b, 0xb9, 0x53, 0x7c, 0x49, 0x2b, 0x05, 0x25,
+	0xc6, 0xcf, 0xc2, 0x53, 0x97, 0xf2, 0x63, 0x94, 0x33, 0xf2, 0x14, 0x5a, 0x79, 0x58, 0xad, 0xff,
+	0x79, 0x61, 0xfd, 0xb5, 0xb1, 0xf5, 0x73, 0x6a, 0xda, 0xca, 0x32, 0x2c, 0x4a, 0x5c, 0xdf, 0x05,
+	0xda, 0xce, 0x67, 0xb0, 0x54, 0x64, 0x28, 0x4b, 0xab, 0x30, 0x5d, 0xb8, 0x4c, 0x52, 0x9a, 0x6b,
+	0xbd, 0x72, 0x03, 0xb6, 0x8f, 0x8b, 0xeb, 0x5d, 0xaa, 0xb5, 0x02, 0xcb, 0x63, 0x5a, 0xea, 0x88,
+	0xb7, 0x61, 0xe9, 0x88, 0xe1, 0x38, 0x93, 0x57, 0xed, 0xe0, 0x0a, 0x2c, 0x8f, 0x71, 0x94, 0xd2,
+	0xaf, 0x61, 0xad, 0xc0, 0x7a, 0x1a, 0x44, 0x41, 0x3f, 0xe9, 0x5f, 0xc1, 0x19, 0xf3, 0x36, 0x88,
+	0xbb, 0xd1, 0x61, 0x41, 0x1f, 0xe9, 0x21, 0xb2, 0x6a, 0xd7, 0x38, 0xf6, 0x5c, 0x42, 0xd6, 0x4f,
+	0x61, 0xfd, 0xa2, 0xf5, 0xaf, 0x90, 0x23, 0xe1, 0xb8, 0x4b, 0x58, 0x49, 0x4c, 0xab, 0xd0, 0x1e,
+	0x67, 0xa9, 0xa0, 0xba, 0x70, 0xbb, 0xc8, 0x7b, 0x11, 0xb1, 0x20, 0xdc, 0xe6, 0xad, 0xf6, 0x3d,
+	0x05, 0x76, 0x17, 0xac, 0xcb, 0x6c, 0x28, 0x4f, 0x5a, 0x60, 0x3e, 0x42, 0x5a, 0x26, 0x2d, 0xcc,
+	0x4f, 0x60, 0x21, 0x87, 0xaa, 0x4c, 0xb4, 0x60, 0xd2, 0xf5, 0x7d, 0xa2, 0xc7, 0x04, 0x49, 0xf0,
+	0x1c, 0xd8, 0x88, 0xa2, 0x0b, 0x72, 0x30, 0xce, 0x52, 0x96, 0x37, 0x61, 0xf9, 0x65, 0x06, 0xe7,
+	0x47, 0xba, 0xb4, 0x25, 0xcc, 0xa8, 0x96, 0x60, 0xed, 0x43, 0x7b, 0x5c, 0xe1, 0x5a, 0xcd, 0x68,
+	0x2d, 0xbb, 0xce, 0xa8, 0x5a, 0xb5, 0xf9, 0x26, 0x54, 0x02, 0x5f, 0x3d, 0x46, 0x2a, 0x81, 0x9f,
+	0xdb, 0x88, 0x4a, 0xa1, 0x00, 0x36, 0x60, 0xfd, 0xa2, 0xc5, 0x54, 0x9c, 0x0b, 0x30, 0xff, 0x4d,
+	0x14, 0x30, 0x79, 0x00, 0x75, 0x62, 0xee, 0x83, 0x99, 0x05, 0xaf, 0x50, 0x69, 0x3f, 0x18, 0xb0,
+	0x7e, 0x88, 0xe3, 0x24, 0x14, 0xd3, 0x6a, 0xec, 0x12, 0x14, 0xb1, 0x6f, 0x71, 0x42, 0x22, 0x37,
+	0xd4, 0x7e, 0x7f, 0x08, 0xb3, 0xbc, 0x1e, 0x1c, 0x8f, 0x20, 0x97, 0x21, 0xdf, 0x89, 0xf4, 0x8b,
+	0xaa, 0xc1, 0xe1, 0x1d, 0x89, 0x7e, 0x47, 0xf9, 0xab, 0xcb, 0xf5, 0xf8, 0xa2, 0xd9, 0x8b, 0x03,
+	0x24, 0x24, 0x2e, 0x8f, 0x2f, 0xa1, 0xde, 0x17, 0x9e, 0x39, 0x6e, 0x18, 0xb8, 0xf2, 0x02, 0xa9,
+	0x6d, 0x2d, 0x16, 0x27, 0xf0, 0x6d, 0xce, 0xb4, 0x6b, 0x52, 0x54, 0x10, 0xe6, 0xa7, 0xd0, 0xca,
+	0xb4, 0xaa, 0xd1, 0xa0, 0x3a, 0x21, 0x6c, 0x2c, 0x64, 0x78, 0xe9, 0xbc, 0x7a, 0x1b, 0x6e, 0x5d,
+	0x18, 0x97, 0x4a, 0xe1, 0x1f, 0x0d, 0x99, 0x2e, 0x95, 0x68, 0x1d, 0xef, 0x8f, 0x61, 0x4a, 0xca,
+	0xb7, 0x8d, 0xcb, 0x1c, 0x54, 0x42, 0x17, 0xfa, 0x56, 0xb9, 0xd0, 0xb7, 0xb2, 0x8c, 0x56, 0x4b,
+	0x32, 0xca, 0xfb, 0x7b, 0xce, 0xbf, 0xd1, 0x08, 0xb4, 0x8b, 0xfa, 0x98, 0xa1, 0xfc, 0xe6, 0xff,
+	0xc1, 0x80, 0x56, 0x1e, 0x57, 0xfb, 0xff, 0x00, 0x16, 0x7c, 0x14, 0x13, 0xe4, 0x09, 0x63, 0xf9,
+	0x52, 0x78, 0x58, 0x69, 0x1b, 0xb6, 0x39, 0x62, 0xa7, 0x3e, 0x3e, 0x84, 0x86, 0xda, 0x2c, 0x75,
+	0x67, 0x54, 0xae, 0x72, 0x67, 0xd4, 0xfb, 0x19, 0x8a, 0x1f, 0xe1, 0x17, 0x91, 0x8f, 0xcb, 0x9c,
+	0x5d, 0x85, 0xf6, 0x38, 0x4b, 0xc5, 0x77, 0x33, 0xbd, 0x24, 0x5f, 0xb9, 0xf4, 0x90, 0x60, 0x2e,
+	0xe2, 0x6b, 0xc5, 0x1f, 0xc1, 0x6a, 0x19, 0x53, 0xa9, 0xfe, 0x9d, 0x7f, 0x45, 0x45, 0xf9, 0x53,
+	0xf1, 0xae, 0x1b, 0x5a, 0xb2, 0x3b, 0x95, 0xb2, 0x7a, 0xff, 0x02, 0x96, 0xc5, 0x33, 0x81, 0x27,
+	0x88, 0xb0, 0x92, 0x37, 0xc2, 0xa2, 0x60, 0x17, 0xbb, 0xe5, 0xf8, 0x73, 0x6b, 0xa2, 0xe4, 0xb9,
+	0xb5, 0x00, 0xf3, 0x99, 0x38, 0x54, 0x74, 0x4f, 0xb2, 0xb1, 0xdb, 0x48, 0xd8, 0x45, 0xfe, 0xf5,
+	0xc2, 0xb4, 0xd6, 0xe0, 0x66, 0xe9, 0x62, 0xca, 0xd6, 0x6f, 0x78, 0x9f, 0xcf, 0x5d, 0x60, 0xdb,
+	0x91, 0xcf, 0x3f, 0x46, 0x64, 0x47, 0x0d, 0xf3, 0x97, 0xb0, 0x48, 0x19, 0x8e, 0xb3, 0xc1, 0x3b,
+	0x7d, 0xec, 0xeb, 0xd7, 0xf5, 0xdd, 0x92, 0x09, 0x26, 0x7f, 0x29, 0x62, 0x1f, 0xd9, 0x0b, 0x74,
+	0x1c, 0xe4, 0x8f, 0x97, 0x3b, 0x97, 0x3a, 0x90, 0x7e, 0x88, 0x68, 0x9c, 0x0c, 0xbb, 0x24, 0xf0,
+	0x9d, 0x2b, 0xcd, 0x4e, 0xa2, 0xde, 0xeb, 0x52, 0x43, 0x22, 0xe6, 0xcf, 0xd3, 0xb1, 0x48, 0x96,
+	0xf8, 0x87, 0x6f, 0x73, 0x7a, 0x7c, 0x3e, 0x52, 0x75, 0x98, 0x6f, 0x24, 0x7c, 0xd2, 0x29, 0x32,
+	0xae, 0xd0, 0x91, 0x7f, 0x67, 0x40, 0xe3, 0xa1, 0xeb, 0x9d, 0x26, 0xe9, 0x28, 0xbb, 0x01, 0x35,
+	0x0f, 0x47, 0x5e, 0x42, 0x08, 0x8a, 0xbc, 0xa1, 0x6a, 0xbe, 0x59, 0x88, 0x4b, 0x88, 0xf7, 0xa8,
+	0xac, 0x17, 0xf5, 0x88, 0xcd, 0x42, 0xe6, 0x7d, 0x68, 0x05, 0x91, 0x47, 0x50, 0x1f, 0x45, 0xcc,
+	0x0d, 0x9d, 0x1e, 0xc1, 0x7d, 0x5e, 0x80, 0xea, 0x3b, 0x97, 0x99, 0xe1, 0xed, 0x13, 0xdc, 0x3f,
+	0xc4, 0xd4, 0xfa, 0x02, 0x9a, 0xda, 0x0d, 0xe5, 0xf5, 0x5d, 0x98, 0x44, 0x83, 0x51, 0x7d, 0x35,
+	0x3b, 0xfa, 0x3f, 0x3a, 0x7b, 0x1c, 0xb5, 0x25, 0xd3, 0xfa, 0xad, 0x21, 0x6e, 0x67, 0x86, 0x09,
+	0xe2, 0x4b, 0xe5, 0x43, 0xb9, 0x0b, 0x4d, 0x22, 0x79, 0x0e, 0xc3, 0xc2, 0x01, 0xf5, 0xad, 0x41,
+	0xa1, 0xcf, 0xf1, 0x21, 0xe6, 0x3b, 0xd2, 0xca, 0x48, 0xf1, 0x53, 0x47, 0x99, 0xdb, 0x8f, 0xd5,
+	0xfe, 0xd4, 0x3b, 0xea, 0x5f, 0x47, 0x7c, 0x24, 0xb1, 0xcd, 0x54, 0xf3, 0xb9, 0x96, 0xb3, 0xb6,
+	0x79, 0x03, 0x19, 0xf3, 0xe0, 0x9d, 0xa2, 0xf8, 0x15, 0xd4, 0x5f, 0xbe, 0x75, 0x76, 0xe0, 0xfb,
+	0x78, 0x86, 0xc9, 0x69, 0x2f, 0xc4, 0x67, 0xfa, 0x0a, 0xd7, 0x34, 0xe7, 0x9d, 0xa2, 0x21, 0x8d,
+	0x5d, 0x0f, 0xa9, 0x2c, 0xa7, 0xb4, 0xf5, 0x35, 0x34, 0x5e, 0x5e, 0x7b, 0xd0, 0xf8, 0x9b, 0x01,
+	0xf5, 0x97, 0xbb, 0x41, 0xaf, 0x97, 0x99, 0xe8, 0x52, 0x4b, 0x46, 0xde, 0xd2, 0xa5, 0x1e, 0x2e,
+	0xc1, 0x94, 0xbc, 0x9d, 0xf5, 0xd7, 0x4e, 0x49, 0x99, 0x6b, 0x00, 0x03, 0x3f, 0xe8, 0xf5, 0x9c,
+	0x24, 0x09, 0x7c, 0xd5, 0x9d, 0x66, 0x04, 0xf2, 0x22, 0x09, 0x7c, 0xf3, 0x27, 0x70, 0x03, 0xc7,
+	0xf2, 0x3f, 0x4c, 0xf2, 0xcb, 0x44, 0xd9, 0xf7, 0x71, 0xe1, 0xe0, 0x33, 0x29, 0x66, 0x6b, 0x79,
+	0xeb, 0x35, 0x34, 0x94, 0xe7, 0xa3, 0xb8, 0x71, 0xc2, 0xe2, 0xe4, 0xd2, 0xb8, 0xa5, 0x44, 0xc1,
+	0xad, 0x4a, 0xc1, 0x2d, 0xeb, 0x9f, 0x3a, 0x2d, 0xca, 0x2a, 0x9f, 0x47, 0x28, 0x4e, 0x78, 0x83,
+	0xf6, 0x50, 0xa8, 0xbf, 0xf6, 0x80, 0x84, 0x76, 0x50, 0x18, 0xf2, 0x69, 0x37, 0xf3, 0x41, 0x90,
+	0xaa, 0x25, 0x6b, 0xa3, 0x6f, 0x7f, 0x34, 0xf3, 0x91, 0xb7, 0x9a, 0xfb, 0xc8, 0x9b, 0x7d, 0x47,
+	0x4e, 0x88, 0xf3, 0x98, 0xbe, 0x83, 0x6d, 0xf8, 0xb0, 0x17, 0x84, 0x0c, 0x11, 0xe4, 0xe7, 0xda,
+	0x62, 0x3a, 0x58, 0x3b, 0x14, 0x79, 0x38, 0xf2, 0x65, 0xf6, 0xaa, 0xb6, 0xa5, 0xa5, 0x0b, 0xf3,
+	0x1e, 0x2f, 0xe7, 0x23, 0x29, 0xf9, 0xf0, 0xfe, 0xeb, 0xce, 0x20, 0x60, 0x88, 0xd2, 0x4e, 0x80,
+	0x37, 0xe5, 0xaf, 0xcd, 0x63, 0xbc, 0x39, 0x60, 0x9b, 0xe2, 0xdf, 0xa7, 0x9b, 0x63, 0xf9, 0xef,
+	0x4e, 0x09, 0xc6, 0x83, 0xff, 0x0e, 0x00, 0xd7, 0xfe, 0x25, 0xdc, 0xd6, 0x1d, 0x00, 0x00,
 }
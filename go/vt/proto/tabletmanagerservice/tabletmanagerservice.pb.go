@@ -29,70 +29,71 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("tabletmanagerservice.proto", fileDescriptor_9ee75fe63cfd9360) }
 
 var fileDescriptor_9ee75fe63cfd9360 = []byte{
-	// 998 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x98, 0x6d, 0x6f, 0x23, 0x35,
-	0x10, 0xc7, 0xa9, 0xc4, 0x9d, 0x84, 0x79, 0x36, 0x88, 0x93, 0x8a, 0x04, 0x07, 0xd7, 0x83, 0xe3,
-	0x0e, 0x9a, 0x7b, 0xe0, 0x78, 0x9f, 0x7b, 0x68, 0xaf, 0xa8, 0x15, 0x21, 0xe9, 0x03, 0x02, 0x09,
-	0xc9, 0x4d, 0xa6, 0x89, 0xe9, 0xc6, 0x5e, 0x6c, 0x27, 0xa2, 0xaf, 0x90, 0x78, 0x8b, 0xc4, 0x07,
-	0xe2, 0xd3, 0x9d, 0xb2, 0x59, 0x7b, 0xc7, 0xbb, 0xb3, 0xce, 0xf6, 0x5d, 0x94, 0xf9, 0xcd, 0xfc,
-	0xed, 0xd9, 0xf1, 0x8c, 0x77, 0xd9, 0xb6, 0x13, 0xe7, 0x19, 0xb8, 0xb9, 0x50, 0x62, 0x0a, 0xc6,
-	0x82, 0x59, 0xca, 0x31, 0xec, 0xe6, 0x46, 0x3b, 0xcd, 0x3f, 0xa6, 0x6c, 0xdb, 0xb7, 0xa2, 0x7f,
-	0x27, 0xc2, 0x89, 0x35, 0xfe, 0xf8, 0xff, 0x1d, 0xf6, 0xee, 0x71, 0x61, 0x3b, 0x5a, 0xdb, 0xf8,
-	0x01, 0x7b, 0x73, 0x20, 0xd5, 0x94, 0x7f, 0xb6, 0xdb, 0xf4, 0x59, 0x19, 0x86, 0xf0, 0xe7, 0x02,
-	0xac, 0xdb, 0xfe, 0xbc, 0xd5, 0x6e, 0x73, 0xad, 0x2c, 0x7c, 0xf9, 0x06, 0x3f, 0x64, 0x37, 0x46,
-	0x19, 0x40, 0xce, 0x29, 0xb6, 0xb0, 0xf8, 0x60, 0xb7, 0xdb, 0x81, 0x10, 0xed, 0x77, 0xf6, 0xf6,
-	0xcb, 0xbf, 0x60, 0xbc, 0x70, 0xf0, 0x4a, 0xeb, 0x4b, 0x7e, 0x97, 0x70, 0x41, 0x76, 0x1f, 0xf9,
-	0xab, 0x4d, 0x58, 0x88, 0xff, 0x0b, 0x7b, 0x6b, 0x1f, 0xdc, 0x68, 0x3c, 0x83, 0xb9, 0xe0, 0x77,
-	0x08, 0xb7, 0x60, 0xf5, 0xb1, 0x77, 0xd2, 0x50, 0x88, 0x3c, 0x65, 0xef, 0xed, 0x83, 0x1b, 0x80,
-	0x99, 0x4b, 0x6b, 0xa5, 0x56, 0x96, 0xdf, 0xa3, 0x3d, 0x11, 0xe2, 0x35, 0xbe, 0xe9, 0x40, 0xe2,
-	0x14, 0x8d, 0xc0, 0x0d, 0x41, 0x4c, 0x7e, 0x52, 0xd9, 0x15, 0x99, 0x22, 0x64, 0x4f, 0xa5, 0x28,
-	0xc2, 0x42, 0x7c, 0xc1, 0xde, 0x29, 0x0d, 0x67, 0x46, 0x3a, 0xe0, 0x09, 0xcf, 0x02, 0xf0, 0x0a,
-	0x5f, 0x6f, 0xe4, 0x82, 0xc4, 0x6f, 0x8c, 0x3d, 0x9f, 0x09, 0x35, 0x85, 0xe3, 0xab, 0x1c, 0x38,
-	0x95, 0xe1, 0xca, 0xec, 0xc3, 0xdf, 0xdd, 0x40, 0xe1, 0xf5, 0x0f, 0xe1, 0xc2, 0x80, 0x9d, 0x8d,
-	0x9c, 0x68, 0x59, 0x3f, 0x06, 0x52, 0xeb, 0x8f, 0x39, 0xfc, 0xac, 0x87, 0x0b, 0xf5, 0x0a, 0x44,
-	0xe6, 0x66, 0xcf, 0x67, 0x30, 0xbe, 0x24, 0x9f, 0x75, 0x8c, 0xa4, 0x9e, 0x75, 0x9d, 0x0c, 0x42,
-	0x39, 0xfb, 0xf0, 0x60, 0xaa, 0xb4, 0x81, 0xb5, 0xf9, 0xa5, 0x31, 0xda, 0xf0, 0x07, 0x44, 0x84,
-	0x06, 0xe5, 0xe5, 0xbe, 0xed, 0x06, 0xc7, 0xd9, 0xcb, 0xb4, 0x98, 0x94, 0x67, 0x84, 0xce, 0x5e,
-	0x05, 0xa4, 0xb3, 0x87, 0xb9, 0x20, 0xf1, 0x07, 0x7b, 0x7f, 0x60, 0xe0, 0x22, 0x93, 0xd3, 0x99,
-	0x3f, 0x89, 0x54, 0x52, 0x6a, 0x8c, 0x17, 0xba, 0xdf, 0x05, 0xc5, 0x87, 0xa5, 0x9f, 0xe7, 0xd9,
-	0x55, 0xa9, 0x43, 0x15, 0x11, 0xb2, 0xa7, 0x0e, 0x4b, 0x84, 0xe1, 0x4a, 0x3e, 0xd4, 0xe3, 0xcb,
-	0xa2, 0xbb, 0x5a, 0xb2, 0x92, 0x2b, 0x73, 0xaa, 0x92, 0x31, 0x85, 0x9f, 0xc5, 0x89, 0xca, 0xaa,
-	0xf0, 0xd4, 0xb2, 0x30, 0x90, 0x7a, 0x16, 0x31, 0x87, 0x0b, 0xac, 0x6c, 0x94, 0x7b, 0xe0, 0xc6,
-	0xb3, 0xbe, 0x7d, 0x71, 0x2e, 0xc8, 0x02, 0x6b, 0x50, 0xa9, 0x02, 0x23, 0xe0, 0xa0, 0xf8, 0x37,
-	0xfb, 0x24, 0x36, 0xf7, 0xb3, 0x6c, 0x60, 0xe4, 0xd2, 0xf2, 0x87, 0x1b, 0x23, 0x79, 0xd4, 0x6b,
-	0x3f, 0xba, 0x86, 0x47, 0xfb, 0x96, 0xfb, 0x79, 0xde, 0x61, 0xcb, 0xfd, 0x3c, 0xef, 0xbe, 0xe5,
-	0x02, 0xc6, 0x8a, 0x43, 0xc8, 0x33, 0x39, 0x16, 0x4e, 0x6a, 0xb5, 0x6a, 0x26, 0x0b, 0x4b, 0x2a,
-	0x36, 0xa8, 0x94, 0x22, 0x01, 0xe3, 0xca, 0x39, 0x12, 0xd6, 0x81, 0x29, 0xc5, 0xa8, 0xca, 0xc1,
-	0x40, 0xaa, 0x72, 0x62, 0x0e, 0xf7, 0xc0, 0xb5, 0x65, 0xa0, 0xad, 0x5c, 0x2d, 0x82, 0xec, 0x81,
-	0x31, 0x92, 0xea, 0x81, 0x75, 0x12, 0xb7, 0x8b, 0x33, 0x21, 0xdd, 0x9e, 0xae, 0x94, 0x28, 0xff,
-	0x1a, 0x93, 0x6a, 0x17, 0x0d, 0x14, 0x6b, 0x8d, 0x9c, 0xce, 0x51, 0x6a, 0x49, 0xad, 0x1a, 0x93,
-	0xd2, 0x6a, 0xa0, 0xf8, 0x20, 0xd4, 0x8c, 0x47, 0x52, 0xc9, 0xf9, 0x62, 0x4e, 0x1e, 0x04, 0x1a,
-	0x4d, 0x1d, 0x84, 0x36, 0x8f, 0xb0, 0x80, 0x39, 0xfb, 0x60, 0xe4, 0x84, 0x71, 0x78, 0xb7, 0xf4,
-	0x16, 0x62, 0xc8, 0x8b, 0x3e, 0xe8, 0xc4, 0x06, 0xb9, 0x7f, 0xb7, 0xd8, 0x76, 0xdd, 0x7c, 0xa2,
-	0x9c, 0xcc, 0xfa, 0x17, 0x0e, 0x0c, 0xff, 0xbe, 0x43, 0xb4, 0x0a, 0xf7, 0x6b, 0x78, 0x7a, 0x4d,
-	0x2f, 0x3c, 0x18, 0xf6, 0xc1, 0x53, 0x96, 0x1c, 0x0c, 0xc8, 0x9e, 0x1a, 0x0c, 0x11, 0x86, 0x93,
-	0x7b, 0x8a, 0xd6, 0xb0, 0x6a, 0x0f, 0x64, 0x72, 0xeb, 0x50, 0x2a, 0xb9, 0x4d, 0x16, 0x17, 0x13,
-	0xb6, 0x56, 0x15, 0x4e, 0x16, 0x13, 0x8d, 0xa6, 0x8a, 0xa9, 0xcd, 0x03, 0xef, 0x77, 0x08, 0x16,
-	0x36, 0x16, 0x53, 0x1d, 0x4a, 0xed, 0xb7, 0xc9, 0xe2, 0xb9, 0x7b, 0xa0, 0xa4, 0x5b, 0x37, 0x0d,
-	0x72, 0xee, 0x56, 0xe6, 0xd4, 0xdc, 0xc5, 0x54, 0x08, 0xfe, 0xcf, 0x16, 0xbb, 0x35, 0xd0, 0xf9,
-	0x22, 0x2b, 0x6e, 0x7d, 0xb9, 0x30, 0xa0, 0xdc, 0x8f, 0x7a, 0x61, 0x94, 0xc8, 0x38, 0x95, 0x9c,
-	0x16, 0xd6, 0xeb, 0x3e, 0xbe, 0x8e, 0x0b, 0x2e, 0xd0, 0xd5, 0xe2, 0xca, 0xed, 0xf3, 0xb6, 0xc5,
-	0x97, 0xf6, 0x54, 0x81, 0x46, 0x18, 0x1e, 0x11, 0x2f, 0x60, 0xae, 0x1d, 0x94, 0x39, 0xa4, 0x3c,
-	0x31, 0x90, 0x1a, 0x11, 0x31, 0x87, 0x6b, 0xe2, 0x44, 0x4d, 0x74, 0x24, 0x73, 0x9f, 0xbc, 0x9b,
-	0xc4, 0x50, 0xaa, 0x26, 0x9a, 0x6c, 0x90, 0xb3, 0x8c, 0x97, 0xdb, 0x3c, 0x13, 0x76, 0x60, 0xf4,
-	0x0a, 0x9a, 0xf0, 0xc4, 0xe8, 0x44, 0x98, 0x97, 0xfc, 0xae, 0x23, 0x8d, 0x5f, 0x28, 0x47, 0xe0,
-	0xeb, 0xf0, 0x0e, 0xfd, 0x0a, 0x14, 0xef, 0x6a, 0x27, 0x0d, 0x85, 0xc8, 0x4b, 0xf6, 0x51, 0xa5,
-	0x3c, 0x04, 0xbb, 0xea, 0x6a, 0x30, 0xe1, 0xe9, 0x15, 0x06, 0xce, 0xab, 0xed, 0x76, 0xc5, 0x83,
-	0xee, 0x7f, 0x5b, 0xec, 0xd3, 0xda, 0xec, 0xe8, 0xab, 0xc9, 0xea, 0x95, 0x77, 0x7d, 0x97, 0x78,
-	0xba, 0x79, 0xd6, 0x60, 0xde, 0x2f, 0xe4, 0x87, 0xeb, 0xba, 0xe1, 0x9b, 0x46, 0x99, 0x78, 0x7f,
-	0x18, 0xee, 0x91, 0xef, 0x00, 0x18, 0x49, 0xdd, 0x34, 0xea, 0x64, 0x10, 0xfa, 0x99, 0xdd, 0x7c,
-	0x26, 0xc6, 0x97, 0x8b, 0x9c, 0x53, 0x9f, 0x2a, 0xd6, 0x26, 0x1f, 0xf8, 0x8b, 0x04, 0xe1, 0x03,
-	0x3e, 0xdc, 0xe2, 0x66, 0x75, 0xf5, 0xb3, 0x4e, 0x1b, 0xd8, 0x33, 0x7a, 0x5e, 0x46, 0x6f, 0xe9,
-	0x75, 0x31, 0x95, 0xbe, 0xfa, 0x35, 0x60, 0xa4, 0x79, 0xc8, 0x6e, 0x9c, 0x16, 0xf3, 0x86, 0xfa,
-	0x22, 0x73, 0x8a, 0x87, 0xcc, 0xed, 0x76, 0xc0, 0xc7, 0x7b, 0xf6, 0xe4, 0xd7, 0x47, 0x4b, 0xe9,
-	0xc0, 0xda, 0x5d, 0xa9, 0x7b, 0xeb, 0x5f, 0xbd, 0xa9, 0xee, 0x2d, 0x5d, 0xaf, 0xf8, 0xb8, 0xd4,
-	0xa3, 0x3e, 0x45, 0x9d, 0xdf, 0x2c, 0x6c, 0x4f, 0x5e, 0x07, 0x00, 0x00, 0xff, 0xff, 0xba, 0xb0,
-	0x28, 0x40, 0xc5, 0x12, 0x00, 0x00,
+	// 1010 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x98, 0xed, 0x6f, 0x23, 0x35,
+	0x10, 0xc6, 0xa9, 0xc4, 0x9d, 0x84, 0x79, 0x37, 0x88, 0x93, 0x8a, 0x04, 0x07, 0x77, 0x85, 0xe3,
+	0x0a, 0xcd, 0xbd, 0x70, 0x7c, 0xcf, 0x5d, 0xaf, 0xbd, 0xa2, 0x56, 0x84, 0xa4, 0x2f, 0x08, 0x24,
+	0x24, 0x37, 0x99, 0x24, 0xa6, 0x9b, 0xf5, 0x62, 0x3b, 0x11, 0xfd, 0x84, 0xc4, 0x27, 0x24, 0x24,
+	0xfe, 0xe6, 0xd3, 0x66, 0xd7, 0xde, 0xf1, 0xee, 0xac, 0xb3, 0xfd, 0x56, 0xf5, 0xf9, 0xcd, 0x3c,
+	0xf6, 0xec, 0xd8, 0xb3, 0x59, 0xb6, 0x6d, 0xc5, 0x65, 0x02, 0x76, 0x21, 0x52, 0x31, 0x03, 0x6d,
+	0x40, 0xaf, 0xe4, 0x18, 0xf6, 0x32, 0xad, 0xac, 0xe2, 0x1f, 0x53, 0xda, 0xf6, 0x9d, 0xe0, 0xbf,
+	0x13, 0x61, 0x45, 0x81, 0x3f, 0xf9, 0x77, 0x87, 0xbd, 0x7b, 0xba, 0xd6, 0x4e, 0x0a, 0x8d, 0x1f,
+	0xb1, 0x37, 0x07, 0x32, 0x9d, 0xf1, 0xcf, 0xf6, 0x9a, 0x31, 0xb9, 0x30, 0x84, 0x3f, 0x97, 0x60,
+	0xec, 0xf6, 0xe7, 0xad, 0xba, 0xc9, 0x54, 0x6a, 0xe0, 0xcb, 0x37, 0xf8, 0x31, 0xbb, 0x35, 0x4a,
+	0x00, 0x32, 0x4e, 0xb1, 0x6b, 0xc5, 0x25, 0xbb, 0xdb, 0x0e, 0xf8, 0x6c, 0xbf, 0xb3, 0xb7, 0x5f,
+	0xfe, 0x05, 0xe3, 0xa5, 0x85, 0x57, 0x4a, 0x5d, 0xf1, 0x1d, 0x22, 0x04, 0xe9, 0x2e, 0xf3, 0x57,
+	0x9b, 0x30, 0x9f, 0xff, 0x17, 0xf6, 0xd6, 0x21, 0xd8, 0xd1, 0x78, 0x0e, 0x0b, 0xc1, 0xef, 0x11,
+	0x61, 0x5e, 0x75, 0xb9, 0xef, 0xc7, 0x21, 0x9f, 0x79, 0xc6, 0xde, 0x3b, 0x04, 0x3b, 0x00, 0xbd,
+	0x90, 0xc6, 0x48, 0x95, 0x1a, 0xfe, 0x80, 0x8e, 0x44, 0x88, 0xf3, 0xf8, 0xa6, 0x03, 0x89, 0x4b,
+	0x34, 0x02, 0x3b, 0x04, 0x31, 0xf9, 0x29, 0x4d, 0xae, 0xc9, 0x12, 0x21, 0x3d, 0x56, 0xa2, 0x00,
+	0xf3, 0xf9, 0x05, 0x7b, 0xa7, 0x14, 0x2e, 0xb4, 0xb4, 0xc0, 0x23, 0x91, 0x6b, 0xc0, 0x39, 0x7c,
+	0xbd, 0x91, 0xf3, 0x16, 0xbf, 0x31, 0xf6, 0x62, 0x2e, 0xd2, 0x19, 0x9c, 0x5e, 0x67, 0xc0, 0xa9,
+	0x0a, 0x57, 0xb2, 0x4b, 0xbf, 0xb3, 0x81, 0xc2, 0xeb, 0x1f, 0xc2, 0x54, 0x83, 0x99, 0x8f, 0xac,
+	0x68, 0x59, 0x3f, 0x06, 0x62, 0xeb, 0x0f, 0x39, 0xfc, 0xac, 0x87, 0xcb, 0xf4, 0x15, 0x88, 0xc4,
+	0xce, 0x5f, 0xcc, 0x61, 0x7c, 0x45, 0x3e, 0xeb, 0x10, 0x89, 0x3d, 0xeb, 0x3a, 0xe9, 0x8d, 0x32,
+	0xf6, 0xe1, 0xd1, 0x2c, 0x55, 0x1a, 0x0a, 0xf9, 0xa5, 0xd6, 0x4a, 0xf3, 0x5d, 0x22, 0x43, 0x83,
+	0x72, 0x76, 0xdf, 0x76, 0x83, 0xc3, 0xea, 0x25, 0x4a, 0x4c, 0xca, 0x33, 0x42, 0x57, 0xaf, 0x02,
+	0xe2, 0xd5, 0xc3, 0x9c, 0xb7, 0xf8, 0x83, 0xbd, 0x3f, 0xd0, 0x30, 0x4d, 0xe4, 0x6c, 0xee, 0x4e,
+	0x22, 0x55, 0x94, 0x1a, 0xe3, 0x8c, 0x1e, 0x76, 0x41, 0xf1, 0x61, 0xe9, 0x67, 0x59, 0x72, 0x5d,
+	0xfa, 0x50, 0x4d, 0x84, 0xf4, 0xd8, 0x61, 0x09, 0x30, 0xdc, 0xc9, 0xc7, 0x6a, 0x7c, 0xb5, 0xbe,
+	0x5d, 0x0d, 0xd9, 0xc9, 0x95, 0x1c, 0xeb, 0x64, 0x4c, 0xe1, 0x67, 0x71, 0x96, 0x26, 0x55, 0x7a,
+	0x6a, 0x59, 0x18, 0x88, 0x3d, 0x8b, 0x90, 0xc3, 0x0d, 0x56, 0x5e, 0x94, 0x07, 0x60, 0xc7, 0xf3,
+	0xbe, 0xd9, 0xbf, 0x14, 0x64, 0x83, 0x35, 0xa8, 0x58, 0x83, 0x11, 0xb0, 0x77, 0xfc, 0x9b, 0x7d,
+	0x12, 0xca, 0xfd, 0x24, 0x19, 0x68, 0xb9, 0x32, 0xfc, 0xd1, 0xc6, 0x4c, 0x0e, 0x75, 0xde, 0x8f,
+	0x6f, 0x10, 0xd1, 0xbe, 0xe5, 0x7e, 0x96, 0x75, 0xd8, 0x72, 0x3f, 0xcb, 0xba, 0x6f, 0x79, 0x0d,
+	0x63, 0xc7, 0x21, 0x64, 0x89, 0x1c, 0x0b, 0x2b, 0x55, 0x3a, 0xb2, 0xc2, 0x2e, 0x0d, 0xe9, 0xd8,
+	0xa0, 0x62, 0x8e, 0x04, 0x8c, 0x3b, 0xe7, 0x44, 0x18, 0x0b, 0xba, 0x34, 0xa3, 0x3a, 0x07, 0x03,
+	0xb1, 0xce, 0x09, 0x39, 0x7c, 0x07, 0x16, 0xca, 0x40, 0x19, 0x99, 0x2f, 0x82, 0xbc, 0x03, 0x43,
+	0x24, 0x76, 0x07, 0xd6, 0x49, 0x7c, 0x5d, 0x5c, 0x08, 0x69, 0x0f, 0x54, 0xe5, 0x44, 0xc5, 0xd7,
+	0x98, 0xd8, 0x75, 0xd1, 0x40, 0xb1, 0xd7, 0xc8, 0xaa, 0x0c, 0x95, 0x96, 0xf4, 0xaa, 0x31, 0x31,
+	0xaf, 0x06, 0x8a, 0x0f, 0x42, 0x4d, 0x3c, 0x91, 0xa9, 0x5c, 0x2c, 0x17, 0xe4, 0x41, 0xa0, 0xd1,
+	0xd8, 0x41, 0x68, 0x8b, 0xf0, 0x0b, 0x58, 0xb0, 0x0f, 0x46, 0x56, 0x68, 0x8b, 0x77, 0x4b, 0x6f,
+	0x21, 0x84, 0x9c, 0xe9, 0x6e, 0x27, 0xd6, 0xdb, 0xfd, 0xb7, 0xc5, 0xb6, 0xeb, 0xf2, 0x59, 0x6a,
+	0x65, 0xd2, 0x9f, 0x5a, 0xd0, 0xfc, 0xfb, 0x0e, 0xd9, 0x2a, 0xdc, 0xad, 0xe1, 0xd9, 0x0d, 0xa3,
+	0xf0, 0x60, 0x38, 0x04, 0x47, 0x19, 0x72, 0x30, 0x20, 0x3d, 0x36, 0x18, 0x02, 0x0c, 0x17, 0xf7,
+	0x1c, 0xad, 0x21, 0xbf, 0x1e, 0xc8, 0xe2, 0xd6, 0xa1, 0x58, 0x71, 0x9b, 0x2c, 0x6e, 0x26, 0xac,
+	0x56, 0x1d, 0x4e, 0x36, 0x13, 0x8d, 0xc6, 0x9a, 0xa9, 0x2d, 0x02, 0xef, 0x77, 0x08, 0x06, 0x36,
+	0x36, 0x53, 0x1d, 0x8a, 0xed, 0xb7, 0xc9, 0xe2, 0xb9, 0x7b, 0x94, 0x4a, 0x5b, 0x5c, 0x1a, 0xe4,
+	0xdc, 0xad, 0xe4, 0xd8, 0xdc, 0xc5, 0x94, 0x4f, 0xfe, 0xcf, 0x16, 0xbb, 0x33, 0x50, 0xd9, 0x32,
+	0x11, 0x16, 0x86, 0x90, 0x09, 0x0d, 0xa9, 0xfd, 0x51, 0x2d, 0x75, 0x2a, 0x12, 0x4e, 0x15, 0xa7,
+	0x85, 0x75, 0xbe, 0x4f, 0x6e, 0x12, 0x82, 0x1b, 0x34, 0x5f, 0x5c, 0xb9, 0x7d, 0xde, 0xb6, 0xf8,
+	0x52, 0x8f, 0x35, 0x68, 0x80, 0xe1, 0x11, 0xb1, 0x0f, 0x0b, 0x65, 0xa1, 0xac, 0x21, 0x15, 0x89,
+	0x81, 0xd8, 0x88, 0x08, 0x39, 0xdc, 0x13, 0x67, 0xe9, 0x44, 0x05, 0x36, 0x0f, 0xc9, 0x77, 0x93,
+	0x89, 0xa2, 0xac, 0x76, 0x3b, 0xb1, 0xde, 0xce, 0x30, 0x5e, 0x6e, 0xf3, 0x42, 0x98, 0x81, 0x56,
+	0x39, 0x34, 0xe1, 0x91, 0xd1, 0x89, 0x30, 0x67, 0xf9, 0x5d, 0x47, 0x1a, 0xff, 0xa0, 0x1c, 0x81,
+	0xeb, 0xc3, 0x7b, 0xf4, 0x4f, 0xa0, 0x70, 0x57, 0xf7, 0xe3, 0x90, 0xcf, 0xbc, 0x62, 0x1f, 0x55,
+	0xce, 0x43, 0x30, 0x56, 0xe8, 0x7c, 0x3f, 0xf1, 0x15, 0x7a, 0xce, 0xb9, 0xed, 0x75, 0xc5, 0xbd,
+	0xef, 0xff, 0x5b, 0xec, 0xd3, 0xda, 0xec, 0xe8, 0xa7, 0x93, 0xfc, 0x27, 0x6f, 0xf1, 0x2e, 0xf1,
+	0x6c, 0xf3, 0xac, 0xc1, 0xbc, 0x5b, 0xc8, 0x0f, 0x37, 0x0d, 0xc3, 0x6f, 0x1a, 0x65, 0xe1, 0xdd,
+	0x61, 0x78, 0x40, 0xfe, 0x06, 0xc0, 0x48, 0xec, 0x4d, 0xa3, 0x4e, 0x7a, 0xa3, 0x9f, 0xd9, 0xed,
+	0xe7, 0x62, 0x7c, 0xb5, 0xcc, 0x38, 0xf5, 0xa9, 0xa2, 0x90, 0x5c, 0xe2, 0x2f, 0x22, 0x84, 0x4b,
+	0xf8, 0x68, 0x8b, 0xeb, 0xfc, 0xd5, 0xcf, 0x58, 0xa5, 0xe1, 0x40, 0xab, 0x45, 0x99, 0xbd, 0xe5,
+	0xae, 0x0b, 0xa9, 0xf8, 0xab, 0x5f, 0x03, 0x46, 0x9e, 0xc7, 0xec, 0xd6, 0xf9, 0x7a, 0xde, 0x50,
+	0x5f, 0x64, 0xce, 0xf1, 0x90, 0xb9, 0xdb, 0x0e, 0xe0, 0xef, 0x3b, 0xe7, 0xfb, 0x72, 0x3a, 0xa5,
+	0xb3, 0xe5, 0x4a, 0x34, 0x5b, 0x01, 0xb8, 0x6c, 0xcf, 0x9f, 0xfe, 0xfa, 0x78, 0x25, 0x2d, 0x18,
+	0xb3, 0x27, 0x55, 0xaf, 0xf8, 0xab, 0x37, 0x53, 0xbd, 0x95, 0xed, 0xad, 0x3f, 0x55, 0xf5, 0xa8,
+	0x0f, 0x5b, 0x97, 0xb7, 0xd7, 0xda, 0xd3, 0xd7, 0x03, 0x00, 0xa5, 0x4f, 0x3c, 0xf9, 0x13, 0x13,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -184,6 +185,9 @@ type TabletManagerClient interface {
 	RestoreFromBackup(ctx context.Context, in *tabletmanagerdata.RestoreFromBackupRequest, opts ...grpc.CallOption) (TabletManager_RestoreFromBackupClient, error)
 	// Generic VExec request. Can be used for various purposes
 	VExec(ctx context.Context, in *tabletmanagerdata.VExecRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VExecResponse, error)
+	// VDiff creates, reports on, stops or resumes a vdiff of the workflow
+	// streams of the tablet, which runs on the tablet itself.
+	VDiff(ctx context.Context, in *tabletmanagerdata.VDiffRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VDiffResponse, error)
 }
 
 type tabletManagerClient struct {
@@ -636,6 +640,15 @@ func (c *tabletManagerClient) VExec(ctx context.Context, in *tabletmanagerdata.V
 	return out, nil
 }
 
+func (c *tabletManagerClient) VDiff(ctx context.Context, in *tabletmanagerdata.VDiffRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VDiffResponse, error) {
+	out := new(tabletmanagerdata.VDiffResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/VDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TabletManagerServer is the server API for TabletManager service.
 type TabletManagerServer interface {
 	// Ping returns the input payload
@@ -715,6 +728,9 @@ type TabletManagerServer interface {
 	RestoreFromBackup(*tabletmanagerdata.RestoreFromBackupRequest, TabletManager_RestoreFromBackupServer) error
 	// Generic VExec request. Can be used for various purposes
 	VExec(context.Context, *tabletmanagerdata.VExecRequest) (*tabletmanagerdata.VExecResponse, error)
+	// VDiff creates, reports on, stops or resumes a vdiff of the workflow
+	// streams of the tablet, which runs on the tablet itself.
+	VDiff(context.Context, *tabletmanagerdata.VDiffRequest) (*tabletmanagerdata.VDiffResponse, error)
 }
 
 // UnimplementedTabletManagerServer can be embedded to have forward compatible implementations.
@@ -853,6 +869,9 @@ func (*UnimplementedTabletManagerServer) RestoreFromBackup(req *tabletmanagerdat
 func (*UnimplementedTabletManagerServer) VExec(ctx context.Context, req *tabletmanagerdata.VExecRequest) (*tabletmanagerdata.VExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VExec not implemented")
 }
+func (*UnimplementedTabletManagerServer) VDiff(ctx context.Context, req *tabletmanagerdata.VDiffRequest) (*tabletmanagerdata.VDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VDiff not implemented")
+}
 
 func RegisterTabletManagerServer(s *grpc.Server, srv TabletManagerServer) {
 	s.RegisterService(&_TabletManager_serviceDesc, srv)
@@ -1656,6 +1675,24 @@ func _TabletManager_VExec_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabletManager_VDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.VDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).VDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/VDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).VDiff(ctx, req.(*tabletmanagerdata.VDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _TabletManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "tabletmanagerservice.TabletManager",
 	HandlerType: (*TabletManagerServer)(nil),
@@ -1828,6 +1865,10 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VExec",
 			Handler:    _TabletManager_VExec_Handler,
 		},
+		{
+			MethodName: "VDiff",
+			Handler:    _TabletManager_VDiff_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -885,6 +885,128 @@ func (m *Keyspace_ServedFrom) GetKeyspace() string {
 	return ""
 }
 
+// ThrottledAppRule throttles an app on the tablets of a keyspace.
+type ThrottledAppRule struct {
+	// name of the app, as given in throttler checks
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// ratio of the checks of the app which are throttled, from 0 to 1
+	Ratio float64 `protobuf:"fixed64,2,opt,name=ratio,proto3" json:"ratio,omitempty"`
+	// expires_at is the time (in UTC) at which the app is no longer throttled
+	ExpiresAt            *vttime.Time `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ThrottledAppRule) Reset()         { *m = ThrottledAppRule{} }
+func (m *ThrottledAppRule) String() string { return proto.CompactTextString(m) }
+func (*ThrottledAppRule) ProtoMessage()    {}
+func (*ThrottledAppRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52c350cb619f972e, []int{5}
+}
+
+func (m *ThrottledAppRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThrottledAppRule.Unmarshal(m, b)
+}
+func (m *ThrottledAppRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThrottledAppRule.Marshal(b, m, deterministic)
+}
+func (m *ThrottledAppRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThrottledAppRule.Merge(m, src)
+}
+func (m *ThrottledAppRule) XXX_Size() int {
+	return xxx_messageInfo_ThrottledAppRule.Size(m)
+}
+func (m *ThrottledAppRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThrottledAppRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThrottledAppRule proto.InternalMessageInfo
+
+func (m *ThrottledAppRule) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ThrottledAppRule) GetRatio() float64 {
+	if m != nil {
+		return m.Ratio
+	}
+	return 0
+}
+
+func (m *ThrottledAppRule) GetExpiresAt() *vttime.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+// ThrottlerConfig is the configuration of the tablet throttler of a keyspace.
+// It is stored in the global topology server, and watched by the primary
+// tablets of the keyspace.
+type ThrottlerConfig struct {
+	// enabled tells the throttler whether it should throttle at all.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// threshold is the replication lag threshold, in seconds. It overrides
+	// the -throttle_threshold flag of the tablets when non zero.
+	Threshold float64 `protobuf:"fixed64,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// throttled_apps are the apps throttled on all the tablets of the keyspace,
+	// by app name.
+	ThrottledApps        map[string]*ThrottledAppRule `protobuf:"bytes,3,rep,name=throttled_apps,json=throttledApps,proto3" json:"throttled_apps,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *ThrottlerConfig) Reset()         { *m = ThrottlerConfig{} }
+func (m *ThrottlerConfig) String() string { return proto.CompactTextString(m) }
+func (*ThrottlerConfig) ProtoMessage()    {}
+func (*ThrottlerConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52c350cb619f972e, []int{6}
+}
+
+func (m *ThrottlerConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThrottlerConfig.Unmarshal(m, b)
+}
+func (m *ThrottlerConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThrottlerConfig.Marshal(b, m, deterministic)
+}
+func (m *ThrottlerConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThrottlerConfig.Merge(m, src)
+}
+func (m *ThrottlerConfig) XXX_Size() int {
+	return xxx_messageInfo_ThrottlerConfig.Size(m)
+}
+func (m *ThrottlerConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThrottlerConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThrottlerConfig proto.InternalMessageInfo
+
+func (m *ThrottlerConfig) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *ThrottlerConfig) GetThreshold() float64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *ThrottlerConfig) GetThrottledApps() map[string]*ThrottledAppRule {
+	if m != nil {
+		return m.ThrottledApps
+	}
+	return nil
+}
+
 // ShardReplication describes the MySQL replication relationships
 // whithin a cell.
 type ShardReplication struct {
@@ -900,7 +1022,7 @@ func (m *ShardReplication) Reset()         { *m = ShardReplication{} }
 func (m *ShardReplication) String() string { return proto.CompactTextString(m) }
 func (*ShardReplication) ProtoMessage()    {}
 func (*ShardReplication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{5}
+	return fileDescriptor_52c350cb619f972e, []int{7}
 }
 
 func (m *ShardReplication) XXX_Unmarshal(b []byte) error {
@@ -940,7 +1062,7 @@ func (m *ShardReplication_Node) Reset()         { *m = ShardReplication_Node{} }
 func (m *ShardReplication_Node) String() string { return proto.CompactTextString(m) }
 func (*ShardReplication_Node) ProtoMessage()    {}
 func (*ShardReplication_Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{5, 0}
+	return fileDescriptor_52c350cb619f972e, []int{7, 0}
 }
 
 func (m *ShardReplication_Node) XXX_Unmarshal(b []byte) error {
@@ -982,7 +1104,7 @@ func (m *ShardReference) Reset()         { *m = ShardReference{} }
 func (m *ShardReference) String() string { return proto.CompactTextString(m) }
 func (*ShardReference) ProtoMessage()    {}
 func (*ShardReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{6}
+	return fileDescriptor_52c350cb619f972e, []int{8}
 }
 
 func (m *ShardReference) XXX_Unmarshal(b []byte) error {
@@ -1033,7 +1155,7 @@ func (m *ShardTabletControl) Reset()         { *m = ShardTabletControl{} }
 func (m *ShardTabletControl) String() string { return proto.CompactTextString(m) }
 func (*ShardTabletControl) ProtoMessage()    {}
 func (*ShardTabletControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{7}
+	return fileDescriptor_52c350cb619f972e, []int{9}
 }
 
 func (m *ShardTabletControl) XXX_Unmarshal(b []byte) error {
@@ -1092,7 +1214,7 @@ func (m *SrvKeyspace) Reset()         { *m = SrvKeyspace{} }
 func (m *SrvKeyspace) String() string { return proto.CompactTextString(m) }
 func (*SrvKeyspace) ProtoMessage()    {}
 func (*SrvKeyspace) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{8}
+	return fileDescriptor_52c350cb619f972e, []int{10}
 }
 
 func (m *SrvKeyspace) XXX_Unmarshal(b []byte) error {
@@ -1157,7 +1279,7 @@ func (m *SrvKeyspace_KeyspacePartition) Reset()         { *m = SrvKeyspace_Keysp
 func (m *SrvKeyspace_KeyspacePartition) String() string { return proto.CompactTextString(m) }
 func (*SrvKeyspace_KeyspacePartition) ProtoMessage()    {}
 func (*SrvKeyspace_KeyspacePartition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{8, 0}
+	return fileDescriptor_52c350cb619f972e, []int{10, 0}
 }
 
 func (m *SrvKeyspace_KeyspacePartition) XXX_Unmarshal(b []byte) error {
@@ -1215,7 +1337,7 @@ func (m *SrvKeyspace_ServedFrom) Reset()         { *m = SrvKeyspace_ServedFrom{}
 func (m *SrvKeyspace_ServedFrom) String() string { return proto.CompactTextString(m) }
 func (*SrvKeyspace_ServedFrom) ProtoMessage()    {}
 func (*SrvKeyspace_ServedFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{8, 1}
+	return fileDescriptor_52c350cb619f972e, []int{10, 1}
 }
 
 func (m *SrvKeyspace_ServedFrom) XXX_Unmarshal(b []byte) error {
@@ -1271,7 +1393,7 @@ func (m *CellInfo) Reset()         { *m = CellInfo{} }
 func (m *CellInfo) String() string { return proto.CompactTextString(m) }
 func (*CellInfo) ProtoMessage()    {}
 func (*CellInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{9}
+	return fileDescriptor_52c350cb619f972e, []int{11}
 }
 
 func (m *CellInfo) XXX_Unmarshal(b []byte) error {
@@ -1319,7 +1441,7 @@ func (m *CellsAlias) Reset()         { *m = CellsAlias{} }
 func (m *CellsAlias) String() string { return proto.CompactTextString(m) }
 func (*CellsAlias) ProtoMessage()    {}
 func (*CellsAlias) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{10}
+	return fileDescriptor_52c350cb619f972e, []int{12}
 }
 
 func (m *CellsAlias) XXX_Unmarshal(b []byte) error {
@@ -1362,6 +1484,9 @@ func init() {
 	proto.RegisterType((*Shard_TabletControl)(nil), "topodata.Shard.TabletControl")
 	proto.RegisterType((*Keyspace)(nil), "topodata.Keyspace")
 	proto.RegisterType((*Keyspace_ServedFrom)(nil), "topodata.Keyspace.ServedFrom")
+	proto.RegisterType((*ThrottledAppRule)(nil), "topodata.ThrottledAppRule")
+	proto.RegisterType((*ThrottlerConfig)(nil), "topodata.ThrottlerConfig")
+	proto.RegisterMapType((map[string]*ThrottledAppRule)(nil), "topodata.ThrottlerConfig.ThrottledAppsEntry")
 	proto.RegisterType((*ShardReplication)(nil), "topodata.ShardReplication")
 	proto.RegisterType((*ShardReplication_Node)(nil), "topodata.ShardReplication.Node")
 	proto.RegisterType((*ShardReference)(nil), "topodata.ShardReference")
@@ -1376,90 +1501,98 @@ func init() {
 func init() { proto.RegisterFile("topodata.proto", fileDescriptor_52c350cb619f972e) }
 
 var fileDescriptor_52c350cb619f972e = []byte{
-	// 1349 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6e, 0xdb, 0x46,
-	0x13, 0x0f, 0xf5, 0xcf, 0xd4, 0x88, 0x92, 0x99, 0x8d, 0x63, 0x10, 0xfa, 0xbe, 0xa0, 0x86, 0x8a,
-	0xa0, 0x82, 0x8b, 0xca, 0xad, 0x93, 0xb4, 0x46, 0x8a, 0x02, 0x51, 0x64, 0xa5, 0x71, 0x6c, 0xcb,
-	0xc2, 0x4a, 0x46, 0x9b, 0x5e, 0x08, 0x5a, 0x5a, 0x3b, 0x84, 0x25, 0x52, 0xd9, 0x5d, 0x0b, 0x50,
-	0x5f, 0xa1, 0x87, 0xf6, 0xdc, 0x37, 0xe8, 0xfb, 0xf4, 0xd8, 0x4b, 0xfb, 0x1c, 0x3d, 0x14, 0x3b,
-	0x4b, 0x52, 0x94, 0x14, 0xa7, 0x4e, 0xe1, 0xdb, 0xcc, 0xec, 0xcc, 0x70, 0xe6, 0xb7, 0xbf, 0x99,
-	0x95, 0xa0, 0x22, 0xc3, 0x49, 0x38, 0xf4, 0xa4, 0xd7, 0x98, 0xf0, 0x50, 0x86, 0xc4, 0x8c, 0xf5,
-	0xaa, 0x35, 0x95, 0xd2, 0x1f, 0x33, 0x6d, 0xaf, 0xed, 0x82, 0x79, 0xc8, 0x66, 0xd4, 0x0b, 0x2e,
-	0x18, 0xd9, 0x80, 0xbc, 0x90, 0x1e, 0x97, 0x8e, 0xb1, 0x65, 0xd4, 0x2d, 0xaa, 0x15, 0x62, 0x43,
-	0x96, 0x05, 0x43, 0x27, 0x83, 0x36, 0x25, 0xd6, 0x1e, 0x41, 0xa9, 0xef, 0x9d, 0x8d, 0x98, 0x6c,
-	0x8e, 0x7c, 0x4f, 0x10, 0x02, 0xb9, 0x01, 0x1b, 0x8d, 0x30, 0xaa, 0x48, 0x51, 0x56, 0x41, 0x57,
-	0xbe, 0x0e, 0x2a, 0x53, 0x25, 0xd6, 0xfe, 0xce, 0x41, 0x41, 0x47, 0x91, 0x4f, 0x21, 0xef, 0xa9,
-	0x48, 0x8c, 0x28, 0xed, 0xde, 0x6f, 0x24, 0xb5, 0xa6, 0xd2, 0x52, 0xed, 0x43, 0xaa, 0x60, 0xbe,
-	0x09, 0x85, 0x0c, 0xbc, 0x31, 0xc3, 0x74, 0x45, 0x9a, 0xe8, 0x64, 0x0f, 0xcc, 0x49, 0xc8, 0xa5,
-	0x3b, 0xf6, 0x26, 0x4e, 0x6e, 0x2b, 0x5b, 0x2f, 0xed, 0x3e, 0x58, 0xce, 0xd5, 0xe8, 0x86, 0x5c,
-	0x1e, 0x7b, 0x93, 0x76, 0x20, 0xf9, 0x8c, 0xae, 0x4d, 0xb4, 0xa6, 0xb2, 0x5e, 0xb2, 0x99, 0x98,
-	0x78, 0x03, 0xe6, 0xe4, 0x75, 0xd6, 0x58, 0x47, 0x18, 0xde, 0x78, 0x7c, 0xe8, 0x14, 0xf0, 0x40,
-	0x2b, 0x64, 0x07, 0x8a, 0x97, 0x6c, 0xe6, 0x72, 0x85, 0x94, 0xb3, 0x86, 0x85, 0x93, 0xf9, 0xc7,
-	0x62, 0x0c, 0x31, 0x8d, 0x46, 0xb3, 0x0e, 0x39, 0x39, 0x9b, 0x30, 0xc7, 0xdc, 0x32, 0xea, 0x95,
-	0xdd, 0x8d, 0xe5, 0xc2, 0xfa, 0xb3, 0x09, 0xa3, 0xe8, 0x41, 0xea, 0x60, 0x0f, 0xcf, 0x5c, 0xd5,
-	0x91, 0x1b, 0x4e, 0x19, 0xe7, 0xfe, 0x90, 0x39, 0x45, 0xfc, 0x76, 0x65, 0x78, 0xd6, 0xf1, 0xc6,
-	0xec, 0x24, 0xb2, 0x92, 0x06, 0xe4, 0xa4, 0x77, 0x21, 0x1c, 0xc0, 0x66, 0xab, 0x2b, 0xcd, 0xf6,
-	0xbd, 0x0b, 0xa1, 0x3b, 0x45, 0x3f, 0xf2, 0x10, 0x2a, 0xe3, 0x99, 0x78, 0x3b, 0x72, 0x13, 0x08,
-	0x2d, 0xcc, 0x5b, 0x46, 0xeb, 0xcb, 0x18, 0xc7, 0x07, 0x00, 0xda, 0x4d, 0xc1, 0xe3, 0x94, 0xb7,
-	0x8c, 0x7a, 0x9e, 0x16, 0xd1, 0xa2, 0xd0, 0x23, 0x4d, 0xd8, 0x1c, 0x7b, 0x42, 0x32, 0xee, 0x4a,
-	0xc6, 0xc7, 0x2e, 0xd2, 0xc2, 0x55, 0x1c, 0x72, 0x2a, 0x88, 0x83, 0xd5, 0x88, 0x28, 0xd5, 0xf7,
-	0xc7, 0x8c, 0xde, 0xd3, 0xbe, 0x7d, 0xc6, 0xc7, 0x3d, 0xe5, 0xa9, 0x8c, 0xd5, 0xa7, 0x60, 0xa5,
-	0x2f, 0x42, 0xf1, 0xe3, 0x92, 0xcd, 0x22, 0xca, 0x28, 0x51, 0xa1, 0x3e, 0xf5, 0x46, 0x57, 0xfa,
-	0x92, 0xf3, 0x54, 0x2b, 0x4f, 0x33, 0x7b, 0x46, 0xf5, 0x2b, 0x28, 0x26, 0x7d, 0xfd, 0x5b, 0x60,
-	0x31, 0x15, 0xf8, 0x2a, 0x67, 0x66, 0xed, 0xdc, 0xab, 0x9c, 0x59, 0xb2, 0xad, 0xda, 0xef, 0x05,
-	0xc8, 0xf7, 0xf0, 0x22, 0xf7, 0xc0, 0x8a, 0xba, 0xb9, 0x01, 0x09, 0x4b, 0xda, 0x55, 0x13, 0xfd,
-	0x7a, 0x1c, 0xcc, 0x1b, 0xe2, 0xb0, 0xc8, 0xa2, 0xcc, 0x0d, 0x58, 0xf4, 0x0d, 0x58, 0x82, 0xf1,
-	0x29, 0x1b, 0xba, 0x8a, 0x2a, 0xc2, 0xc9, 0x2e, 0xdf, 0x3c, 0x36, 0xd5, 0xe8, 0xa1, 0x0f, 0x72,
-	0xaa, 0x24, 0x12, 0x59, 0x90, 0x67, 0x50, 0x16, 0xe1, 0x15, 0x1f, 0x30, 0x17, 0x59, 0x2c, 0xa2,
-	0x31, 0xf9, 0xdf, 0x4a, 0x3c, 0x3a, 0xa1, 0x4c, 0x2d, 0x31, 0x57, 0x04, 0x79, 0x01, 0xeb, 0x12,
-	0x01, 0x71, 0x07, 0x61, 0x20, 0x79, 0x38, 0x12, 0x4e, 0x61, 0x79, 0xd4, 0x74, 0x0e, 0x8d, 0x5b,
-	0x4b, 0x7b, 0xd1, 0x8a, 0x4c, 0xab, 0x82, 0x6c, 0xc3, 0x5d, 0x5f, 0xb8, 0x11, 0x7e, 0xaa, 0x44,
-	0x3f, 0xb8, 0xc0, 0x39, 0x32, 0xe9, 0xba, 0x2f, 0x8e, 0xd1, 0xde, 0xd3, 0xe6, 0xea, 0x6b, 0x80,
-	0x79, 0x43, 0xe4, 0x09, 0x94, 0xa2, 0x0a, 0x70, 0x9e, 0x8c, 0xf7, 0xcc, 0x13, 0xc8, 0x44, 0x56,
-	0xbc, 0x50, 0xab, 0x48, 0x38, 0x99, 0xad, 0xac, 0xe2, 0x05, 0x2a, 0xd5, 0x5f, 0x0d, 0x28, 0xa5,
-	0x9a, 0x8d, 0x17, 0x95, 0x91, 0x2c, 0xaa, 0x85, 0xd5, 0x90, 0xb9, 0x6e, 0x35, 0x64, 0xaf, 0x5d,
-	0x0d, 0xb9, 0x1b, 0x5c, 0xea, 0x26, 0x14, 0xb0, 0x50, 0xe1, 0xe4, 0xb1, 0xb6, 0x48, 0xab, 0xfe,
-	0x66, 0x40, 0x79, 0x01, 0xc5, 0x5b, 0xed, 0x9d, 0x7c, 0x06, 0xe4, 0x6c, 0xe4, 0x0d, 0x2e, 0x47,
-	0xbe, 0x90, 0x8a, 0x50, 0xba, 0x84, 0x1c, 0xba, 0xdc, 0x4d, 0x9d, 0x60, 0x52, 0xa1, 0xaa, 0x3c,
-	0xe7, 0xe1, 0x8f, 0x2c, 0xc0, 0x0d, 0x69, 0xd2, 0x48, 0x4b, 0xc6, 0x2a, 0x6f, 0x17, 0x6a, 0x7f,
-	0x64, 0xf1, 0xfd, 0xd0, 0xe8, 0x7c, 0x0e, 0x1b, 0x08, 0x88, 0x1f, 0x5c, 0xb8, 0x83, 0x70, 0x74,
-	0x35, 0x0e, 0x70, 0xa9, 0x45, 0xc3, 0x4a, 0xe2, 0xb3, 0x16, 0x1e, 0xa9, 0xbd, 0x46, 0x5e, 0xad,
-	0x46, 0x60, 0x9f, 0x19, 0xec, 0xd3, 0x59, 0x00, 0x11, 0xbf, 0x71, 0xa0, 0x39, 0xbe, 0x94, 0x0b,
-	0x7b, 0x7e, 0x96, 0x4c, 0xca, 0x39, 0x0f, 0xc7, 0x62, 0xf5, 0x41, 0x88, 0x73, 0x44, 0xc3, 0xf2,
-	0x82, 0x87, 0xe3, 0x78, 0x58, 0x94, 0x2c, 0xc8, 0xd7, 0x50, 0x8e, 0x6f, 0x5a, 0x97, 0x91, 0xc7,
-	0x32, 0x36, 0x57, 0x53, 0x60, 0x11, 0xd6, 0x65, 0x4a, 0x23, 0x1f, 0x43, 0xf9, 0xcc, 0x13, 0xcc,
-	0x4d, 0xb8, 0xa3, 0x5f, 0x0f, 0x4b, 0x19, 0x13, 0x84, 0xbe, 0x80, 0xb2, 0x08, 0xbc, 0x89, 0x78,
-	0x13, 0x46, 0x8b, 0x63, 0xed, 0x1d, 0x8b, 0xc3, 0x8a, 0x5d, 0x70, 0x73, 0x5e, 0xc5, 0xb3, 0xa0,
-	0x6a, 0xbc, 0x5d, 0x3e, 0xa4, 0x99, 0x9e, 0x5d, 0x64, 0xba, 0xbe, 0xe4, 0xda, 0x4f, 0x06, 0xd8,
-	0x7a, 0x29, 0xb0, 0xc9, 0xc8, 0x1f, 0x78, 0xd2, 0x0f, 0x03, 0xf2, 0x04, 0xf2, 0x41, 0x38, 0x64,
-	0x6a, 0x73, 0x2a, 0x84, 0x3f, 0x5a, 0xda, 0x03, 0x29, 0xd7, 0x46, 0x27, 0x1c, 0x32, 0xaa, 0xbd,
-	0xab, 0xcf, 0x20, 0xa7, 0x54, 0xb5, 0x7f, 0xa3, 0x16, 0x6e, 0xb2, 0x7f, 0xe5, 0x5c, 0xa9, 0x9d,
-	0x42, 0x25, 0xfa, 0xc2, 0x39, 0xe3, 0x2c, 0x18, 0x30, 0xf5, 0xd3, 0x23, 0xc5, 0x30, 0x94, 0x3f,
-	0x78, 0xc5, 0xd6, 0x7e, 0x36, 0x80, 0x60, 0xde, 0xc5, 0xd1, 0xbb, 0x8d, 0xdc, 0xe4, 0x31, 0x6c,
-	0xbe, 0xbd, 0x62, 0x7c, 0xa6, 0x37, 0xde, 0x80, 0xb9, 0x43, 0x5f, 0xa8, 0xaf, 0xe8, 0x0d, 0x62,
-	0xd2, 0x0d, 0x3c, 0xed, 0xe9, 0xc3, 0xfd, 0xe8, 0xac, 0xf6, 0x57, 0x0e, 0x4a, 0x3d, 0x3e, 0x4d,
-	0x68, 0xf3, 0x2d, 0xc0, 0xc4, 0xe3, 0xd2, 0x57, 0x98, 0xc6, 0xb0, 0x7f, 0x92, 0x82, 0x7d, 0xee,
-	0x9a, 0x30, 0xb4, 0x1b, 0xfb, 0xd3, 0x54, 0xe8, 0xb5, 0x13, 0x9a, 0xf9, 0xe0, 0x09, 0xcd, 0xfe,
-	0x87, 0x09, 0x6d, 0x42, 0x29, 0x35, 0xa1, 0xd1, 0x80, 0x6e, 0xbd, 0xbb, 0x8f, 0xd4, 0x8c, 0xc2,
-	0x7c, 0x46, 0xab, 0x7f, 0x1a, 0x70, 0x77, 0xa5, 0x45, 0x35, 0x15, 0xa9, 0x47, 0xf2, 0xfd, 0x53,
-	0x31, 0x7f, 0x1d, 0x49, 0x0b, 0x6c, 0xac, 0xd2, 0xe5, 0x31, 0xa1, 0xf4, 0x80, 0x94, 0xd2, 0x7d,
-	0x2d, 0x32, 0x8e, 0xae, 0x8b, 0x05, 0x5d, 0x90, 0x2e, 0xdc, 0xd7, 0x49, 0x96, 0x5f, 0x49, 0xfd,
-	0x52, 0xff, 0x7f, 0x29, 0xd3, 0xe2, 0x23, 0x79, 0x4f, 0xac, 0xd8, 0x44, 0xd5, 0xbd, 0x8d, 0x89,
-	0x7f, 0xcf, 0x2b, 0x16, 0xad, 0xee, 0x43, 0x30, 0x5b, 0x6c, 0x34, 0x3a, 0x08, 0xce, 0x43, 0xf5,
-	0x3b, 0x11, 0x71, 0xe1, 0xae, 0x37, 0x1c, 0x72, 0x26, 0x44, 0xc4, 0xfa, 0xb2, 0xb6, 0x36, 0xb5,
-	0x51, 0x8d, 0x04, 0x0f, 0x43, 0x19, 0x25, 0x44, 0x39, 0x5a, 0x14, 0x35, 0x00, 0x95, 0x4c, 0xe8,
-	0x1f, 0x4a, 0xef, 0x5c, 0x37, 0xdb, 0x75, 0xb0, 0xd2, 0xfb, 0x93, 0x00, 0x14, 0x3a, 0x27, 0xf4,
-	0xb8, 0x79, 0x64, 0xdf, 0x21, 0x16, 0x98, 0xbd, 0x4e, 0xb3, 0xdb, 0x7b, 0x79, 0xd2, 0xb7, 0x8d,
-	0xed, 0x5d, 0xa8, 0x2c, 0xd2, 0x89, 0x14, 0x21, 0x7f, 0xda, 0xe9, 0xb5, 0xfb, 0xf6, 0x1d, 0x15,
-	0x76, 0x7a, 0xd0, 0xe9, 0x7f, 0xf9, 0xd8, 0x36, 0x94, 0xf9, 0xf9, 0xeb, 0x7e, 0xbb, 0x67, 0x67,
-	0xb6, 0x7f, 0x31, 0x00, 0xe6, 0x58, 0x90, 0x12, 0xac, 0x9d, 0x76, 0x0e, 0x3b, 0x27, 0xdf, 0x75,
-	0x74, 0xc8, 0x71, 0xb3, 0xd7, 0x6f, 0x53, 0xdb, 0x50, 0x07, 0xb4, 0xdd, 0x3d, 0x3a, 0x68, 0x35,
-	0xed, 0x8c, 0x3a, 0xa0, 0xfb, 0x27, 0x9d, 0xa3, 0xd7, 0x76, 0x16, 0x73, 0x35, 0xfb, 0xad, 0x97,
-	0x5a, 0xec, 0x75, 0x9b, 0xb4, 0x6d, 0xe7, 0x88, 0x0d, 0x56, 0xfb, 0xfb, 0x6e, 0x9b, 0x1e, 0x1c,
-	0xb7, 0x3b, 0xfd, 0xe6, 0x91, 0x9d, 0x57, 0x31, 0xcf, 0x9b, 0xad, 0xc3, 0xd3, 0xae, 0x5d, 0xd0,
-	0xc9, 0x7a, 0xfd, 0x13, 0xda, 0xb6, 0xd7, 0x94, 0xb2, 0x4f, 0x9b, 0x07, 0x9d, 0xf6, 0xbe, 0x6d,
-	0x56, 0x33, 0xb6, 0xf1, 0x7c, 0x0f, 0xd6, 0xfd, 0xb0, 0x31, 0xf5, 0x25, 0x13, 0x42, 0xff, 0xdd,
-	0xfa, 0xe1, 0x61, 0xa4, 0xf9, 0xe1, 0x8e, 0x96, 0x76, 0x2e, 0xc2, 0x9d, 0xa9, 0xdc, 0xc1, 0xd3,
-	0x9d, 0xf8, 0x52, 0xcf, 0x0a, 0xa8, 0x3f, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0x51, 0xac, 0x2b,
-	0xc1, 0xc6, 0x0d, 0x00, 0x00,
+	// 1475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x0e, 0xf5, 0x67, 0x69, 0x44, 0xc9, 0xcc, 0xc6, 0x31, 0x08, 0x35, 0x41, 0x0d, 0x15, 0x41,
+	0x0d, 0xa7, 0x95, 0x53, 0x27, 0x69, 0x8d, 0x14, 0x05, 0xc2, 0xc8, 0x4a, 0xe3, 0xd8, 0x96, 0x05,
+	0x4a, 0x46, 0x9b, 0xa2, 0x00, 0x41, 0x4b, 0x6b, 0x9b, 0x30, 0x45, 0x32, 0xbb, 0x6b, 0xa1, 0xea,
+	0x2b, 0xf4, 0xd0, 0x9e, 0xfb, 0x06, 0x7d, 0x9f, 0x1e, 0x7b, 0x69, 0x9f, 0x23, 0x87, 0x62, 0x67,
+	0x49, 0x89, 0x92, 0x1c, 0xd7, 0x29, 0x7c, 0xdb, 0x99, 0x9d, 0x1d, 0xce, 0x7c, 0x3b, 0xf3, 0xcd,
+	0x12, 0xaa, 0x22, 0x8c, 0xc2, 0x81, 0x2b, 0xdc, 0x46, 0xc4, 0x42, 0x11, 0x92, 0x62, 0x22, 0xd7,
+	0xf4, 0x91, 0x10, 0xde, 0x90, 0x2a, 0x7d, 0x7d, 0x0b, 0x8a, 0x7b, 0x74, 0x6c, 0xbb, 0xc1, 0x29,
+	0x25, 0x2b, 0x90, 0xe7, 0xc2, 0x65, 0xc2, 0xd4, 0xd6, 0xb4, 0x75, 0xdd, 0x56, 0x02, 0x31, 0x20,
+	0x4b, 0x83, 0x81, 0x99, 0x41, 0x9d, 0x5c, 0xd6, 0x1f, 0x43, 0xb9, 0xe7, 0x1e, 0xfb, 0x54, 0x58,
+	0xbe, 0xe7, 0x72, 0x42, 0x20, 0xd7, 0xa7, 0xbe, 0x8f, 0xa7, 0x4a, 0x36, 0xae, 0xe5, 0xa1, 0x0b,
+	0x4f, 0x1d, 0xaa, 0xd8, 0x72, 0x59, 0x7f, 0x97, 0x83, 0x82, 0x3a, 0x45, 0x1e, 0x42, 0xde, 0x95,
+	0x27, 0xf1, 0x44, 0x79, 0xeb, 0x6e, 0x63, 0x12, 0x6b, 0xca, 0xad, 0xad, 0x6c, 0x48, 0x0d, 0x8a,
+	0x67, 0x21, 0x17, 0x81, 0x3b, 0xa4, 0xe8, 0xae, 0x64, 0x4f, 0x64, 0xb2, 0x0d, 0xc5, 0x28, 0x64,
+	0xc2, 0x19, 0xba, 0x91, 0x99, 0x5b, 0xcb, 0xae, 0x97, 0xb7, 0xee, 0xcf, 0xfb, 0x6a, 0x74, 0x42,
+	0x26, 0x0e, 0xdc, 0xa8, 0x15, 0x08, 0x36, 0xb6, 0x97, 0x22, 0x25, 0x49, 0xaf, 0xe7, 0x74, 0xcc,
+	0x23, 0xb7, 0x4f, 0xcd, 0xbc, 0xf2, 0x9a, 0xc8, 0x08, 0xc3, 0x99, 0xcb, 0x06, 0x66, 0x01, 0x37,
+	0x94, 0x40, 0x36, 0xa1, 0x74, 0x4e, 0xc7, 0x0e, 0x93, 0x48, 0x99, 0x4b, 0x18, 0x38, 0x99, 0x7e,
+	0x2c, 0xc1, 0x10, 0xdd, 0xe0, 0x8a, 0xac, 0x43, 0x4e, 0x8c, 0x23, 0x6a, 0x16, 0xd7, 0xb4, 0xf5,
+	0xea, 0xd6, 0xca, 0x7c, 0x60, 0xbd, 0x71, 0x44, 0x6d, 0xb4, 0x20, 0xeb, 0x60, 0x0c, 0x8e, 0x1d,
+	0x99, 0x91, 0x13, 0x8e, 0x28, 0x63, 0xde, 0x80, 0x9a, 0x25, 0xfc, 0x76, 0x75, 0x70, 0xdc, 0x76,
+	0x87, 0xf4, 0x30, 0xd6, 0x92, 0x06, 0xe4, 0x84, 0x7b, 0xca, 0x4d, 0xc0, 0x64, 0x6b, 0x0b, 0xc9,
+	0xf6, 0xdc, 0x53, 0xae, 0x32, 0x45, 0x3b, 0xf2, 0x00, 0xaa, 0xc3, 0x31, 0x7f, 0xeb, 0x3b, 0x13,
+	0x08, 0x75, 0xf4, 0x5b, 0x41, 0xed, 0xab, 0x04, 0xc7, 0xfb, 0x00, 0xca, 0x4c, 0xc2, 0x63, 0x56,
+	0xd6, 0xb4, 0xf5, 0xbc, 0x5d, 0x42, 0x8d, 0x44, 0x8f, 0x58, 0xb0, 0x3a, 0x74, 0xb9, 0xa0, 0xcc,
+	0x11, 0x94, 0x0d, 0x1d, 0x2c, 0x0b, 0x47, 0xd6, 0x90, 0x59, 0x45, 0x1c, 0xf4, 0x46, 0x5c, 0x52,
+	0x3d, 0x6f, 0x48, 0xed, 0x3b, 0xca, 0xb6, 0x47, 0xd9, 0xb0, 0x2b, 0x2d, 0xa5, 0xb2, 0xf6, 0x0c,
+	0xf4, 0xf4, 0x45, 0xc8, 0xfa, 0x38, 0xa7, 0xe3, 0xb8, 0x64, 0xe4, 0x52, 0xa2, 0x3e, 0x72, 0xfd,
+	0x0b, 0x75, 0xc9, 0x79, 0x5b, 0x09, 0xcf, 0x32, 0xdb, 0x5a, 0xed, 0x2b, 0x28, 0x4d, 0xf2, 0xfa,
+	0xaf, 0x83, 0xa5, 0xd4, 0xc1, 0xd7, 0xb9, 0x62, 0xd6, 0xc8, 0xbd, 0xce, 0x15, 0xcb, 0x86, 0x5e,
+	0xff, 0xb3, 0x00, 0xf9, 0x2e, 0x5e, 0xe4, 0x36, 0xe8, 0x71, 0x36, 0xd7, 0x28, 0xc2, 0xb2, 0x32,
+	0x45, 0xe1, 0x0a, 0x1c, 0x8a, 0xd7, 0xc4, 0x61, 0xb6, 0x8a, 0x32, 0xd7, 0xa8, 0xa2, 0x6f, 0x40,
+	0xe7, 0x94, 0x8d, 0xe8, 0xc0, 0x91, 0xa5, 0xc2, 0xcd, 0xec, 0xfc, 0xcd, 0x63, 0x52, 0x8d, 0x2e,
+	0xda, 0x60, 0x4d, 0x95, 0xf9, 0x64, 0xcd, 0xc9, 0x73, 0xa8, 0xf0, 0xf0, 0x82, 0xf5, 0xa9, 0x83,
+	0x55, 0xcc, 0xe3, 0x36, 0xf9, 0x68, 0xe1, 0x3c, 0x1a, 0xe1, 0xda, 0xd6, 0xf9, 0x54, 0xe0, 0xe4,
+	0x25, 0x2c, 0x0b, 0x04, 0xc4, 0xe9, 0x87, 0x81, 0x60, 0xa1, 0xcf, 0xcd, 0xc2, 0x7c, 0xab, 0x29,
+	0x1f, 0x0a, 0xb7, 0xa6, 0xb2, 0xb2, 0xab, 0x22, 0x2d, 0x72, 0xb2, 0x01, 0xb7, 0x3d, 0xee, 0xc4,
+	0xf8, 0xc9, 0x10, 0xbd, 0xe0, 0x14, 0xfb, 0xa8, 0x68, 0x2f, 0x7b, 0xfc, 0x00, 0xf5, 0x5d, 0xa5,
+	0xae, 0xbd, 0x01, 0x98, 0x26, 0x44, 0x9e, 0x42, 0x39, 0x8e, 0x00, 0xfb, 0x49, 0xbb, 0xa2, 0x9f,
+	0x40, 0x4c, 0xd6, 0xb2, 0x2e, 0x24, 0x15, 0x71, 0x33, 0xb3, 0x96, 0x95, 0x75, 0x81, 0x42, 0xed,
+	0x77, 0x0d, 0xca, 0xa9, 0x64, 0x13, 0xa2, 0xd2, 0x26, 0x44, 0x35, 0x43, 0x0d, 0x99, 0xf7, 0x51,
+	0x43, 0xf6, 0xbd, 0xd4, 0x90, 0xbb, 0xc6, 0xa5, 0xae, 0x42, 0x01, 0x03, 0xe5, 0x66, 0x1e, 0x63,
+	0x8b, 0xa5, 0xda, 0x1f, 0x1a, 0x54, 0x66, 0x50, 0xbc, 0xd1, 0xdc, 0xc9, 0xe7, 0x40, 0x8e, 0x7d,
+	0xb7, 0x7f, 0xee, 0x7b, 0x5c, 0xc8, 0x82, 0x52, 0x21, 0xe4, 0xd0, 0xe4, 0x76, 0x6a, 0x07, 0x9d,
+	0x72, 0x19, 0xe5, 0x09, 0x0b, 0x7f, 0xa6, 0x01, 0x32, 0x64, 0xd1, 0x8e, 0xa5, 0x49, 0x5b, 0xe5,
+	0x8d, 0x42, 0xfd, 0xaf, 0x2c, 0xce, 0x0f, 0x85, 0xce, 0x23, 0x58, 0x41, 0x40, 0xbc, 0xe0, 0xd4,
+	0xe9, 0x87, 0xfe, 0xc5, 0x30, 0x40, 0x52, 0x8b, 0x9b, 0x95, 0x24, 0x7b, 0x4d, 0xdc, 0x92, 0xbc,
+	0x46, 0x5e, 0x2f, 0x9e, 0xc0, 0x3c, 0x33, 0x98, 0xa7, 0x39, 0x03, 0x22, 0x7e, 0x63, 0x57, 0xd5,
+	0xf8, 0x9c, 0x2f, 0xcc, 0xf9, 0xf9, 0xa4, 0x53, 0x4e, 0x58, 0x38, 0xe4, 0x8b, 0x03, 0x21, 0xf1,
+	0x11, 0x37, 0xcb, 0x4b, 0x16, 0x0e, 0x93, 0x66, 0x91, 0x6b, 0x4e, 0xbe, 0x86, 0x4a, 0x72, 0xd3,
+	0x2a, 0x8c, 0x3c, 0x86, 0xb1, 0xba, 0xe8, 0x02, 0x83, 0xd0, 0xcf, 0x53, 0x12, 0xf9, 0x04, 0x2a,
+	0xc7, 0x2e, 0xa7, 0xce, 0xa4, 0x76, 0xd4, 0xf4, 0xd0, 0xa5, 0x72, 0x82, 0xd0, 0x17, 0x50, 0xe1,
+	0x81, 0x1b, 0xf1, 0xb3, 0x30, 0x26, 0x8e, 0xa5, 0x4b, 0x88, 0x43, 0x4f, 0x4c, 0x90, 0x39, 0x2f,
+	0x92, 0x5e, 0x90, 0x31, 0xde, 0x6c, 0x3d, 0xa4, 0x2b, 0x3d, 0x3b, 0x5b, 0xe9, 0xea, 0x92, 0xeb,
+	0x1e, 0x18, 0xbd, 0x33, 0x16, 0x0a, 0xe1, 0xd3, 0x81, 0x15, 0x45, 0xf6, 0x85, 0x4f, 0xe5, 0xb8,
+	0x4f, 0xdd, 0x2a, 0xae, 0xa5, 0x7f, 0xe6, 0x0a, 0x2f, 0xc4, 0x8b, 0xd3, 0x6c, 0x25, 0x90, 0x87,
+	0x00, 0xf4, 0xa7, 0xc8, 0x63, 0x94, 0x3b, 0xae, 0x30, 0xb3, 0x97, 0xa4, 0x5a, 0x8a, 0xf7, 0x2d,
+	0x51, 0x7f, 0xa7, 0xc1, 0x72, 0xf2, 0x2d, 0xd6, 0x0c, 0x83, 0x13, 0xef, 0x94, 0x98, 0xb0, 0x44,
+	0x03, 0x99, 0x85, 0x6a, 0xd0, 0xa2, 0x9d, 0x88, 0xe4, 0x1e, 0x94, 0xc4, 0x19, 0xa3, 0xfc, 0x2c,
+	0xf4, 0x07, 0xf1, 0x47, 0xa7, 0x0a, 0xd2, 0x85, 0xaa, 0x48, 0xc2, 0x76, 0xdc, 0x28, 0x4a, 0x68,
+	0xf3, 0xb3, 0x14, 0x50, 0xb3, 0x9f, 0x6a, 0xa4, 0xd3, 0x8c, 0x47, 0x68, 0x45, 0xa4, 0x75, 0xb5,
+	0x1f, 0x81, 0x2c, 0x1a, 0x5d, 0x32, 0x8f, 0x1e, 0xa5, 0xe7, 0xd1, 0xec, 0x90, 0x9e, 0x83, 0x32,
+	0x35, 0xab, 0xea, 0xbf, 0x68, 0x60, 0x28, 0xfa, 0xa5, 0x91, 0xef, 0xf5, 0x25, 0x7e, 0x01, 0x79,
+	0x0a, 0xf9, 0x20, 0x1c, 0x50, 0x39, 0xa3, 0x64, 0xf8, 0x1f, 0xcf, 0x31, 0x6e, 0xca, 0xb4, 0xd1,
+	0x0e, 0x07, 0xd4, 0x56, 0xd6, 0xb5, 0xe7, 0x90, 0x93, 0xa2, 0x9c, 0x74, 0x71, 0xb1, 0x5c, 0x67,
+	0xd2, 0x89, 0xa9, 0x50, 0x3f, 0x82, 0x6a, 0xfc, 0x85, 0x13, 0xca, 0x68, 0xd0, 0xbf, 0xfc, 0xd6,
+	0x3f, 0x74, 0x98, 0xd5, 0x7f, 0xd5, 0x80, 0xa0, 0xdf, 0x59, 0x92, 0xbb, 0x09, 0xdf, 0xe4, 0x09,
+	0xac, 0xbe, 0xbd, 0xa0, 0x6c, 0xac, 0x66, 0x4b, 0x9f, 0x3a, 0x03, 0x8f, 0xab, 0xd2, 0xc9, 0x62,
+	0xe9, 0xac, 0xe0, 0x6e, 0x57, 0x6d, 0xee, 0xc4, 0x7b, 0xf5, 0x7f, 0x72, 0x50, 0xee, 0xb2, 0xd1,
+	0xa4, 0x41, 0xbf, 0x05, 0x88, 0x5c, 0x26, 0x3c, 0x89, 0x69, 0x02, 0xfb, 0xa7, 0x29, 0xd8, 0xa7,
+	0xa6, 0x13, 0x2e, 0xe8, 0x24, 0xf6, 0x76, 0xea, 0xe8, 0x7b, 0xb9, 0x30, 0xf3, 0xc1, 0x5c, 0x98,
+	0xfd, 0x1f, 0x5c, 0x68, 0x41, 0x39, 0xc5, 0x85, 0x31, 0x15, 0xae, 0x5d, 0x9e, 0x47, 0x8a, 0x0d,
+	0x61, 0xca, 0x86, 0xb5, 0xbf, 0x35, 0xb8, 0xbd, 0x90, 0xa2, 0xe4, 0x9f, 0xd4, 0x73, 0xe4, 0x6a,
+	0xfe, 0x99, 0xbe, 0x43, 0x48, 0x13, 0x0c, 0x8c, 0xd2, 0x61, 0x49, 0x41, 0x29, 0x2a, 0x2a, 0xa7,
+	0xf3, 0x9a, 0xad, 0x38, 0x7b, 0x99, 0xcf, 0xc8, 0x9c, 0x74, 0xe0, 0xae, 0x72, 0x32, 0xff, 0x1e,
+	0x51, 0xcd, 0x7d, 0x6f, 0xce, 0xd3, 0xec, 0x73, 0xe4, 0x0e, 0x5f, 0xd0, 0xf1, 0x9a, 0x73, 0x13,
+	0xdc, 0x7a, 0xc5, 0x7b, 0x21, 0x1e, 0x92, 0x7b, 0x50, 0x6c, 0x52, 0xdf, 0xdf, 0x0d, 0x4e, 0x42,
+	0xf9, 0x22, 0x47, 0x5c, 0x98, 0xe3, 0x0e, 0x06, 0x8c, 0x72, 0x1e, 0x57, 0x7d, 0x45, 0x69, 0x2d,
+	0xa5, 0x94, 0x2d, 0xc1, 0xc2, 0x50, 0xc4, 0x0e, 0x71, 0x1d, 0x53, 0x72, 0x1d, 0x40, 0x3a, 0xe3,
+	0xea, 0x49, 0x7a, 0x29, 0xb1, 0x6f, 0xac, 0x83, 0x9e, 0x9e, 0x54, 0x04, 0xa0, 0xd0, 0x3e, 0xb4,
+	0x0f, 0xac, 0x7d, 0xe3, 0x16, 0xd1, 0xa1, 0xd8, 0x6d, 0x5b, 0x9d, 0xee, 0xab, 0xc3, 0x9e, 0xa1,
+	0x6d, 0x6c, 0x41, 0x75, 0xb6, 0x9c, 0x48, 0x09, 0xf2, 0x47, 0xed, 0x6e, 0xab, 0x67, 0xdc, 0x92,
+	0xc7, 0x8e, 0x76, 0xdb, 0xbd, 0x2f, 0x9f, 0x18, 0x9a, 0x54, 0xbf, 0x78, 0xd3, 0x6b, 0x75, 0x8d,
+	0xcc, 0xc6, 0x6f, 0x1a, 0xc0, 0x14, 0x0b, 0x52, 0x86, 0xa5, 0xa3, 0xf6, 0x5e, 0xfb, 0xf0, 0xbb,
+	0xb6, 0x3a, 0x72, 0x60, 0x75, 0x7b, 0x2d, 0xdb, 0xd0, 0xe4, 0x86, 0xdd, 0xea, 0xec, 0xef, 0x36,
+	0x2d, 0x23, 0x23, 0x37, 0xec, 0x9d, 0xc3, 0xf6, 0xfe, 0x1b, 0x23, 0x8b, 0xbe, 0xac, 0x5e, 0xf3,
+	0x95, 0x5a, 0x76, 0x3b, 0x96, 0xdd, 0x32, 0x72, 0xc4, 0x00, 0xbd, 0xf5, 0x7d, 0xa7, 0x65, 0xef,
+	0x1e, 0xb4, 0xda, 0x3d, 0x6b, 0xdf, 0xc8, 0xcb, 0x33, 0x2f, 0xac, 0xe6, 0xde, 0x51, 0xc7, 0x28,
+	0x28, 0x67, 0xdd, 0xde, 0xa1, 0xdd, 0x32, 0x96, 0xa4, 0xb0, 0x63, 0x5b, 0xbb, 0xed, 0xd6, 0x8e,
+	0x51, 0xac, 0x65, 0x0c, 0xed, 0xc5, 0x36, 0x2c, 0x7b, 0x61, 0x63, 0xe4, 0x09, 0xca, 0xb9, 0xfa,
+	0xb1, 0xfd, 0xe1, 0x41, 0x2c, 0x79, 0xe1, 0xa6, 0x5a, 0x6d, 0x9e, 0x86, 0x9b, 0x23, 0xb1, 0x89,
+	0xbb, 0x9b, 0xc9, 0xa5, 0x1e, 0x17, 0x50, 0x7e, 0xfc, 0xef, 0x00, 0xec, 0x44, 0x4b, 0xdb, 0x30,
+	0x0f, 0x00, 0x00,
 }
@@ -589,6 +589,531 @@ func (m *InitShardPrimaryResponse) GetEvents() []*logutil.Event {
 	return nil
 }
 
+type GetQueryRulesRequest struct {
+	Keyspace             string              `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard                string              `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	TabletType           topodata.TabletType `protobuf:"varint,3,opt,name=tablet_type,json=tabletType,proto3,enum=topodata.TabletType" json:"tablet_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GetQueryRulesRequest) Reset()         { *m = GetQueryRulesRequest{} }
+func (m *GetQueryRulesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetQueryRulesRequest) ProtoMessage()    {}
+func (*GetQueryRulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{14}
+}
+
+func (m *GetQueryRulesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetQueryRulesRequest.Unmarshal(m, b)
+}
+func (m *GetQueryRulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetQueryRulesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetQueryRulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQueryRulesRequest.Merge(m, src)
+}
+func (m *GetQueryRulesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetQueryRulesRequest.Size(m)
+}
+func (m *GetQueryRulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQueryRulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQueryRulesRequest proto.InternalMessageInfo
+
+func (m *GetQueryRulesRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *GetQueryRulesRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *GetQueryRulesRequest) GetTabletType() topodata.TabletType {
+	if m != nil {
+		return m.TabletType
+	}
+	return topodata.TabletType_UNKNOWN
+}
+
+type GetQueryRulesResponse struct {
+	// rules is the JSON list of the rules, including the expired ones.
+	Rules                string   `protobuf:"bytes,1,opt,name=rules,proto3" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetQueryRulesResponse) Reset()         { *m = GetQueryRulesResponse{} }
+func (m *GetQueryRulesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetQueryRulesResponse) ProtoMessage()    {}
+func (*GetQueryRulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{15}
+}
+
+func (m *GetQueryRulesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetQueryRulesResponse.Unmarshal(m, b)
+}
+func (m *GetQueryRulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetQueryRulesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetQueryRulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQueryRulesResponse.Merge(m, src)
+}
+func (m *GetQueryRulesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetQueryRulesResponse.Size(m)
+}
+func (m *GetQueryRulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQueryRulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQueryRulesResponse proto.InternalMessageInfo
+
+func (m *GetQueryRulesResponse) GetRules() string {
+	if m != nil {
+		return m.Rules
+	}
+	return ""
+}
+
+type AddQueryRuleRequest struct {
+	Keyspace   string              `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard      string              `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	TabletType topodata.TabletType `protobuf:"varint,3,opt,name=tablet_type,json=tabletType,proto3,enum=topodata.TabletType" json:"tablet_type,omitempty"`
+	// rule is the JSON object of the rule. Its name must be unique.
+	Rule string `protobuf:"bytes,4,opt,name=rule,proto3" json:"rule,omitempty"`
+	// ttl is how long the rule is enforced. The rule never expires if it
+	// is not set.
+	Ttl *duration.Duration `protobuf:"bytes,5,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// added_by records who added the rule. It defaults to the caller of
+	// the RPC.
+	AddedBy              string   `protobuf:"bytes,6,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddQueryRuleRequest) Reset()         { *m = AddQueryRuleRequest{} }
+func (m *AddQueryRuleRequest) String() string { return proto.CompactTextString(m) }
+func (*AddQueryRuleRequest) ProtoMessage()    {}
+func (*AddQueryRuleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{16}
+}
+
+func (m *AddQueryRuleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddQueryRuleRequest.Unmarshal(m, b)
+}
+func (m *AddQueryRuleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddQueryRuleRequest.Marshal(b, m, deterministic)
+}
+func (m *AddQueryRuleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddQueryRuleRequest.Merge(m, src)
+}
+func (m *AddQueryRuleRequest) XXX_Size() int {
+	return xxx_messageInfo_AddQueryRuleRequest.Size(m)
+}
+func (m *AddQueryRuleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddQueryRuleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddQueryRuleRequest proto.InternalMessageInfo
+
+func (m *AddQueryRuleRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *AddQueryRuleRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *AddQueryRuleRequest) GetTabletType() topodata.TabletType {
+	if m != nil {
+		return m.TabletType
+	}
+	return topodata.TabletType_UNKNOWN
+}
+
+func (m *AddQueryRuleRequest) GetRule() string {
+	if m != nil {
+		return m.Rule
+	}
+	return ""
+}
+
+func (m *AddQueryRuleRequest) GetTtl() *duration.Duration {
+	if m != nil {
+		return m.Ttl
+	}
+	return nil
+}
+
+func (m *AddQueryRuleRequest) GetAddedBy() string {
+	if m != nil {
+		return m.AddedBy
+	}
+	return ""
+}
+
+type AddQueryRuleResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddQueryRuleResponse) Reset()         { *m = AddQueryRuleResponse{} }
+func (m *AddQueryRuleResponse) String() string { return proto.CompactTextString(m) }
+func (*AddQueryRuleResponse) ProtoMessage()    {}
+func (*AddQueryRuleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{17}
+}
+
+func (m *AddQueryRuleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddQueryRuleResponse.Unmarshal(m, b)
+}
+func (m *AddQueryRuleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddQueryRuleResponse.Marshal(b, m, deterministic)
+}
+func (m *AddQueryRuleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddQueryRuleResponse.Merge(m, src)
+}
+func (m *AddQueryRuleResponse) XXX_Size() int {
+	return xxx_messageInfo_AddQueryRuleResponse.Size(m)
+}
+func (m *AddQueryRuleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddQueryRuleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddQueryRuleResponse proto.InternalMessageInfo
+
+type DeleteQueryRuleRequest struct {
+	Keyspace   string              `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard      string              `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	TabletType topodata.TabletType `protobuf:"varint,3,opt,name=tablet_type,json=tabletType,proto3,enum=topodata.TabletType" json:"tablet_type,omitempty"`
+	// name is the name of the rule.
+	Name                 string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteQueryRuleRequest) Reset()         { *m = DeleteQueryRuleRequest{} }
+func (m *DeleteQueryRuleRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteQueryRuleRequest) ProtoMessage()    {}
+func (*DeleteQueryRuleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{18}
+}
+
+func (m *DeleteQueryRuleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteQueryRuleRequest.Unmarshal(m, b)
+}
+func (m *DeleteQueryRuleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteQueryRuleRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteQueryRuleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteQueryRuleRequest.Merge(m, src)
+}
+func (m *DeleteQueryRuleRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteQueryRuleRequest.Size(m)
+}
+func (m *DeleteQueryRuleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteQueryRuleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteQueryRuleRequest proto.InternalMessageInfo
+
+func (m *DeleteQueryRuleRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *DeleteQueryRuleRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *DeleteQueryRuleRequest) GetTabletType() topodata.TabletType {
+	if m != nil {
+		return m.TabletType
+	}
+	return topodata.TabletType_UNKNOWN
+}
+
+func (m *DeleteQueryRuleRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type DeleteQueryRuleResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteQueryRuleResponse) Reset()         { *m = DeleteQueryRuleResponse{} }
+func (m *DeleteQueryRuleResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteQueryRuleResponse) ProtoMessage()    {}
+func (*DeleteQueryRuleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{19}
+}
+
+func (m *DeleteQueryRuleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteQueryRuleResponse.Unmarshal(m, b)
+}
+func (m *DeleteQueryRuleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteQueryRuleResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteQueryRuleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteQueryRuleResponse.Merge(m, src)
+}
+func (m *DeleteQueryRuleResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteQueryRuleResponse.Size(m)
+}
+func (m *DeleteQueryRuleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteQueryRuleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteQueryRuleResponse proto.InternalMessageInfo
+
+type GetThrottlerConfigRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetThrottlerConfigRequest) Reset()         { *m = GetThrottlerConfigRequest{} }
+func (m *GetThrottlerConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*GetThrottlerConfigRequest) ProtoMessage()    {}
+func (*GetThrottlerConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{20}
+}
+
+func (m *GetThrottlerConfigRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetThrottlerConfigRequest.Unmarshal(m, b)
+}
+func (m *GetThrottlerConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetThrottlerConfigRequest.Marshal(b, m, deterministic)
+}
+func (m *GetThrottlerConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetThrottlerConfigRequest.Merge(m, src)
+}
+func (m *GetThrottlerConfigRequest) XXX_Size() int {
+	return xxx_messageInfo_GetThrottlerConfigRequest.Size(m)
+}
+func (m *GetThrottlerConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetThrottlerConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetThrottlerConfigRequest proto.InternalMessageInfo
+
+func (m *GetThrottlerConfigRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+type GetThrottlerConfigResponse struct {
+	// throttler_config is nil if the keyspace has no throttler configuration.
+	ThrottlerConfig      *topodata.ThrottlerConfig `protobuf:"bytes,1,opt,name=throttler_config,json=throttlerConfig,proto3" json:"throttler_config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *GetThrottlerConfigResponse) Reset()         { *m = GetThrottlerConfigResponse{} }
+func (m *GetThrottlerConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*GetThrottlerConfigResponse) ProtoMessage()    {}
+func (*GetThrottlerConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{21}
+}
+
+func (m *GetThrottlerConfigResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetThrottlerConfigResponse.Unmarshal(m, b)
+}
+func (m *GetThrottlerConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetThrottlerConfigResponse.Marshal(b, m, deterministic)
+}
+func (m *GetThrottlerConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetThrottlerConfigResponse.Merge(m, src)
+}
+func (m *GetThrottlerConfigResponse) XXX_Size() int {
+	return xxx_messageInfo_GetThrottlerConfigResponse.Size(m)
+}
+func (m *GetThrottlerConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetThrottlerConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetThrottlerConfigResponse proto.InternalMessageInfo
+
+func (m *GetThrottlerConfigResponse) GetThrottlerConfig() *topodata.ThrottlerConfig {
+	if m != nil {
+		return m.ThrottlerConfig
+	}
+	return nil
+}
+
+type UpdateThrottlerConfigRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	// enable and disable enable or disable the throttler. At most one of
+	// them can be set.
+	Enable  bool `protobuf:"varint,2,opt,name=enable,proto3" json:"enable,omitempty"`
+	Disable bool `protobuf:"varint,3,opt,name=disable,proto3" json:"disable,omitempty"`
+	// threshold is the new replication lag threshold, in seconds. It is not
+	// changed if 0.
+	Threshold float64 `protobuf:"fixed64,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// throttle_app throttles the app with this name, with the ratio
+	// throttle_app_ratio, for throttle_app_duration.
+	ThrottleApp         string             `protobuf:"bytes,5,opt,name=throttle_app,json=throttleApp,proto3" json:"throttle_app,omitempty"`
+	ThrottleAppRatio    float64            `protobuf:"fixed64,6,opt,name=throttle_app_ratio,json=throttleAppRatio,proto3" json:"throttle_app_ratio,omitempty"`
+	ThrottleAppDuration *duration.Duration `protobuf:"bytes,7,opt,name=throttle_app_duration,json=throttleAppDuration,proto3" json:"throttle_app_duration,omitempty"`
+	// unthrottle_app unthrottles the app with this name.
+	UnthrottleApp        string   `protobuf:"bytes,8,opt,name=unthrottle_app,json=unthrottleApp,proto3" json:"unthrottle_app,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateThrottlerConfigRequest) Reset()         { *m = UpdateThrottlerConfigRequest{} }
+func (m *UpdateThrottlerConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateThrottlerConfigRequest) ProtoMessage()    {}
+func (*UpdateThrottlerConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{22}
+}
+
+func (m *UpdateThrottlerConfigRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateThrottlerConfigRequest.Unmarshal(m, b)
+}
+func (m *UpdateThrottlerConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateThrottlerConfigRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateThrottlerConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateThrottlerConfigRequest.Merge(m, src)
+}
+func (m *UpdateThrottlerConfigRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateThrottlerConfigRequest.Size(m)
+}
+func (m *UpdateThrottlerConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateThrottlerConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateThrottlerConfigRequest proto.InternalMessageInfo
+
+func (m *UpdateThrottlerConfigRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *UpdateThrottlerConfigRequest) GetEnable() bool {
+	if m != nil {
+		return m.Enable
+	}
+	return false
+}
+
+func (m *UpdateThrottlerConfigRequest) GetDisable() bool {
+	if m != nil {
+		return m.Disable
+	}
+	return false
+}
+
+func (m *UpdateThrottlerConfigRequest) GetThreshold() float64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *UpdateThrottlerConfigRequest) GetThrottleApp() string {
+	if m != nil {
+		return m.ThrottleApp
+	}
+	return ""
+}
+
+func (m *UpdateThrottlerConfigRequest) GetThrottleAppRatio() float64 {
+	if m != nil {
+		return m.ThrottleAppRatio
+	}
+	return 0
+}
+
+func (m *UpdateThrottlerConfigRequest) GetThrottleAppDuration() *duration.Duration {
+	if m != nil {
+		return m.ThrottleAppDuration
+	}
+	return nil
+}
+
+func (m *UpdateThrottlerConfigRequest) GetUnthrottleApp() string {
+	if m != nil {
+		return m.UnthrottleApp
+	}
+	return ""
+}
+
+type UpdateThrottlerConfigResponse struct {
+	ThrottlerConfig      *topodata.ThrottlerConfig `protobuf:"bytes,1,opt,name=throttler_config,json=throttlerConfig,proto3" json:"throttler_config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *UpdateThrottlerConfigResponse) Reset()         { *m = UpdateThrottlerConfigResponse{} }
+func (m *UpdateThrottlerConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateThrottlerConfigResponse) ProtoMessage()    {}
+func (*UpdateThrottlerConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{23}
+}
+
+func (m *UpdateThrottlerConfigResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateThrottlerConfigResponse.Unmarshal(m, b)
+}
+func (m *UpdateThrottlerConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateThrottlerConfigResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateThrottlerConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateThrottlerConfigResponse.Merge(m, src)
+}
+func (m *UpdateThrottlerConfigResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateThrottlerConfigResponse.Size(m)
+}
+func (m *UpdateThrottlerConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateThrottlerConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateThrottlerConfigResponse proto.InternalMessageInfo
+
+func (m *UpdateThrottlerConfigResponse) GetThrottlerConfig() *topodata.ThrottlerConfig {
+	if m != nil {
+		return m.ThrottlerConfig
+	}
+	return nil
+}
+
 type Keyspace struct {
 	Name                 string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Keyspace             *topodata.Keyspace `protobuf:"bytes,2,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
@@ -601,7 +1126,7 @@ func (m *Keyspace) Reset()         { *m = Keyspace{} }
 func (m *Keyspace) String() string { return proto.CompactTextString(m) }
 func (*Keyspace) ProtoMessage()    {}
 func (*Keyspace) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{14}
+	return fileDescriptor_f41247b323a1ab2e, []int{24}
 }
 
 func (m *Keyspace) XXX_Unmarshal(b []byte) error {
@@ -647,7 +1172,7 @@ func (m *FindAllShardsInKeyspaceRequest) Reset()         { *m = FindAllShardsInK
 func (m *FindAllShardsInKeyspaceRequest) String() string { return proto.CompactTextString(m) }
 func (*FindAllShardsInKeyspaceRequest) ProtoMessage()    {}
 func (*FindAllShardsInKeyspaceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{15}
+	return fileDescriptor_f41247b323a1ab2e, []int{25}
 }
 
 func (m *FindAllShardsInKeyspaceRequest) XXX_Unmarshal(b []byte) error {
@@ -686,7 +1211,7 @@ func (m *FindAllShardsInKeyspaceResponse) Reset()         { *m = FindAllShardsIn
 func (m *FindAllShardsInKeyspaceResponse) String() string { return proto.CompactTextString(m) }
 func (*FindAllShardsInKeyspaceResponse) ProtoMessage()    {}
 func (*FindAllShardsInKeyspaceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{16}
+	return fileDescriptor_f41247b323a1ab2e, []int{26}
 }
 
 func (m *FindAllShardsInKeyspaceResponse) XXX_Unmarshal(b []byte) error {
@@ -727,7 +1252,7 @@ func (m *Shard) Reset()         { *m = Shard{} }
 func (m *Shard) String() string { return proto.CompactTextString(m) }
 func (*Shard) ProtoMessage()    {}
 func (*Shard) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{17}
+	return fileDescriptor_f41247b323a1ab2e, []int{27}
 }
 
 func (m *Shard) XXX_Unmarshal(b []byte) error {
@@ -787,7 +1312,7 @@ func (m *TableMaterializeSettings) Reset()         { *m = TableMaterializeSettin
 func (m *TableMaterializeSettings) String() string { return proto.CompactTextString(m) }
 func (*TableMaterializeSettings) ProtoMessage()    {}
 func (*TableMaterializeSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{18}
+	return fileDescriptor_f41247b323a1ab2e, []int{28}
 }
 
 func (m *TableMaterializeSettings) XXX_Unmarshal(b []byte) error {
@@ -850,7 +1375,7 @@ func (m *MaterializeSettings) Reset()         { *m = MaterializeSettings{} }
 func (m *MaterializeSettings) String() string { return proto.CompactTextString(m) }
 func (*MaterializeSettings) ProtoMessage()    {}
 func (*MaterializeSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{19}
+	return fileDescriptor_f41247b323a1ab2e, []int{29}
 }
 
 func (m *MaterializeSettings) XXX_Unmarshal(b []byte) error {
@@ -936,6 +1461,16 @@ func init() {
 	proto.RegisterType((*GetKeyspaceResponse)(nil), "vtctldata.GetKeyspaceResponse")
 	proto.RegisterType((*InitShardPrimaryRequest)(nil), "vtctldata.InitShardPrimaryRequest")
 	proto.RegisterType((*InitShardPrimaryResponse)(nil), "vtctldata.InitShardPrimaryResponse")
+	proto.RegisterType((*GetQueryRulesRequest)(nil), "vtctldata.GetQueryRulesRequest")
+	proto.RegisterType((*GetQueryRulesResponse)(nil), "vtctldata.GetQueryRulesResponse")
+	proto.RegisterType((*AddQueryRuleRequest)(nil), "vtctldata.AddQueryRuleRequest")
+	proto.RegisterType((*AddQueryRuleResponse)(nil), "vtctldata.AddQueryRuleResponse")
+	proto.RegisterType((*DeleteQueryRuleRequest)(nil), "vtctldata.DeleteQueryRuleRequest")
+	proto.RegisterType((*DeleteQueryRuleResponse)(nil), "vtctldata.DeleteQueryRuleResponse")
+	proto.RegisterType((*GetThrottlerConfigRequest)(nil), "vtctldata.GetThrottlerConfigRequest")
+	proto.RegisterType((*GetThrottlerConfigResponse)(nil), "vtctldata.GetThrottlerConfigResponse")
+	proto.RegisterType((*UpdateThrottlerConfigRequest)(nil), "vtctldata.UpdateThrottlerConfigRequest")
+	proto.RegisterType((*UpdateThrottlerConfigResponse)(nil), "vtctldata.UpdateThrottlerConfigResponse")
 	proto.RegisterType((*Keyspace)(nil), "vtctldata.Keyspace")
 	proto.RegisterType((*FindAllShardsInKeyspaceRequest)(nil), "vtctldata.FindAllShardsInKeyspaceRequest")
 	proto.RegisterType((*FindAllShardsInKeyspaceResponse)(nil), "vtctldata.FindAllShardsInKeyspaceResponse")
@@ -948,61 +1483,80 @@ func init() {
 func init() { proto.RegisterFile("vtctldata.proto", fileDescriptor_f41247b323a1ab2e) }
 
 var fileDescriptor_f41247b323a1ab2e = []byte{
-	// 896 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdf, 0x6f, 0xdb, 0x54,
-	0x14, 0x56, 0xd2, 0xa5, 0x8b, 0x4f, 0xd6, 0xa4, 0xdc, 0xb6, 0xd4, 0x33, 0x62, 0x14, 0xc3, 0xba,
-	0x08, 0x24, 0x67, 0x14, 0x09, 0x21, 0xc4, 0x4b, 0xd7, 0x65, 0x28, 0x4c, 0xab, 0x26, 0xaf, 0x02,
-	0x89, 0x07, 0xac, 0x5b, 0xe7, 0x24, 0x58, 0xbd, 0xf1, 0x35, 0xbe, 0x37, 0xe9, 0xc2, 0x33, 0x2f,
-	0xfc, 0x33, 0x3c, 0xf2, 0xc8, 0xdf, 0x86, 0xee, 0x2f, 0xdb, 0xd9, 0x5a, 0x60, 0x6f, 0xf7, 0x7e,
-	0xe7, 0x7e, 0xe7, 0xc7, 0x77, 0xce, 0xb1, 0x61, 0xb0, 0x92, 0xa9, 0x64, 0x53, 0x2a, 0x69, 0x54,
-	0x94, 0x5c, 0x72, 0xe2, 0x55, 0x40, 0xb0, 0xc3, 0xf8, 0x7c, 0x29, 0x33, 0x66, 0x2c, 0x41, 0x5f,
-	0xf2, 0x82, 0xd7, 0x2f, 0x83, 0x07, 0x73, 0xce, 0xe7, 0x0c, 0x47, 0xfa, 0x76, 0xb9, 0x9c, 0x8d,
-	0xa6, 0xcb, 0x92, 0xca, 0x8c, 0xe7, 0xc6, 0x1e, 0xfe, 0x08, 0xc1, 0xf8, 0x35, 0xa6, 0x4b, 0x89,
-	0x3f, 0x28, 0x97, 0x67, 0x7c, 0xb1, 0xa0, 0xf9, 0x34, 0xc6, 0x5f, 0x97, 0x28, 0x24, 0x21, 0x70,
-	0x87, 0x96, 0x73, 0xe1, 0xb7, 0x8e, 0xb6, 0x86, 0x5e, 0xac, 0xcf, 0xe4, 0x21, 0xf4, 0x69, 0xaa,
-	0x3c, 0x24, 0x32, 0x5b, 0x20, 0x5f, 0x4a, 0xbf, 0x7d, 0xd4, 0x1a, 0x6e, 0xc5, 0x3b, 0x06, 0xbd,
-	0x30, 0x60, 0x78, 0x06, 0x1f, 0xdc, 0xe8, 0x58, 0x14, 0x3c, 0x17, 0x48, 0x3e, 0x85, 0x0e, 0xae,
-	0x30, 0x97, 0x7e, 0xeb, 0xa8, 0x35, 0xec, 0x9d, 0xf4, 0x23, 0x57, 0xc6, 0x58, 0xa1, 0xb1, 0x31,
-	0x86, 0xf7, 0xe1, 0xf0, 0x3b, 0x94, 0x67, 0xc8, 0xd8, 0x24, 0x9f, 0xf1, 0x73, 0xba, 0x40, 0x61,
-	0x53, 0x0b, 0x1f, 0x83, 0xff, 0xb6, 0xc9, 0x3a, 0xdf, 0x87, 0x4e, 0xae, 0x00, 0x9b, 0xb7, 0xb9,
-	0x84, 0x43, 0x20, 0x0d, 0x46, 0xa3, 0xc4, 0x14, 0x19, 0xd3, 0x79, 0x78, 0xb1, 0x3e, 0x87, 0xcf,
-	0x60, 0x6f, 0xe3, 0xa5, 0x75, 0x3b, 0x02, 0x4f, 0x99, 0x93, 0x2c, 0x9f, 0x71, 0x9b, 0x37, 0x89,
-	0x2a, 0xbd, 0xab, 0xe7, 0xdd, 0xd4, 0x9e, 0x42, 0x1f, 0xde, 0xb7, 0x7e, 0xc4, 0x29, 0xcb, 0xa8,
-	0xa8, 0xb3, 0xff, 0xab, 0x55, 0x55, 0x56, 0x9b, 0x6c, 0x98, 0x09, 0xdc, 0xa5, 0x06, 0xd2, 0xf9,
-	0xf7, 0x4e, 0x46, 0x51, 0xdd, 0xff, 0x5b, 0x48, 0x91, 0xbd, 0x8f, 0x73, 0x59, 0xae, 0x63, 0xc7,
-	0x0f, 0x5e, 0xc2, 0xbd, 0xa6, 0x81, 0xec, 0xc2, 0xd6, 0x15, 0xae, 0x6d, 0xad, 0xea, 0x48, 0x3e,
-	0x83, 0xce, 0x8a, 0xb2, 0x25, 0xea, 0x26, 0xf6, 0x4e, 0xf6, 0x37, 0xeb, 0x31, 0x61, 0x62, 0xf3,
-	0xe4, 0x9b, 0xf6, 0xd7, 0xad, 0xf0, 0x40, 0x4b, 0xf3, 0x1c, 0xd7, 0xa2, 0xa0, 0x69, 0x5d, 0xcf,
-	0x04, 0xf6, 0x37, 0x61, 0x5b, 0xcb, 0x17, 0xe0, 0x5d, 0x39, 0xd0, 0x56, 0xb3, 0xd7, 0xa8, 0xc6,
-	0x11, 0xe2, 0xfa, 0x55, 0xf8, 0x58, 0xb7, 0xa9, 0xb2, 0xd8, 0x36, 0x05, 0xd0, 0x75, 0x4f, 0x6c,
-	0xfa, 0xd5, 0xdd, 0xb6, 0xab, 0x66, 0x54, 0xed, 0xda, 0xa4, 0xdc, 0x12, 0xba, 0xf6, 0xf3, 0x7b,
-	0x1b, 0x0e, 0x27, 0x79, 0x26, 0x5f, 0xfd, 0x42, 0xcb, 0xe9, 0xcb, 0x32, 0x5b, 0xd0, 0x72, 0xfd,
-	0x3f, 0xe2, 0xab, 0x71, 0x13, 0x8a, 0xa2, 0x35, 0xf4, 0x62, 0x73, 0x21, 0x31, 0x04, 0x85, 0xf1,
-	0x91, 0x20, 0xc3, 0x54, 0x26, 0x92, 0x5e, 0x32, 0x94, 0x89, 0xee, 0x8d, 0xbf, 0xa5, 0x13, 0x3a,
-	0xa8, 0xe5, 0xbe, 0xd0, 0x56, 0xa3, 0xf7, 0xa1, 0x25, 0x8e, 0x15, 0xaf, 0x61, 0x50, 0x91, 0x66,
-	0xbc, 0x4c, 0xd1, 0xbf, 0x73, 0xd4, 0x1a, 0x76, 0x63, 0x73, 0x21, 0x2f, 0xe0, 0xe0, 0x9a, 0x66,
-	0x32, 0x29, 0xb1, 0x60, 0x59, 0x4a, 0x45, 0xb5, 0x98, 0x1d, 0x1d, 0xe4, 0x7e, 0x64, 0xbe, 0x01,
-	0x91, 0xfb, 0x06, 0x44, 0x4f, 0xed, 0x37, 0x20, 0xde, 0x53, 0xbc, 0xd8, 0xd2, 0xdc, 0xe6, 0x3e,
-	0x01, 0xff, 0x6d, 0x15, 0xac, 0xa6, 0xc7, 0xb0, 0xad, 0x37, 0xd3, 0x35, 0xf3, 0xcd, 0xbd, 0xb5,
-	0xd6, 0xf0, 0x1c, 0xba, 0x4e, 0x60, 0xb5, 0x61, 0x6a, 0x01, 0xdd, 0x86, 0xa9, 0x33, 0x89, 0x1a,
-	0x72, 0xb6, 0xdf, 0xdc, 0xa4, 0x1b, 0x5a, 0xf3, 0x2d, 0x3c, 0x78, 0x96, 0xe5, 0xd3, 0x53, 0xc6,
-	0x74, 0x5a, 0x62, 0x92, 0xbf, 0xcb, 0x80, 0xfc, 0xdd, 0x82, 0x8f, 0x6e, 0xa5, 0xdb, 0xca, 0xce,
-	0x61, 0x5b, 0xf7, 0xcd, 0x55, 0xf6, 0x55, 0x63, 0x56, 0xfe, 0x83, 0x1b, 0x19, 0x83, 0xd9, 0x3d,
-	0xeb, 0x25, 0x78, 0x0e, 0xbd, 0x06, 0x7c, 0xc3, 0xe6, 0x1d, 0x6f, 0x6e, 0xde, 0x6e, 0x23, 0x9e,
-	0x26, 0x36, 0xb7, 0xee, 0x67, 0xe8, 0x68, 0xec, 0x5f, 0xc7, 0xd0, 0xe9, 0xdc, 0x6e, 0xe8, 0xfc,
-	0xd0, 0x8d, 0xa6, 0x99, 0xb7, 0x41, 0x2d, 0xb2, 0x8d, 0xa1, 0xad, 0xe1, 0x1f, 0x2d, 0xf0, 0xf5,
-	0x9c, 0xbd, 0xa0, 0x12, 0xcb, 0x8c, 0xb2, 0xec, 0x37, 0x7c, 0x85, 0x52, 0x66, 0xf9, 0x5c, 0x90,
-	0x8f, 0xe1, 0x9e, 0xa4, 0xe5, 0x1c, 0xed, 0x04, 0xdb, 0xb8, 0x3d, 0x83, 0x69, 0x16, 0xf9, 0x1c,
-	0xde, 0x13, 0x7c, 0x59, 0xa6, 0x98, 0xe0, 0xeb, 0xa2, 0x44, 0x21, 0x32, 0x9e, 0xdb, 0x3c, 0x76,
-	0x8d, 0x61, 0x5c, 0xe1, 0xe4, 0x43, 0x80, 0xb4, 0x44, 0x2a, 0x31, 0x99, 0x4e, 0x99, 0x4e, 0xcc,
-	0x8b, 0x3d, 0x83, 0x3c, 0x9d, 0xb2, 0xf0, 0xcf, 0x36, 0xec, 0xdd, 0x94, 0x46, 0x00, 0xdd, 0x6b,
-	0x5e, 0x5e, 0xcd, 0x18, 0xbf, 0x76, 0xa5, 0xbb, 0x3b, 0x79, 0x04, 0x03, 0x1b, 0x7f, 0x63, 0xaa,
-	0xbc, 0xb8, 0x6f, 0xe0, 0x6a, 0x16, 0x1f, 0xc1, 0xc0, 0xd6, 0x52, 0x3d, 0x34, 0x09, 0xf4, 0x0d,
-	0x5c, 0x3d, 0x3c, 0x86, 0x81, 0x90, 0xbc, 0x48, 0xe8, 0x4c, 0x62, 0x99, 0xa4, 0xbc, 0x58, 0xdb,
-	0x9d, 0xdb, 0x51, 0xf0, 0xa9, 0x42, 0xcf, 0x78, 0xb1, 0x26, 0xdf, 0x43, 0x5f, 0xab, 0x92, 0x08,
-	0x9b, 0xa7, 0xdf, 0xd1, 0xe3, 0xf3, 0x49, 0xa3, 0x9d, 0xb7, 0x29, 0x1b, 0xef, 0x68, 0x6a, 0x55,
-	0xa1, 0xfb, 0x15, 0x6d, 0xd7, 0xbf, 0x22, 0x23, 0xbe, 0xfe, 0x6e, 0xc8, 0x75, 0x81, 0xc2, 0xbf,
-	0xeb, 0xc4, 0x57, 0xd8, 0x85, 0x82, 0x9e, 0x0c, 0x7f, 0x3a, 0x5e, 0x65, 0x12, 0x85, 0x88, 0x32,
-	0x3e, 0x32, 0xa7, 0xd1, 0x9c, 0x8f, 0x56, 0xd2, 0xfc, 0xf4, 0x47, 0x55, 0x22, 0x97, 0xdb, 0x1a,
-	0xf8, 0xf2, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd7, 0x9d, 0x28, 0x4a, 0x50, 0x08, 0x00, 0x00,
+	// 1195 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4b, 0x73, 0x1b, 0xc5,
+	0x13, 0xaf, 0x95, 0x22, 0x59, 0xdb, 0x8a, 0x25, 0xff, 0xc7, 0xaf, 0xb5, 0xfe, 0x49, 0x30, 0x0b,
+	0x71, 0x54, 0x04, 0xa4, 0x10, 0x8a, 0x47, 0x51, 0x5c, 0x14, 0xdb, 0x49, 0x99, 0x54, 0x5c, 0x61,
+	0x62, 0xa0, 0x8a, 0x03, 0x5b, 0xe3, 0xdd, 0x91, 0xbc, 0xe5, 0xf1, 0xce, 0xb2, 0x33, 0xb2, 0x23,
+	0x2e, 0x5c, 0xb8, 0xf0, 0x01, 0xf8, 0x1a, 0x1c, 0x39, 0xf2, 0x1d, 0xb8, 0xf2, 0x69, 0xa8, 0x79,
+	0xec, 0x43, 0x7e, 0xe0, 0x84, 0xa2, 0xe0, 0x36, 0xf3, 0xeb, 0xee, 0xe9, 0xee, 0x5f, 0xf7, 0xf6,
+	0xcc, 0x42, 0xf7, 0x54, 0x86, 0x92, 0x45, 0x44, 0x92, 0x41, 0x9a, 0x71, 0xc9, 0x91, 0x5b, 0x00,
+	0xbd, 0x45, 0xc6, 0x27, 0x53, 0x19, 0x33, 0x23, 0xe9, 0x75, 0x24, 0x4f, 0x79, 0xa9, 0xd9, 0xbb,
+	0x33, 0xe1, 0x7c, 0xc2, 0xe8, 0x50, 0xef, 0x0e, 0xa7, 0xe3, 0x61, 0x34, 0xcd, 0x88, 0x8c, 0x79,
+	0x62, 0xe4, 0xfe, 0xd7, 0xd0, 0xdb, 0x7d, 0x49, 0xc3, 0xa9, 0xa4, 0x5f, 0xa9, 0x23, 0xb7, 0xf9,
+	0xc9, 0x09, 0x49, 0x22, 0x4c, 0xbf, 0x9b, 0x52, 0x21, 0x11, 0x82, 0x1b, 0x24, 0x9b, 0x08, 0xcf,
+	0xd9, 0xac, 0xf7, 0x5d, 0xac, 0xd7, 0xe8, 0x2e, 0x74, 0x48, 0xa8, 0x4e, 0x08, 0x64, 0x7c, 0x42,
+	0xf9, 0x54, 0x7a, 0xb5, 0x4d, 0xa7, 0x5f, 0xc7, 0x8b, 0x06, 0x3d, 0x30, 0xa0, 0xbf, 0x0d, 0xff,
+	0xbf, 0xf4, 0x60, 0x91, 0xf2, 0x44, 0x50, 0xf4, 0x36, 0x34, 0xe8, 0x29, 0x4d, 0xa4, 0xe7, 0x6c,
+	0x3a, 0xfd, 0xf6, 0xc3, 0xce, 0x20, 0x4f, 0x63, 0x57, 0xa1, 0xd8, 0x08, 0xfd, 0x0d, 0x58, 0x7f,
+	0x42, 0xe5, 0x36, 0x65, 0x6c, 0x2f, 0x19, 0xf3, 0x7d, 0x72, 0x42, 0x85, 0x0d, 0xcd, 0x7f, 0x00,
+	0xde, 0x45, 0x91, 0x3d, 0x7c, 0x05, 0x1a, 0x89, 0x02, 0x6c, 0xdc, 0x66, 0xe3, 0xf7, 0x01, 0x55,
+	0x2c, 0x2a, 0x29, 0x86, 0x94, 0x31, 0x1d, 0x87, 0x8b, 0xf5, 0xda, 0x7f, 0x0c, 0xcb, 0x73, 0x9a,
+	0xf6, 0xd8, 0x21, 0xb8, 0x4a, 0x1c, 0xc4, 0xc9, 0x98, 0xdb, 0xb8, 0xd1, 0xa0, 0xe0, 0xbb, 0x50,
+	0x6f, 0x85, 0x76, 0xe5, 0x7b, 0xb0, 0x66, 0xcf, 0x11, 0x23, 0x16, 0x13, 0x51, 0x46, 0xff, 0xab,
+	0x03, 0xeb, 0x17, 0x44, 0xd6, 0xcd, 0x1e, 0x2c, 0x10, 0x03, 0xe9, 0xf8, 0xdb, 0x0f, 0x87, 0x83,
+	0xb2, 0xfe, 0x57, 0x18, 0x0d, 0xec, 0x7e, 0x37, 0x91, 0xd9, 0x0c, 0xe7, 0xf6, 0xbd, 0xe7, 0x70,
+	0xb3, 0x2a, 0x40, 0x4b, 0x50, 0x3f, 0xa6, 0x33, 0x9b, 0xab, 0x5a, 0xa2, 0x77, 0xa0, 0x71, 0x4a,
+	0xd8, 0x94, 0xea, 0x22, 0xb6, 0x1f, 0xae, 0xcc, 0xe7, 0x63, 0xdc, 0x60, 0xa3, 0xf2, 0x69, 0xed,
+	0x13, 0xc7, 0x5f, 0xd5, 0xd4, 0x3c, 0xa5, 0x33, 0x91, 0x92, 0xb0, 0xcc, 0x67, 0x0f, 0x56, 0xe6,
+	0x61, 0x9b, 0xcb, 0xfb, 0xe0, 0x1e, 0xe7, 0xa0, 0xcd, 0x66, 0xb9, 0x92, 0x4d, 0x6e, 0x80, 0x4b,
+	0x2d, 0xff, 0x81, 0x2e, 0x53, 0x21, 0xb1, 0x65, 0xea, 0x41, 0x2b, 0x57, 0xb1, 0xe1, 0x17, 0x7b,
+	0x5b, 0xae, 0xd2, 0xa2, 0x28, 0xd7, 0xbc, 0xc9, 0x15, 0xae, 0xcb, 0x73, 0x7e, 0xac, 0xc1, 0xfa,
+	0x5e, 0x12, 0xcb, 0x17, 0x47, 0x24, 0x8b, 0x9e, 0x67, 0xf1, 0x09, 0xc9, 0x66, 0xaf, 0xe0, 0x5f,
+	0xb5, 0x9b, 0x50, 0x26, 0x9a, 0x43, 0x17, 0x9b, 0x0d, 0xc2, 0xd0, 0x4b, 0xcd, 0x19, 0x01, 0x65,
+	0x34, 0x94, 0x81, 0x24, 0x87, 0x8c, 0xca, 0x40, 0xd7, 0xc6, 0xab, 0xeb, 0x80, 0x56, 0x4b, 0xba,
+	0x0f, 0xb4, 0xd4, 0xf0, 0xbd, 0x6e, 0x0d, 0x77, 0x95, 0x5d, 0x45, 0xa0, 0x3c, 0x8d, 0x79, 0x16,
+	0x52, 0xef, 0xc6, 0xa6, 0xd3, 0x6f, 0x61, 0xb3, 0x41, 0xcf, 0x60, 0xf5, 0x8c, 0xc4, 0x32, 0xc8,
+	0x68, 0xca, 0xe2, 0x90, 0x88, 0xe2, 0xc3, 0x6c, 0x68, 0x27, 0x1b, 0x03, 0x33, 0x03, 0x06, 0xf9,
+	0x0c, 0x18, 0xec, 0xd8, 0x19, 0x80, 0x97, 0x95, 0x1d, 0xb6, 0x66, 0xf9, 0x97, 0xfb, 0x08, 0xbc,
+	0x8b, 0x2c, 0x58, 0x4e, 0xb7, 0xa0, 0xa9, 0xbf, 0xcc, 0xbc, 0x98, 0xe7, 0xbf, 0x5b, 0x2b, 0xf5,
+	0x7f, 0xd0, 0xfd, 0xf0, 0xc5, 0x94, 0x66, 0x33, 0x3c, 0x65, 0x54, 0xfc, 0x7d, 0x1a, 0x3f, 0x84,
+	0xb6, 0x25, 0x4e, 0xce, 0x52, 0xaa, 0x79, 0xeb, 0x54, 0xdb, 0xd4, 0xd0, 0x73, 0x30, 0x4b, 0x29,
+	0x06, 0x59, 0xac, 0xfd, 0xf7, 0x60, 0xf5, 0x5c, 0x00, 0xe5, 0x6c, 0xc8, 0x14, 0x60, 0xdd, 0x9b,
+	0x8d, 0xff, 0x87, 0x03, 0xcb, 0xa3, 0x28, 0x2a, 0xf4, 0xff, 0xed, 0x78, 0xd5, 0x18, 0x52, 0x91,
+	0xe8, 0xc2, 0xba, 0x58, 0xaf, 0xd1, 0x7d, 0xa8, 0x4b, 0xc9, 0xae, 0xaf, 0xa2, 0xd2, 0x42, 0x1b,
+	0xd0, 0x22, 0x51, 0x44, 0xa3, 0xe0, 0x70, 0xe6, 0x35, 0xf5, 0x21, 0x0b, 0x7a, 0xff, 0x68, 0xe6,
+	0xaf, 0xc1, 0xca, 0x7c, 0x6e, 0x86, 0x0a, 0xff, 0x67, 0x07, 0xd6, 0x76, 0x28, 0xa3, 0x92, 0xfe,
+	0xa7, 0x79, 0xab, 0xe9, 0x9c, 0xe7, 0xad, 0xd6, 0x6a, 0xea, 0x5f, 0x08, 0xcb, 0x86, 0xfc, 0x31,
+	0x6c, 0x3c, 0xa1, 0xf2, 0xe0, 0x28, 0xe3, 0x52, 0x32, 0x9a, 0x6d, 0xf3, 0x64, 0x1c, 0x4f, 0x5e,
+	0x65, 0x46, 0x1c, 0x42, 0xef, 0x32, 0x43, 0xdb, 0x14, 0x3b, 0xb0, 0x24, 0x73, 0x51, 0x10, 0x6a,
+	0x99, 0x1d, 0x19, 0x1b, 0x95, 0x0c, 0xce, 0x19, 0x77, 0xe5, 0x3c, 0xe0, 0xff, 0x5e, 0x83, 0x5b,
+	0x5f, 0xa6, 0x11, 0x91, 0xf4, 0xf5, 0x03, 0x44, 0x6b, 0xd0, 0xa4, 0x89, 0xe2, 0x45, 0xd3, 0xda,
+	0xc2, 0x76, 0x87, 0x3c, 0x58, 0x88, 0x62, 0xa1, 0x05, 0x75, 0x2d, 0xc8, 0xb7, 0xe8, 0x16, 0xb8,
+	0xf2, 0x28, 0xa3, 0xe2, 0x88, 0xb3, 0x48, 0xf3, 0xe7, 0xe0, 0x12, 0x40, 0x6f, 0xc2, 0xcd, 0x3c,
+	0xbe, 0x80, 0xa4, 0xa9, 0xee, 0x22, 0x17, 0xb7, 0x73, 0x6c, 0x94, 0xa6, 0xe8, 0x5d, 0x40, 0x55,
+	0x95, 0x40, 0xb7, 0x93, 0x6e, 0x1e, 0x07, 0x2f, 0x55, 0x14, 0xb1, 0xc2, 0xd5, 0x94, 0x99, 0xd3,
+	0xce, 0x1f, 0x12, 0xde, 0xc2, 0xb5, 0x53, 0xa6, 0x72, 0x56, 0x0e, 0xaa, 0x67, 0xc4, 0x34, 0x99,
+	0x8b, 0xb0, 0xa5, 0x23, 0x5c, 0x2c, 0xd1, 0x51, 0x9a, 0xfa, 0x14, 0x6e, 0x5f, 0x41, 0xe9, 0x3f,
+	0x5a, 0xba, 0x7d, 0x68, 0xe5, 0x17, 0x42, 0xd1, 0x92, 0x4e, 0xd9, 0x92, 0x68, 0x50, 0xa9, 0x5c,
+	0xed, 0xfc, 0xcd, 0x7f, 0xc9, 0x55, 0xf2, 0x19, 0xdc, 0x79, 0x1c, 0x27, 0xd1, 0x88, 0x31, 0x3d,
+	0x46, 0xc5, 0x5e, 0xf2, 0x3a, 0x17, 0xda, 0x6f, 0x0e, 0xbc, 0x71, 0xa5, 0xb9, 0xcd, 0x7b, 0x1f,
+	0x9a, 0xfa, 0xc3, 0xcb, 0x27, 0xf1, 0x47, 0x95, 0xbb, 0xed, 0x1a, 0xdb, 0x81, 0x11, 0x98, 0xb7,
+	0x82, 0x3d, 0xa5, 0xf7, 0x14, 0xda, 0x15, 0xf8, 0x92, 0x97, 0xc2, 0xd6, 0xfc, 0x4b, 0x61, 0xa9,
+	0xe2, 0x4f, 0x1b, 0x56, 0x5f, 0x09, 0xdf, 0x42, 0x43, 0x63, 0x7f, 0xd9, 0xf1, 0x39, 0xcf, 0xb5,
+	0x0a, 0xcf, 0x77, 0xf3, 0xd9, 0x62, 0xee, 0xc7, 0x6e, 0x49, 0xb2, 0xf5, 0xa1, 0xa5, 0xfe, 0x4f,
+	0x0e, 0x78, 0x7a, 0xa0, 0x3c, 0x23, 0x92, 0x66, 0x31, 0x61, 0xf1, 0xf7, 0xf4, 0x05, 0x95, 0x32,
+	0x4e, 0x26, 0x42, 0x77, 0x3e, 0xc9, 0x26, 0xd4, 0xde, 0xb8, 0xd6, 0x6f, 0xdb, 0x60, 0xda, 0x0a,
+	0xdd, 0x87, 0xff, 0x09, 0x3e, 0xcd, 0x42, 0x1a, 0xd0, 0x97, 0x69, 0x46, 0x85, 0x50, 0x7d, 0x6c,
+	0xe2, 0x58, 0x32, 0x82, 0xdd, 0x02, 0x47, 0xb7, 0x01, 0xc2, 0x8c, 0x12, 0x49, 0x83, 0x28, 0x62,
+	0x3a, 0x30, 0x17, 0xbb, 0x06, 0xd9, 0x89, 0x98, 0xff, 0x4b, 0x0d, 0x96, 0x2f, 0x0b, 0xa3, 0x07,
+	0xad, 0x33, 0x9e, 0x1d, 0x8f, 0x19, 0x3f, 0xcb, 0x53, 0xcf, 0xf7, 0xe8, 0x1e, 0x74, 0xad, 0xff,
+	0xb9, 0xae, 0x72, 0x71, 0xc7, 0xc0, 0x45, 0x2f, 0xde, 0x83, 0xae, 0xcd, 0xa5, 0x50, 0x34, 0x01,
+	0x74, 0x0c, 0x5c, 0x28, 0x6e, 0x41, 0x57, 0x48, 0x9e, 0x06, 0x64, 0x2c, 0xf5, 0x77, 0x90, 0xce,
+	0xec, 0x1b, 0x61, 0x51, 0xc1, 0x23, 0x85, 0x6e, 0xf3, 0x74, 0x86, 0x3e, 0x87, 0x8e, 0x66, 0x25,
+	0x10, 0x36, 0x4e, 0xaf, 0xa1, 0xdb, 0xe7, 0xad, 0x4a, 0x39, 0xaf, 0x62, 0x16, 0x2f, 0x6a, 0xd3,
+	0x22, 0xc3, 0xfc, 0xe9, 0xdc, 0x2c, 0x9f, 0xce, 0x86, 0xfc, 0xe2, 0x1a, 0x10, 0x7a, 0x38, 0x68,
+	0xf2, 0xf3, 0x89, 0x2f, 0x1e, 0xf5, 0xbf, 0xd9, 0x3a, 0x8d, 0x25, 0x15, 0x62, 0x10, 0xf3, 0xa1,
+	0x59, 0x0d, 0x27, 0x7c, 0x78, 0x2a, 0xcd, 0x4f, 0xca, 0xb0, 0x08, 0xe4, 0xb0, 0xa9, 0x81, 0x0f,
+	0xfe, 0x1c, 0x00, 0x1a, 0x4a, 0x9d, 0xde, 0x00, 0x0d, 0x00, 0x00,
 }
@@ -29,27 +29,34 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("vtctlservice.proto", fileDescriptor_27055cdbb1148d2b) }
 
 var fileDescriptor_27055cdbb1148d2b = []byte{
-	// 320 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x5f, 0x4b, 0xf3, 0x30,
-	0x14, 0xc6, 0xdf, 0xf7, 0x62, 0xbb, 0x88, 0x83, 0x49, 0xbc, 0x10, 0x06, 0x4e, 0xac, 0x28, 0x4c,
-	0xa1, 0x95, 0xf9, 0x09, 0xe6, 0x50, 0x29, 0xc2, 0xf0, 0x0f, 0x78, 0x31, 0xf0, 0x22, 0xb6, 0x47,
-	0x17, 0x48, 0x93, 0x2e, 0x27, 0x2b, 0xee, 0x23, 0xf8, 0xad, 0xc5, 0xcc, 0xd4, 0xd8, 0xb5, 0xd3,
-	0xbb, 0xe4, 0xfc, 0x9e, 0xf3, 0xe4, 0x90, 0x87, 0x43, 0x68, 0x61, 0x12, 0x23, 0x10, 0x74, 0xc1,
-	0x13, 0x08, 0x73, 0xad, 0x8c, 0xa2, 0x1d, 0xbf, 0xd6, 0xeb, 0xda, 0x5b, 0xca, 0x0c, 0x5b, 0xe1,
-	0xe1, 0x9c, 0xb4, 0x1e, 0x3f, 0x4b, 0x74, 0x46, 0x76, 0x2e, 0xdf, 0x20, 0x59, 0x18, 0xb0, 0xf7,
-	0xb1, 0xca, 0x32, 0x26, 0x53, 0x7a, 0x14, 0x7e, 0x77, 0xd4, 0xf0, 0x7b, 0x98, 0x2f, 0x00, 0x4d,
-	0xef, 0xf8, 0x37, 0x19, 0xe6, 0x4a, 0x22, 0x04, 0xff, 0xce, 0xfe, 0x0f, 0xdf, 0x5b, 0xa4, 0x6d,
-	0x61, 0x4a, 0x35, 0xd9, 0xbd, 0xe2, 0x32, 0x1d, 0x09, 0xf1, 0x30, 0x63, 0x3a, 0xc5, 0x58, 0xde,
-	0xc0, 0x12, 0x73, 0x96, 0x00, 0x1d, 0x78, 0x8e, 0x0d, 0x1a, 0xf7, 0xf8, 0xc9, 0x5f, 0xa4, 0x6e,
-	0x00, 0xfa, 0x44, 0xb6, 0xaf, 0xc1, 0x8c, 0x41, 0x88, 0x58, 0xbe, 0xa8, 0x09, 0xcb, 0x00, 0x69,
-	0xe0, 0x39, 0x54, 0xa1, 0x7b, 0xe5, 0x70, 0xa3, 0xa6, 0xb4, 0x9f, 0x90, 0x2d, 0x8f, 0xd2, 0xbd,
-	0xfa, 0x2e, 0x67, 0xda, 0x6f, 0xc2, 0xa5, 0xdf, 0x94, 0x74, 0xbf, 0x00, 0x8e, 0x04, 0x67, 0x08,
-	0x48, 0x0f, 0xd6, 0x9b, 0x1c, 0x73, 0xbe, 0xc1, 0x26, 0x49, 0x65, 0xd6, 0xf2, 0xcb, 0x2b, 0xb3,
-	0x56, 0xbf, 0xb9, 0xdf, 0x84, 0x4b, 0xbf, 0x3b, 0xd2, 0xf1, 0x00, 0xd2, 0x86, 0x8e, 0x72, 0xca,
-	0xfd, 0x46, 0xee, 0xa7, 0x15, 0x4b, 0x6e, 0x6c, 0x9e, 0xb7, 0x9a, 0x67, 0x4c, 0x2f, 0x7f, 0xa4,
-	0x55, 0x85, 0x75, 0x69, 0xad, 0x6b, 0x9c, 0xfd, 0xc5, 0xe9, 0x74, 0x50, 0x70, 0x03, 0x88, 0x21,
-	0x57, 0xd1, 0xea, 0x14, 0xbd, 0xaa, 0xa8, 0x30, 0x91, 0x5d, 0x8f, 0xc8, 0x5f, 0x9e, 0xe7, 0xb6,
-	0xad, 0x9d, 0x7f, 0x04, 0x00, 0x00, 0xff, 0xff, 0x79, 0x0f, 0x93, 0xa5, 0x67, 0x03, 0x00, 0x00,
+	// 420 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xd1, 0xce, 0xd2, 0x30,
+	0x14, 0xc7, 0xf5, 0xc2, 0x2f, 0xa6, 0x62, 0x3e, 0x53, 0x63, 0x4c, 0x48, 0x44, 0x45, 0x51, 0xd1,
+	0x84, 0x19, 0x7c, 0x02, 0x44, 0x25, 0xc4, 0x84, 0x08, 0xa2, 0x17, 0x24, 0x5e, 0xd4, 0xf5, 0x00,
+	0x4d, 0xba, 0x75, 0xb4, 0x67, 0x8b, 0x3c, 0xae, 0x6f, 0x62, 0x1c, 0xb6, 0x74, 0x65, 0x03, 0xef,
+	0x58, 0x7f, 0xff, 0xf3, 0x3b, 0xe5, 0xe4, 0xa4, 0x84, 0x16, 0x18, 0xa3, 0x34, 0xa0, 0x0b, 0x11,
+	0xc3, 0x20, 0xd3, 0x0a, 0x15, 0x6d, 0xf9, 0x67, 0xed, 0xeb, 0xf2, 0x8b, 0x33, 0x64, 0x07, 0x3c,
+	0xdc, 0x91, 0x5b, 0xdf, 0xff, 0x1e, 0xd1, 0x2d, 0xb9, 0xff, 0xf1, 0x17, 0xc4, 0x39, 0x42, 0xf9,
+	0x3d, 0x56, 0x49, 0xc2, 0x52, 0x4e, 0x7b, 0x83, 0x63, 0x45, 0x0d, 0x5f, 0xc0, 0x2e, 0x07, 0x83,
+	0xed, 0x17, 0x97, 0x62, 0x26, 0x53, 0xa9, 0x81, 0xee, 0x8d, 0xb7, 0x37, 0x87, 0xbf, 0x6f, 0x93,
+	0xab, 0x12, 0x72, 0x3a, 0x27, 0xad, 0x11, 0xe7, 0xf3, 0x1c, 0xf4, 0x7e, 0x91, 0x4b, 0xa0, 0x1d,
+	0x4f, 0xe3, 0x03, 0xdb, 0xe6, 0x71, 0x23, 0xb7, 0x7e, 0xba, 0x22, 0xd7, 0x1f, 0x40, 0x02, 0xc2,
+	0xd1, 0xfa, 0xd4, 0xab, 0x0a, 0x98, 0x15, 0x77, 0xcf, 0x45, 0x9c, 0x5b, 0x93, 0x87, 0x9f, 0x44,
+	0xca, 0x47, 0x52, 0x7e, 0xdd, 0x32, 0xcd, 0xcd, 0x34, 0xfd, 0x0c, 0x7b, 0x93, 0xb1, 0x18, 0x68,
+	0xdf, 0x13, 0x34, 0x64, 0x6c, 0xaf, 0xd7, 0xff, 0x13, 0x75, 0x3d, 0x7f, 0x90, 0x7b, 0x13, 0xc0,
+	0x31, 0x48, 0x39, 0x4d, 0xd7, 0x6a, 0xc6, 0x12, 0x30, 0xd4, 0xbf, 0x6d, 0x08, 0x6d, 0x97, 0x67,
+	0x67, 0x33, 0x4e, 0x3f, 0x23, 0x77, 0x3c, 0x4a, 0x1f, 0xd5, 0x57, 0x59, 0x69, 0xa7, 0x09, 0xfb,
+	0xe3, 0xff, 0x07, 0xcc, 0x48, 0x0a, 0x66, 0xc0, 0x54, 0xc6, 0x1f, 0xb0, 0xba, 0xf1, 0x9f, 0x44,
+	0x82, 0xbb, 0xba, 0x91, 0x07, 0x77, 0x0d, 0xc7, 0xdc, 0x69, 0xc2, 0xce, 0x37, 0x27, 0x2d, 0x0f,
+	0x18, 0xda, 0x50, 0x61, 0xea, 0xb6, 0xaf, 0xca, 0x9d, 0x72, 0x49, 0xee, 0x4e, 0x00, 0xdd, 0xee,
+	0x18, 0x1a, 0xd4, 0x1c, 0x89, 0x95, 0x3e, 0x69, 0x0e, 0x38, 0x6b, 0x4c, 0xe8, 0x04, 0x70, 0xb9,
+	0xd5, 0x0a, 0x51, 0x82, 0x1e, 0xab, 0x74, 0x2d, 0x36, 0xf4, 0x79, 0xb5, 0x32, 0xc0, 0xd6, 0xdf,
+	0xbb, 0x90, 0xf2, 0x17, 0x6d, 0x9a, 0x0a, 0x2c, 0x57, 0xf1, 0x8b, 0x16, 0x09, 0xd3, 0xfb, 0xca,
+	0xa2, 0x85, 0xb0, 0x6e, 0xd1, 0x4e, 0x33, 0x4e, 0x2f, 0xc9, 0x83, 0x6f, 0x19, 0x67, 0x08, 0xe1,
+	0xdf, 0x78, 0xe9, 0xd5, 0xd7, 0x26, 0x6c, 0xa3, 0x57, 0x97, 0x83, 0xb6, 0xdb, 0xfb, 0x37, 0xab,
+	0x7e, 0x21, 0x10, 0x8c, 0x19, 0x08, 0x15, 0x1d, 0x7e, 0x45, 0x1b, 0x15, 0x15, 0x18, 0x95, 0xcf,
+	0x5e, 0xe4, 0x3f, 0x8a, 0x3f, 0xaf, 0xca, 0xb3, 0x77, 0x7f, 0x06, 0x00, 0xb2, 0x7e, 0x2f, 0x1c,
+	0x3f, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -163,6 +170,12 @@ var _Vtctl_serviceDesc = grpc.ServiceDesc{
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type VtctldClient interface {
+	// AddQueryRule adds a query rule to the tablets of a keyspace, shard and
+	// tablet type.
+	AddQueryRule(ctx context.Context, in *vtctldata.AddQueryRuleRequest, opts ...grpc.CallOption) (*vtctldata.AddQueryRuleResponse, error)
+	// DeleteQueryRule deletes a query rule of the tablets of a keyspace, shard
+	// and tablet type.
+	DeleteQueryRule(ctx context.Context, in *vtctldata.DeleteQueryRuleRequest, opts ...grpc.CallOption) (*vtctldata.DeleteQueryRuleResponse, error)
 	// FindAllShardsInKeyspace returns a map of shard names to shard references
 	// for a given keyspace.
 	FindAllShardsInKeyspace(ctx context.Context, in *vtctldata.FindAllShardsInKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.FindAllShardsInKeyspaceResponse, error)
@@ -178,6 +191,12 @@ type VtctldClient interface {
 	GetKeyspace(ctx context.Context, in *vtctldata.GetKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.GetKeyspaceResponse, error)
 	// GetKeyspaces returns the keyspace struct of all keyspaces in the topo.
 	GetKeyspaces(ctx context.Context, in *vtctldata.GetKeyspacesRequest, opts ...grpc.CallOption) (*vtctldata.GetKeyspacesResponse, error)
+	// GetQueryRules returns the query rules of the tablets of a keyspace, shard
+	// and tablet type.
+	GetQueryRules(ctx context.Context, in *vtctldata.GetQueryRulesRequest, opts ...grpc.CallOption) (*vtctldata.GetQueryRulesResponse, error)
+	// GetThrottlerConfig returns the tablet throttler configuration of a
+	// keyspace.
+	GetThrottlerConfig(ctx context.Context, in *vtctldata.GetThrottlerConfigRequest, opts ...grpc.CallOption) (*vtctldata.GetThrottlerConfigResponse, error)
 	// InitShardPrimary sets the initial primary for a shard. Will make all other
 	// tablets in the shard replicas of the provided primary.
 	//
@@ -185,6 +204,9 @@ type VtctldClient interface {
 	// PlannedReparentShard or EmergencyReparentShard should be used in those
 	// cases instead.
 	InitShardPrimary(ctx context.Context, in *vtctldata.InitShardPrimaryRequest, opts ...grpc.CallOption) (*vtctldata.InitShardPrimaryResponse, error)
+	// UpdateThrottlerConfig updates the tablet throttler configuration of a
+	// keyspace: enablement, threshold and throttled apps.
+	UpdateThrottlerConfig(ctx context.Context, in *vtctldata.UpdateThrottlerConfigRequest, opts ...grpc.CallOption) (*vtctldata.UpdateThrottlerConfigResponse, error)
 }
 
 type vtctldClient struct {
@@ -195,6 +217,24 @@ func NewVtctldClient(cc *grpc.ClientConn) VtctldClient {
 	return &vtctldClient{cc}
 }
 
+func (c *vtctldClient) AddQueryRule(ctx context.Context, in *vtctldata.AddQueryRuleRequest, opts ...grpc.CallOption) (*vtctldata.AddQueryRuleResponse, error) {
+	out := new(vtctldata.AddQueryRuleResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/AddQueryRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) DeleteQueryRule(ctx context.Context, in *vtctldata.DeleteQueryRuleRequest, opts ...grpc.CallOption) (*vtctldata.DeleteQueryRuleResponse, error) {
+	out := new(vtctldata.DeleteQueryRuleResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/DeleteQueryRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) FindAllShardsInKeyspace(ctx context.Context, in *vtctldata.FindAllShardsInKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.FindAllShardsInKeyspaceResponse, error) {
 	out := new(vtctldata.FindAllShardsInKeyspaceResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/FindAllShardsInKeyspace", in, out, opts...)
@@ -249,6 +289,24 @@ func (c *vtctldClient) GetKeyspaces(ctx context.Context, in *vtctldata.GetKeyspa
 	return out, nil
 }
 
+func (c *vtctldClient) GetQueryRules(ctx context.Context, in *vtctldata.GetQueryRulesRequest, opts ...grpc.CallOption) (*vtctldata.GetQueryRulesResponse, error) {
+	out := new(vtctldata.GetQueryRulesResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetQueryRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetThrottlerConfig(ctx context.Context, in *vtctldata.GetThrottlerConfigRequest, opts ...grpc.CallOption) (*vtctldata.GetThrottlerConfigResponse, error) {
+	out := new(vtctldata.GetThrottlerConfigResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetThrottlerConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) InitShardPrimary(ctx context.Context, in *vtctldata.InitShardPrimaryRequest, opts ...grpc.CallOption) (*vtctldata.InitShardPrimaryResponse, error) {
 	out := new(vtctldata.InitShardPrimaryResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/InitShardPrimary", in, out, opts...)
@@ -258,8 +316,23 @@ func (c *vtctldClient) InitShardPrimary(ctx context.Context, in *vtctldata.InitS
 	return out, nil
 }
 
+func (c *vtctldClient) UpdateThrottlerConfig(ctx context.Context, in *vtctldata.UpdateThrottlerConfigRequest, opts ...grpc.CallOption) (*vtctldata.UpdateThrottlerConfigResponse, error) {
+	out := new(vtctldata.UpdateThrottlerConfigResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/UpdateThrottlerConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VtctldServer is the server API for Vtctld service.
 type VtctldServer interface {
+	// AddQueryRule adds a query rule to the tablets of a keyspace, shard and
+	// tablet type.
+	AddQueryRule(context.Context, *vtctldata.AddQueryRuleRequest) (*vtctldata.AddQueryRuleResponse, error)
+	// DeleteQueryRule deletes a query rule of the tablets of a keyspace, shard
+	// and tablet type.
+	DeleteQueryRule(context.Context, *vtctldata.DeleteQueryRuleRequest) (*vtctldata.DeleteQueryRuleResponse, error)
 	// FindAllShardsInKeyspace returns a map of shard names to shard references
 	// for a given keyspace.
 	FindAllShardsInKeyspace(context.Context, *vtctldata.FindAllShardsInKeyspaceRequest) (*vtctldata.FindAllShardsInKeyspaceResponse, error)
@@ -275,6 +348,12 @@ type VtctldServer interface {
 	GetKeyspace(context.Context, *vtctldata.GetKeyspaceRequest) (*vtctldata.GetKeyspaceResponse, error)
 	// GetKeyspaces returns the keyspace struct of all keyspaces in the topo.
 	GetKeyspaces(context.Context, *vtctldata.GetKeyspacesRequest) (*vtctldata.GetKeyspacesResponse, error)
+	// GetQueryRules returns the query rules of the tablets of a keyspace, shard
+	// and tablet type.
+	GetQueryRules(context.Context, *vtctldata.GetQueryRulesRequest) (*vtctldata.GetQueryRulesResponse, error)
+	// GetThrottlerConfig returns the tablet throttler configuration of a
+	// keyspace.
+	GetThrottlerConfig(context.Context, *vtctldata.GetThrottlerConfigRequest) (*vtctldata.GetThrottlerConfigResponse, error)
 	// InitShardPrimary sets the initial primary for a shard. Will make all other
 	// tablets in the shard replicas of the provided primary.
 	//
@@ -282,12 +361,21 @@ type VtctldServer interface {
 	// PlannedReparentShard or EmergencyReparentShard should be used in those
 	// cases instead.
 	InitShardPrimary(context.Context, *vtctldata.InitShardPrimaryRequest) (*vtctldata.InitShardPrimaryResponse, error)
+	// UpdateThrottlerConfig updates the tablet throttler configuration of a
+	// keyspace: enablement, threshold and throttled apps.
+	UpdateThrottlerConfig(context.Context, *vtctldata.UpdateThrottlerConfigRequest) (*vtctldata.UpdateThrottlerConfigResponse, error)
 }
 
 // UnimplementedVtctldServer can be embedded to have forward compatible implementations.
 type UnimplementedVtctldServer struct {
 }
 
+func (*UnimplementedVtctldServer) AddQueryRule(ctx context.Context, req *vtctldata.AddQueryRuleRequest) (*vtctldata.AddQueryRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddQueryRule not implemented")
+}
+func (*UnimplementedVtctldServer) DeleteQueryRule(ctx context.Context, req *vtctldata.DeleteQueryRuleRequest) (*vtctldata.DeleteQueryRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQueryRule not implemented")
+}
 func (*UnimplementedVtctldServer) FindAllShardsInKeyspace(ctx context.Context, req *vtctldata.FindAllShardsInKeyspaceRequest) (*vtctldata.FindAllShardsInKeyspaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindAllShardsInKeyspace not implemented")
 }
@@ -306,14 +394,59 @@ func (*UnimplementedVtctldServer) GetKeyspace(ctx context.Context, req *vtctldat
 func (*UnimplementedVtctldServer) GetKeyspaces(ctx context.Context, req *vtctldata.GetKeyspacesRequest) (*vtctldata.GetKeyspacesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetKeyspaces not implemented")
 }
+func (*UnimplementedVtctldServer) GetQueryRules(ctx context.Context, req *vtctldata.GetQueryRulesRequest) (*vtctldata.GetQueryRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueryRules not implemented")
+}
+func (*UnimplementedVtctldServer) GetThrottlerConfig(ctx context.Context, req *vtctldata.GetThrottlerConfigRequest) (*vtctldata.GetThrottlerConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThrottlerConfig not implemented")
+}
 func (*UnimplementedVtctldServer) InitShardPrimary(ctx context.Context, req *vtctldata.InitShardPrimaryRequest) (*vtctldata.InitShardPrimaryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InitShardPrimary not implemented")
 }
+func (*UnimplementedVtctldServer) UpdateThrottlerConfig(ctx context.Context, req *vtctldata.UpdateThrottlerConfigRequest) (*vtctldata.UpdateThrottlerConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateThrottlerConfig not implemented")
+}
 
 func RegisterVtctldServer(s *grpc.Server, srv VtctldServer) {
 	s.RegisterService(&_Vtctld_serviceDesc, srv)
 }
 
+func _Vtctld_AddQueryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.AddQueryRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).AddQueryRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/AddQueryRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).AddQueryRule(ctx, req.(*vtctldata.AddQueryRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_DeleteQueryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.DeleteQueryRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).DeleteQueryRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/DeleteQueryRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).DeleteQueryRule(ctx, req.(*vtctldata.DeleteQueryRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_FindAllShardsInKeyspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.FindAllShardsInKeyspaceRequest)
 	if err := dec(in); err != nil {
@@ -422,6 +555,42 @@ func _Vtctld_GetKeyspaces_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_GetQueryRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetQueryRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetQueryRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetQueryRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetQueryRules(ctx, req.(*vtctldata.GetQueryRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetThrottlerConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetThrottlerConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetThrottlerConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetThrottlerConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetThrottlerConfig(ctx, req.(*vtctldata.GetThrottlerConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_InitShardPrimary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.InitShardPrimaryRequest)
 	if err := dec(in); err != nil {
@@ -440,10 +609,36 @@ func _Vtctld_InitShardPrimary_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_UpdateThrottlerConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.UpdateThrottlerConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).UpdateThrottlerConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/UpdateThrottlerConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).UpdateThrottlerConfig(ctx, req.(*vtctldata.UpdateThrottlerConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Vtctld_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "vtctlservice.Vtctld",
 	HandlerType: (*VtctldServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddQueryRule",
+			Handler:    _Vtctld_AddQueryRule_Handler,
+		},
+		{
+			MethodName: "DeleteQueryRule",
+			Handler:    _Vtctld_DeleteQueryRule_Handler,
+		},
 		{
 			MethodName: "FindAllShardsInKeyspace",
 			Handler:    _Vtctld_FindAllShardsInKeyspace_Handler,
@@ -468,10 +663,22 @@ var _Vtctld_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetKeyspaces",
 			Handler:    _Vtctld_GetKeyspaces_Handler,
 		},
+		{
+			MethodName: "GetQueryRules",
+			Handler:    _Vtctld_GetQueryRules_Handler,
+		},
+		{
+			MethodName: "GetThrottlerConfig",
+			Handler:    _Vtctld_GetThrottlerConfig_Handler,
+		},
 		{
 			MethodName: "InitShardPrimary",
 			Handler:    _Vtctld_InitShardPrimary_Handler,
 		},
+		{
+			MethodName: "UpdateThrottlerConfig",
+			Handler:    _Vtctld_UpdateThrottlerConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "vtctlservice.proto",
@@ -971,6 +971,72 @@ func (m *ResolveTransactionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResolveTransactionResponse proto.InternalMessageInfo
 
+// VStreamFlags are the optional flags of a VStream request. They
+// default to the behavior of a request without flags.
+type VStreamFlags struct {
+	// heartbeat_interval is the number of seconds after which a
+	// HEARTBEAT event is sent if there were no other events.
+	// Heartbeats are not sent if the value is 0.
+	HeartbeatInterval uint32 `protobuf:"varint,1,opt,name=heartbeat_interval,json=heartbeatInterval,proto3" json:"heartbeat_interval,omitempty"`
+	// stop_on_reshard ends the stream when a reshard of the streamed
+	// shards completes, instead of following the new shards. The
+	// JOURNAL event of the reshard, followed by a VGTID event with the
+	// positions of the new shards, is sent before the stream ends.
+	StopOnReshard bool `protobuf:"varint,2,opt,name=stop_on_reshard,json=stopOnReshard,proto3" json:"stop_on_reshard,omitempty"`
+	// send_fields_on_start sends a FIELD event for every streamed table
+	// when the stream of a shard starts, before any row of the table.
+	SendFieldsOnStart    bool     `protobuf:"varint,3,opt,name=send_fields_on_start,json=sendFieldsOnStart,proto3" json:"send_fields_on_start,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VStreamFlags) Reset()         { *m = VStreamFlags{} }
+func (m *VStreamFlags) String() string { return proto.CompactTextString(m) }
+func (*VStreamFlags) ProtoMessage()    {}
+func (*VStreamFlags) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab96496ceaf1ebb, []int{10}
+}
+
+func (m *VStreamFlags) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VStreamFlags.Unmarshal(m, b)
+}
+func (m *VStreamFlags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VStreamFlags.Marshal(b, m, deterministic)
+}
+func (m *VStreamFlags) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VStreamFlags.Merge(m, src)
+}
+func (m *VStreamFlags) XXX_Size() int {
+	return xxx_messageInfo_VStreamFlags.Size(m)
+}
+func (m *VStreamFlags) XXX_DiscardUnknown() {
+	xxx_messageInfo_VStreamFlags.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VStreamFlags proto.InternalMessageInfo
+
+func (m *VStreamFlags) GetHeartbeatInterval() uint32 {
+	if m != nil {
+		return m.HeartbeatInterval
+	}
+	return 0
+}
+
+func (m *VStreamFlags) GetStopOnReshard() bool {
+	if m != nil {
+		return m.StopOnReshard
+	}
+	return false
+}
+
+func (m *VStreamFlags) GetSendFieldsOnStart() bool {
+	if m != nil {
+		return m.SendFieldsOnStart
+	}
+	return false
+}
+
 // VStreamRequest is the payload for VStream.
 type VStreamRequest struct {
 	CallerId   *vtrpc.CallerID     `protobuf:"bytes,1,opt,name=caller_id,json=callerId,proto3" json:"caller_id,omitempty"`
@@ -980,6 +1046,7 @@ type VStreamRequest struct {
 	// position is of the form 'ks1:0@MySQL56/<mysql_pos>|ks2:-80@MySQL56/<mysql_pos>'.
 	Vgtid                *binlogdata.VGtid  `protobuf:"bytes,3,opt,name=vgtid,proto3" json:"vgtid,omitempty"`
 	Filter               *binlogdata.Filter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	Flags                *VStreamFlags      `protobuf:"bytes,5,opt,name=flags,proto3" json:"flags,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -989,7 +1056,7 @@ func (m *VStreamRequest) Reset()         { *m = VStreamRequest{} }
 func (m *VStreamRequest) String() string { return proto.CompactTextString(m) }
 func (*VStreamRequest) ProtoMessage()    {}
 func (*VStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab96496ceaf1ebb, []int{10}
+	return fileDescriptor_aab96496ceaf1ebb, []int{11}
 }
 
 func (m *VStreamRequest) XXX_Unmarshal(b []byte) error {
@@ -1038,6 +1105,13 @@ func (m *VStreamRequest) GetFilter() *binlogdata.Filter {
 	return nil
 }
 
+func (m *VStreamRequest) GetFlags() *VStreamFlags {
+	if m != nil {
+		return m.Flags
+	}
+	return nil
+}
+
 // VStreamResponse is streamed by VStream.
 type VStreamResponse struct {
 	Events               []*binlogdata.VEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
@@ -1050,7 +1124,7 @@ func (m *VStreamResponse) Reset()         { *m = VStreamResponse{} }
 func (m *VStreamResponse) String() string { return proto.CompactTextString(m) }
 func (*VStreamResponse) ProtoMessage()    {}
 func (*VStreamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab96496ceaf1ebb, []int{11}
+	return fileDescriptor_aab96496ceaf1ebb, []int{12}
 }
 
 func (m *VStreamResponse) XXX_Unmarshal(b []byte) error {
@@ -1094,6 +1168,7 @@ func init() {
 	proto.RegisterType((*StreamExecuteResponse)(nil), "vtgate.StreamExecuteResponse")
 	proto.RegisterType((*ResolveTransactionRequest)(nil), "vtgate.ResolveTransactionRequest")
 	proto.RegisterType((*ResolveTransactionResponse)(nil), "vtgate.ResolveTransactionResponse")
+	proto.RegisterType((*VStreamFlags)(nil), "vtgate.VStreamFlags")
 	proto.RegisterType((*VStreamRequest)(nil), "vtgate.VStreamRequest")
 	proto.RegisterType((*VStreamResponse)(nil), "vtgate.VStreamResponse")
 }
@@ -1101,90 +1176,96 @@ func init() {
 func init() { proto.RegisterFile("vtgate.proto", fileDescriptor_aab96496ceaf1ebb) }
 
 var fileDescriptor_aab96496ceaf1ebb = []byte{
-	// 1357 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x6d, 0x6f, 0x1b, 0xc5,
-	0x13, 0xef, 0xf9, 0xd9, 0xe3, 0xa7, 0xcb, 0xe6, 0xe1, 0x7f, 0xcd, 0xbf, 0x80, 0xe5, 0xb6, 0xaa,
-	0x5b, 0x50, 0x02, 0x41, 0x40, 0x85, 0x40, 0x90, 0xd8, 0x6e, 0x71, 0x95, 0xd4, 0x61, 0xed, 0x24,
-	0x12, 0x02, 0x9d, 0x2e, 0xbe, 0x8d, 0xb3, 0x8a, 0x73, 0xeb, 0xee, 0xae, 0x1d, 0xfc, 0x29, 0x78,
-	0x0f, 0x1f, 0x80, 0x37, 0xbc, 0xe7, 0x3b, 0xf0, 0x8e, 0x6f, 0x84, 0x76, 0xf7, 0xce, 0x3e, 0xbb,
-	0x81, 0xa6, 0xad, 0xfa, 0xc6, 0xba, 0x9d, 0xdf, 0xec, 0xec, 0xcc, 0xfc, 0x66, 0x76, 0xd6, 0x50,
-	0x9c, 0xc8, 0x81, 0x27, 0xc9, 0xd6, 0x88, 0x33, 0xc9, 0x50, 0xc6, 0xac, 0x36, 0xed, 0x53, 0x1a,
-	0x0c, 0xd9, 0xc0, 0xf7, 0xa4, 0x67, 0x90, 0xcd, 0xc2, 0x8b, 0x31, 0xe1, 0xd3, 0x70, 0x51, 0x96,
-	0x6c, 0xc4, 0xe2, 0xe0, 0x44, 0xf2, 0x51, 0xdf, 0x2c, 0x6a, 0xbf, 0x15, 0x20, 0xdb, 0x25, 0x42,
-	0x50, 0x16, 0xa0, 0xfb, 0x50, 0xa6, 0x81, 0x2b, 0xb9, 0x17, 0x08, 0xaf, 0x2f, 0x29, 0x0b, 0x1c,
-	0xab, 0x6a, 0xd5, 0x73, 0xb8, 0x44, 0x83, 0xde, 0x5c, 0x88, 0x1a, 0x50, 0x16, 0xe7, 0x1e, 0xf7,
-	0x5d, 0x61, 0xf6, 0x09, 0x27, 0x51, 0x4d, 0xd6, 0x0b, 0x3b, 0x77, 0xb6, 0x42, 0xef, 0x42, 0x7b,
-	0x5b, 0x5d, 0xa5, 0x15, 0x2e, 0x70, 0x49, 0xc4, 0x56, 0x02, 0xbd, 0x0f, 0xe0, 0x8d, 0x25, 0xeb,
-	0xb3, 0xcb, 0x4b, 0x2a, 0x9d, 0x94, 0x3e, 0x27, 0x26, 0x41, 0x77, 0xa1, 0x24, 0x3d, 0x3e, 0x20,
-	0xd2, 0x15, 0x92, 0xd3, 0x60, 0xe0, 0xa4, 0xab, 0x56, 0x3d, 0x8f, 0x8b, 0x46, 0xd8, 0xd5, 0x32,
-	0xb4, 0x0d, 0x59, 0x36, 0x92, 0xda, 0x85, 0x4c, 0xd5, 0xaa, 0x17, 0x76, 0xd6, 0xb7, 0x4c, 0xe0,
-	0xad, 0x9f, 0x49, 0x7f, 0x2c, 0x49, 0xc7, 0x80, 0x38, 0xd2, 0x42, 0x7b, 0x60, 0xc7, 0xc2, 0x73,
-	0x2f, 0x99, 0x4f, 0x9c, 0x6c, 0xd5, 0xaa, 0x97, 0x77, 0xfe, 0x17, 0x39, 0x1f, 0x8b, 0xf4, 0x80,
-	0xf9, 0x04, 0x57, 0xe4, 0xa2, 0x00, 0x6d, 0x43, 0xee, 0xca, 0xe3, 0x01, 0x0d, 0x06, 0xc2, 0xc9,
-	0xe9, 0xc0, 0x57, 0xc3, 0x53, 0xbf, 0x57, 0xbf, 0x27, 0x06, 0xc3, 0x33, 0x25, 0xf4, 0x0d, 0x14,
-	0x47, 0x9c, 0xcc, 0xb3, 0x95, 0xbf, 0x41, 0xb6, 0x0a, 0x23, 0x4e, 0x66, 0xb9, 0xda, 0x85, 0xd2,
-	0x88, 0x09, 0x39, 0xb7, 0x00, 0x37, 0xb0, 0x50, 0x54, 0x5b, 0x66, 0x26, 0xee, 0x41, 0x79, 0xe8,
-	0x09, 0xe9, 0xd2, 0x40, 0x10, 0x2e, 0x5d, 0xea, 0x3b, 0x85, 0xaa, 0x55, 0x4f, 0xe1, 0xa2, 0x92,
-	0xb6, 0xb5, 0xb0, 0xed, 0xa3, 0xf7, 0x00, 0xce, 0xd8, 0x38, 0xf0, 0x5d, 0xce, 0xae, 0x84, 0x53,
-	0xd4, 0x1a, 0x79, 0x2d, 0xc1, 0xec, 0x4a, 0x20, 0x17, 0x36, 0xc6, 0x82, 0x70, 0xd7, 0x27, 0x67,
-	0x34, 0x20, 0xbe, 0x3b, 0xf1, 0x38, 0xf5, 0x4e, 0x87, 0x44, 0x38, 0x25, 0xed, 0xd0, 0xc3, 0x65,
-	0x87, 0x8e, 0x04, 0xe1, 0x4d, 0xa3, 0x7c, 0x1c, 0xe9, 0xb6, 0x02, 0xc9, 0xa7, 0x78, 0x6d, 0x7c,
-	0x0d, 0x84, 0x3a, 0x60, 0x8b, 0xa9, 0x90, 0xe4, 0x32, 0x66, 0xba, 0xac, 0x4d, 0xdf, 0x7b, 0x29,
-	0x56, 0xad, 0xb7, 0x64, 0xb5, 0x22, 0x16, 0xa5, 0xe8, 0xff, 0x90, 0xe7, 0xec, 0xca, 0xed, 0xb3,
-	0x71, 0x20, 0x9d, 0x4a, 0xd5, 0xaa, 0x27, 0x71, 0x8e, 0xb3, 0xab, 0x86, 0x5a, 0xab, 0x12, 0x14,
-	0xde, 0x84, 0x8c, 0x18, 0x0d, 0xa4, 0x70, 0xec, 0x6a, 0xb2, 0x9e, 0xc7, 0x31, 0x09, 0xaa, 0x83,
-	0x4d, 0x03, 0x97, 0x13, 0x41, 0xf8, 0x84, 0xf8, 0x6e, 0x9f, 0x05, 0x81, 0xb3, 0xa2, 0x0b, 0xb5,
-	0x4c, 0x03, 0x1c, 0x8a, 0x1b, 0x2c, 0x08, 0x14, 0xc3, 0x43, 0xd6, 0xbf, 0x88, 0x08, 0x72, 0x90,
-	0x2e, 0xc6, 0x57, 0x30, 0xac, 0x76, 0x44, 0x9d, 0xb7, 0x05, 0xab, 0x9a, 0x1e, 0x6d, 0xe5, 0x9c,
-	0x78, 0x5c, 0x9e, 0x12, 0x4f, 0x3a, 0xab, 0xda, 0xe3, 0x15, 0x05, 0xed, 0xb3, 0xfe, 0xc5, 0x77,
-	0x11, 0x80, 0xbe, 0x05, 0x9b, 0x13, 0xcf, 0x77, 0xbd, 0x33, 0x49, 0xb8, 0x7b, 0xc5, 0xa9, 0x24,
-	0xce, 0x9a, 0x3e, 0x74, 0x23, 0x3a, 0x14, 0x13, 0xcf, 0xdf, 0x55, 0xf0, 0x89, 0x42, 0x71, 0x99,
-	0x2f, 0xac, 0x51, 0x15, 0x0a, 0xcd, 0xe6, 0x7e, 0x57, 0x72, 0x4f, 0x92, 0xc1, 0xd4, 0x59, 0xd7,
-	0xdd, 0x15, 0x17, 0x29, 0x8d, 0xd0, 0xbd, 0xa3, 0xa3, 0x76, 0xd3, 0xd9, 0x30, 0x1a, 0x31, 0xd1,
-	0xe6, 0x9f, 0x16, 0x14, 0xe3, 0x31, 0xa1, 0xfb, 0x90, 0x31, 0xfd, 0xa9, 0x2f, 0x8e, 0xc2, 0x4e,
-	0x29, 0x6c, 0x8c, 0x9e, 0x16, 0xe2, 0x10, 0x54, 0xf7, 0x4c, 0xbc, 0x0b, 0xa9, 0xef, 0x24, 0x74,
-	0xa0, 0xa5, 0x98, 0xb4, 0xed, 0xa3, 0xc7, 0x50, 0x94, 0x8a, 0x46, 0xe9, 0x7a, 0x43, 0xea, 0x09,
-	0x27, 0x19, 0xb6, 0xf8, 0xec, 0x3a, 0xeb, 0x69, 0x74, 0x57, 0x81, 0xb8, 0x20, 0xe7, 0x0b, 0xf4,
-	0x01, 0x14, 0x66, 0xb4, 0x51, 0x5f, 0xdf, 0x2e, 0x49, 0x0c, 0x91, 0xa8, 0xed, 0x6f, 0xfe, 0x08,
-	0xb7, 0xff, 0xb5, 0x36, 0x91, 0x0d, 0xc9, 0x0b, 0x32, 0xd5, 0x21, 0xe4, 0xb1, 0xfa, 0x44, 0x0f,
-	0x21, 0x3d, 0xf1, 0x86, 0x63, 0xa2, 0xfd, 0x9c, 0xf7, 0xfb, 0x1e, 0x0d, 0x66, 0x7b, 0xb1, 0xd1,
-	0xf8, 0x32, 0xf1, 0xd8, 0xda, 0xdc, 0x83, 0xb5, 0xeb, 0xca, 0xf3, 0x1a, 0xc3, 0x6b, 0x71, 0xc3,
-	0xf9, 0x98, 0x8d, 0x67, 0xa9, 0x5c, 0xd2, 0x4e, 0xd5, 0xfe, 0xb0, 0xa0, 0xbc, 0x48, 0x24, 0xfa,
-	0x04, 0xd6, 0x97, 0xa9, 0x77, 0x07, 0x92, 0xfa, 0xa1, 0x59, 0xb4, 0xc8, 0xf3, 0x53, 0x49, 0x7d,
-	0xf4, 0x05, 0x38, 0x2f, 0x6d, 0x91, 0xf4, 0x92, 0xb0, 0xb1, 0xd4, 0x07, 0x5b, 0x78, 0x7d, 0x71,
-	0x57, 0xcf, 0x80, 0xaa, 0x2c, 0xc3, 0x92, 0x56, 0x53, 0xa1, 0x7f, 0xa1, 0x0f, 0x32, 0x44, 0xe4,
-	0xf0, 0x4a, 0x08, 0xf5, 0x14, 0xa2, 0xce, 0x11, 0xb5, 0xdf, 0x13, 0x50, 0x0e, 0xaf, 0x5e, 0x4c,
-	0x5e, 0x8c, 0x89, 0x90, 0xe8, 0x23, 0xc8, 0xf7, 0xbd, 0xe1, 0x90, 0x70, 0x37, 0x74, 0xb1, 0xb0,
-	0x53, 0xd9, 0x32, 0x03, 0xa8, 0xa1, 0xe5, 0xed, 0x26, 0xce, 0x19, 0x8d, 0xb6, 0x8f, 0x1e, 0x42,
-	0x36, 0xea, 0xa1, 0xc4, 0x4c, 0x37, 0xde, 0x43, 0x38, 0xc2, 0xd1, 0x03, 0x48, 0x6b, 0x16, 0xc2,
-	0xb2, 0x58, 0x89, 0x38, 0x51, 0xb7, 0x95, 0xbe, 0x88, 0xb1, 0xc1, 0xd1, 0x67, 0x10, 0xd6, 0x86,
-	0x2b, 0xa7, 0x23, 0xa2, 0x8b, 0xa1, 0xbc, 0xb3, 0xb6, 0x5c, 0x45, 0xbd, 0xe9, 0x88, 0x60, 0x90,
-	0xb3, 0x6f, 0x55, 0xa4, 0x17, 0x64, 0x2a, 0x46, 0x5e, 0x9f, 0xb8, 0x7a, 0x74, 0xe9, 0x11, 0x93,
-	0xc7, 0xa5, 0x48, 0xaa, 0x2b, 0x3f, 0x3e, 0x82, 0xb2, 0x37, 0x19, 0x41, 0xcf, 0x52, 0xb9, 0xb4,
-	0x9d, 0xa9, 0xfd, 0x62, 0x41, 0x65, 0x96, 0x29, 0x31, 0x62, 0x81, 0x50, 0x27, 0xa6, 0x09, 0xe7,
-	0x8c, 0x2f, 0xa5, 0x09, 0x1f, 0x36, 0x5a, 0x4a, 0x8c, 0x0d, 0xfa, 0x3a, 0x39, 0x7a, 0x04, 0x19,
-	0x4e, 0xc4, 0x78, 0x28, 0xc3, 0x24, 0xa1, 0xf8, 0xa0, 0xc2, 0x1a, 0xc1, 0xa1, 0x46, 0xed, 0xef,
-	0x04, 0xac, 0x86, 0x1e, 0xed, 0x79, 0xb2, 0x7f, 0xfe, 0xce, 0x09, 0xfc, 0x10, 0xb2, 0xca, 0x1b,
-	0x4a, 0x54, 0x41, 0x25, 0xaf, 0xa7, 0x30, 0xd2, 0x78, 0x0b, 0x12, 0x3d, 0xb1, 0xf0, 0xa2, 0x49,
-	0x9b, 0x17, 0x8d, 0x27, 0xe2, 0x2f, 0x9a, 0x77, 0xc4, 0x75, 0xed, 0x57, 0x0b, 0xd6, 0x16, 0x73,
-	0xfa, 0xce, 0xa8, 0xfe, 0x18, 0xb2, 0x86, 0xc8, 0x28, 0x9b, 0x1b, 0xa1, 0x6f, 0x86, 0xe6, 0x13,
-	0x2a, 0xcf, 0x8d, 0xe9, 0x48, 0x4d, 0x35, 0xeb, 0x5a, 0x57, 0x72, 0xe2, 0x5d, 0xbe, 0x55, 0xcb,
-	0xce, 0xfa, 0x30, 0xf1, 0x7a, 0x7d, 0x98, 0x7c, 0xe3, 0x3e, 0x4c, 0xbd, 0x82, 0x9b, 0xf4, 0x8d,
-	0x9e, 0x82, 0xb1, 0xdc, 0x66, 0xfe, 0x3b, 0xb7, 0xb5, 0x06, 0xac, 0x2f, 0x25, 0x2a, 0xa4, 0x71,
-	0xde, 0x5f, 0xd6, 0x2b, 0xfb, 0xeb, 0x27, 0xb8, 0x8d, 0x89, 0x60, 0xc3, 0x09, 0x89, 0x55, 0xde,
-	0x9b, 0xa5, 0x1c, 0x41, 0xca, 0x97, 0xe1, 0xd4, 0xcc, 0x63, 0xfd, 0x5d, 0xbb, 0x03, 0x9b, 0xd7,
-	0x99, 0x37, 0x8e, 0xd6, 0xfe, 0xb2, 0xa0, 0x7c, 0x6c, 0x62, 0x78, 0xb3, 0x23, 0x97, 0xc8, 0x4b,
-	0xdc, 0x90, 0xbc, 0x07, 0x90, 0x9e, 0xe8, 0xe1, 0x14, 0x5d, 0xd2, 0xb1, 0x7f, 0x2a, 0xc7, 0x6a,
-	0x66, 0x60, 0x83, 0xab, 0x4c, 0x9e, 0xd1, 0xa1, 0x24, 0x5c, 0xb3, 0xab, 0x32, 0x19, 0xd3, 0x7c,
-	0xa2, 0x11, 0x1c, 0x6a, 0xd4, 0xbe, 0x86, 0xca, 0x2c, 0x96, 0x39, 0x11, 0x64, 0x42, 0xd4, 0x33,
-	0xce, 0xd2, 0xc5, 0xbf, 0xb0, 0xfd, 0xb8, 0xa5, 0x20, 0x1c, 0x6a, 0x3c, 0x6a, 0x42, 0x65, 0xe9,
-	0x8d, 0x8f, 0x2a, 0x50, 0x38, 0x7a, 0xde, 0x3d, 0x6c, 0x35, 0xda, 0x4f, 0xda, 0xad, 0xa6, 0x7d,
-	0x0b, 0x01, 0x64, 0xba, 0xed, 0xe7, 0x4f, 0xf7, 0x5b, 0xb6, 0x85, 0xf2, 0x90, 0x3e, 0x38, 0xda,
-	0xef, 0xb5, 0xed, 0x84, 0xfa, 0xec, 0x9d, 0x74, 0x0e, 0x1b, 0x76, 0xf2, 0xd1, 0x57, 0x50, 0x68,
-	0xe8, 0x7f, 0x2a, 0x1d, 0xee, 0x13, 0xae, 0x36, 0x3c, 0xef, 0xe0, 0x83, 0xdd, 0x7d, 0xfb, 0x16,
-	0xca, 0x42, 0xf2, 0x10, 0xab, 0x9d, 0x39, 0x48, 0x1d, 0x76, 0xba, 0x3d, 0x3b, 0x81, 0xca, 0x00,
-	0xbb, 0x47, 0xbd, 0x4e, 0xa3, 0x73, 0x70, 0xd0, 0xee, 0xd9, 0xc9, 0xbd, 0xcf, 0xa1, 0x42, 0xd9,
-	0xd6, 0x84, 0x4a, 0x22, 0x84, 0xf9, 0x23, 0xf6, 0xc3, 0xdd, 0x70, 0x45, 0xd9, 0xb6, 0xf9, 0xda,
-	0x1e, 0xb0, 0xed, 0x89, 0xdc, 0xd6, 0xe8, 0xb6, 0x29, 0xcd, 0xd3, 0x8c, 0x5e, 0x7d, 0xfa, 0x4f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x90, 0xad, 0x39, 0x45, 0x08, 0x0e, 0x00, 0x00,
+	// 1452 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x5f, 0x6f, 0x1b, 0xc7,
+	0x11, 0xf7, 0xf1, 0x3f, 0x87, 0xff, 0x4e, 0x2b, 0x4a, 0x3d, 0xab, 0x6e, 0x4b, 0xd0, 0x76, 0x4d,
+	0xab, 0xad, 0xd8, 0xaa, 0x68, 0x6b, 0x14, 0x09, 0x12, 0x89, 0x92, 0x1c, 0x1a, 0x92, 0xa9, 0x2c,
+	0x29, 0x09, 0x08, 0x12, 0x1c, 0x4e, 0xbc, 0x15, 0x75, 0x10, 0x75, 0x4b, 0xef, 0x2e, 0xa9, 0xf0,
+	0x53, 0xe4, 0x2d, 0x0f, 0xc9, 0x07, 0xc8, 0x4b, 0xde, 0xf3, 0x39, 0xf2, 0x65, 0xf2, 0x1c, 0xec,
+	0x9f, 0x23, 0x8f, 0xb4, 0x12, 0xcb, 0x36, 0xfc, 0x42, 0xdc, 0xcc, 0x6f, 0x76, 0x76, 0x76, 0x7e,
+	0x33, 0x3b, 0x4b, 0x28, 0x4e, 0xc4, 0xc0, 0x13, 0x64, 0x6b, 0xc4, 0xa8, 0xa0, 0x28, 0xa3, 0xa5,
+	0x0d, 0xfb, 0x3c, 0x08, 0x87, 0x74, 0xe0, 0x7b, 0xc2, 0xd3, 0xc8, 0x46, 0xe1, 0xd5, 0x98, 0xb0,
+	0xa9, 0x11, 0xca, 0x82, 0x8e, 0x68, 0x1c, 0x9c, 0x08, 0x36, 0xea, 0x6b, 0xa1, 0xfe, 0x7d, 0x01,
+	0xb2, 0x5d, 0xc2, 0x79, 0x40, 0x43, 0xf4, 0x18, 0xca, 0x41, 0xe8, 0x0a, 0xe6, 0x85, 0xdc, 0xeb,
+	0x8b, 0x80, 0x86, 0x8e, 0x55, 0xb3, 0x1a, 0x39, 0x5c, 0x0a, 0xc2, 0xde, 0x5c, 0x89, 0x5a, 0x50,
+	0xe6, 0x97, 0x1e, 0xf3, 0x5d, 0xae, 0xd7, 0x71, 0x27, 0x51, 0x4b, 0x36, 0x0a, 0xdb, 0x0f, 0xb6,
+	0x4c, 0x74, 0xc6, 0xdf, 0x56, 0x57, 0x5a, 0x19, 0x01, 0x97, 0x78, 0x4c, 0xe2, 0xe8, 0xcf, 0x00,
+	0xde, 0x58, 0xd0, 0x3e, 0xbd, 0xbe, 0x0e, 0x84, 0x93, 0x52, 0xfb, 0xc4, 0x34, 0xe8, 0x21, 0x94,
+	0x84, 0xc7, 0x06, 0x44, 0xb8, 0x5c, 0xb0, 0x20, 0x1c, 0x38, 0xe9, 0x9a, 0xd5, 0xc8, 0xe3, 0xa2,
+	0x56, 0x76, 0x95, 0x0e, 0x35, 0x21, 0x4b, 0x47, 0x42, 0x85, 0x90, 0xa9, 0x59, 0x8d, 0xc2, 0xf6,
+	0xda, 0x96, 0x3e, 0xf8, 0xfe, 0xd7, 0xa4, 0x3f, 0x16, 0xa4, 0xa3, 0x41, 0x1c, 0x59, 0xa1, 0x5d,
+	0xb0, 0x63, 0xc7, 0x73, 0xaf, 0xa9, 0x4f, 0x9c, 0x6c, 0xcd, 0x6a, 0x94, 0xb7, 0xff, 0x10, 0x05,
+	0x1f, 0x3b, 0xe9, 0x11, 0xf5, 0x09, 0xae, 0x88, 0x45, 0x05, 0x6a, 0x42, 0xee, 0xc6, 0x63, 0x61,
+	0x10, 0x0e, 0xb8, 0x93, 0x53, 0x07, 0x5f, 0x35, 0xbb, 0x7e, 0x2e, 0x7f, 0xcf, 0x34, 0x86, 0x67,
+	0x46, 0xe8, 0x13, 0x28, 0x8e, 0x18, 0x99, 0x67, 0x2b, 0x7f, 0x87, 0x6c, 0x15, 0x46, 0x8c, 0xcc,
+	0x72, 0xb5, 0x03, 0xa5, 0x11, 0xe5, 0x62, 0xee, 0x01, 0xee, 0xe0, 0xa1, 0x28, 0x97, 0xcc, 0x5c,
+	0x3c, 0x82, 0xf2, 0xd0, 0xe3, 0xc2, 0x0d, 0x42, 0x4e, 0x98, 0x70, 0x03, 0xdf, 0x29, 0xd4, 0xac,
+	0x46, 0x0a, 0x17, 0xa5, 0xb6, 0xad, 0x94, 0x6d, 0x1f, 0xfd, 0x09, 0xe0, 0x82, 0x8e, 0x43, 0xdf,
+	0x65, 0xf4, 0x86, 0x3b, 0x45, 0x65, 0x91, 0x57, 0x1a, 0x4c, 0x6f, 0x38, 0x72, 0x61, 0x7d, 0xcc,
+	0x09, 0x73, 0x7d, 0x72, 0x11, 0x84, 0xc4, 0x77, 0x27, 0x1e, 0x0b, 0xbc, 0xf3, 0x21, 0xe1, 0x4e,
+	0x49, 0x05, 0xf4, 0x74, 0x39, 0xa0, 0x13, 0x4e, 0xd8, 0x9e, 0x36, 0x3e, 0x8d, 0x6c, 0xf7, 0x43,
+	0xc1, 0xa6, 0xb8, 0x3a, 0xbe, 0x05, 0x42, 0x1d, 0xb0, 0xf9, 0x94, 0x0b, 0x72, 0x1d, 0x73, 0x5d,
+	0x56, 0xae, 0x1f, 0xbd, 0x76, 0x56, 0x65, 0xb7, 0xe4, 0xb5, 0xc2, 0x17, 0xb5, 0xe8, 0x8f, 0x90,
+	0x67, 0xf4, 0xc6, 0xed, 0xd3, 0x71, 0x28, 0x9c, 0x4a, 0xcd, 0x6a, 0x24, 0x71, 0x8e, 0xd1, 0x9b,
+	0x96, 0x94, 0x65, 0x09, 0x72, 0x6f, 0x42, 0x46, 0x34, 0x08, 0x05, 0x77, 0xec, 0x5a, 0xb2, 0x91,
+	0xc7, 0x31, 0x0d, 0x6a, 0x80, 0x1d, 0x84, 0x2e, 0x23, 0x9c, 0xb0, 0x09, 0xf1, 0xdd, 0x3e, 0x0d,
+	0x43, 0x67, 0x45, 0x15, 0x6a, 0x39, 0x08, 0xb1, 0x51, 0xb7, 0x68, 0x18, 0x4a, 0x86, 0x87, 0xb4,
+	0x7f, 0x15, 0x11, 0xe4, 0xa0, 0x9a, 0xf5, 0x46, 0x7e, 0x0a, 0x72, 0x85, 0x11, 0xd0, 0x16, 0xac,
+	0x2a, 0x7a, 0x94, 0x97, 0x4b, 0xe2, 0x31, 0x71, 0x4e, 0x3c, 0xe1, 0xac, 0xaa, 0x88, 0x57, 0x24,
+	0x74, 0x48, 0xfb, 0x57, 0x9f, 0x45, 0x00, 0xfa, 0x14, 0x6c, 0x46, 0x3c, 0xdf, 0xf5, 0x2e, 0x04,
+	0x61, 0xee, 0x0d, 0x0b, 0x04, 0x71, 0xaa, 0x6a, 0xd3, 0xf5, 0x68, 0x53, 0x4c, 0x3c, 0x7f, 0x47,
+	0xc2, 0x67, 0x12, 0xc5, 0x65, 0xb6, 0x20, 0xa3, 0x1a, 0x14, 0xf6, 0xf6, 0x0e, 0xbb, 0x82, 0x79,
+	0x82, 0x0c, 0xa6, 0xce, 0x9a, 0xea, 0xae, 0xb8, 0x4a, 0x5a, 0x98, 0xf0, 0x4e, 0x4e, 0xda, 0x7b,
+	0xce, 0xba, 0xb6, 0x88, 0xa9, 0x36, 0x7e, 0xb2, 0xa0, 0x18, 0x3f, 0x13, 0x7a, 0x0c, 0x19, 0xdd,
+	0x9f, 0xea, 0xe2, 0x28, 0x6c, 0x97, 0x4c, 0x63, 0xf4, 0x94, 0x12, 0x1b, 0x50, 0xde, 0x33, 0xf1,
+	0x2e, 0x0c, 0x7c, 0x27, 0xa1, 0x0e, 0x5a, 0x8a, 0x69, 0xdb, 0x3e, 0x7a, 0x06, 0x45, 0x21, 0x69,
+	0x14, 0xae, 0x37, 0x0c, 0x3c, 0xee, 0x24, 0x4d, 0x8b, 0xcf, 0xae, 0xb3, 0x9e, 0x42, 0x77, 0x24,
+	0x88, 0x0b, 0x62, 0x2e, 0xa0, 0xbf, 0x40, 0x61, 0x46, 0x5b, 0xe0, 0xab, 0xdb, 0x25, 0x89, 0x21,
+	0x52, 0xb5, 0xfd, 0x8d, 0x2f, 0xe1, 0xfe, 0x6f, 0xd6, 0x26, 0xb2, 0x21, 0x79, 0x45, 0xa6, 0xea,
+	0x08, 0x79, 0x2c, 0x3f, 0xd1, 0x53, 0x48, 0x4f, 0xbc, 0xe1, 0x98, 0xa8, 0x38, 0xe7, 0xfd, 0xbe,
+	0x1b, 0x84, 0xb3, 0xb5, 0x58, 0x5b, 0xfc, 0x3f, 0xf1, 0xcc, 0xda, 0xd8, 0x85, 0xea, 0x6d, 0xe5,
+	0x79, 0x8b, 0xe3, 0x6a, 0xdc, 0x71, 0x3e, 0xe6, 0xe3, 0x45, 0x2a, 0x97, 0xb4, 0x53, 0xf5, 0x1f,
+	0x2d, 0x28, 0x2f, 0x12, 0x89, 0xfe, 0x05, 0x6b, 0xcb, 0xd4, 0xbb, 0x03, 0x11, 0xf8, 0xc6, 0x2d,
+	0x5a, 0xe4, 0xf9, 0xb9, 0x08, 0x7c, 0xf4, 0x3f, 0x70, 0x5e, 0x5b, 0x22, 0x82, 0x6b, 0x42, 0xc7,
+	0x42, 0x6d, 0x6c, 0xe1, 0xb5, 0xc5, 0x55, 0x3d, 0x0d, 0xca, 0xb2, 0x34, 0x25, 0x2d, 0xa7, 0x42,
+	0xff, 0x4a, 0x6d, 0xa4, 0x89, 0xc8, 0xe1, 0x15, 0x03, 0xf5, 0x24, 0x22, 0xf7, 0xe1, 0xf5, 0x1f,
+	0x12, 0x50, 0x36, 0x57, 0x2f, 0x26, 0xaf, 0xc6, 0x84, 0x0b, 0xf4, 0x77, 0xc8, 0xf7, 0xbd, 0xe1,
+	0x90, 0x30, 0xd7, 0x84, 0x58, 0xd8, 0xae, 0x6c, 0xe9, 0x01, 0xd4, 0x52, 0xfa, 0xf6, 0x1e, 0xce,
+	0x69, 0x8b, 0xb6, 0x8f, 0x9e, 0x42, 0x36, 0xea, 0xa1, 0xc4, 0xcc, 0x36, 0xde, 0x43, 0x38, 0xc2,
+	0xd1, 0x13, 0x48, 0x2b, 0x16, 0x4c, 0x59, 0xac, 0x44, 0x9c, 0xc8, 0xdb, 0x4a, 0x5d, 0xc4, 0x58,
+	0xe3, 0xe8, 0x3f, 0x60, 0x6a, 0xc3, 0x15, 0xd3, 0x11, 0x51, 0xc5, 0x50, 0xde, 0xae, 0x2e, 0x57,
+	0x51, 0x6f, 0x3a, 0x22, 0x18, 0xc4, 0xec, 0x5b, 0x16, 0xe9, 0x15, 0x99, 0xf2, 0x91, 0xd7, 0x27,
+	0xae, 0x1a, 0x5d, 0x6a, 0xc4, 0xe4, 0x71, 0x29, 0xd2, 0xaa, 0xca, 0x8f, 0x8f, 0xa0, 0xec, 0x5d,
+	0x46, 0xd0, 0x8b, 0x54, 0x2e, 0x6d, 0x67, 0xea, 0xdf, 0x58, 0x50, 0x99, 0x65, 0x8a, 0x8f, 0x68,
+	0xc8, 0xe5, 0x8e, 0x69, 0xc2, 0x18, 0x65, 0x4b, 0x69, 0xc2, 0xc7, 0xad, 0x7d, 0xa9, 0xc6, 0x1a,
+	0x7d, 0x9b, 0x1c, 0x6d, 0x42, 0x86, 0x11, 0x3e, 0x1e, 0x0a, 0x93, 0x24, 0x14, 0x1f, 0x54, 0x58,
+	0x21, 0xd8, 0x58, 0xd4, 0x7f, 0x4e, 0xc0, 0xaa, 0x89, 0x68, 0xd7, 0x13, 0xfd, 0xcb, 0x0f, 0x4e,
+	0xe0, 0xdf, 0x20, 0x2b, 0xa3, 0x09, 0x88, 0x2c, 0xa8, 0xe4, 0xed, 0x14, 0x46, 0x16, 0xef, 0x41,
+	0xa2, 0xc7, 0x17, 0x5e, 0x34, 0x69, 0xfd, 0xa2, 0xf1, 0x78, 0xfc, 0x45, 0xf3, 0x81, 0xb8, 0xae,
+	0x7f, 0x67, 0x41, 0x75, 0x31, 0xa7, 0x1f, 0x8c, 0xea, 0x7f, 0x42, 0x56, 0x13, 0x19, 0x65, 0x73,
+	0xdd, 0xc4, 0xa6, 0x69, 0x3e, 0x0b, 0xc4, 0xa5, 0x76, 0x1d, 0x99, 0xc9, 0x66, 0xad, 0x76, 0x05,
+	0x23, 0xde, 0xf5, 0x7b, 0xb5, 0xec, 0xac, 0x0f, 0x13, 0x6f, 0xd7, 0x87, 0xc9, 0x77, 0xee, 0xc3,
+	0xd4, 0x1b, 0xb8, 0x49, 0xdf, 0xe9, 0x29, 0x18, 0xcb, 0x6d, 0xe6, 0xf7, 0x73, 0x5b, 0x6f, 0xc1,
+	0xda, 0x52, 0xa2, 0x0c, 0x8d, 0xf3, 0xfe, 0xb2, 0xde, 0xd8, 0x5f, 0x5f, 0xc1, 0x7d, 0x4c, 0x38,
+	0x1d, 0x4e, 0x48, 0xac, 0xf2, 0xde, 0x2d, 0xe5, 0x08, 0x52, 0xbe, 0x30, 0x53, 0x33, 0x8f, 0xd5,
+	0x77, 0xfd, 0x01, 0x6c, 0xdc, 0xe6, 0x5e, 0x07, 0x5a, 0xff, 0xd6, 0x82, 0xe2, 0xa9, 0x3e, 0xc3,
+	0xc1, 0xd0, 0x1b, 0x70, 0xf4, 0x0f, 0x40, 0xb3, 0x67, 0x86, 0x1b, 0x84, 0x82, 0xb0, 0x89, 0x37,
+	0x54, 0x3b, 0x97, 0xf0, 0xca, 0x0c, 0x69, 0x1b, 0x00, 0xfd, 0x15, 0x2a, 0x5c, 0xd0, 0x91, 0x4b,
+	0xd5, 0x7b, 0x48, 0xb1, 0x90, 0xd0, 0x8d, 0x24, 0xd5, 0x9d, 0x10, 0x6b, 0x25, 0x6a, 0x42, 0x95,
+	0x93, 0xd0, 0x77, 0x2f, 0x02, 0x32, 0xf4, 0xb9, 0x34, 0xe7, 0xc2, 0x63, 0x62, 0x3e, 0x31, 0x42,
+	0xff, 0x40, 0x41, 0x9d, 0xb0, 0x2b, 0x81, 0xfa, 0x2f, 0x16, 0x94, 0x4d, 0x60, 0xef, 0x96, 0x8b,
+	0xa5, 0xaa, 0x4a, 0xdc, 0xb1, 0xaa, 0x9e, 0x40, 0x7a, 0xa2, 0xa6, 0x66, 0x34, 0x3d, 0x62, 0x7f,
+	0xa1, 0x4e, 0xe5, 0x30, 0xc3, 0x1a, 0x97, 0x14, 0x5f, 0x04, 0x43, 0x41, 0x98, 0x93, 0x32, 0x14,
+	0xc7, 0x2c, 0x0f, 0x14, 0x82, 0x8d, 0x05, 0xda, 0x84, 0xf4, 0x85, 0xcc, 0xae, 0xa9, 0xc0, 0x6a,
+	0x54, 0x50, 0xf1, 0xcc, 0x63, 0x6d, 0x52, 0xff, 0x18, 0x2a, 0xb3, 0x73, 0xcf, 0xab, 0x89, 0x4c,
+	0x88, 0x7c, 0x8b, 0x5a, 0xb5, 0xe4, 0xf2, 0x56, 0xa7, 0xfb, 0x12, 0xc2, 0xc6, 0x62, 0x73, 0x0f,
+	0x2a, 0x4b, 0x7f, 0x54, 0x50, 0x05, 0x0a, 0x27, 0x2f, 0xbb, 0xc7, 0xfb, 0xad, 0xf6, 0x41, 0x7b,
+	0x7f, 0xcf, 0xbe, 0x87, 0x00, 0x32, 0xdd, 0xf6, 0xcb, 0xe7, 0x87, 0xfb, 0xb6, 0x85, 0xf2, 0x90,
+	0x3e, 0x3a, 0x39, 0xec, 0xb5, 0xed, 0x84, 0xfc, 0xec, 0x9d, 0x75, 0x8e, 0x5b, 0x76, 0x72, 0xf3,
+	0x23, 0x28, 0xb4, 0xd4, 0xdf, 0xad, 0x0e, 0xf3, 0x09, 0x93, 0x0b, 0x5e, 0x76, 0xf0, 0xd1, 0xce,
+	0xa1, 0x7d, 0x0f, 0x65, 0x21, 0x79, 0x8c, 0xe5, 0xca, 0x1c, 0xa4, 0x8e, 0x3b, 0xdd, 0x9e, 0x9d,
+	0x40, 0x65, 0x80, 0x9d, 0x93, 0x5e, 0xa7, 0xd5, 0x39, 0x3a, 0x6a, 0xf7, 0xec, 0xe4, 0xee, 0x7f,
+	0xa1, 0x12, 0xd0, 0xad, 0x49, 0x20, 0x08, 0xe7, 0xfa, 0xdf, 0xe4, 0x17, 0x0f, 0x8d, 0x14, 0xd0,
+	0xa6, 0xfe, 0x6a, 0x0e, 0x68, 0x73, 0x22, 0x9a, 0x0a, 0x6d, 0xea, 0x74, 0x9c, 0x67, 0x94, 0xf4,
+	0xef, 0x5f, 0x07, 0x00, 0x5f, 0x20, 0x0a, 0x62, 0xcd, 0x0e, 0x00, 0x00,
 }
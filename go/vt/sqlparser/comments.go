@@ -20,6 +20,8 @@ import (
 	"strconv"
 	"strings"
 	"unicode"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 const (
@@ -36,6 +38,8 @@ const (
 	DirectiveIgnoreMaxPayloadSize = "IGNORE_MAX_PAYLOAD_SIZE"
 	// DirectiveIgnoreMaxMemoryRows skips memory row validation when set.
 	DirectiveIgnoreMaxMemoryRows = "IGNORE_MAX_MEMORY_ROWS"
+	// DirectivePriority sets the priority of a query: low or normal.
+	DirectivePriority = "PRIORITY"
 )
 
 func isNonSpace(r rune) bool {
@@ -344,3 +348,34 @@ func IgnoreMaxMaxMemoryRowsDirective(stmt Statement) bool {
 		return false
 	}
 }
+
+// PriorityDirective returns the priority set by the PRIORITY directive.
+// It returns NORMAL if the directive is not set or has an unknown value.
+// The directive of a UNION is the one of its first SELECT.
+func PriorityDirective(stmt Statement) querypb.ExecuteOptions_Priority {
+	var comments Comments
+	switch stmt := stmt.(type) {
+	case *Union:
+		return PriorityDirective(stmt.FirstStatement)
+	case *ParenSelect:
+		return PriorityDirective(stmt.Select)
+	case *Select:
+		comments = stmt.Comments
+	case *Insert:
+		comments = stmt.Comments
+	case *Update:
+		comments = stmt.Comments
+	case *Delete:
+		comments = stmt.Comments
+	default:
+		return querypb.ExecuteOptions_NORMAL
+	}
+	val, ok := ExtractCommentDirectives(comments)[DirectivePriority].(string)
+	if !ok {
+		return querypb.ExecuteOptions_NORMAL
+	}
+	if priority, ok := querypb.ExecuteOptions_Priority_value[strings.ToUpper(val)]; ok {
+		return querypb.ExecuteOptions_Priority(priority)
+	}
+	return querypb.ExecuteOptions_NORMAL
+}
@@ -22,6 +22,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 func TestSplitComments(t *testing.T) {
@@ -440,3 +442,32 @@ func TestIgnoreMaxMaxMemoryRowsDirective(t *testing.T) {
 		})
 	}
 }
+
+func TestPriorityDirective(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected querypb.ExecuteOptions_Priority
+	}{
+		{"select /*vt+ PRIORITY=low */ * from users", querypb.ExecuteOptions_LOW},
+		{"select /*vt+ QUERY_TIMEOUT_MS=1000 PRIORITY=LOW */ * from users", querypb.ExecuteOptions_LOW},
+		{"select /*vt+ PRIORITY=normal */ * from users", querypb.ExecuteOptions_NORMAL},
+		{"select /*vt+ PRIORITY=urgent */ * from users", querypb.ExecuteOptions_NORMAL},
+		{"select /*vt+ PRIORITY=1 */ * from users", querypb.ExecuteOptions_NORMAL},
+		{"select * from users", querypb.ExecuteOptions_NORMAL},
+		{"insert /*vt+ PRIORITY=low */ into user(id) values (1), (2)", querypb.ExecuteOptions_LOW},
+		{"update /*vt+ PRIORITY=low */ users set name=1", querypb.ExecuteOptions_LOW},
+		{"delete /*vt+ PRIORITY=low */ from users", querypb.ExecuteOptions_LOW},
+		{"select /*vt+ PRIORITY=low */ * from users union select * from customers", querypb.ExecuteOptions_LOW},
+		{"(select /*vt+ PRIORITY=low */ * from users) union all (select * from customers)", querypb.ExecuteOptions_LOW},
+		{"select * from users union select /*vt+ PRIORITY=low */ * from customers", querypb.ExecuteOptions_NORMAL},
+		{"show /*vt+ PRIORITY=low */ create table users", querypb.ExecuteOptions_NORMAL},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.query, func(t *testing.T) {
+			stmt, _ := Parse(test.query)
+			got := PriorityDirective(stmt)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
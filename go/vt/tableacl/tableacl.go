@@ -30,21 +30,92 @@ import (
 
 	"vitess.io/vitess/go/json2"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/tableacl/acl"
 
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	tableaclpb "vitess.io/vitess/go/vt/proto/tableacl"
 )
 
 // ACLResult embeds an acl.ACL and also tell which table group it belongs to.
+// It also contains the column and row restrictions of the table group.
 type ACLResult struct {
 	acl.ACL
-	GroupName string
+	GroupName  string
+	ColumnACLs []*ColumnACL
+	RowFilters []*RowFilter
+}
+
+// ColumnACL embeds the acl.ACL of the principals who can read the columns.
+type ColumnACL struct {
+	acl.ACL
+	// Columns are lower case.
+	Columns []string
+}
+
+// RowFilter embeds the acl.ACL of the principals whose queries are
+// restricted to the rows matching the predicate.
+type RowFilter struct {
+	acl.ACL
+	Predicate string
+}
+
+// Expr returns a newly parsed copy of the predicate, which the
+// caller can modify.
+func (rf *RowFilter) Expr() (sqlparser.Expr, error) {
+	stmt, err := sqlparser.Parse("select 1 from dual where " + rf.Predicate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid row filter predicate %q: %v", rf.Predicate, err)
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || sel.Where == nil || sel.Limit != nil || sel.OrderBy != nil || sel.GroupBy != nil || sel.Having != nil || sel.Lock != sqlparser.NoLock {
+		return nil, fmt.Errorf("invalid row filter predicate %q", rf.Predicate)
+	}
+	return sel.Where.Expr, nil
+}
+
+// DeniedColumns returns the columns that the principal is not allowed
+// to read. The "*" column stands for all the columns of the table.
+func (r *ACLResult) DeniedColumns(principal *querypb.VTGateCallerID, columns []string) []string {
+	var denied []string
+	for _, column := range columns {
+		for _, columnACL := range r.ColumnACLs {
+			if columnACL.IsMember(principal) {
+				continue
+			}
+			if column == "*" {
+				denied = append(denied, columnACL.Columns...)
+				continue
+			}
+			for _, restricted := range columnACL.Columns {
+				if column == restricted {
+					denied = append(denied, column)
+					break
+				}
+			}
+		}
+	}
+	return denied
+}
+
+// ApplicableRowFilters returns the row filters that restrict the rows
+// the principal can access.
+func (r *ACLResult) ApplicableRowFilters(principal *querypb.VTGateCallerID) []*RowFilter {
+	var rowFilters []*RowFilter
+	for _, rowFilter := range r.RowFilters {
+		if rowFilter.IsMember(principal) {
+			rowFilters = append(rowFilters, rowFilter)
+		}
+	}
+	return rowFilters
 }
 
 type aclEntry struct {
 	tableNameOrPrefix string
 	groupName         string
 	acl               map[Role]acl.ACL
+	columnACLs        []*ColumnACL
+	rowFilters        []*RowFilter
 }
 
 type aclEntries []aclEntry
@@ -94,7 +165,19 @@ var currentTableACL tableACL
 //       "table_names_or_prefixes": ["name1"],
 //       "readers": ["client1"],
 //       "writers": ["client1"],
-//       "admins": ["client1"]
+//       "admins": ["client1"],
+//       "column_acls": [
+//         {
+//           "columns": ["column1"],
+//           "readers": ["client2"]
+//         }
+//       ],
+//       "row_filters": [
+//         {
+//           "principals": ["client3"],
+//           "predicate": "column2 = 'value'"
+//         }
+//       ]
 //     }
 //   ]
 // }
@@ -154,6 +237,27 @@ func load(config *tableaclpb.Config, newACL func([]string) (acl.ACL, error)) (en
 		if err != nil {
 			return nil, err
 		}
+		var columnACLs []*ColumnACL
+		for _, columnACL := range group.ColumnAcls {
+			// The admins of the group can read all the columns.
+			readers, err := newACL(append(append([]string(nil), columnACL.Readers...), group.Admins...))
+			if err != nil {
+				return nil, err
+			}
+			columns := make([]string, 0, len(columnACL.Columns))
+			for _, column := range columnACL.Columns {
+				columns = append(columns, strings.ToLower(column))
+			}
+			columnACLs = append(columnACLs, &ColumnACL{ACL: readers, Columns: columns})
+		}
+		var rowFilters []*RowFilter
+		for _, rowFilter := range group.RowFilters {
+			principals, err := newACL(rowFilter.Principals)
+			if err != nil {
+				return nil, err
+			}
+			rowFilters = append(rowFilters, &RowFilter{ACL: principals, Predicate: rowFilter.Predicate})
+		}
 		for _, tableNameOrPrefix := range group.TableNamesOrPrefixes {
 			entries = append(entries, aclEntry{
 				tableNameOrPrefix: tableNameOrPrefix,
@@ -163,6 +267,8 @@ func load(config *tableaclpb.Config, newACL func([]string) (acl.ACL, error)) (en
 					WRITER: writers,
 					ADMIN:  admins,
 				},
+				columnACLs: columnACLs,
+				rowFilters: rowFilters,
 			})
 		}
 	}
@@ -231,6 +337,21 @@ func ValidateProto(config *tableaclpb.Config) (err error) {
 			}
 			t.Insert(prefix, name)
 		}
+		for _, columnACL := range group.ColumnAcls {
+			if len(columnACL.Columns) == 0 {
+				return fmt.Errorf("column acl of table group %q has no columns", group.Name)
+			}
+			for _, column := range columnACL.Columns {
+				if column == "" || column == "*" {
+					return fmt.Errorf("invalid column %q in column acl of table group %q", column, group.Name)
+				}
+			}
+		}
+		for _, rowFilter := range group.RowFilters {
+			if _, err := (&RowFilter{Predicate: rowFilter.Predicate}).Expr(); err != nil {
+				return fmt.Errorf("table group %q: %v", group.Name, err)
+			}
+		}
 	}
 	return nil
 }
@@ -252,8 +373,10 @@ func (tacl *tableACL) Authorized(table string, role Role) *ACLResult {
 			acl, ok := tacl.entries[mid].acl[role]
 			if ok {
 				return &ACLResult{
-					ACL:       acl,
-					GroupName: tacl.entries[mid].groupName,
+					ACL:        acl,
+					GroupName:  tacl.entries[mid].groupName,
+					ColumnACLs: tacl.entries[mid].columnACLs,
+					RowFilters: tacl.entries[mid].rowFilters,
 				}
 			}
 			break
@@ -25,7 +25,10 @@ import (
 	"testing"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/tableacl/acl"
 	"vitess.io/vitess/go/vt/tableacl/simpleacl"
 
@@ -139,6 +142,48 @@ func TestTableACLValidateConfig(t *testing.T) {
 	}
 }
 
+func TestTableACLValidateRestrictions(t *testing.T) {
+	tests := []struct {
+		columnACL *tableaclpb.ColumnACL
+		rowFilter *tableaclpb.RowFilter
+		err       string
+	}{{
+		columnACL: &tableaclpb.ColumnACL{Columns: []string{"ssn"}},
+		rowFilter: &tableaclpb.RowFilter{Predicate: "region = 'eu'"},
+	}, {
+		columnACL: &tableaclpb.ColumnACL{},
+		err:       `column acl of table group "g" has no columns`,
+	}, {
+		columnACL: &tableaclpb.ColumnACL{Columns: []string{"*"}},
+		err:       `invalid column "*" in column acl of table group "g"`,
+	}, {
+		rowFilter: &tableaclpb.RowFilter{Predicate: "region = 'eu' order by id"},
+		err:       `table group "g": invalid row filter predicate "region = 'eu' order by id"`,
+	}, {
+		rowFilter: &tableaclpb.RowFilter{Predicate: "region ="},
+		err:       `table group "g": invalid row filter predicate "region ="`,
+	}}
+	for _, test := range tests {
+		group := &tableaclpb.TableGroupSpec{
+			Name:                 "g",
+			TableNamesOrPrefixes: []string{"t"},
+		}
+		if test.columnACL != nil {
+			group.ColumnAcls = []*tableaclpb.ColumnACL{test.columnACL}
+		}
+		if test.rowFilter != nil {
+			group.RowFilters = []*tableaclpb.RowFilter{test.rowFilter}
+		}
+		err := ValidateProto(&tableaclpb.Config{TableGroups: []*tableaclpb.TableGroupSpec{group}})
+		if test.err == "" {
+			assert.NoError(t, err)
+			continue
+		}
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), test.err)
+	}
+}
+
 func TestTableACLAuthorize(t *testing.T) {
 	tacl := tableACL{factory: &simpleacl.Factory{}}
 	config := &tableaclpb.Config{
@@ -186,6 +231,51 @@ func TestTableACLAuthorize(t *testing.T) {
 	}
 }
 
+func TestTableACLColumnsAndRowFilters(t *testing.T) {
+	tacl := tableACL{factory: &simpleacl.Factory{}}
+	config := &tableaclpb.Config{
+		TableGroups: []*tableaclpb.TableGroupSpec{{
+			Name:                 "pii",
+			TableNamesOrPrefixes: []string{"test_user"},
+			Readers:              []string{"u1", "u2", "u3"},
+			Admins:               []string{"u4"},
+			ColumnAcls: []*tableaclpb.ColumnACL{{
+				Columns: []string{"SSN", "email"},
+				Readers: []string{"u1"},
+			}},
+			RowFilters: []*tableaclpb.RowFilter{{
+				Principals: []string{"u2"},
+				Predicate:  "region = 'eu' or region is null",
+			}},
+		}},
+	}
+	require.NoError(t, tacl.Set(config))
+
+	readerACL := tacl.Authorized("test_user", READER)
+	u1 := &querypb.VTGateCallerID{Username: "u1"}
+	u2 := &querypb.VTGateCallerID{Username: "u2"}
+	u4 := &querypb.VTGateCallerID{Username: "u4"}
+	assert.Empty(t, readerACL.DeniedColumns(u1, []string{"ssn", "*"}))
+	assert.Empty(t, readerACL.DeniedColumns(u4, []string{"ssn", "*"}))
+	assert.Empty(t, readerACL.DeniedColumns(u2, []string{"name", "region"}))
+	assert.Equal(t, []string{"ssn"}, readerACL.DeniedColumns(u2, []string{"name", "ssn"}))
+	assert.Equal(t, []string{"ssn", "email"}, readerACL.DeniedColumns(u2, []string{"*"}))
+
+	assert.Empty(t, readerACL.ApplicableRowFilters(u1))
+	rowFilters := readerACL.ApplicableRowFilters(u2)
+	require.Len(t, rowFilters, 1)
+	expr, err := rowFilters[0].Expr()
+	require.NoError(t, err)
+	assert.Equal(t, "region = 'eu' or region is null", sqlparser.String(expr))
+
+	// The restrictions apply to all the roles.
+	writerACL := tacl.Authorized("test_user", WRITER)
+	assert.Len(t, writerACL.RowFilters, 1)
+
+	unknownACL := tacl.Authorized("unknown_table", READER)
+	assert.Empty(t, unknownACL.DeniedColumns(u2, []string{"*"}))
+}
+
 func TestFailedToCreateACL(t *testing.T) {
 	tacl := tableACL{factory: &fakeACLFactory{}}
 	config := &tableaclpb.Config{
@@ -294,6 +294,10 @@ func (ts *Server) DeleteKeyspace(ctx context.Context, keyspace string) error {
 		return err
 	}
 
+	if err := ts.DeleteThrottlerConfig(ctx, keyspace); err != nil && !IsErrType(err, NoNode) {
+		return err
+	}
+
 	event.Dispatch(&events.KeyspaceChange{
 		KeyspaceName: keyspace,
 		Keyspace:     nil,
@@ -0,0 +1,64 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// This file contains the storage of the query rules managed by vtctld.
+// The rules of the tablets of a keyspace, shard and tablet type are
+// stored as a JSON list in one file of the global cell. The tablets
+// watch their file with the topocustomrule plugin.
+
+// QueryRulesFile returns the path of the query rules of the tablets of a
+// keyspace, shard and tablet type, in the global cell.
+func QueryRulesFile(keyspace, shard string, tabletType topodatapb.TabletType) string {
+	return path.Join(QueryRulesPath, keyspace, shard, strings.ToLower(tabletType.String()))
+}
+
+// GetQueryRules returns the JSON of the query rules of a keyspace, shard
+// and tablet type, and its version. It returns a nil version if there are
+// no rules.
+func (ts *Server) GetQueryRules(ctx context.Context, keyspace, shard string, tabletType topodatapb.TabletType) ([]byte, Version, error) {
+	data, version, err := ts.globalCell.Get(ctx, QueryRulesFile(keyspace, shard, tabletType))
+	if err != nil {
+		if IsErrType(err, NoNode) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	return data, version, nil
+}
+
+// UpdateQueryRules saves the JSON of the query rules of a keyspace, shard
+// and tablet type, if the rules weren't changed since version was read.
+// A nil version means the rules must not exist yet. It returns a
+// BadVersion or NodeExists error if the rules were changed concurrently.
+func (ts *Server) UpdateQueryRules(ctx context.Context, keyspace, shard string, tabletType topodatapb.TabletType, data []byte, version Version) error {
+	filePath := QueryRulesFile(keyspace, shard, tabletType)
+	if version == nil {
+		_, err := ts.globalCell.Create(ctx, filePath, data)
+		return err
+	}
+	_, err := ts.globalCell.Update(ctx, filePath, data, version)
+	return err
+}
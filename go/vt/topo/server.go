@@ -76,6 +76,7 @@ const (
 	SrvVSchemaFile       = "SrvVSchema"
 	SrvKeyspaceFile      = "SrvKeyspace"
 	RoutingRulesFile     = "RoutingRules"
+	ThrottlerConfigFile  = "ThrottlerConfig"
 )
 
 // Path for all object types.
@@ -86,6 +87,7 @@ const (
 	ShardsPath       = "shards"
 	TabletsPath      = "tablets"
 	MetadataPath     = "metadata"
+	QueryRulesPath   = "query_rules"
 )
 
 // Factory is a factory interface to create Conn objects.
@@ -0,0 +1,157 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"path"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// This file contains the utility methods to manage the ThrottlerConfig
+// of a keyspace. It is stored next to the Keyspace in the global cell,
+// and watched by the tablet throttlers of the keyspace.
+
+// WatchThrottlerConfigData is returned / streamed by WatchThrottlerConfig.
+// The WatchThrottlerConfig API guarantees exactly one of Value or Err will be set.
+type WatchThrottlerConfigData struct {
+	Value *topodatapb.ThrottlerConfig
+	Err   error
+}
+
+func throttlerConfigFilePath(keyspace string) string {
+	return path.Join(KeyspacesPath, keyspace, ThrottlerConfigFile)
+}
+
+// GetThrottlerConfig returns the ThrottlerConfig of a keyspace. It returns a
+// NoNode error if the keyspace has no ThrottlerConfig.
+func (ts *Server) GetThrottlerConfig(ctx context.Context, keyspace string) (*topodatapb.ThrottlerConfig, error) {
+	config, _, err := ts.getThrottlerConfig(ctx, keyspace)
+	return config, err
+}
+
+func (ts *Server) getThrottlerConfig(ctx context.Context, keyspace string) (*topodatapb.ThrottlerConfig, Version, error) {
+	data, version, err := ts.globalCell.Get(ctx, throttlerConfigFilePath(keyspace))
+	if err != nil {
+		return nil, nil, err
+	}
+	config := &topodatapb.ThrottlerConfig{}
+	if err := proto.Unmarshal(data, config); err != nil {
+		return nil, nil, vterrors.Wrap(err, "bad ThrottlerConfig data")
+	}
+	return config, version, nil
+}
+
+// UpdateThrottlerConfigFields is a high level helper to read a
+// ThrottlerConfig object, update its fields, and then write it back.
+// If the keyspace has no ThrottlerConfig yet, update is called with
+// newConfig, and the result is created. If the write fails due to a
+// version mismatch, it will re-read the record and retry the update.
+// If the update succeeds, it returns the updated ThrottlerConfig.
+// If the update method returns ErrNoUpdateNeeded, nothing is written,
+// and nil,nil is returned.
+func (ts *Server) UpdateThrottlerConfigFields(ctx context.Context, keyspace string, newConfig func() *topodatapb.ThrottlerConfig, update func(*topodatapb.ThrottlerConfig) error) (*topodatapb.ThrottlerConfig, error) {
+	filePath := throttlerConfigFilePath(keyspace)
+	for {
+		config, version, err := ts.getThrottlerConfig(ctx, keyspace)
+		switch {
+		case IsErrType(err, NoNode):
+			config = newConfig()
+		case err != nil:
+			return nil, err
+		}
+		if err = update(config); err != nil {
+			if IsErrType(err, NoUpdateNeeded) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		data, err := proto.Marshal(config)
+		if err != nil {
+			return nil, err
+		}
+		if version == nil {
+			_, err = ts.globalCell.Create(ctx, filePath, data)
+		} else {
+			_, err = ts.globalCell.Update(ctx, filePath, data, version)
+		}
+		if !IsErrType(err, BadVersion) && !IsErrType(err, NodeExists) {
+			return config, err
+		}
+	}
+}
+
+// DeleteThrottlerConfig deletes the ThrottlerConfig of a keyspace.
+func (ts *Server) DeleteThrottlerConfig(ctx context.Context, keyspace string) error {
+	return ts.globalCell.Delete(ctx, throttlerConfigFilePath(keyspace), nil)
+}
+
+// WatchThrottlerConfig will set a watch on the ThrottlerConfig of a keyspace.
+// It has the same contract as Conn.Watch, but it also unpacks the
+// contents into a ThrottlerConfig object.
+func (ts *Server) WatchThrottlerConfig(ctx context.Context, keyspace string) (*WatchThrottlerConfigData, <-chan *WatchThrottlerConfigData, CancelFunc) {
+	current, wdChannel, cancel := ts.globalCell.Watch(ctx, throttlerConfigFilePath(keyspace))
+	if current.Err != nil {
+		return &WatchThrottlerConfigData{Err: current.Err}, nil, nil
+	}
+	value := &topodatapb.ThrottlerConfig{}
+	if err := proto.Unmarshal(current.Contents, value); err != nil {
+		// Cancel the watch, drain channel.
+		cancel()
+		for range wdChannel {
+		}
+		return &WatchThrottlerConfigData{Err: vterrors.Wrapf(err, "error unpacking initial ThrottlerConfig object")}, nil, nil
+	}
+
+	changes := make(chan *WatchThrottlerConfigData, 10)
+
+	// The background routine reads any event from the watch channel,
+	// translates it, and sends it to the caller.
+	// If cancel() is called, the underlying Watch() code will
+	// send an ErrInterrupted and then close the channel. We'll
+	// just propagate that back to our caller.
+	go func() {
+		defer close(changes)
+
+		for wd := range wdChannel {
+			if wd.Err != nil {
+				// Last error value, we're done.
+				// wdChannel will be closed right after
+				// this, no need to do anything.
+				changes <- &WatchThrottlerConfigData{Err: wd.Err}
+				return
+			}
+
+			value := &topodatapb.ThrottlerConfig{}
+			if err := proto.Unmarshal(wd.Contents, value); err != nil {
+				cancel()
+				for range wdChannel {
+				}
+				changes <- &WatchThrottlerConfigData{Err: vterrors.Wrapf(err, "error unpacking ThrottlerConfig object")}
+				return
+			}
+			changes <- &WatchThrottlerConfigData{Value: value}
+		}
+	}()
+
+	return &WatchThrottlerConfigData{Value: value}, changes, cancel
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestThrottlerConfig(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}))
+
+	_, err := ts.GetThrottlerConfig(ctx, "ks")
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "%v", err)
+	current, _, _ := ts.WatchThrottlerConfig(ctx, "ks")
+	assert.True(t, topo.IsErrType(current.Err, topo.NoNode), "%v", current.Err)
+
+	newConfig := func() *topodatapb.ThrottlerConfig {
+		return &topodatapb.ThrottlerConfig{Enabled: true}
+	}
+	config, err := ts.UpdateThrottlerConfigFields(ctx, "ks", newConfig, func(config *topodatapb.ThrottlerConfig) error {
+		config.Threshold = 2.5
+		return nil
+	})
+	require.NoError(t, err)
+	want := &topodatapb.ThrottlerConfig{Enabled: true, Threshold: 2.5}
+	assert.True(t, proto.Equal(want, config), "got %v", config)
+
+	current, changes, cancel := ts.WatchThrottlerConfig(ctx, "ks")
+	require.NoError(t, current.Err)
+	assert.True(t, proto.Equal(want, current.Value), "got %v", current.Value)
+
+	config, err = ts.UpdateThrottlerConfigFields(ctx, "ks", newConfig, func(config *topodatapb.ThrottlerConfig) error {
+		config.Enabled = false
+		return nil
+	})
+	require.NoError(t, err)
+	want = &topodatapb.ThrottlerConfig{Enabled: false, Threshold: 2.5}
+	assert.True(t, proto.Equal(want, config), "got %v", config)
+
+	select {
+	case wd := <-changes:
+		require.NoError(t, wd.Err)
+		assert.True(t, proto.Equal(want, wd.Value), "got %v", wd.Value)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for ThrottlerConfig change")
+	}
+
+	// no update needed: nothing is written
+	config, err = ts.UpdateThrottlerConfigFields(ctx, "ks", newConfig, func(config *topodatapb.ThrottlerConfig) error {
+		return topo.NewError(topo.NoUpdateNeeded, "ks")
+	})
+	require.NoError(t, err)
+	assert.Nil(t, config)
+
+	cancel()
+	for wd := range changes {
+		assert.True(t, topo.IsErrType(wd.Err, topo.Interrupted), "%v", wd.Err)
+	}
+
+	// deleting the keyspace deletes its ThrottlerConfig
+	require.NoError(t, ts.DeleteKeyspace(ctx, "ks"))
+	_, err = ts.GetThrottlerConfig(ctx, "ks")
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "%v", err)
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtcdc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+const createCheckpointTable = `create table if not exists %s (
+  name varbinary(255) not null,
+  vgtid longblob not null,
+  time_updated bigint not null,
+  primary key (name)
+) engine=InnoDB`
+
+// Executor executes queries through vtgate. It's implemented by
+// vtgateconn.VTGateSession.
+type Executor interface {
+	Execute(ctx context.Context, query string, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error)
+}
+
+// Checkpointer saves the VGTID of a stream in a Vitess table, so that
+// the stream resumes from there after a restart. The table should be
+// in an unsharded keyspace.
+type Checkpointer struct {
+	exec      Executor
+	keyspace  string
+	tableName string
+	// table is the qualified name of the table, for the queries.
+	table string
+	name  string
+}
+
+// NewCheckpointer creates a Checkpointer that saves the VGTID of the
+// stream called name in keyspace.table.
+func NewCheckpointer(exec Executor, keyspace, table, name string) *Checkpointer {
+	return &Checkpointer{
+		exec:      exec,
+		keyspace:  keyspace,
+		tableName: table,
+		table: sqlparser.String(sqlparser.TableName{
+			Qualifier: sqlparser.NewTableIdent(keyspace),
+			Name:      sqlparser.NewTableIdent(table),
+		}),
+		name: name,
+	}
+}
+
+// Init creates the checkpoint table if it doesn't exist.
+func (cp *Checkpointer) Init(ctx context.Context) error {
+	_, err := cp.exec.Execute(ctx, fmt.Sprintf(createCheckpointTable, cp.table), nil)
+	return err
+}
+
+// Load returns the saved VGTID, or nil if the stream has no checkpoint.
+func (cp *Checkpointer) Load(ctx context.Context) (*binlogdatapb.VGtid, error) {
+	qr, err := cp.exec.Execute(ctx, fmt.Sprintf("select vgtid from %s where name = :name", cp.table), map[string]*querypb.BindVariable{
+		"name": sqltypes.StringBindVariable(cp.name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, nil
+	}
+	vgtid := &binlogdatapb.VGtid{}
+	if err := proto.Unmarshal(qr.Rows[0][0].ToBytes(), vgtid); err != nil {
+		return nil, fmt.Errorf("invalid checkpoint of stream %s: %v", cp.name, err)
+	}
+	return vgtid, nil
+}
+
+// Save saves vgtid as the checkpoint of the stream.
+func (cp *Checkpointer) Save(ctx context.Context, vgtid *binlogdatapb.VGtid) error {
+	value, err := proto.Marshal(vgtid)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf("insert into %s(name, vgtid, time_updated) values (:name, :vgtid, :time_updated) on duplicate key update vgtid = values(vgtid), time_updated = values(time_updated)", cp.table)
+	_, err = cp.exec.Execute(ctx, query, map[string]*querypb.BindVariable{
+		"name":         sqltypes.StringBindVariable(cp.name),
+		"vgtid":        sqltypes.BytesBindVariable(value),
+		"time_updated": sqltypes.Int64BindVariable(time.Now().Unix()),
+	})
+	return err
+}
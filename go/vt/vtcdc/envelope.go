@@ -0,0 +1,220 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtcdc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// The operations of a change, as found in the envelopes.
+const (
+	OpCreate = "c"
+	OpUpdate = "u"
+	OpDelete = "d"
+)
+
+// The supported envelopes.
+const (
+	// EnvelopeJSON encodes the changes as JSON objects.
+	EnvelopeJSON = "json"
+	// EnvelopeSchema wraps the JSON object of a change with an
+	// Avro-like record schema that describes the columns of the table.
+	EnvelopeSchema = "json_schema"
+)
+
+// change is a row change of a table.
+type change struct {
+	op        string
+	keyspace  string
+	table     string
+	timestamp int64
+	fields    []*querypb.Field
+	before    []sqltypes.Value
+	after     []sqltypes.Value
+}
+
+// encodeFunc encodes the value of the message of a change. vgtid is
+// the position of the transaction of the change.
+type encodeFunc func(ch *change, vgtid *binlogdatapb.VGtid) ([]byte, error)
+
+func envelopeEncoder(envelope string) (encodeFunc, error) {
+	switch envelope {
+	case "", EnvelopeJSON:
+		return encodeJSON, nil
+	case EnvelopeSchema:
+		return encodeSchema, nil
+	}
+	return nil, fmt.Errorf("unsupported envelope: %s", envelope)
+}
+
+// payload is the JSON object of a change.
+type payload struct {
+	Op        string      `json:"op"`
+	Keyspace  string      `json:"keyspace"`
+	Table     string      `json:"table"`
+	Timestamp int64       `json:"ts"`
+	Before    *jsonRow    `json:"before"`
+	After     *jsonRow    `json:"after"`
+	Position  []*position `json:"position"`
+}
+
+// position is the position of a shard, as found in the VGTID.
+// Consumers can use it to discard the changes that are produced again
+// after a restart.
+type position struct {
+	Keyspace string `json:"keyspace"`
+	Shard    string `json:"shard"`
+	Gtid     string `json:"gtid"`
+}
+
+func newPayload(ch *change, vgtid *binlogdatapb.VGtid) *payload {
+	p := &payload{
+		Op:        ch.op,
+		Keyspace:  ch.keyspace,
+		Table:     ch.table,
+		Timestamp: ch.timestamp,
+		Before:    newJSONRow(ch.fields, ch.before),
+		After:     newJSONRow(ch.fields, ch.after),
+	}
+	for _, sgtid := range vgtid.GetShardGtids() {
+		p.Position = append(p.Position, &position{
+			Keyspace: sgtid.Keyspace,
+			Shard:    sgtid.Shard,
+			Gtid:     sgtid.Gtid,
+		})
+	}
+	return p
+}
+
+func encodeJSON(ch *change, vgtid *binlogdatapb.VGtid) ([]byte, error) {
+	return json.Marshal(newPayload(ch, vgtid))
+}
+
+// recordSchema is the Avro-like schema of the rows of a table.
+type recordSchema struct {
+	Type      string         `json:"type"`
+	Name      string         `json:"name"`
+	Namespace string         `json:"namespace"`
+	Fields    []*fieldSchema `json:"fields"`
+}
+
+type fieldSchema struct {
+	Name string   `json:"name"`
+	Type []string `json:"type"`
+}
+
+func encodeSchema(ch *change, vgtid *binlogdatapb.VGtid) ([]byte, error) {
+	schema := &recordSchema{
+		Type:      "record",
+		Name:      ch.table,
+		Namespace: ch.keyspace,
+	}
+	for _, field := range ch.fields {
+		schema.Fields = append(schema.Fields, &fieldSchema{
+			Name: field.Name,
+			Type: []string{"null", schemaType(field.Type)},
+		})
+	}
+	return json.Marshal(&struct {
+		Schema  *recordSchema `json:"schema"`
+		Payload *payload      `json:"payload"`
+	}{
+		Schema:  schema,
+		Payload: newPayload(ch, vgtid),
+	})
+}
+
+// schemaType returns the Avro type of a column of type typ.
+func schemaType(typ querypb.Type) string {
+	switch {
+	case typ == sqltypes.Int8, typ == sqltypes.Uint8, typ == sqltypes.Int16, typ == sqltypes.Uint16,
+		typ == sqltypes.Int24, typ == sqltypes.Uint24, typ == sqltypes.Int32, typ == sqltypes.Year:
+		return "int"
+	case typ == sqltypes.Uint32, typ == sqltypes.Int64:
+		return "long"
+	case typ == sqltypes.Float32:
+		return "float"
+	case typ == sqltypes.Float64:
+		return "double"
+	case typ == sqltypes.Bit, sqltypes.IsBinary(typ):
+		return "bytes"
+	}
+	// Uint64 and Decimal can't be represented by the numeric types.
+	return "string"
+}
+
+// jsonRow encodes the values of a row as a JSON object, in the order
+// of the columns.
+type jsonRow struct {
+	fields []*querypb.Field
+	values []sqltypes.Value
+}
+
+func newJSONRow(fields []*querypb.Field, values []sqltypes.Value) *jsonRow {
+	if values == nil {
+		return nil
+	}
+	return &jsonRow{
+		fields: fields,
+		values: values,
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r *jsonRow) MarshalJSON() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	buf.WriteByte('{')
+	for i, field := range r.fields {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(field.Name)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		value, err := jsonValue(field.Type, r.values[i])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func jsonValue(typ querypb.Type, v sqltypes.Value) ([]byte, error) {
+	switch {
+	case v.IsNull():
+		return []byte("null"), nil
+	case schemaType(typ) == "bytes":
+		// Encoded as base64.
+		return json.Marshal(v.ToBytes())
+	case schemaType(typ) == "string":
+		return json.Marshal(v.ToString())
+	}
+	// Integral and float values are valid JSON numbers.
+	return v.ToBytes(), nil
+}
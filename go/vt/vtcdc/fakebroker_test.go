@@ -0,0 +1,103 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtcdc
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+func init() {
+	RegisterProducerFactory("fake", func(brokers []string) (Producer, error) {
+		return NewFakeBroker(), nil
+	})
+}
+
+// FakeBroker is an in-process broker that keeps the messages of every
+// topic in memory. It's used by the tests.
+type FakeBroker struct {
+	mu     sync.Mutex
+	topics map[string][]*Message
+	err    error
+	closed bool
+}
+
+// NewFakeBroker creates a FakeBroker without topics.
+func NewFakeBroker() *FakeBroker {
+	return &FakeBroker{
+		topics: make(map[string][]*Message),
+	}
+}
+
+// Produce is part of the Producer interface. Topics are created on
+// their first message.
+func (fb *FakeBroker) Produce(ctx context.Context, messages []*Message) error {
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+	if fb.err != nil {
+		err := fb.err
+		fb.err = nil
+		return err
+	}
+	for _, msg := range messages {
+		fb.topics[msg.Topic] = append(fb.topics[msg.Topic], msg)
+	}
+	return nil
+}
+
+// Close is part of the Producer interface.
+func (fb *FakeBroker) Close() error {
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+	fb.closed = true
+	return nil
+}
+
+// SetError makes the next call to Produce fail with err, without
+// accepting any message.
+func (fb *FakeBroker) SetError(err error) {
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+	fb.err = err
+}
+
+// Topics returns the sorted names of the topics.
+func (fb *FakeBroker) Topics() []string {
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+	var topics []string
+	for topic := range fb.topics {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+	return topics
+}
+
+// Messages returns the messages of a topic in the order they were produced.
+func (fb *FakeBroker) Messages(topic string) []*Message {
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+	return append([]*Message(nil), fb.topics[topic]...)
+}
+
+// Closed returns true if Close was called.
+func (fb *FakeBroker) Closed() bool {
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+	return fb.closed
+}
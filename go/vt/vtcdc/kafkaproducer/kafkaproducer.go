@@ -0,0 +1,127 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kafkaproducer registers the "kafka" implementation of
+// vtcdc.Producer, which writes the messages to Kafka brokers.
+package kafkaproducer
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"sync"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+
+	"vitess.io/vitess/go/vt/vtcdc"
+)
+
+var (
+	writeTimeout = flag.Duration("kafka_write_timeout", 10*time.Second, "timeout of the writes to the kafka brokers")
+	maxAttempts  = flag.Int("kafka_max_attempts", 10, "maximum number of attempts of a write to the kafka brokers")
+)
+
+func init() {
+	vtcdc.RegisterProducerFactory("kafka", func(brokers []string) (vtcdc.Producer, error) {
+		return newProducer(brokers)
+	})
+}
+
+// producer writes the messages of every topic with its own kafka.Writer.
+// The messages that have the same key are sent to the same partition.
+type producer struct {
+	brokers []string
+
+	mu      sync.Mutex
+	writers map[string]*kafka.Writer
+}
+
+func newProducer(brokers []string) (*producer, error) {
+	var addrs []string
+	for _, broker := range brokers {
+		if broker != "" {
+			addrs = append(addrs, broker)
+		}
+	}
+	if len(addrs) == 0 {
+		return nil, errors.New("no kafka broker specified")
+	}
+	return &producer{
+		brokers: addrs,
+		writers: make(map[string]*kafka.Writer),
+	}, nil
+}
+
+// Produce is part of the vtcdc.Producer interface. The writes are
+// synchronous and wait for the acknowledgement of all the replicas.
+func (p *producer) Produce(ctx context.Context, messages []*vtcdc.Message) error {
+	for _, batch := range splitByTopic(messages) {
+		kmsgs := make([]kafka.Message, 0, len(batch))
+		for _, msg := range batch {
+			kmsgs = append(kmsgs, kafka.Message{Key: msg.Key, Value: msg.Value})
+		}
+		if err := p.writer(batch[0].Topic).WriteMessages(ctx, kmsgs...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *producer) writer(topic string) *kafka.Writer {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if w, ok := p.writers[topic]; ok {
+		return w
+	}
+	w := kafka.NewWriter(kafka.WriterConfig{
+		Brokers:      p.brokers,
+		Topic:        topic,
+		Balancer:     &kafka.Hash{},
+		MaxAttempts:  *maxAttempts,
+		WriteTimeout: *writeTimeout,
+		RequiredAcks: -1,
+	})
+	p.writers[topic] = w
+	return w
+}
+
+// Close is part of the vtcdc.Producer interface.
+func (p *producer) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var firstErr error
+	for topic, w := range p.writers {
+		if err := w.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+		delete(p.writers, topic)
+	}
+	return firstErr
+}
+
+// splitByTopic splits the messages into the runs of consecutive messages
+// that have the same topic, so that they are written in order.
+func splitByTopic(messages []*vtcdc.Message) [][]*vtcdc.Message {
+	var batches [][]*vtcdc.Message
+	for i, msg := range messages {
+		if i == 0 || msg.Topic != messages[i-1].Topic {
+			batches = append(batches, nil)
+		}
+		batches[len(batches)-1] = append(batches[len(batches)-1], msg)
+	}
+	return batches
+}
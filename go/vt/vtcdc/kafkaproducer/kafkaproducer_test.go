@@ -0,0 +1,46 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkaproducer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vtcdc"
+)
+
+func TestNewProducer(t *testing.T) {
+	p, err := vtcdc.NewProducer("kafka", []string{"localhost:9092", ""})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"localhost:9092"}, p.(*producer).brokers)
+	require.NoError(t, p.Close())
+
+	_, err = vtcdc.NewProducer("kafka", []string{""})
+	assert.EqualError(t, err, "no kafka broker specified")
+}
+
+func TestSplitByTopic(t *testing.T) {
+	a1 := &vtcdc.Message{Topic: "a", Value: []byte("1")}
+	a2 := &vtcdc.Message{Topic: "a", Value: []byte("2")}
+	b3 := &vtcdc.Message{Topic: "b", Value: []byte("3")}
+	a4 := &vtcdc.Message{Topic: "a", Value: []byte("4")}
+
+	assert.Empty(t, splitByTopic(nil))
+	assert.Equal(t, [][]*vtcdc.Message{{a1, a2}, {b3}, {a4}}, splitByTopic([]*vtcdc.Message{a1, a2, b3, a4}))
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtcdc
+
+import (
+	"context"
+	"fmt"
+
+	"vitess.io/vitess/go/vt/log"
+)
+
+// Message is a record written to a topic of the sink.
+type Message struct {
+	Topic string
+	// Key is used by the broker to pick the partition of the message.
+	// It is nil for the tables that have no primary key.
+	Key   []byte
+	Value []byte
+}
+
+// Producer writes messages to a Kafka-compatible broker.
+type Producer interface {
+	// Produce writes the messages in order. It must return only
+	// after all the messages were acknowledged by the broker.
+	Produce(ctx context.Context, messages []*Message) error
+
+	// Close releases the resources of the producer.
+	Close() error
+}
+
+// ProducerFactory creates a Producer that connects to the brokers.
+type ProducerFactory func(brokers []string) (Producer, error)
+
+var producerFactories = make(map[string]ProducerFactory)
+
+// RegisterProducerFactory registers a ProducerFactory under name.
+// Kafka client implementations are registered through plugins.
+func RegisterProducerFactory(name string, factory ProducerFactory) {
+	if _, ok := producerFactories[name]; ok {
+		log.Fatalf("RegisterProducerFactory %s already exists", name)
+	}
+	producerFactories[name] = factory
+}
+
+// NewProducer creates a Producer with the factory registered under name.
+func NewProducer(name string, brokers []string) (Producer, error) {
+	factory, ok := producerFactories[name]
+	if !ok {
+		return nil, fmt.Errorf("no producer registered for %s", name)
+	}
+	return factory(brokers)
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtcdc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultTopicTemplate sends the changes of every table to its own topic.
+const DefaultTopicTemplate = "{keyspace}.{table}"
+
+// TopicMapper maps the tables of a keyspace to topics.
+type TopicMapper struct {
+	template string
+	tables   map[string]string
+}
+
+// NewTopicMapper creates a TopicMapper. The topic of a table is found
+// in tables if present. Otherwise, the {keyspace} and {table}
+// placeholders of template are replaced with the names of the table.
+func NewTopicMapper(template string, tables map[string]string) *TopicMapper {
+	if template == "" {
+		template = DefaultTopicTemplate
+	}
+	return &TopicMapper{
+		template: template,
+		tables:   tables,
+	}
+}
+
+// ParseTopicMap parses a comma-separated list of table=topic pairs.
+func ParseTopicMap(s string) (map[string]string, error) {
+	tables := make(map[string]string)
+	if s == "" {
+		return tables, nil
+	}
+	for _, pair := range strings.Split(s, ",") {
+		parts := strings.Split(pair, "=")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid table=topic pair: %q", pair)
+		}
+		tables[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	return tables, nil
+}
+
+// Topic returns the topic of a table.
+func (tm *TopicMapper) Topic(keyspace, table string) string {
+	if topic, ok := tm.tables[table]; ok {
+		return topic
+	}
+	return strings.NewReplacer("{keyspace}", keyspace, "{table}", table).Replace(tm.template)
+}
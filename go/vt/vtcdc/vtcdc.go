@@ -0,0 +1,315 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package vtcdc streams the row changes of a keyspace from vtgate to
+// a Kafka-compatible broker.
+//
+// The changes of a transaction are produced when vtgate sends the
+// VGTID that follows it. The VGTID is then saved in a checkpoint
+// table. If the streamer stops between the two, the changes are
+// produced again after the restart: every message contains the
+// position of its transaction, for consumers to discard duplicates.
+package vtcdc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// VStreamer opens a VStream. It's implemented by vtgateconn.VTGateConn.
+type VStreamer interface {
+	VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags) (vtgateconn.VStreamReader, error)
+}
+
+// Config is the configuration of a Streamer.
+type Config struct {
+	// Keyspace is the keyspace to stream.
+	Keyspace string
+	// TabletType is the type of the tablets to stream from.
+	TabletType topodatapb.TabletType
+	// Filter selects the tables of the stream. All the tables are
+	// streamed if nil.
+	Filter *binlogdatapb.Filter
+	// Topics maps the tables to topics.
+	Topics *TopicMapper
+	// Envelope is the encoding of the messages.
+	Envelope string
+	// HeartbeatInterval is the interval of the heartbeats requested
+	// from vtgate, to detect broken streams.
+	HeartbeatInterval time.Duration
+	// CheckpointInterval is the minimum interval between the
+	// checkpoints of the transactions that have no change to produce,
+	// like the transactions of the tables that are not streamed. The
+	// VGTID is always saved after changes were produced.
+	CheckpointInterval time.Duration
+}
+
+// Streamer produces the changes of a VStream to a Producer.
+type Streamer struct {
+	config       Config
+	conn         VStreamer
+	producer     Producer
+	checkpointer *Checkpointer
+	encode       encodeFunc
+
+	// fields are the columns of the tables, as sent by the last FIELD
+	// event of each table.
+	fields map[string][]*querypb.Field
+	// changes are the changes received since the last VGTID.
+	changes []*change
+
+	lastCheckpoint time.Time
+}
+
+// NewStreamer creates a Streamer.
+func NewStreamer(config Config, conn VStreamer, producer Producer, checkpointer *Checkpointer) (*Streamer, error) {
+	if config.Keyspace == "" {
+		return nil, fmt.Errorf("keyspace must be specified")
+	}
+	encode, err := envelopeEncoder(config.Envelope)
+	if err != nil {
+		return nil, err
+	}
+	if config.Topics == nil {
+		config.Topics = NewTopicMapper("", nil)
+	}
+	if config.Filter == nil {
+		config.Filter = &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match: "/.*/",
+			}},
+		}
+	}
+	// Streaming the checkpoint table would make every checkpoint a change
+	// to stream, followed by another checkpoint, endlessly.
+	if checkpointer != nil && checkpointer.keyspace == config.Keyspace && ruleMatches(checkpointer.tableName, config.Filter) {
+		return nil, fmt.Errorf("the filter of keyspace %s must not include the checkpoint table %s", config.Keyspace, checkpointer.tableName)
+	}
+	return &Streamer{
+		config:       config,
+		conn:         conn,
+		producer:     producer,
+		checkpointer: checkpointer,
+		encode:       encode,
+	}, nil
+}
+
+// Run streams from the checkpoint, or from the current position of all
+// the shards of the keyspace if there's none. It returns when the
+// stream ends or fails, or when ctx is done.
+func (st *Streamer) Run(ctx context.Context) error {
+	if err := st.checkpointer.Init(ctx); err != nil {
+		return err
+	}
+	vgtid, err := st.checkpointer.Load(ctx)
+	if err != nil {
+		return err
+	}
+	if vgtid == nil {
+		vgtid = &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: st.config.Keyspace,
+				Gtid:     "current",
+			}},
+		}
+		log.Infof("No checkpoint found, streaming keyspace %s from the current position", st.config.Keyspace)
+	} else {
+		log.Infof("Streaming keyspace %s from checkpoint %v", st.config.Keyspace, vgtid)
+	}
+
+	st.fields = make(map[string][]*querypb.Field)
+	st.changes = nil
+	flags := &vtgatepb.VStreamFlags{
+		HeartbeatInterval: uint32(st.config.HeartbeatInterval.Seconds()),
+	}
+	reader, err := st.conn.VStream(ctx, st.config.TabletType, vgtid, st.config.Filter, flags)
+	if err != nil {
+		return err
+	}
+	for {
+		events, err := reader.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			if err := st.processEvent(ctx, event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// ruleMatches returns true if a rule of filter matches tableName, like
+// the vstreamer does.
+func ruleMatches(tableName string, filter *binlogdatapb.Filter) bool {
+	for _, rule := range filter.Rules {
+		switch {
+		case strings.HasPrefix(rule.Match, "/"):
+			result, err := regexp.MatchString(strings.Trim(rule.Match, "/"), tableName)
+			if err == nil && result {
+				return true
+			}
+		case tableName == rule.Match:
+			return true
+		}
+	}
+	return false
+}
+
+func (st *Streamer) processEvent(ctx context.Context, event *binlogdatapb.VEvent) error {
+	switch event.Type {
+	case binlogdatapb.VEventType_FIELD:
+		st.fields[event.FieldEvent.TableName] = event.FieldEvent.Fields
+	case binlogdatapb.VEventType_ROW:
+		fields, ok := st.fields[event.RowEvent.TableName]
+		if !ok {
+			return fmt.Errorf("received rows of table %s before its fields", event.RowEvent.TableName)
+		}
+		for _, rowChange := range event.RowEvent.RowChanges {
+			st.addChange(event, fields, rowChange)
+		}
+	case binlogdatapb.VEventType_VGTID:
+		return st.flush(ctx, event.Vgtid)
+	}
+	return nil
+}
+
+func (st *Streamer) addChange(event *binlogdatapb.VEvent, fields []*querypb.Field, rowChange *binlogdatapb.RowChange) {
+	ch := &change{
+		keyspace:  st.config.Keyspace,
+		table:     event.RowEvent.TableName,
+		timestamp: event.Timestamp,
+		fields:    fields,
+	}
+	if rowChange.Before != nil {
+		ch.before = sqltypes.MakeRowTrusted(fields, rowChange.Before)
+	}
+	if rowChange.After != nil {
+		ch.after = sqltypes.MakeRowTrusted(fields, rowChange.After)
+	}
+	switch {
+	case ch.before == nil:
+		ch.op = OpCreate
+	case ch.after == nil:
+		ch.op = OpDelete
+	case !equalKeys(fields, ch.before, ch.after):
+		// The row has a new key, which may be in another partition:
+		// it's deleted and created again.
+		st.changes = append(st.changes, &change{
+			op:        OpDelete,
+			keyspace:  ch.keyspace,
+			table:     ch.table,
+			timestamp: ch.timestamp,
+			fields:    fields,
+			before:    ch.before,
+		})
+		ch.op = OpCreate
+		ch.before = nil
+	default:
+		ch.op = OpUpdate
+	}
+	st.changes = append(st.changes, ch)
+}
+
+// flush produces the pending changes, and saves the checkpoint.
+func (st *Streamer) flush(ctx context.Context, vgtid *binlogdatapb.VGtid) error {
+	if len(st.changes) != 0 {
+		messages := make([]*Message, 0, len(st.changes))
+		for _, ch := range st.changes {
+			msg, err := st.newMessage(ch, vgtid)
+			if err != nil {
+				return err
+			}
+			messages = append(messages, msg)
+		}
+		if err := st.producer.Produce(ctx, messages); err != nil {
+			return err
+		}
+		st.changes = nil
+	} else if time.Since(st.lastCheckpoint) < st.config.CheckpointInterval {
+		return nil
+	}
+	if err := st.checkpointer.Save(ctx, vgtid); err != nil {
+		return err
+	}
+	st.lastCheckpoint = time.Now()
+	return nil
+}
+
+func (st *Streamer) newMessage(ch *change, vgtid *binlogdatapb.VGtid) (*Message, error) {
+	value, err := st.encode(ch, vgtid)
+	if err != nil {
+		return nil, err
+	}
+	row := ch.after
+	if row == nil {
+		row = ch.before
+	}
+	key, err := encodeKey(ch.fields, row)
+	if err != nil {
+		return nil, err
+	}
+	return &Message{
+		Topic: st.config.Topics.Topic(ch.keyspace, ch.table),
+		Key:   key,
+		Value: value,
+	}, nil
+}
+
+// encodeKey encodes the primary key columns of row as a JSON object.
+// It returns nil if the table has no primary key.
+func encodeKey(fields []*querypb.Field, row []sqltypes.Value) ([]byte, error) {
+	key := &jsonRow{}
+	for i, field := range fields {
+		if isPKField(field) {
+			key.fields = append(key.fields, field)
+			key.values = append(key.values, row[i])
+		}
+	}
+	if len(key.fields) == 0 {
+		return nil, nil
+	}
+	return key.MarshalJSON()
+}
+
+func equalKeys(fields []*querypb.Field, before, after []sqltypes.Value) bool {
+	for i, field := range fields {
+		if isPKField(field) && before[i].String() != after[i].String() {
+			return false
+		}
+	}
+	return true
+}
+
+func isPKField(field *querypb.Field) bool {
+	return field.Flags&uint32(querypb.MySqlFlag_PRI_KEY_FLAG) != 0
+}
@@ -0,0 +1,296 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtcdc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// fakeVStreamer returns the events, and then io.EOF.
+type fakeVStreamer struct {
+	events [][]*binlogdatapb.VEvent
+
+	vgtid  *binlogdatapb.VGtid
+	filter *binlogdatapb.Filter
+}
+
+func (fv *fakeVStreamer) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags) (vtgateconn.VStreamReader, error) {
+	fv.vgtid = vgtid
+	fv.filter = filter
+	return fv, nil
+}
+
+func (fv *fakeVStreamer) Recv() ([]*binlogdatapb.VEvent, error) {
+	if len(fv.events) == 0 {
+		return nil, io.EOF
+	}
+	events := fv.events[0]
+	fv.events = fv.events[1:]
+	return events, nil
+}
+
+// fakeExecutor stores the checkpoints in memory.
+type fakeExecutor struct {
+	checkpoints map[string][]byte
+	queries     []string
+}
+
+func newFakeExecutor() *fakeExecutor {
+	return &fakeExecutor{
+		checkpoints: make(map[string][]byte),
+	}
+}
+
+func (fe *fakeExecutor) Execute(ctx context.Context, query string, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	fe.queries = append(fe.queries, query)
+	switch {
+	case strings.HasPrefix(query, "select"):
+		value, ok := fe.checkpoints[string(bindVars["name"].Value)]
+		if !ok {
+			return &sqltypes.Result{}, nil
+		}
+		return &sqltypes.Result{Rows: [][]sqltypes.Value{{sqltypes.MakeTrusted(sqltypes.VarBinary, value)}}}, nil
+	case strings.HasPrefix(query, "insert"):
+		fe.checkpoints[string(bindVars["name"].Value)] = bindVars["vgtid"].Value
+	}
+	return &sqltypes.Result{}, nil
+}
+
+func (fe *fakeExecutor) checkpoint(t *testing.T, name string) *binlogdatapb.VGtid {
+	t.Helper()
+	value, ok := fe.checkpoints[name]
+	if !ok {
+		return nil
+	}
+	vgtid := &binlogdatapb.VGtid{}
+	require.NoError(t, proto.Unmarshal(value, vgtid))
+	return vgtid
+}
+
+var testFields = []*querypb.Field{{
+	Name:  "id",
+	Type:  sqltypes.Int64,
+	Flags: uint32(querypb.MySqlFlag_PRI_KEY_FLAG),
+}, {
+	Name: "val",
+	Type: sqltypes.VarChar,
+}}
+
+func testRow(id int64, val string) *querypb.Row {
+	return sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(id), sqltypes.NewVarChar(val)})
+}
+
+func testVGtid(gtid string) *binlogdatapb.VGtid {
+	return &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: "ks",
+			Shard:    "0",
+			Gtid:     gtid,
+		}},
+	}
+}
+
+func testRowEvent(before, after *querypb.Row) *binlogdatapb.VEvent {
+	return &binlogdatapb.VEvent{
+		Type:      binlogdatapb.VEventType_ROW,
+		Timestamp: 1000,
+		RowEvent: &binlogdatapb.RowEvent{
+			TableName: "t1",
+			RowChanges: []*binlogdatapb.RowChange{{
+				Before: before,
+				After:  after,
+			}},
+		},
+	}
+}
+
+func messageStrings(messages []*Message) []string {
+	var out []string
+	for _, msg := range messages {
+		out = append(out, string(msg.Key)+" "+string(msg.Value))
+	}
+	return out
+}
+
+func TestStreamer(t *testing.T) {
+	ctx := context.Background()
+	fv := &fakeVStreamer{
+		events: [][]*binlogdatapb.VEvent{{
+			{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "t1", Fields: testFields}},
+		}, {
+			{Type: binlogdatapb.VEventType_BEGIN},
+			testRowEvent(nil, testRow(1, "a")),
+			{Type: binlogdatapb.VEventType_COMMIT},
+			{Type: binlogdatapb.VEventType_VGTID, Vgtid: testVGtid("gtid1")},
+		}, {
+			{Type: binlogdatapb.VEventType_BEGIN},
+			testRowEvent(testRow(1, "a"), testRow(1, "b")),
+			testRowEvent(testRow(1, "b"), testRow(2, "b")),
+			testRowEvent(testRow(2, "b"), nil),
+			{Type: binlogdatapb.VEventType_COMMIT},
+			{Type: binlogdatapb.VEventType_VGTID, Vgtid: testVGtid("gtid2")},
+		}},
+	}
+	fe := newFakeExecutor()
+	broker := NewFakeBroker()
+	st, err := NewStreamer(Config{Keyspace: "ks"}, fv, broker, NewCheckpointer(fe, "cdc", "checkpoint", "ks"))
+	require.NoError(t, err)
+	require.NoError(t, st.Run(ctx))
+
+	assert.Equal(t, testVGtid("current").ShardGtids[0].Gtid, fv.vgtid.ShardGtids[0].Gtid)
+	assert.Equal(t, "", fv.vgtid.ShardGtids[0].Shard)
+	assert.Equal(t, "/.*/", fv.filter.Rules[0].Match)
+	assert.Contains(t, fe.queries[0], "create table if not exists cdc.checkpoint (")
+
+	assert.Equal(t, []string{"ks.t1"}, broker.Topics())
+	pos1 := `"position":[{"keyspace":"ks","shard":"0","gtid":"gtid1"}]`
+	pos2 := `"position":[{"keyspace":"ks","shard":"0","gtid":"gtid2"}]`
+	want := []string{
+		`{"id":1} {"op":"c","keyspace":"ks","table":"t1","ts":1000,"before":null,"after":{"id":1,"val":"a"},` + pos1 + `}`,
+		`{"id":1} {"op":"u","keyspace":"ks","table":"t1","ts":1000,"before":{"id":1,"val":"a"},"after":{"id":1,"val":"b"},` + pos2 + `}`,
+		`{"id":1} {"op":"d","keyspace":"ks","table":"t1","ts":1000,"before":{"id":1,"val":"b"},"after":null,` + pos2 + `}`,
+		`{"id":2} {"op":"c","keyspace":"ks","table":"t1","ts":1000,"before":null,"after":{"id":2,"val":"b"},` + pos2 + `}`,
+		`{"id":2} {"op":"d","keyspace":"ks","table":"t1","ts":1000,"before":{"id":2,"val":"b"},"after":null,` + pos2 + `}`,
+	}
+	assert.Equal(t, want, messageStrings(broker.Messages("ks.t1")))
+	assert.True(t, proto.Equal(testVGtid("gtid2"), fe.checkpoint(t, "ks")))
+
+	// The stream resumes from the checkpoint.
+	fv.events = nil
+	require.NoError(t, st.Run(ctx))
+	assert.True(t, proto.Equal(testVGtid("gtid2"), fv.vgtid))
+}
+
+func TestStreamerProduceError(t *testing.T) {
+	ctx := context.Background()
+	fv := &fakeVStreamer{
+		events: [][]*binlogdatapb.VEvent{{
+			{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "t1", Fields: testFields}},
+			testRowEvent(nil, testRow(1, "a")),
+			{Type: binlogdatapb.VEventType_VGTID, Vgtid: testVGtid("gtid1")},
+		}},
+	}
+	fe := newFakeExecutor()
+	fe.checkpoints["ks"], _ = proto.Marshal(testVGtid("gtid0"))
+	broker := NewFakeBroker()
+	broker.SetError(errors.New("broker down"))
+	st, err := NewStreamer(Config{Keyspace: "ks"}, fv, broker, NewCheckpointer(fe, "cdc", "checkpoint", "ks"))
+	require.NoError(t, err)
+
+	assert.EqualError(t, st.Run(ctx), "broker down")
+	assert.Empty(t, broker.Topics())
+	assert.True(t, proto.Equal(testVGtid("gtid0"), fe.checkpoint(t, "ks")))
+}
+
+func TestStreamerNoPK(t *testing.T) {
+	fields := []*querypb.Field{{Name: "val", Type: sqltypes.VarBinary}}
+	fv := &fakeVStreamer{
+		events: [][]*binlogdatapb.VEvent{{
+			{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "t2", Fields: fields}},
+			{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{
+				TableName: "t2",
+				RowChanges: []*binlogdatapb.RowChange{{
+					After: sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewVarBinary("\x00\x01")}),
+				}},
+			}},
+			{Type: binlogdatapb.VEventType_VGTID, Vgtid: testVGtid("gtid1")},
+		}},
+	}
+	broker := NewFakeBroker()
+	config := Config{
+		Keyspace: "ks",
+		Topics:   NewTopicMapper("", map[string]string{"t2": "blobs"}),
+		Envelope: EnvelopeSchema,
+	}
+	st, err := NewStreamer(config, fv, broker, NewCheckpointer(newFakeExecutor(), "cdc", "checkpoint", "ks"))
+	require.NoError(t, err)
+	require.NoError(t, st.Run(context.Background()))
+
+	messages := broker.Messages("blobs")
+	require.Len(t, messages, 1)
+	assert.Nil(t, messages[0].Key)
+	want := `{"schema":{"type":"record","name":"t2","namespace":"ks","fields":[{"name":"val","type":["null","bytes"]}]},` +
+		`"payload":{"op":"c","keyspace":"ks","table":"t2","ts":0,"before":null,"after":{"val":"AAE="},"position":[{"keyspace":"ks","shard":"0","gtid":"gtid1"}]}}`
+	assert.Equal(t, want, string(messages[0].Value))
+}
+
+func TestStreamerRowsBeforeFields(t *testing.T) {
+	fv := &fakeVStreamer{
+		events: [][]*binlogdatapb.VEvent{{
+			testRowEvent(nil, testRow(1, "a")),
+		}},
+	}
+	st, err := NewStreamer(Config{Keyspace: "ks"}, fv, NewFakeBroker(), NewCheckpointer(newFakeExecutor(), "cdc", "checkpoint", "ks"))
+	require.NoError(t, err)
+	assert.EqualError(t, st.Run(context.Background()), "received rows of table t1 before its fields")
+}
+
+func TestNewStreamerErrors(t *testing.T) {
+	_, err := NewStreamer(Config{}, nil, nil, nil)
+	assert.EqualError(t, err, "keyspace must be specified")
+	_, err = NewStreamer(Config{Keyspace: "ks", Envelope: "avro"}, nil, nil, nil)
+	assert.EqualError(t, err, "unsupported envelope: avro")
+
+	// The checkpoint table can't be streamed.
+	checkpointer := NewCheckpointer(newFakeExecutor(), "ks", "checkpoint", "ks")
+	_, err = NewStreamer(Config{Keyspace: "ks"}, nil, nil, checkpointer)
+	assert.EqualError(t, err, "the filter of keyspace ks must not include the checkpoint table checkpoint")
+	filter := &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{Match: "t1"}, {Match: "checkpoint"}}}
+	_, err = NewStreamer(Config{Keyspace: "ks", Filter: filter}, nil, nil, checkpointer)
+	assert.EqualError(t, err, "the filter of keyspace ks must not include the checkpoint table checkpoint")
+	filter = &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{Match: "t1"}, {Match: "/^t/"}}}
+	_, err = NewStreamer(Config{Keyspace: "ks", Filter: filter}, nil, nil, checkpointer)
+	assert.NoError(t, err)
+	_, err = NewStreamer(Config{Keyspace: "ks2"}, nil, nil, checkpointer)
+	assert.NoError(t, err)
+}
+
+func TestTopicMapper(t *testing.T) {
+	tables, err := ParseTopicMap("t1=orders, t2 = items")
+	require.NoError(t, err)
+	tm := NewTopicMapper("cdc.{keyspace}_{table}", tables)
+	assert.Equal(t, "orders", tm.Topic("ks", "t1"))
+	assert.Equal(t, "items", tm.Topic("ks", "t2"))
+	assert.Equal(t, "cdc.ks_t3", tm.Topic("ks", "t3"))
+
+	_, err = ParseTopicMap("t1")
+	assert.EqualError(t, err, `invalid table=topic pair: "t1"`)
+}
+
+func TestProducerRegistry(t *testing.T) {
+	p, err := NewProducer("fake", nil)
+	require.NoError(t, err)
+	assert.IsType(t, &FakeBroker{}, p)
+	_, err = NewProducer("unknown", nil)
+	assert.EqualError(t, err, "no producer registered for unknown")
+}
@@ -21,10 +21,12 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"time"
 
 	"context"
 
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/topo/topoproto"
@@ -40,29 +42,40 @@ func init() {
 	addCommand("Shards", command{
 		"BackupShard",
 		commandBackupShard,
-		"[-allow_master=false] <keyspace/shard>",
+		"[-allow_master=false] [-incremental_from_pos=<pos|auto>] <keyspace/shard>",
 		"Chooses a tablet and creates a backup for a shard."})
 	addCommand("Shards", command{
 		"RemoveBackup",
 		commandRemoveBackup,
 		"<keyspace/shard> <backup name>",
 		"Removes a backup for the BackupStorage."})
+	addCommand("Shards", command{
+		"PruneBackups",
+		commandPruneBackups,
+		"[-keep_last=N] [-keep_daily=N] [-keep_weekly=N] [-keep_monthly=N] [-min_age=<duration>] [-dry_run] <keyspace/shard>",
+		"Removes the backups of a shard from the BackupStorage that the retention policy doesn't keep. A full backup is kept if it is among the keep_last most recent ones, the most recent of one of the keep_daily/keep_weekly/keep_monthly most recent days/weeks/months, or younger than min_age. Incremental backups are kept if they are more recent than the oldest full backup kept."})
+	addCommand("Shards", command{
+		"VerifyBackup",
+		commandVerifyBackup,
+		"[-concurrency=4] [-record=true] <keyspace/shard> [<backup name>]",
+		"Checks the files of a backup against the hashes in its MANIFEST, and records the result in the BackupStorage. Verifies the most recent backup if no backup name is given. Use vtbackup -verify_backup to also restore the backup and run sanity queries on it."})
 
 	addCommand("Tablets", command{
 		"Backup",
 		commandBackup,
-		"[-concurrency=4] [-allow_master=false] <tablet alias>",
-		"Stops mysqld and uses the BackupStorage service to store a new backup. This function also remembers if the tablet was replicating so that it can restore the same state after the backup completes."})
+		"[-concurrency=4] [-allow_master=false] [-incremental_from_pos=<pos|auto>] <tablet alias>",
+		"Stops mysqld and uses the BackupStorage service to store a new backup. This function also remembers if the tablet was replicating so that it can restore the same state after the backup completes. With -incremental_from_pos, mysqld keeps running and only the binary logs since that position are backed up."})
 	addCommand("Tablets", command{
 		"RestoreFromBackup",
 		commandRestoreFromBackup,
-		"<tablet alias>",
-		"Stops mysqld and restores the data from the latest backup."})
+		"[-restore_to_pos=<pos>] [-restore_to_timestamp=<time>] <tablet alias>",
+		"Stops mysqld and restores the data from the latest backup. With -restore_to_pos or -restore_to_timestamp, restores the latest backup before that point in time, then applies the binary logs archived in incremental backups up to it."})
 }
 
 func commandBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	concurrency := subFlags.Int("concurrency", 4, "Specifies the number of compression/checksum jobs to run simultaneously")
 	allowMaster := subFlags.Bool("allow_master", false, "Allows backups to be taken on master. Warning!! If you are using the builtin backup engine, this will shutdown your master mysql for as long as it takes to create a backup ")
+	incrementalFromPos := subFlags.String("incremental_from_pos", "", "Takes an incremental backup of the binary logs since this position instead of a full backup. Use 'auto' to start at the position of the most recent backup")
 
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -80,12 +93,13 @@ func commandBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fl
 		return err
 	}
 
-	return execBackup(ctx, wr, tabletInfo.Tablet, *concurrency, *allowMaster)
+	return execBackup(ctx, wr, tabletInfo.Tablet, *concurrency, *allowMaster, *incrementalFromPos)
 }
 
 func commandBackupShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	concurrency := subFlags.Int("concurrency", 4, "Specifies the number of compression/checksum jobs to run simultaneously")
 	allowMaster := subFlags.Bool("allow_master", false, "Whether to use master tablet for backup. Warning!! If you are using the builtin backup engine, this will shutdown your master mysql for as long as it takes to create a backup ")
+	incrementalFromPos := subFlags.String("incremental_from_pos", "", "Takes an incremental backup of the binary logs since this position instead of a full backup. Use 'auto' to start at the position of the most recent backup")
 
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -147,12 +161,12 @@ func commandBackupShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 		return errors.New("no tablet available for backup")
 	}
 
-	return execBackup(ctx, wr, tabletForBackup, *concurrency, *allowMaster)
+	return execBackup(ctx, wr, tabletForBackup, *concurrency, *allowMaster, *incrementalFromPos)
 }
 
 // execBackup is shared by Backup and BackupShard
-func execBackup(ctx context.Context, wr *wrangler.Wrangler, tablet *topodatapb.Tablet, concurrency int, allowMaster bool) error {
-	stream, err := wr.TabletManagerClient().Backup(ctx, tablet, concurrency, allowMaster)
+func execBackup(ctx context.Context, wr *wrangler.Wrangler, tablet *topodatapb.Tablet, concurrency int, allowMaster bool, incrementalFromPos string) error {
+	stream, err := wr.TabletManagerClient().Backup(ctx, tablet, concurrency, allowMaster, incrementalFromPos)
 	if err != nil {
 		return err
 	}
@@ -221,7 +235,78 @@ func commandRemoveBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 	return bs.RemoveBackup(ctx, bucket, name)
 }
 
+func commandPruneBackups(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	keepLast := subFlags.Int("keep_last", 1, "Keeps this many of the most recent full backups")
+	keepDaily := subFlags.Int("keep_daily", 0, "Keeps the most recent full backup of each of the last N days with a backup")
+	keepWeekly := subFlags.Int("keep_weekly", 0, "Keeps the most recent full backup of each of the last N weeks with a backup")
+	keepMonthly := subFlags.Int("keep_monthly", 0, "Keeps the most recent full backup of each of the last N months with a backup")
+	minAge := subFlags.Duration("min_age", 0, "Keeps all backups younger than this")
+	dryRun := subFlags.Bool("dry_run", false, "Lists the backups that would be removed, without removing them")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("action PruneBackups requires <keyspace/shard>")
+	}
+
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return err
+	}
+	defer bs.Close()
+	pruned, err := mysqlctl.PruneBackups(ctx, bs, keyspace, shard, mysqlctl.RetentionPolicy{
+		KeepLast:    *keepLast,
+		KeepDaily:   *keepDaily,
+		KeepWeekly:  *keepWeekly,
+		KeepMonthly: *keepMonthly,
+		MinAge:      *minAge,
+	}, *dryRun, wr.Logger())
+	if err != nil {
+		return err
+	}
+	for _, name := range pruned {
+		wr.Logger().Printf("%v\n", name)
+	}
+	return nil
+}
+
+func commandVerifyBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	concurrency := subFlags.Int("concurrency", 4, "Specifies the number of files to check simultaneously")
+	record := subFlags.Bool("record", true, "Records the result of the verification in the BackupStorage")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 && subFlags.NArg() != 2 {
+		return fmt.Errorf("action VerifyBackup requires <keyspace/shard> [<backup name>]")
+	}
+
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	result, err := mysqlctl.VerifyBackup(ctx, mysqlctl.VerifyParams{
+		Logger:      wr.Logger(),
+		Concurrency: *concurrency,
+		Keyspace:    keyspace,
+		Shard:       shard,
+		BackupName:  subFlags.Arg(1),
+		Record:      *record,
+	})
+	if result != nil {
+		if perr := printJSON(wr.Logger(), result); perr != nil && err == nil {
+			err = perr
+		}
+	}
+	return err
+}
+
 func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	restoreToPos := subFlags.String("restore_to_pos", "", "Restores to this GTID position, applying the binary logs archived in incremental backups. The tablet is left DRAINED.")
+	restoreToTimestamp := subFlags.String("restore_to_timestamp", "", "Restores to this time (RFC 3339 format, e.g. 2006-01-02T15:04:05Z), applying the binary logs archived in incremental backups. The tablet is left DRAINED.")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -237,7 +322,13 @@ func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFla
 	if err != nil {
 		return err
 	}
-	stream, err := wr.TabletManagerClient().RestoreFromBackup(ctx, tabletInfo.Tablet)
+	var toTime time.Time
+	if *restoreToTimestamp != "" {
+		if toTime, err = time.Parse(time.RFC3339, *restoreToTimestamp); err != nil {
+			return fmt.Errorf("invalid -restore_to_timestamp %q: %v", *restoreToTimestamp, err)
+		}
+	}
+	stream, err := wr.TabletManagerClient().RestoreFromBackup(ctx, tabletInfo.Tablet, *restoreToPos, toTime)
 	if err != nil {
 		return err
 	}
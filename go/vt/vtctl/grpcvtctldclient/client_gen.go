@@ -28,6 +28,24 @@ import (
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 )
 
+// AddQueryRule is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) AddQueryRule(ctx context.Context, in *vtctldatapb.AddQueryRuleRequest, opts ...grpc.CallOption) (*vtctldatapb.AddQueryRuleResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.AddQueryRule(ctx, in, opts...)
+}
+
+// DeleteQueryRule is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) DeleteQueryRule(ctx context.Context, in *vtctldatapb.DeleteQueryRuleRequest, opts ...grpc.CallOption) (*vtctldatapb.DeleteQueryRuleResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.DeleteQueryRule(ctx, in, opts...)
+}
+
 // FindAllShardsInKeyspace is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) FindAllShardsInKeyspace(ctx context.Context, in *vtctldatapb.FindAllShardsInKeyspaceRequest, opts ...grpc.CallOption) (*vtctldatapb.FindAllShardsInKeyspaceResponse, error) {
 	if client.c == nil {
@@ -82,6 +100,24 @@ func (client *gRPCVtctldClient) GetKeyspaces(ctx context.Context, in *vtctldatap
 	return client.c.GetKeyspaces(ctx, in, opts...)
 }
 
+// GetQueryRules is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetQueryRules(ctx context.Context, in *vtctldatapb.GetQueryRulesRequest, opts ...grpc.CallOption) (*vtctldatapb.GetQueryRulesResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetQueryRules(ctx, in, opts...)
+}
+
+// GetThrottlerConfig is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetThrottlerConfig(ctx context.Context, in *vtctldatapb.GetThrottlerConfigRequest, opts ...grpc.CallOption) (*vtctldatapb.GetThrottlerConfigResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetThrottlerConfig(ctx, in, opts...)
+}
+
 // InitShardPrimary is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) InitShardPrimary(ctx context.Context, in *vtctldatapb.InitShardPrimaryRequest, opts ...grpc.CallOption) (*vtctldatapb.InitShardPrimaryResponse, error) {
 	if client.c == nil {
@@ -90,3 +126,12 @@ func (client *gRPCVtctldClient) InitShardPrimary(ctx context.Context, in *vtctld
 
 	return client.c.InitShardPrimary(ctx, in, opts...)
 }
+
+// UpdateThrottlerConfig is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) UpdateThrottlerConfig(ctx context.Context, in *vtctldatapb.UpdateThrottlerConfigRequest, opts ...grpc.CallOption) (*vtctldatapb.UpdateThrottlerConfigResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.UpdateThrottlerConfig(ctx, in, opts...)
+}
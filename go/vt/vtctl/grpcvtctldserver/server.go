@@ -17,7 +17,9 @@ limitations under the License.
 package grpcvtctldserver
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"sync"
 	"time"
@@ -27,6 +29,7 @@ import (
 
 	"vitess.io/vitess/go/event"
 	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/vt/callinfo"
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
@@ -35,9 +38,11 @@ import (
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/topotools/events"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
 	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 	vtctlservicepb "vitess.io/vitess/go/vt/proto/vtctlservice"
 	"vitess.io/vitess/go/vt/proto/vtrpc"
@@ -45,6 +50,11 @@ import (
 
 const (
 	initShardMasterOperation = "InitShardMaster" // (TODO:@amason) Can I rename this to Primary?
+
+	// defaults for the apps throttled by UpdateThrottlerConfig, same as the
+	// defaults of the tablet throttler
+	defaultThrottleAppRatio    = 1.0
+	defaultThrottleAppDuration = time.Hour
 )
 
 // VtctldServer implements the Vtctld RPC service protocol.
@@ -57,6 +67,87 @@ func NewVtctldServer(ts *topo.Server) *VtctldServer {
 	return &VtctldServer{ts: ts}
 }
 
+// AddQueryRule is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) AddQueryRule(ctx context.Context, req *vtctldatapb.AddQueryRuleRequest) (*vtctldatapb.AddQueryRuleResponse, error) {
+	if err := s.validateQueryRulesTarget(ctx, req.Keyspace, req.Shard, req.TabletType); err != nil {
+		return nil, err
+	}
+
+	ruleInfo := make(map[string]interface{})
+	dec := json.NewDecoder(bytes.NewReader([]byte(req.Rule)))
+	dec.UseNumber()
+	if err := dec.Decode(&ruleInfo); err != nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid rule: %v", err)
+	}
+	qr, err := rules.BuildQueryRule(ruleInfo)
+	if err != nil {
+		return nil, err
+	}
+	if qr.Name == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "rule must have a name")
+	}
+
+	qr.AddedBy = req.AddedBy
+	if qr.AddedBy == "" {
+		if ci, ok := callinfo.FromContext(ctx); ok {
+			qr.AddedBy = ci.Username()
+		}
+	}
+	if qr.AddedBy == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "added_by field is required")
+	}
+	// The times of the rules have a precision of a second.
+	now := time.Now().Truncate(time.Second)
+	qr.AddedAt = now
+	if req.Ttl != nil {
+		ttl, err := ptypes.Duration(req.Ttl)
+		if err != nil {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid ttl: %v", err)
+		}
+		if ttl <= 0 {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "ttl must be positive: %v", ttl)
+		}
+		qr.ExpiresAt = now.Add(ttl)
+	}
+
+	err = s.updateQueryRules(ctx, req.Keyspace, req.Shard, req.TabletType, func(qrs *rules.Rules) error {
+		if qrs.Find(qr.Name) != nil {
+			return vterrors.Errorf(vtrpc.Code_ALREADY_EXISTS, "rule %s already exists", qr.Name)
+		}
+		qrs.Add(qr)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Query rule %s added to %s/%s %v by %s, expires at %v", qr.Name, req.Keyspace, req.Shard, req.TabletType, qr.AddedBy, qr.ExpiresAt)
+	return &vtctldatapb.AddQueryRuleResponse{}, nil
+}
+
+// DeleteQueryRule is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) DeleteQueryRule(ctx context.Context, req *vtctldatapb.DeleteQueryRuleRequest) (*vtctldatapb.DeleteQueryRuleResponse, error) {
+	if err := s.validateQueryRulesTarget(ctx, req.Keyspace, req.Shard, req.TabletType); err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "name field is required")
+	}
+
+	err := s.updateQueryRules(ctx, req.Keyspace, req.Shard, req.TabletType, func(qrs *rules.Rules) error {
+		if qrs.Delete(req.Name) == nil {
+			return vterrors.Errorf(vtrpc.Code_NOT_FOUND, "rule %s not found", req.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Query rule %s deleted from %s/%s %v", req.Name, req.Keyspace, req.Shard, req.TabletType)
+	return &vtctldatapb.DeleteQueryRuleResponse{}, nil
+}
+
 // FindAllShardsInKeyspace is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) FindAllShardsInKeyspace(ctx context.Context, req *vtctldatapb.FindAllShardsInKeyspaceRequest) (*vtctldatapb.FindAllShardsInKeyspaceResponse, error) {
 	result, err := s.ts.FindAllShardsInKeyspace(ctx, req.Keyspace)
@@ -152,6 +243,40 @@ func (s *VtctldServer) GetKeyspaces(ctx context.Context, req *vtctldatapb.GetKey
 	return &vtctldatapb.GetKeyspacesResponse{Keyspaces: keyspaces}, nil
 }
 
+// GetQueryRules is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetQueryRules(ctx context.Context, req *vtctldatapb.GetQueryRulesRequest) (*vtctldatapb.GetQueryRulesResponse, error) {
+	if err := s.validateQueryRulesTarget(ctx, req.Keyspace, req.Shard, req.TabletType); err != nil {
+		return nil, err
+	}
+
+	data, _, err := s.ts.GetQueryRules(ctx, req.Keyspace, req.Shard, req.TabletType)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		data = []byte("[]")
+	}
+
+	return &vtctldatapb.GetQueryRulesResponse{Rules: string(data)}, nil
+}
+
+// GetThrottlerConfig is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetThrottlerConfig(ctx context.Context, req *vtctldatapb.GetThrottlerConfigRequest) (*vtctldatapb.GetThrottlerConfigResponse, error) {
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace field is required")
+	}
+	if _, err := s.ts.GetKeyspace(ctx, req.Keyspace); err != nil {
+		return nil, err
+	}
+
+	throttlerConfig, err := s.ts.GetThrottlerConfig(ctx, req.Keyspace)
+	if err != nil && !topo.IsErrType(err, topo.NoNode) {
+		return nil, err
+	}
+
+	return &vtctldatapb.GetThrottlerConfigResponse{ThrottlerConfig: throttlerConfig}, nil
+}
+
 // InitShardPrimary is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) InitShardPrimary(ctx context.Context, req *vtctldatapb.InitShardPrimaryRequest) (*vtctldatapb.InitShardPrimaryResponse, error) {
 	if req.Keyspace == "" {
@@ -398,6 +523,153 @@ func (s *VtctldServer) InitShardPrimaryLocked(
 	return nil
 }
 
+// UpdateThrottlerConfig is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) UpdateThrottlerConfig(ctx context.Context, req *vtctldatapb.UpdateThrottlerConfigRequest) (*vtctldatapb.UpdateThrottlerConfigResponse, error) {
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace field is required")
+	}
+	if req.Enable && req.Disable {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "enable and disable are mutually exclusive")
+	}
+	if req.Threshold < 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "threshold must not be negative: %v", req.Threshold)
+	}
+	if !req.Enable && !req.Disable && req.Threshold == 0 && req.ThrottleApp == "" && req.UnthrottleApp == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "no update requested")
+	}
+	if req.ThrottleApp != "" && req.ThrottleApp == req.UnthrottleApp {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "cannot both throttle and unthrottle app %s", req.ThrottleApp)
+	}
+
+	now := time.Now()
+	var appRule *topodatapb.ThrottledAppRule
+	if req.ThrottleApp != "" {
+		ratio := req.ThrottleAppRatio
+		if ratio == 0 {
+			ratio = defaultThrottleAppRatio
+		}
+		if ratio < 0 || ratio > 1 {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "throttle_app_ratio must be between 0 and 1: %v", ratio)
+		}
+		duration := defaultThrottleAppDuration
+		if req.ThrottleAppDuration != nil {
+			var err error
+			if duration, err = ptypes.Duration(req.ThrottleAppDuration); err != nil {
+				return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid throttle_app_duration: %v", err)
+			}
+			if duration <= 0 {
+				return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "throttle_app_duration must be positive: %v", duration)
+			}
+		}
+		appRule = &topodatapb.ThrottledAppRule{
+			Name:      req.ThrottleApp,
+			Ratio:     ratio,
+			ExpiresAt: logutil.TimeToProto(now.Add(duration)),
+		}
+	}
+
+	if _, err := s.ts.GetKeyspace(ctx, req.Keyspace); err != nil {
+		return nil, err
+	}
+	newConfig := func() *topodatapb.ThrottlerConfig {
+		// Without a ThrottlerConfig, the tablet throttlers are enabled.
+		return &topodatapb.ThrottlerConfig{Enabled: true}
+	}
+	throttlerConfig, err := s.ts.UpdateThrottlerConfigFields(ctx, req.Keyspace, newConfig, func(throttlerConfig *topodatapb.ThrottlerConfig) error {
+		for appName, rule := range throttlerConfig.ThrottledApps {
+			if !logutil.ProtoToTime(rule.ExpiresAt).After(now) {
+				delete(throttlerConfig.ThrottledApps, appName)
+			}
+		}
+		if req.UnthrottleApp != "" {
+			if _, ok := throttlerConfig.ThrottledApps[req.UnthrottleApp]; !ok {
+				return vterrors.Errorf(vtrpc.Code_NOT_FOUND, "app %s is not throttled", req.UnthrottleApp)
+			}
+			delete(throttlerConfig.ThrottledApps, req.UnthrottleApp)
+		}
+		if appRule != nil {
+			if throttlerConfig.ThrottledApps == nil {
+				throttlerConfig.ThrottledApps = make(map[string]*topodatapb.ThrottledAppRule)
+			}
+			throttlerConfig.ThrottledApps[appRule.Name] = appRule
+		}
+		switch {
+		case req.Enable:
+			throttlerConfig.Enabled = true
+		case req.Disable:
+			throttlerConfig.Enabled = false
+		}
+		if req.Threshold > 0 {
+			throttlerConfig.Threshold = req.Threshold
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Throttler config of keyspace %s updated: %v", req.Keyspace, throttlerConfig)
+	return &vtctldatapb.UpdateThrottlerConfigResponse{ThrottlerConfig: throttlerConfig}, nil
+}
+
+// validateQueryRulesTarget checks that the query rules of a keyspace, shard
+// and tablet type can be managed.
+func (s *VtctldServer) validateQueryRulesTarget(ctx context.Context, keyspace, shard string, tabletType topodatapb.TabletType) error {
+	if keyspace == "" {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace field is required")
+	}
+	if shard == "" {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "shard field is required")
+	}
+	if !topo.IsRunningQueryService(tabletType) {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "tablet type %v does not serve queries", tabletType)
+	}
+	_, err := s.ts.GetShard(ctx, keyspace, shard)
+	return err
+}
+
+// updateQueryRules applies update to the query rules of a keyspace, shard
+// and tablet type, and saves them. The rules that expired are dropped.
+// The update is retried if the rules were changed concurrently.
+func (s *VtctldServer) updateQueryRules(ctx context.Context, keyspace, shard string, tabletType topodatapb.TabletType, update func(qrs *rules.Rules) error) error {
+	for {
+		data, version, err := s.ts.GetQueryRules(ctx, keyspace, shard, tabletType)
+		if err != nil {
+			return err
+		}
+		qrs := rules.New()
+		if data != nil {
+			if err := qrs.UnmarshalJSON(data); err != nil {
+				return vterrors.Wrapf(err, "bad query rules data for %s/%s %v", keyspace, shard, tabletType)
+			}
+		}
+		for _, qr := range qrs.DeleteExpired(time.Now()) {
+			log.Infof("Query rule %s of %s/%s %v expired at %v", qr.Name, keyspace, shard, tabletType, qr.ExpiresAt)
+		}
+		if err := update(qrs); err != nil {
+			return err
+		}
+		data, err = json.Marshal(qrs)
+		if err != nil {
+			return err
+		}
+
+		err = s.ts.UpdateQueryRules(ctx, keyspace, shard, tabletType, data, version)
+		switch {
+		case err == nil:
+			return nil
+		case topo.IsErrType(err, topo.BadVersion), topo.IsErrType(err, topo.NodeExists):
+			// The rules were changed concurrently, try again.
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			continue
+		default:
+			return err
+		}
+	}
+}
+
 // StartServer registers a VtctldServer for RPCs on the given gRPC server.
 func StartServer(s *grpc.Server, ts *topo.Server) {
 	vtctlservicepb.RegisterVtctldServer(s, NewVtctldServer(ts))
@@ -21,15 +21,23 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver/testutil"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 func TestFindAllShardsInKeyspace(t *testing.T) {
@@ -218,3 +226,173 @@ func TestGetKeyspaces(t *testing.T) {
 	_, err = vtctld.GetKeyspaces(ctx, &vtctldatapb.GetKeyspacesRequest{})
 	assert.Error(t, err)
 }
+
+func TestQueryRules(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	vtctld := NewVtctldServer(ts)
+
+	testutil.AddKeyspace(ctx, t, ts, &vtctldatapb.Keyspace{
+		Name:     "testkeyspace",
+		Keyspace: &topodatapb.Keyspace{},
+	})
+	_, err := ts.GetOrCreateShard(ctx, "testkeyspace", "-")
+	require.NoError(t, err)
+
+	getRules := func() *rules.Rules {
+		resp, err := vtctld.GetQueryRules(ctx, &vtctldatapb.GetQueryRulesRequest{
+			Keyspace:   "testkeyspace",
+			Shard:      "-",
+			TabletType: topodatapb.TabletType_REPLICA,
+		})
+		require.NoError(t, err)
+		qrs := rules.New()
+		require.NoError(t, qrs.UnmarshalJSON([]byte(resp.Rules)))
+		return qrs
+	}
+	addRule := func(rule string, ttl time.Duration, addedBy string) error {
+		req := &vtctldatapb.AddQueryRuleRequest{
+			Keyspace:   "testkeyspace",
+			Shard:      "-",
+			TabletType: topodatapb.TabletType_REPLICA,
+			Rule:       rule,
+			AddedBy:    addedBy,
+		}
+		if ttl != 0 {
+			req.Ttl = ptypes.DurationProto(ttl)
+		}
+		_, err := vtctld.AddQueryRule(ctx, req)
+		return err
+	}
+
+	assert.True(t, getRules().Equal(rules.New()))
+
+	start := time.Now().Truncate(time.Second)
+	require.NoError(t, addRule(`{"Name": "r1", "Query": "select.*", "Action": "FAIL"}`, time.Hour, "alice"))
+	require.NoError(t, addRule(`{"Name": "r2", "TableNames": ["t1"], "Action": "FAIL_RETRY"}`, 0, "bob"))
+
+	qrs := getRules()
+	r1 := qrs.Find("r1")
+	require.NotNil(t, r1)
+	assert.Equal(t, "alice", r1.AddedBy)
+	assert.False(t, r1.AddedAt.Before(start), "AddedAt: %v", r1.AddedAt)
+	assert.Equal(t, r1.AddedAt.Add(time.Hour), r1.ExpiresAt)
+	r2 := qrs.Find("r2")
+	require.NotNil(t, r2)
+	assert.Equal(t, "bob", r2.AddedBy)
+	assert.True(t, r2.ExpiresAt.IsZero())
+
+	// Names are unique, and an audit is required.
+	err = addRule(`{"Name": "r1", "Action": "FAIL"}`, 0, "alice")
+	assert.Equal(t, vtrpcpb.Code_ALREADY_EXISTS, vterrors.Code(err), "%v", err)
+	err = addRule(`{"Name": "r3", "Action": "FAIL"}`, 0, "")
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err), "%v", err)
+	err = addRule(`{"Action": "FAIL"}`, 0, "alice")
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err), "%v", err)
+	err = addRule(`{"Name": "r3", "Action": "FOO"}`, 0, "alice")
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err), "%v", err)
+
+	// The rules of other tablet types are separate.
+	resp, err := vtctld.GetQueryRules(ctx, &vtctldatapb.GetQueryRulesRequest{
+		Keyspace:   "testkeyspace",
+		Shard:      "-",
+		TabletType: topodatapb.TabletType_MASTER,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "[]", resp.Rules)
+	_, err = vtctld.GetQueryRules(ctx, &vtctldatapb.GetQueryRulesRequest{
+		Keyspace:   "testkeyspace",
+		Shard:      "-",
+		TabletType: topodatapb.TabletType_BACKUP,
+	})
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err), "%v", err)
+
+	_, err = vtctld.DeleteQueryRule(ctx, &vtctldatapb.DeleteQueryRuleRequest{
+		Keyspace:   "testkeyspace",
+		Shard:      "-",
+		TabletType: topodatapb.TabletType_REPLICA,
+		Name:       "r1",
+	})
+	require.NoError(t, err)
+	qrs = getRules()
+	assert.Nil(t, qrs.Find("r1"))
+	assert.NotNil(t, qrs.Find("r2"))
+
+	_, err = vtctld.DeleteQueryRule(ctx, &vtctldatapb.DeleteQueryRuleRequest{
+		Keyspace:   "testkeyspace",
+		Shard:      "-",
+		TabletType: topodatapb.TabletType_REPLICA,
+		Name:       "r1",
+	})
+	assert.Equal(t, vtrpcpb.Code_NOT_FOUND, vterrors.Code(err), "%v", err)
+}
+
+func TestThrottlerConfig(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	vtctld := NewVtctldServer(ts)
+
+	testutil.AddKeyspace(ctx, t, ts, &vtctldatapb.Keyspace{
+		Name:     "testkeyspace",
+		Keyspace: &topodatapb.Keyspace{},
+	})
+
+	getConfig := func() *topodatapb.ThrottlerConfig {
+		resp, err := vtctld.GetThrottlerConfig(ctx, &vtctldatapb.GetThrottlerConfigRequest{Keyspace: "testkeyspace"})
+		require.NoError(t, err)
+		return resp.ThrottlerConfig
+	}
+	update := func(req *vtctldatapb.UpdateThrottlerConfigRequest) error {
+		req.Keyspace = "testkeyspace"
+		_, err := vtctld.UpdateThrottlerConfig(ctx, req)
+		return err
+	}
+
+	assert.Nil(t, getConfig())
+	_, err := vtctld.GetThrottlerConfig(ctx, &vtctldatapb.GetThrottlerConfigRequest{Keyspace: "nokeyspace"})
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "%v", err)
+
+	// The first update creates an enabled config.
+	require.NoError(t, update(&vtctldatapb.UpdateThrottlerConfigRequest{Threshold: 2.5}))
+	assert.True(t, proto.Equal(&topodatapb.ThrottlerConfig{Enabled: true, Threshold: 2.5}, getConfig()), "%v", getConfig())
+
+	require.NoError(t, update(&vtctldatapb.UpdateThrottlerConfigRequest{Disable: true}))
+	assert.True(t, proto.Equal(&topodatapb.ThrottlerConfig{Enabled: false, Threshold: 2.5}, getConfig()), "%v", getConfig())
+
+	start := time.Now()
+	require.NoError(t, update(&vtctldatapb.UpdateThrottlerConfigRequest{Enable: true, ThrottleApp: "app1"}))
+	require.NoError(t, update(&vtctldatapb.UpdateThrottlerConfigRequest{ThrottleApp: "app2", ThrottleAppRatio: 0.5, ThrottleAppDuration: ptypes.DurationProto(time.Minute)}))
+	config := getConfig()
+	assert.True(t, config.Enabled)
+	require.Len(t, config.ThrottledApps, 2)
+	app1 := config.ThrottledApps["app1"]
+	assert.Equal(t, "app1", app1.Name)
+	assert.Equal(t, 1.0, app1.Ratio)
+	assert.WithinDuration(t, start.Add(time.Hour), logutil.ProtoToTime(app1.ExpiresAt), 10*time.Second)
+	app2 := config.ThrottledApps["app2"]
+	assert.Equal(t, 0.5, app2.Ratio)
+	assert.WithinDuration(t, start.Add(time.Minute), logutil.ProtoToTime(app2.ExpiresAt), 10*time.Second)
+
+	require.NoError(t, update(&vtctldatapb.UpdateThrottlerConfigRequest{UnthrottleApp: "app1"}))
+	config = getConfig()
+	require.Len(t, config.ThrottledApps, 1)
+	assert.NotNil(t, config.ThrottledApps["app2"])
+
+	err = update(&vtctldatapb.UpdateThrottlerConfigRequest{UnthrottleApp: "app1"})
+	assert.Equal(t, vtrpcpb.Code_NOT_FOUND, vterrors.Code(err), "%v", err)
+
+	invalid := []*vtctldatapb.UpdateThrottlerConfigRequest{
+		{},
+		{Enable: true, Disable: true},
+		{Threshold: -1},
+		{ThrottleApp: "app3", ThrottleAppRatio: 1.5},
+		{ThrottleApp: "app3", ThrottleAppDuration: ptypes.DurationProto(-time.Minute)},
+		{ThrottleApp: "app3", UnthrottleApp: "app3"},
+	}
+	for _, req := range invalid {
+		err := update(req)
+		assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err), "%v: %v", req, err)
+	}
+	_, err = vtctld.UpdateThrottlerConfig(ctx, &vtctldatapb.UpdateThrottlerConfigRequest{Keyspace: "nokeyspace", Enable: true})
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "%v", err)
+}
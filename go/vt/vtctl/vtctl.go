@@ -88,6 +88,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"math"
+	"os"
 	"sort"
 	"strconv"
 	"strings"
@@ -115,6 +116,7 @@ import (
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff"
 	"vitess.io/vitess/go/vt/wrangler"
 
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
@@ -328,8 +330,12 @@ var commands = []commandGroup{
 				"<from_keyspace> <to_keyspace> <tables>",
 				"Start the VerticalSplitClone process to perform vertical resharding. Example: SplitClone from_ks to_ks 'a,/b.*/'"},
 			{"VDiff", commandVDiff,
-				"[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=replica] [-filtered_replication_wait_time=30s] <keyspace.workflow>",
-				"Perform a diff of all tables in the workflow"},
+				"[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=replica] [-filtered_replication_wait_time=30s] [-repair_output=<file>|-] [-apply_repairs] <keyspace.workflow> [create|show|stop|resume] [<uuid>|last|all]",
+				"Perform a diff of all tables in the workflow.\n" +
+					"Without an action, the diff runs in this process and its report is printed at the end. With -repair_output, the INSERT, UPDATE and DELETE statements that make the target rows match the source rows are written to the file, or printed. With -apply_repairs, they are executed on the target masters.\n" +
+					"create starts a diff that runs on the target masters instead, and prints its uuid. The diff saves its progress, so it can be stopped and resumed, also across tablet restarts.\n" +
+					"show reports the progress, with an ETA, and the results of the diff with the uuid (default: last, or all).\n" +
+					"stop and resume stop and resume the diff with the uuid."},
 			{"MigrateServedTypes", commandMigrateServedTypes,
 				"[-cells=c1,c2,...] [-reverse] [-skip-refresh-state] [-filtered_replication_wait_time=30s] [-reverse_replication=false] <keyspace/shard> <served tablet type>",
 				"Migrates a serving type from the source shard to the shards that it replicates to. This command also rebuilds the serving graph. The <keyspace/shard> argument can specify any of the shards involved in the migration."},
@@ -340,8 +346,8 @@ var commands = []commandGroup{
 				"[-cells=c1,c2,...] [-reverse] -tablet_type={replica|rdonly} [-dry-run] <keyspace.workflow>",
 				"Switch read traffic for the specified workflow."},
 			{"SwitchWrites", commandSwitchWrites,
-				"[-timeout=30s] [-reverse] [-reverse_replication=true] [-dry-run] <keyspace.workflow>",
-				"Switch write traffic for the specified workflow."},
+				"[-timeout=30s] [-reverse] [-reverse_replication=true] [-dry-run] [-monitor_duration=0] [-monitor_interval=10s] [-max_error_rate=0] [-max_reverse_lag=0] [-failure_threshold=3] <keyspace.workflow>",
+				"Switch write traffic for the specified workflow. If -monitor_duration is set, the target masters and the reverse streams are monitored after the switch, and write traffic is automatically switched back if they're unhealthy."},
 			{"CancelResharding", commandCancelResharding,
 				"<keyspace/shard>",
 				"Permanently cancels a resharding in progress. All resharding related metadata will be deleted."},
@@ -2304,11 +2310,13 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 	maxRows := subFlags.Int64("limit", math.MaxInt64, "Max rows to stop comparing after")
 	format := subFlags.String("format", "", "Format of report") //"json" or ""
 	tables := subFlags.String("tables", "", "Only run vdiff for these tables in the workflow")
+	repairOutput := subFlags.String("repair_output", "", "Write the statements that make the target rows match the source rows to this file, or print them if '-'")
+	applyRepairs := subFlags.Bool("apply_repairs", false, "Execute the statements that make the target rows match the source rows on the target masters. The workflow stays stopped while a table is repaired")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
 
-	if subFlags.NArg() != 1 {
+	if subFlags.NArg() < 1 || subFlags.NArg() > 3 {
 		return fmt.Errorf("<keyspace.workflow> is required")
 	}
 	keyspace, workflow, err := splitKeyspaceWorkflow(subFlags.Arg(0))
@@ -2318,8 +2326,76 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 	if *maxRows <= 0 {
 		return fmt.Errorf("maximum number of rows to compare needs to be greater than 0")
 	}
+	if subFlags.NArg() > 1 {
+		action := strings.ToLower(subFlags.Arg(1))
+		vdiffUUID := subFlags.Arg(2)
+		switch action {
+		case vdiff.CreateAction:
+			if vdiffUUID != "" {
+				return fmt.Errorf("a uuid can't be specified for %s", action)
+			}
+		case vdiff.ShowAction:
+			if vdiffUUID == "" {
+				vdiffUUID = vdiff.LastVDiff
+			}
+		case vdiff.StopAction, vdiff.ResumeAction:
+			if vdiffUUID == "" {
+				return fmt.Errorf("the uuid of the vdiff is required for %s", action)
+			}
+		default:
+			return fmt.Errorf("invalid vdiff action: %s", action)
+		}
+		if *targetCell != "" {
+			return fmt.Errorf("-target_cell is not supported for %s: the diff runs on the target masters", action)
+		}
+		if *repairOutput != "" || *applyRepairs {
+			return fmt.Errorf("repairs are not supported for %s", action)
+		}
+		var tableList []string
+		if *tables != "" {
+			tableList = strings.Split(*tables, ",")
+		}
+		options := &tabletmanagerdatapb.VDiffOptions{
+			SourceCell:                         *sourceCell,
+			TabletTypes:                        *tabletTypes,
+			Tables:                             tableList,
+			FilteredReplicationWaitTimeSeconds: int64(filteredReplicationWaitTime.Seconds()),
+		}
+		if *maxRows != math.MaxInt64 {
+			options.MaxRows = *maxRows
+		}
+		vdiffUUID, summaries, err := wr.VDiffAction(ctx, keyspace, workflow, action, vdiffUUID, options)
+		if err != nil {
+			return err
+		}
+		switch action {
+		case vdiff.CreateAction:
+			wr.Logger().Printf("VDiff %s scheduled on the target shards, use show to view the progress\n", vdiffUUID)
+		case vdiff.ShowAction:
+			return printJSON(wr.Logger(), summaries)
+		default:
+			wr.Logger().Printf("VDiff %s: %s done\n", vdiffUUID, action)
+		}
+		return nil
+	}
+	var repair *wrangler.VDiffRepairOptions
+	if *repairOutput != "" || *applyRepairs {
+		repair = &wrangler.VDiffRepairOptions{Apply: *applyRepairs}
+		switch *repairOutput {
+		case "":
+		case "-":
+			repair.Output = logutil.NewLoggerWriter(wr.Logger())
+		default:
+			f, err := os.Create(*repairOutput)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			repair.Output = f
+		}
+	}
 	_, err = wr.
-		VDiff(ctx, keyspace, workflow, *sourceCell, *targetCell, *tabletTypes, *filteredReplicationWaitTime, *format, *maxRows, *tables)
+		VDiff(ctx, keyspace, workflow, *sourceCell, *targetCell, *tabletTypes, *filteredReplicationWaitTime, *format, *maxRows, *tables, repair)
 	if err != nil {
 		log.Errorf("vdiff returning with error: %v", err)
 		if strings.Contains(err.Error(), "context deadline exceeded") {
@@ -2486,6 +2562,11 @@ func commandSwitchWrites(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 	cancel := subFlags.Bool("cancel", false, "Cancel the failed migration and serve from source")
 	reverse := subFlags.Bool("reverse", false, "Reverse a previous SwitchWrites serve from source")
 	dryRun := subFlags.Bool("dry_run", false, "Does a dry run of SwitchWrites and only reports the actions to be taken")
+	monitorDuration := subFlags.Duration("monitor_duration", 0, "If set, monitors the cutover for this duration after switching writes, and switches them back if the target masters or the reverse streams are unhealthy. Requires reverse replication.")
+	monitorInterval := subFlags.Duration("monitor_interval", 10*time.Second, "Interval between two health checks of a monitored cutover")
+	maxErrorRate := subFlags.Float64("max_error_rate", 0, "Maximum rate of errors per second of a target master during a monitored cutover. 0 disables the check.")
+	maxReverseLag := subFlags.Duration("max_reverse_lag", 0, "Maximum replication lag of a reverse stream during a monitored cutover. 0 disables the check.")
+	failureThreshold := subFlags.Int("failure_threshold", 3, "Number of consecutive failed health checks that switch writes back during a monitored cutover")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -2501,6 +2582,28 @@ func commandSwitchWrites(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 		timeout = filteredReplicationWaitTime
 	}
 
+	if *monitorDuration > 0 {
+		if *cancel || *reverse || *dryRun || !*reverseReplication {
+			return fmt.Errorf("-monitor_duration can't be combined with -cancel, -reverse, -dry_run or -reverse_replication=false")
+		}
+		journalID, rolledBack, err := wr.GuardedSwitchWrites(ctx, keyspace, workflow, &wrangler.GuardedCutoverParams{
+			Timeout:          *timeout,
+			MonitorDuration:  *monitorDuration,
+			CheckInterval:    *monitorInterval,
+			MaxErrorRate:     *maxErrorRate,
+			MaxReverseLag:    *maxReverseLag,
+			FailureThreshold: *failureThreshold,
+		})
+		if err != nil {
+			return err
+		}
+		wr.Logger().Infof("Migration Journal ID: %v", journalID)
+		if rolledBack {
+			return fmt.Errorf("the cutover of workflow %s was unhealthy, and writes were switched back", workflow)
+		}
+		return nil
+	}
+
 	journalID, dryRunResults, err := wr.SwitchWrites(ctx, keyspace, workflow, *timeout, *cancel, *reverse, *reverseReplication, *dryRun)
 	if err != nil {
 		return err
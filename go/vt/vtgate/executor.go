@@ -1294,6 +1294,7 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 	}
 	ignoreMaxMemoryRows := sqlparser.IgnoreMaxMaxMemoryRowsDirective(stmt)
 	vcursor.SetIgnoreMaxMemoryRows(ignoreMaxMemoryRows)
+	vcursor.SetPriority(sqlparser.PriorityDirective(stmt))
 
 	planKey := vcursor.planPrefixKey() + ":" + sql
 	if plan, ok := e.plans.Get(planKey); ok {
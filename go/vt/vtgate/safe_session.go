@@ -44,6 +44,11 @@ type SafeSession struct {
 	// this is a signal that found_rows has already been handles by the primitives,
 	// and doesn't have to be updated by the executor
 	foundRowsHandled bool
+
+	// queryPriority is the priority of the query that is being executed.
+	// It's only set by the vcursor for the duration of an execution, and
+	// is not saved in the options of the session, see executeOptions.
+	queryPriority querypb.ExecuteOptions_Priority
 	*vtgatepb.Session
 }
 
@@ -524,6 +529,29 @@ func removeShard(tabletAlias *topodatapb.TabletAlias, sessions []*vtgatepb.Sessi
 	return append(sessions[:idx], sessions[idx+1:]...), nil
 }
 
+// setQueryPriority sets the priority of the query that is being executed.
+func (session *SafeSession) setQueryPriority(priority querypb.ExecuteOptions_Priority) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.queryPriority = priority
+}
+
+// executeOptions returns the options sent to the tablets: the options of
+// the session, with the priority of the query that is being executed.
+func (session *SafeSession) executeOptions() *querypb.ExecuteOptions {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.queryPriority == querypb.ExecuteOptions_NORMAL {
+		return session.Options
+	}
+	options := &querypb.ExecuteOptions{}
+	if session.Options != nil {
+		options = proto.Clone(session.Options).(*querypb.ExecuteOptions)
+	}
+	options.Priority = session.queryPriority
+	return options
+}
+
 // GetOrCreateOptions will return the current options struct, or create one and return it if no-one exists
 func (session *SafeSession) GetOrCreateOptions() *querypb.ExecuteOptions {
 	if session.Session.Options == nil {
@@ -201,7 +201,7 @@ func (stc *ScatterConn) ExecuteMultiShard(
 			reservedID := info.reservedID
 
 			if session != nil && session.Session != nil {
-				opts = session.Session.Options
+				opts = session.executeOptions()
 			}
 
 			if autocommit {
@@ -613,7 +613,7 @@ func (stc *ScatterConn) ExecuteLock(
 		return nil, vterrors.New(vtrpcpb.Code_INTERNAL, "session cannot be nil")
 	}
 
-	opts = session.Session.Options
+	opts = session.executeOptions()
 	info, err := lockInfo(rs.Target, session)
 	// Lock session is created on alphabetic sorted keyspace.
 	// This error will occur if the existing session target does not match the current target.
@@ -102,6 +102,9 @@ type vcursorImpl struct {
 	vschema               *vindexes.VSchema
 	vm                    VSchemaOperator
 	semTable              *semantics.SemTable
+	// priority is the priority of the current query. It's only sent
+	// to the tablets with the executions of this query.
+	priority querypb.ExecuteOptions_Priority
 }
 
 func (vc *vcursorImpl) GetKeyspace() string {
@@ -204,6 +207,12 @@ func (vc *vcursorImpl) SetIgnoreMaxMemoryRows(ignoreMaxMemoryRows bool) {
 	vc.ignoreMaxMemoryRows = ignoreMaxMemoryRows
 }
 
+// SetPriority sets the priority of the current query. Unlike the options
+// of the session, it doesn't apply to the next queries.
+func (vc *vcursorImpl) SetPriority(priority querypb.ExecuteOptions_Priority) {
+	vc.priority = priority
+}
+
 // SetContextTimeout updates context and sets a timeout.
 func (vc *vcursorImpl) SetContextTimeout(timeout time.Duration) context.CancelFunc {
 	ctx, cancel := context.WithTimeout(vc.ctx, timeout)
@@ -401,6 +410,8 @@ func (vc *vcursorImpl) Execute(method string, query string, bindVars map[string]
 // ExecuteMultiShard is part of the engine.VCursor interface.
 func (vc *vcursorImpl) ExecuteMultiShard(rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, rollbackOnError, autocommit bool) (*sqltypes.Result, []error) {
 	atomic.AddUint32(&vc.logStats.ShardQueries, uint32(len(queries)))
+	vc.safeSession.setQueryPriority(vc.priority)
+	defer vc.safeSession.setQueryPriority(querypb.ExecuteOptions_NORMAL)
 	qr, errs := vc.executor.ExecuteMultiShard(vc.ctx, rss, commentedShardQueries(queries, vc.marginComments), vc.safeSession, autocommit, vc.ignoreMaxMemoryRows)
 
 	if errs == nil && rollbackOnError {
@@ -430,6 +441,8 @@ func (vc *vcursorImpl) LookupRowLockShardSession() vtgatepb.CommitOrder {
 
 func (vc *vcursorImpl) ExecuteLock(rs *srvtopo.ResolvedShard, query *querypb.BoundQuery) (*sqltypes.Result, error) {
 	query.Sql = vc.marginComments.Leading + query.Sql + vc.marginComments.Trailing
+	vc.safeSession.setQueryPriority(vc.priority)
+	defer vc.safeSession.setQueryPriority(querypb.ExecuteOptions_NORMAL)
 	return vc.executor.ExecuteLock(vc.ctx, rs, query, vc.safeSession)
 }
 
@@ -449,14 +462,18 @@ func (vc *vcursorImpl) ExecuteStandalone(query string, bindVars map[string]*quer
 	}
 	// The autocommit flag is always set to false because we currently don't
 	// execute DMLs through ExecuteStandalone.
-	qr, errs := vc.executor.ExecuteMultiShard(vc.ctx, rss, bqs, NewAutocommitSession(vc.safeSession.Session), false /* autocommit */, vc.ignoreMaxMemoryRows)
+	session := NewAutocommitSession(vc.safeSession.Session)
+	session.setQueryPriority(vc.priority)
+	qr, errs := vc.executor.ExecuteMultiShard(vc.ctx, rss, bqs, session, false /* autocommit */, vc.ignoreMaxMemoryRows)
 	return qr, vterrors.Aggregate(errs)
 }
 
 // StreamExeculteMulti is the streaming version of ExecuteMultiShard.
 func (vc *vcursorImpl) StreamExecuteMulti(query string, rss []*srvtopo.ResolvedShard, bindVars []map[string]*querypb.BindVariable, callback func(reply *sqltypes.Result) error) error {
 	atomic.AddUint32(&vc.logStats.ShardQueries, uint32(len(rss)))
-	return vc.executor.StreamExecuteMulti(vc.ctx, vc.marginComments.Leading+query+vc.marginComments.Trailing, rss, bindVars, vc.safeSession.Options, callback)
+	vc.safeSession.setQueryPriority(vc.priority)
+	defer vc.safeSession.setQueryPriority(querypb.ExecuteOptions_NORMAL)
+	return vc.executor.StreamExecuteMulti(vc.ctx, vc.marginComments.Leading+query+vc.marginComments.Trailing, rss, bindVars, vc.safeSession.executeOptions(), callback)
 }
 
 // ExecuteKeyspaceID is part of the engine.VCursor interface.
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"time"
 
 	"context"
 
@@ -29,6 +30,7 @@ import (
 	"vitess.io/vitess/go/vt/log"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -53,8 +55,10 @@ type vstream struct {
 	vgtid     *binlogdatapb.VGtid
 	send      func(events []*binlogdatapb.VEvent) error
 	journaler map[int64]*journalEvent
+	// lastSent is the time of the last send.
+	lastSent time.Time
 
-	// err can only be set once.
+	// err can only be set once, and not after the stream was stopped.
 	once sync.Once
 	err  error
 
@@ -63,6 +67,13 @@ type vstream struct {
 	filter     *binlogdatapb.Filter
 	resolver   *srvtopo.Resolver
 
+	// heartbeatInterval is the time without events after which a
+	// HEARTBEAT event is sent. No heartbeats are sent if it's 0.
+	heartbeatInterval time.Duration
+	// stopOnReshard ends the stream when a reshard completes,
+	// instead of continuing with the new shards.
+	stopOnReshard bool
+
 	cancel context.CancelFunc
 	wg     sync.WaitGroup
 }
@@ -81,18 +92,28 @@ func newVStreamManager(resolver *srvtopo.Resolver, serv srvtopo.Server, cell str
 	}
 }
 
-func (vsm *vstreamManager) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, send func(events []*binlogdatapb.VEvent) error) error {
+func (vsm *vstreamManager) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags, send func(events []*binlogdatapb.VEvent) error) error {
 	vgtid, filter, err := vsm.resolveParams(ctx, tabletType, vgtid, filter)
 	if err != nil {
 		return err
 	}
+	if flags == nil {
+		flags = &vtgatepb.VStreamFlags{}
+	}
+	if flags.SendFieldsOnStart {
+		// The FIELD events are generated by the vstreamers of the tablets.
+		filter = proto.Clone(filter).(*binlogdatapb.Filter)
+		filter.SendFieldsOnStart = true
+	}
 	vs := &vstream{
-		vgtid:      vgtid,
-		tabletType: tabletType,
-		filter:     filter,
-		send:       send,
-		resolver:   vsm.resolver,
-		journaler:  make(map[int64]*journalEvent),
+		vgtid:             vgtid,
+		tabletType:        tabletType,
+		filter:            filter,
+		send:              send,
+		resolver:          vsm.resolver,
+		journaler:         make(map[int64]*journalEvent),
+		heartbeatInterval: time.Duration(flags.HeartbeatInterval) * time.Second,
+		stopOnReshard:     flags.StopOnReshard,
 	}
 	return vs.stream(ctx)
 }
@@ -161,15 +182,88 @@ func (vs *vstream) stream(ctx context.Context) error {
 	ctx, vs.cancel = context.WithCancel(ctx)
 	defer vs.cancel()
 
+	var heartbeatsDone chan struct{}
+	if vs.heartbeatInterval > 0 {
+		vs.lastSent = time.Now()
+		heartbeatsDone = make(chan struct{})
+		go func() {
+			defer close(heartbeatsDone)
+			vs.sendHeartbeats(ctx)
+		}()
+	}
+
 	// Make a copy first, because the ShardGtids list can change once streaming starts.
 	copylist := append(([]*binlogdatapb.ShardGtid)(nil), vs.vgtid.ShardGtids...)
 	for _, sgtid := range copylist {
 		vs.startOneStream(ctx, sgtid)
 	}
 	vs.wg.Wait()
+	if heartbeatsDone != nil {
+		// Nothing must be sent once the stream has returned.
+		vs.cancel()
+		<-heartbeatsDone
+	}
 	return vs.err
 }
 
+// sendHeartbeats sends a HEARTBEAT event whenever no events were
+// sent during the last heartbeat interval.
+func (vs *vstream) sendHeartbeats(ctx context.Context) {
+	timer := time.NewTimer(vs.heartbeatInterval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+		next, err := vs.sendHeartbeat()
+		if err != nil {
+			vs.setError(err)
+			return
+		}
+		timer.Reset(next)
+	}
+}
+
+// sendHeartbeat sends a HEARTBEAT event if nothing was sent during
+// the last heartbeat interval. It returns the time to wait before
+// the next check.
+func (vs *vstream) sendHeartbeat() (time.Duration, error) {
+	vs.mu.Lock()
+	defer vs.mu.Unlock()
+
+	if elapsed := time.Since(vs.lastSent); elapsed < vs.heartbeatInterval {
+		return vs.heartbeatInterval - elapsed, nil
+	}
+	now := time.Now()
+	if err := vs.send([]*binlogdatapb.VEvent{{
+		Type:        binlogdatapb.VEventType_HEARTBEAT,
+		Timestamp:   now.Unix(),
+		CurrentTime: now.UnixNano(),
+	}}); err != nil {
+		return 0, err
+	}
+	vs.lastSent = now
+	return vs.heartbeatInterval, nil
+}
+
+// setError ends the stream with err. The first error wins.
+func (vs *vstream) setError(err error) {
+	vs.once.Do(func() {
+		vs.err = err
+		vs.cancel()
+	})
+}
+
+// stop ends the stream without an error, unless one was set before.
+// The errors of the shard streams that end because of it are ignored.
+func (vs *vstream) stop() {
+	vs.once.Do(func() {
+		vs.cancel()
+	})
+}
+
 // startOneStream sets up one shard stream.
 func (vs *vstream) startOneStream(ctx context.Context, sgtid *binlogdatapb.ShardGtid) {
 	vs.wg.Add(1)
@@ -179,10 +273,7 @@ func (vs *vstream) startOneStream(ctx context.Context, sgtid *binlogdatapb.Shard
 
 		// Set the error on exit. First one wins.
 		if err != nil {
-			vs.once.Do(func() {
-				vs.err = err
-				vs.cancel()
-			})
+			vs.setError(err)
 		}
 	}()
 }
@@ -355,6 +446,7 @@ func (vs *vstream) sendAll(sgtid *binlogdatapb.ShardGtid, eventss [][]*binlogdat
 		if err := vs.send(events); err != nil {
 			return err
 		}
+		vs.lastSent = time.Now()
 	}
 	return nil
 }
@@ -447,6 +539,28 @@ func (vs *vstream) getJournalEvent(ctx context.Context, sgtid *binlogdatapb.Shar
 		newsgtids = append(newsgtids, cursgtid)
 	}
 
+	if vs.stopOnReshard {
+		// Instead of streaming from the new shards, let the client know
+		// about them, and end the stream.
+		newsgtids = append(newsgtids, je.journal.ShardGtids...)
+		vs.vgtid.ShardGtids = newsgtids
+		err := vs.send([]*binlogdatapb.VEvent{{
+			Type:    binlogdatapb.VEventType_JOURNAL,
+			Journal: je.journal,
+		}, {
+			Type:  binlogdatapb.VEventType_VGTID,
+			Vgtid: proto.Clone(vs.vgtid).(*binlogdatapb.VGtid),
+		}})
+		if err != nil {
+			vs.setError(err)
+		} else {
+			log.Infof("Stopping the stream on reshard: %v", je.journal.ShardGtids)
+			vs.stop()
+		}
+		close(je.done)
+		return je, nil
+	}
+
 	log.Infof("Adding shard gtids: %v", je.journal.ShardGtids)
 	for _, sgtid := range je.journal.ShardGtids {
 		newsgtids = append(newsgtids, sgtid)
@@ -24,6 +24,8 @@ import (
 	"context"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/discovery"
@@ -109,6 +111,35 @@ func TestVTGateExecute(t *testing.T) {
 	}
 }
 
+func TestVTGateExecutePriority(t *testing.T) {
+	createSandbox(KsTestUnsharded)
+	hcVTGateTest.Reset()
+	sbc := hcVTGateTest.AddTestTablet("aa", "1.1.1.1", 1001, KsTestUnsharded, "0", topodatapb.TabletType_MASTER, true, 1, nil)
+	session := &vtgatepb.Session{
+		Autocommit:   true,
+		TargetString: "@master",
+	}
+	session, _, err := rpcVTGate.Execute(context.Background(), session, "select /*vt+ PRIORITY=low */ id from t1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, querypb.ExecuteOptions_LOW, sbc.Options[0].GetPriority())
+	// the priority is not saved in the session
+	assert.Equal(t, querypb.ExecuteOptions_NORMAL, session.GetOptions().GetPriority())
+
+	// the priority only applies to the query with the directive
+	session, _, err = rpcVTGate.Execute(context.Background(), session, "select id from t1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, querypb.ExecuteOptions_NORMAL, sbc.Options[1].GetPriority())
+
+	// the other options of the session are kept
+	session.Options = &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_OLAP}
+	session, _, err = rpcVTGate.Execute(context.Background(), session, "select /*vt+ PRIORITY=low */ id from t1 union select id from t1", nil)
+	require.NoError(t, err)
+	require.Len(t, sbc.Options, 3)
+	assert.Equal(t, querypb.ExecuteOptions_LOW, sbc.Options[2].GetPriority())
+	assert.Equal(t, querypb.ExecuteOptions_OLAP, sbc.Options[2].GetWorkload())
+	assert.Equal(t, &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_OLAP}, session.Options)
+}
+
 func TestVTGateExecuteWithKeyspaceShard(t *testing.T) {
 	createSandbox(KsTestUnsharded)
 	hcVTGateTest.Reset()
@@ -22,9 +22,9 @@ package topocustomrule
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
-	"reflect"
 	"sync"
 	"time"
 
@@ -33,21 +33,39 @@ import (
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 var (
 	// Commandline flag to specify rule cell and path.
 	ruleCell = flag.String("topocustomrule_cell", "global", "topo cell for customrules file.")
 	rulePath = flag.String("topocustomrule_path", "", "path for customrules file. Disabled if empty.")
+
+	managedRules = flag.Bool("topocustomrule_managed_rules", false, "watch the query rules managed by vtctld for the keyspace, shard and tablet type of the tablet.")
 )
 
 // topoCustomRuleSource is topo based custom rule source name
 const topoCustomRuleSource string = "TOPO_CUSTOM_RULE"
 
+// topoManagedRuleSource is the rule source name of the rules managed by vtctld.
+const topoManagedRuleSource string = "TOPO_MANAGED_RULE"
+
 // sleepDuringTopoFailure is how long to sleep before retrying in case of error.
 // (it's a var not a const so the test can change the value).
 var sleepDuringTopoFailure = 30 * time.Second
 
+// pathCheckInterval is how often we check whether the rules file exists,
+// or was moved by a change of the tablet type.
+// (it's a var not a const so the test can change the value).
+var pathCheckInterval = 5 * time.Second
+
+// errPathChanged is returned by a watch when the path of the rules changed.
+var errPathChanged = errors.New("path of the rules changed")
+
+// errNoPath is returned by a watch when the path of the rules isn't known yet.
+var errNoPath = errors.New("path of the rules is not known yet")
+
 // topoCustomRule is the topo backed implementation.
 type topoCustomRule struct {
 	// qsc is set at construction time.
@@ -56,8 +74,11 @@ type topoCustomRule struct {
 	// conn is the topo connection. Set at construction time.
 	conn topo.Conn
 
-	// filePath is the file to read from.
-	filePath string
+	// source is the name of the rule source.
+	source string
+
+	// filePath returns the file to read from, or "" if it isn't known yet.
+	filePath func() string
 
 	// qrs is the current rule set that we read.
 	qrs *rules.Rules
@@ -80,16 +101,36 @@ func newTopoCustomRule(qsc tabletserver.Controller, cell, filePath string) (*top
 	return &topoCustomRule{
 		qsc:      qsc,
 		conn:     conn,
-		filePath: filePath,
+		source:   topoCustomRuleSource,
+		filePath: func() string { return filePath },
+	}, nil
+}
+
+// newTopoManagedRule watches the rules managed by vtctld for the current
+// keyspace, shard and tablet type of the tablet.
+func newTopoManagedRule(qsc tabletserver.Controller) (*topoCustomRule, error) {
+	conn, err := qsc.TopoServer().ConnForCell(context.Background(), topo.GlobalCell)
+	if err != nil {
+		return nil, err
+	}
+	return &topoCustomRule{
+		qsc:    qsc,
+		conn:   conn,
+		source: topoManagedRuleSource,
+		filePath: func() string {
+			target := qsc.CurrentTarget()
+			if target.Keyspace == "" || target.TabletType == topodatapb.TabletType_UNKNOWN {
+				return ""
+			}
+			return topo.QueryRulesFile(target.Keyspace, target.Shard, target.TabletType)
+		},
 	}, nil
 }
 
 func (cr *topoCustomRule) start() {
 	go func() {
 		for {
-			if err := cr.oneWatch(); err != nil {
-				log.Warningf("Background watch of topo custom rule failed: %v", err)
-			}
+			err := cr.oneWatch()
 
 			cr.mu.Lock()
 			stopped := cr.stopped
@@ -100,6 +141,17 @@ func (cr *topoCustomRule) start() {
 				return
 			}
 
+			switch {
+			case err == errPathChanged:
+				// Watch the new path right away.
+				continue
+			case err == errNoPath || topo.IsErrType(err, topo.NoNode):
+				// There are no rules for now.
+				time.Sleep(pathCheckInterval)
+				continue
+			}
+
+			log.Warningf("Background watch of topo custom rule failed: %v", err)
 			log.Warningf("Sleeping for %v before trying again", sleepDuringTopoFailure)
 			time.Sleep(sleepDuringTopoFailure)
 		}
@@ -121,13 +173,18 @@ func (cr *topoCustomRule) apply(wd *topo.WatchData) error {
 		return fmt.Errorf("error unmarshaling query rules: %v, original data '%s' version %v", err, wd.Contents, wd.Version)
 	}
 
-	if !reflect.DeepEqual(cr.qrs, qrs) {
+	cr.setRules(qrs)
+	log.Infof("Custom rule version %v fetched from topo", wd.Version)
+	return nil
+}
+
+// setRules applies the rules to the tablet, if they changed.
+func (cr *topoCustomRule) setRules(qrs *rules.Rules) {
+	if cr.qrs == nil || !cr.qrs.Equal(qrs) {
 		cr.qrs = qrs.Copy()
-		cr.qsc.SetQueryRules(topoCustomRuleSource, qrs)
-		log.Infof("Custom rule version %v fetched from topo and applied to vttablet", wd.Version)
+		cr.qsc.SetQueryRules(cr.source, qrs)
+		log.Infof("Custom rules of %s applied to vttablet", cr.source)
 	}
-
-	return nil
 }
 
 func (cr *topoCustomRule) oneWatch() error {
@@ -138,9 +195,18 @@ func (cr *topoCustomRule) oneWatch() error {
 		cr.mu.Unlock()
 	}()
 
+	filePath := cr.filePath()
+	if filePath == "" {
+		return errNoPath
+	}
+
 	ctx := context.Background()
-	current, wdChannel, cancel := cr.conn.Watch(ctx, cr.filePath)
+	current, wdChannel, cancel := cr.conn.Watch(ctx, filePath)
 	if current.Err != nil {
+		if topo.IsErrType(current.Err, topo.NoNode) {
+			// The rules were deleted, or never created.
+			cr.setRules(rules.New())
+		}
 		return current.Err
 	}
 
@@ -164,25 +230,42 @@ func (cr *topoCustomRule) oneWatch() error {
 		return err
 	}
 
-	for wd := range wdChannel {
-		if wd.Err != nil {
-			// Last error value, we're done.
-			// wdChannel will be closed right after
-			// this, no need to do anything.
-			return wd.Err
-		}
+	ticker := time.NewTicker(pathCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case wd, ok := <-wdChannel:
+			if !ok {
+				return fmt.Errorf("watch terminated with no error")
+			}
+			if wd.Err != nil {
+				// Last error value, we're done.
+				// wdChannel will be closed right after
+				// this, no need to do anything.
+				if topo.IsErrType(wd.Err, topo.NoNode) {
+					cr.setRules(rules.New())
+				}
+				return wd.Err
+			}
 
-		if err := cr.apply(wd); err != nil {
-			// Cancel the watch, drain channel.
+			if err := cr.apply(wd); err != nil {
+				// Cancel the watch, drain channel.
+				cancel()
+				for range wdChannel {
+				}
+				return err
+			}
+		case <-ticker.C:
+			if cr.filePath() == filePath {
+				continue
+			}
+			// The tablet type changed: cancel the watch, drain channel.
 			cancel()
 			for range wdChannel {
 			}
-			return err
+			return errPathChanged
 		}
-
 	}
-
-	return fmt.Errorf("watch terminated with no error")
 }
 
 // activateTopoCustomRules activates topo dynamic custom rule mechanism.
@@ -196,6 +279,17 @@ func activateTopoCustomRules(qsc tabletserver.Controller) {
 		}
 		cr.start()
 
+		servenv.OnTerm(cr.stop)
+	}
+	if *managedRules {
+		qsc.RegisterQueryRuleSource(topoManagedRuleSource)
+
+		cr, err := newTopoManagedRule(qsc)
+		if err != nil {
+			log.Fatalf("cannot start TopoCustomRule for managed rules: %v", err)
+		}
+		cr.start()
+
 		servenv.OnTerm(cr.stop)
 	}
 }
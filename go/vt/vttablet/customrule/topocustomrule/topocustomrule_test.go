@@ -21,9 +21,13 @@ import (
 	"testing"
 	"time"
 
+	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletservermock"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 var customRule1 = `
@@ -49,10 +53,10 @@ var customRule2 = `
   }
 ]`
 
-func waitForValue(t *testing.T, qsc *tabletservermock.Controller, expected *rules.Rules) {
+func waitForValue(t *testing.T, qsc *tabletservermock.Controller, source string, expected *rules.Rules) {
 	start := time.Now()
 	for {
-		val := qsc.GetQueryRules(topoCustomRuleSource)
+		val := qsc.GetQueryRules(source)
 		if val != nil {
 			if val.Equal(expected) {
 				return
@@ -99,11 +103,64 @@ func TestUpdate(t *testing.T) {
 	if _, err := conn.Create(ctx, filePath, []byte(customRule1)); err != nil {
 		t.Fatalf("conn.Create failed: %v", err)
 	}
-	waitForValue(t, qsc, custom1)
+	waitForValue(t, qsc, topoCustomRuleSource, custom1)
 
 	// update the value, wait until we get it.
 	if _, err := conn.Update(ctx, filePath, []byte(customRule2), nil); err != nil {
 		t.Fatalf("conn.Update failed: %v", err)
 	}
-	waitForValue(t, qsc, custom2)
+	waitForValue(t, qsc, topoCustomRuleSource, custom2)
+}
+
+func TestManagedRules(t *testing.T) {
+	custom1 := rules.New()
+	if err := custom1.UnmarshalJSON([]byte(customRule1)); err != nil {
+		t.Fatalf("error unmarshaling customRule1: %v", err)
+	}
+	custom2 := rules.New()
+	if err := custom2.UnmarshalJSON([]byte(customRule2)); err != nil {
+		t.Fatalf("error unmarshaling customRule2: %v", err)
+	}
+
+	ts := memorytopo.NewServer("cell1")
+	qsc := tabletservermock.NewController()
+	qsc.TS = ts
+	sleepDuringTopoFailure = time.Millisecond
+	pathCheckInterval = time.Millisecond
+	ctx := context.Background()
+
+	cr, err := newTopoManagedRule(qsc)
+	if err != nil {
+		t.Fatalf("newTopoManagedRule failed: %v", err)
+	}
+	cr.start()
+	defer cr.stop()
+
+	// The rules are only watched once the target is known.
+	if err := ts.UpdateQueryRules(ctx, "ks", "0", topodatapb.TabletType_REPLICA, []byte(customRule1), nil); err != nil {
+		t.Fatalf("UpdateQueryRules failed: %v", err)
+	}
+	if err := ts.UpdateQueryRules(ctx, "ks", "0", topodatapb.TabletType_MASTER, []byte(customRule2), nil); err != nil {
+		t.Fatalf("UpdateQueryRules failed: %v", err)
+	}
+	if err := qsc.InitDBConfig(querypb.Target{Keyspace: "ks", Shard: "0", TabletType: topodatapb.TabletType_REPLICA}, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	waitForValue(t, qsc, topoManagedRuleSource, custom1)
+
+	// The rules of the new tablet type are applied.
+	if err := qsc.SetServingType(topodatapb.TabletType_MASTER, time.Time{}, true, ""); err != nil {
+		t.Fatal(err)
+	}
+	waitForValue(t, qsc, topoManagedRuleSource, custom2)
+
+	// The rules are cleared when their file is deleted.
+	conn, err := ts.ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		t.Fatalf("ConnForCell failed: %v", err)
+	}
+	if err := conn.Delete(ctx, topo.QueryRulesFile("ks", "0", topodatapb.TabletType_MASTER), nil); err != nil {
+		t.Fatalf("conn.Delete failed: %v", err)
+	}
+	waitForValue(t, qsc, topoManagedRuleSource, rules.New())
 }
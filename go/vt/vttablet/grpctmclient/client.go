@@ -589,6 +589,16 @@ func (client *Client) VExec(ctx context.Context, tablet *topodatapb.Tablet, quer
 	return response.Result, nil
 }
 
+// VDiff is part of the tmclient.TabletManagerClient interface.
+func (client *Client) VDiff(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	return c.VDiff(ctx, req)
+}
+
 // VReplicationExec is part of the tmclient.TabletManagerClient interface.
 func (client *Client) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
 	cc, c, err := client.dial(tablet)
@@ -800,15 +810,16 @@ func (e *backupStreamAdapter) Recv() (*logutilpb.Event, error) {
 }
 
 // Backup is part of the tmclient.TabletManagerClient interface.
-func (client *Client) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster bool) (logutil.EventStream, error) {
+func (client *Client) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster bool, incrementalFromPos string) (logutil.EventStream, error) {
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return nil, err
 	}
 
 	stream, err := c.Backup(ctx, &tabletmanagerdatapb.BackupRequest{
-		Concurrency: int64(concurrency),
-		AllowMaster: bool(allowMaster),
+		Concurrency:        int64(concurrency),
+		AllowMaster:        bool(allowMaster),
+		IncrementalFromPos: incrementalFromPos,
 	})
 	if err != nil {
 		cc.Close()
@@ -835,13 +846,19 @@ func (e *restoreFromBackupStreamAdapter) Recv() (*logutilpb.Event, error) {
 }
 
 // RestoreFromBackup is part of the tmclient.TabletManagerClient interface.
-func (client *Client) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
+func (client *Client) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, restoreToPos string, restoreToTimestamp time.Time) (logutil.EventStream, error) {
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return nil, err
 	}
 
-	stream, err := c.RestoreFromBackup(ctx, &tabletmanagerdatapb.RestoreFromBackupRequest{})
+	req := &tabletmanagerdatapb.RestoreFromBackupRequest{
+		RestoreToPos: restoreToPos,
+	}
+	if !restoreToTimestamp.IsZero() {
+		req.RestoreToTimestamp = logutil.TimeToProto(restoreToTimestamp)
+	}
+	stream, err := c.RestoreFromBackup(ctx, req)
 	if err != nil {
 		cc.Close()
 		return nil, err
@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"flag"
+	"sync"
+	"time"
+
+	"context"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var (
+	binlogArchiveInterval    = flag.Duration("binlog_archive_interval", 0, "if greater than 0, the master archives its binary logs to the BackupStorage at this interval, as incremental backups. This allows restoring to any point in time with RestoreFromBackup -restore_to_pos or -restore_to_timestamp. Requires a full backup of the shard to exist.")
+	binlogArchiveConcurrency = flag.Int("binlog_archive_concurrency", 2, "how many binary logs to archive at once")
+
+	statsBinlogArchives = stats.NewCountersWithSingleLabel("BinlogArchives", "Number of binary log archiving attempts, by result", "result")
+)
+
+// binlogArchiver periodically archives the binary logs of the master
+// to the BackupStorage, as incremental backups. Only the master archives,
+// so there is a single, continuous chain of archives for the shard.
+type binlogArchiver struct {
+	ctx   context.Context
+	tm    *TabletManager
+	ticks *timer.Timer
+
+	// mu protects cancel, which cancels the archive in progress, if any.
+	mu     sync.Mutex
+	cancel context.CancelFunc
+}
+
+func newBinlogArchiver(ctx context.Context, tm *TabletManager, interval time.Duration) *binlogArchiver {
+	return &binlogArchiver{
+		ctx:   ctx,
+		tm:    tm,
+		ticks: timer.NewTimer(interval),
+	}
+}
+
+// SetTabletType starts archiving if the tablet is a master,
+// and stops it otherwise.
+func (ba *binlogArchiver) SetTabletType(tabletType topodatapb.TabletType) {
+	if ba.ticks.Interval() <= 0 || ba.tm.Cnf == nil {
+		return
+	}
+	if tabletType != topodatapb.TabletType_MASTER {
+		if !ba.ticks.Running() {
+			return
+		}
+		// Don't let an archive in progress delay the transition.
+		ba.mu.Lock()
+		if ba.cancel != nil {
+			ba.cancel()
+		}
+		ba.mu.Unlock()
+		ba.ticks.Stop()
+		log.Info("Binlog archiver: stopped")
+		return
+	}
+	if ba.ticks.Running() {
+		return
+	}
+	log.Info("Binlog archiver: starting")
+	ba.ticks.Start(ba.archive)
+}
+
+// archive takes an incremental backup of the binary logs written since
+// the last backup of the shard.
+func (ba *binlogArchiver) archive() {
+	// Skip this round if a backup is already running.
+	if err := ba.tm.beginBackup(backupModeOnline); err != nil {
+		statsBinlogArchives.Add("Skipped", 1)
+		return
+	}
+	defer ba.tm.endBackup(backupModeOnline)
+
+	ctx, cancel := context.WithCancel(ba.ctx)
+	defer cancel()
+	ba.mu.Lock()
+	ba.cancel = cancel
+	ba.mu.Unlock()
+	defer func() {
+		ba.mu.Lock()
+		ba.cancel = nil
+		ba.mu.Unlock()
+	}()
+
+	tablet := ba.tm.Tablet()
+	err := mysqlctl.Backup(ctx, mysqlctl.BackupParams{
+		Cnf:          ba.tm.Cnf,
+		Mysqld:       ba.tm.MysqlDaemon,
+		Logger:       logutil.NewConsoleLogger(),
+		Concurrency:  *binlogArchiveConcurrency,
+		HookExtraEnv: ba.tm.hookExtraEnv(),
+		TopoServer:   ba.tm.TopoServer,
+		Keyspace:     tablet.Keyspace,
+		Shard:        tablet.Shard,
+		TabletAlias:  topoproto.TabletAliasString(tablet.Alias),
+		BackupTime:   time.Now(),
+
+		IncrementalFromPos: mysqlctl.IncrementalFromPosAuto,
+	})
+	switch err {
+	case nil:
+		statsBinlogArchives.Add("Success", 1)
+	case mysqlctl.ErrNoNewTransactions:
+		statsBinlogArchives.Add("Skipped", 1)
+	default:
+		statsBinlogArchives.Add("Error", 1)
+		log.Errorf("Binlog archiver: failed to archive binary logs: %v", err)
+	}
+}
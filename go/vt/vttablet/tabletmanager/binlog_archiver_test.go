@@ -0,0 +1,54 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"testing"
+	"time"
+
+	"context"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/vt/mysqlctl"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestBinlogArchiverSetTabletType(t *testing.T) {
+	tm := &TabletManager{}
+
+	// A zero interval disables archiving.
+	tm.binlogArchiver = newBinlogArchiver(context.Background(), tm, 0)
+	tm.binlogArchiver.SetTabletType(topodatapb.TabletType_MASTER)
+	assert.False(t, tm.binlogArchiver.ticks.Running())
+
+	// So does not managing mysqld.
+	tm.binlogArchiver = newBinlogArchiver(context.Background(), tm, time.Hour)
+	tm.binlogArchiver.SetTabletType(topodatapb.TabletType_MASTER)
+	assert.False(t, tm.binlogArchiver.ticks.Running())
+
+	// Only the master archives.
+	tm.Cnf = &mysqlctl.Mycnf{}
+	tm.binlogArchiver.SetTabletType(topodatapb.TabletType_REPLICA)
+	assert.False(t, tm.binlogArchiver.ticks.Running())
+	tm.binlogArchiver.SetTabletType(topodatapb.TabletType_MASTER)
+	assert.True(t, tm.binlogArchiver.ticks.Running())
+	tm.binlogArchiver.SetTabletType(topodatapb.TabletType_MASTER)
+	assert.True(t, tm.binlogArchiver.ticks.Running())
+	tm.binlogArchiver.SetTabletType(topodatapb.TabletType_REPLICA)
+	assert.False(t, tm.binlogArchiver.ticks.Running())
+}
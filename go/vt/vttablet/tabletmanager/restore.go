@@ -53,6 +53,8 @@ var (
 	restoreFromBackup     = flag.Bool("restore_from_backup", false, "(init restore parameter) will check BackupStorage for a recent backup at startup and start there")
 	restoreConcurrency    = flag.Int("restore_concurrency", 4, "(init restore parameter) how many concurrent files to restore at once")
 	waitForBackupInterval = flag.Duration("wait_for_backup_interval", 0, "(init restore parameter) if this is greater than 0, instead of starting up empty when no backups are found, keep checking at this interval for a backup to appear")
+	restoreToPos          = flag.String("restore_to_pos", "", "(init restore parameter) if set, restore the last full backup before this GTID position, then apply incremental backups up to it. The tablet does not replicate afterwards and is left DRAINED.")
+	restoreToTimestamp    = flag.String("restore_to_timestamp", "", "(init restore parameter) if set, restore the last full backup before this time (RFC 3339 format), then apply incremental backups up to it. The tablet does not replicate afterwards and is left DRAINED.")
 
 	// Flags for PITR
 	binlogHost           = flag.String("binlog_host", "", "PITR restore parameter: hostname/IP of binlog server.")
@@ -78,10 +80,20 @@ func (tm *TabletManager) RestoreData(ctx context.Context, logger logutil.Logger,
 	if tm.Cnf == nil {
 		return fmt.Errorf("cannot perform restore without my.cnf, please restart vttablet with a my.cnf file specified")
 	}
-	return tm.restoreDataLocked(ctx, logger, waitForBackupInterval, deleteBeforeRestore)
+	var toTime time.Time
+	if *restoreToTimestamp != "" {
+		var err error
+		if toTime, err = time.Parse(time.RFC3339, *restoreToTimestamp); err != nil {
+			return vterrors.Wrapf(err, "invalid -restore_to_timestamp %q", *restoreToTimestamp)
+		}
+	}
+	return tm.restoreDataLocked(ctx, logger, waitForBackupInterval, deleteBeforeRestore, *restoreToPos, toTime)
 }
 
-func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.Logger, waitForBackupInterval time.Duration, deleteBeforeRestore bool) error {
+// restoreDataLocked restores the tablet from backup. If restoreToPos or
+// restoreToTimestamp is set, it restores the tablet to that point in time
+// using incremental backups, and leaves it DRAINED.
+func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.Logger, waitForBackupInterval time.Duration, deleteBeforeRestore bool, restoreToPos string, restoreToTimestamp time.Time) error {
 
 	tablet := tm.Tablet()
 	originalType := tablet.Type
@@ -118,7 +130,14 @@ func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.L
 		Keyspace:            keyspace,
 		Shard:               tablet.Shard,
 		StartTime:           logutil.ProtoToTime(keyspaceInfo.SnapshotTime),
+		RestoreToTimestamp:  restoreToTimestamp,
 	}
+	if restoreToPos != "" {
+		if params.RestoreToPos, err = mysql.DecodePosition(restoreToPos); err != nil {
+			return vterrors.Wrapf(err, "invalid restore position %q", restoreToPos)
+		}
+	}
+	pointInTime := !params.RestoreToPos.IsZero() || !params.RestoreToTimestamp.IsZero()
 
 	// Check whether we're going to restore before changing to RESTORE type,
 	// so we keep our MasterTermStartTime (if any) if we aren't actually restoring.
@@ -174,6 +193,12 @@ func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.L
 	case nil:
 		// Starting from here we won't be able to recover if we get stopped by a cancelled
 		// context. Thus we use the background context to get through to the finish.
+		if pointInTime {
+			// Replicating would move us past the point we restored to, and
+			// serving would expose old data: leave the tablet DRAINED.
+			log.Infof("Restored to point in time at position %v, not starting replication", pos)
+			return tm.tmState.ChangeTabletType(ctx, topodatapb.TabletType_DRAINED, DBActionNone)
+		}
 		if keyspaceInfo.KeyspaceType == topodatapb.KeyspaceType_NORMAL {
 			// Reconnect to master only for "NORMAL" keyspaces
 			if err := tm.startReplication(context.Background(), pos, originalType); err != nil {
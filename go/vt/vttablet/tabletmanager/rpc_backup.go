@@ -35,8 +35,10 @@ const (
 	backupModeOffline = "offline"
 )
 
-// Backup takes a db backup and sends it to the BackupStorage
-func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowMaster bool) error {
+// Backup takes a db backup and sends it to the BackupStorage.
+// If incrementalFromPos is set, only the binary logs since that position
+// are backed up, which doesn't require draining the tablet.
+func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowMaster bool, incrementalFromPos string) error {
 	if tm.Cnf == nil {
 		return fmt.Errorf("cannot perform backup without my.cnf, please restart vttablet with a my.cnf file specified")
 	}
@@ -53,6 +55,7 @@ func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger log
 	if err != nil {
 		return vterrors.Wrap(err, "failed to find backup engine")
 	}
+	shouldDrain := engine.ShouldDrainForBackup() && incrementalFromPos == ""
 	// get Tablet info from topo so that it is up to date
 	tablet, err := tm.TopoServer.GetTablet(ctx, tm.tabletAlias)
 	if err != nil {
@@ -64,7 +67,7 @@ func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger log
 
 	// prevent concurrent backups, and record stats
 	backupMode := backupModeOnline
-	if engine.ShouldDrainForBackup() {
+	if shouldDrain {
 		backupMode = backupModeOffline
 	}
 	if err := tm.beginBackup(backupMode); err != nil {
@@ -73,7 +76,7 @@ func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger log
 	defer tm.endBackup(backupMode)
 
 	var originalType topodatapb.TabletType
-	if engine.ShouldDrainForBackup() {
+	if shouldDrain {
 		if err := tm.lock(ctx); err != nil {
 			return err
 		}
@@ -104,11 +107,13 @@ func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger log
 		Shard:        tablet.Shard,
 		TabletAlias:  topoproto.TabletAliasString(tablet.Alias),
 		BackupTime:   time.Now(),
+
+		IncrementalFromPos: incrementalFromPos,
 	}
 
 	returnErr := mysqlctl.Backup(ctx, backupParams)
 
-	if engine.ShouldDrainForBackup() {
+	if shouldDrain {
 		bgCtx := context.Background()
 		// Starting from here we won't be able to recover if we get stopped by a cancelled
 		// context. It is also possible that the context already timed out during the
@@ -130,7 +135,10 @@ func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger log
 }
 
 // RestoreFromBackup deletes all local data and restores anew from the latest backup.
-func (tm *TabletManager) RestoreFromBackup(ctx context.Context, logger logutil.Logger) error {
+// If restoreToPos or restoreToTimestamp is set, it restores from the latest backup
+// before that point in time instead, and applies the binary logs archived in
+// incremental backups up to it.
+func (tm *TabletManager) RestoreFromBackup(ctx context.Context, logger logutil.Logger, restoreToPos string, restoreToTimestamp time.Time) error {
 	if err := tm.lock(ctx); err != nil {
 		return err
 	}
@@ -148,7 +156,7 @@ func (tm *TabletManager) RestoreFromBackup(ctx context.Context, logger logutil.L
 	l := logutil.NewTeeLogger(logutil.NewConsoleLogger(), logger)
 
 	// now we can run restore
-	err = tm.restoreDataLocked(ctx, l, 0 /* waitForBackupInterval */, true /* deleteBeforeRestore */)
+	err = tm.restoreDataLocked(ctx, l, 0 /* waitForBackupInterval */, true /* deleteBeforeRestore */, restoreToPos, restoreToTimestamp)
 
 	// re-run health check to be sure to capture any replication delay
 	tm.QueryServiceControl.BroadcastHealth()
@@ -61,6 +61,7 @@ import (
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff"
 	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver"
 
@@ -128,6 +129,7 @@ type TabletManager struct {
 	QueryServiceControl tabletserver.Controller
 	UpdateStream        binlog.UpdateStreamControl
 	VREngine            *vreplication.Engine
+	VDiffEngine         *vdiff.Engine
 
 	// tmState manages the TabletManager state.
 	tmState *tmState
@@ -135,6 +137,9 @@ type TabletManager struct {
 	// replManager manages replication.
 	replManager *replManager
 
+	// binlogArchiver archives the binary logs of the master.
+	binlogArchiver *binlogArchiver
+
 	// tabletAlias is saved away from tablet for read-only access
 	tabletAlias *topodatapb.TabletAlias
 
@@ -232,6 +237,7 @@ func BuildTabletFromInput(alias *topodatapb.TabletAlias, port, grpcPort int32) (
 func (tm *TabletManager) Start(tablet *topodatapb.Tablet, healthCheckInterval time.Duration) error {
 	tm.DBConfigs.DBName = topoproto.TabletDbName(tablet)
 	tm.replManager = newReplManager(tm.BatchCtx, tm, healthCheckInterval)
+	tm.binlogArchiver = newBinlogArchiver(tm.BatchCtx, tm, *binlogArchiveInterval)
 	tm.tabletAlias = tablet.Alias
 	tm.tmState = newTMState(tm, tablet)
 	tm.actionSema = sync2.NewSemaphore(1, 0)
@@ -282,6 +288,11 @@ func (tm *TabletManager) Start(tablet *topodatapb.Tablet, healthCheckInterval ti
 		servenv.OnTerm(tm.VREngine.Close)
 	}
 
+	if tm.VDiffEngine != nil {
+		tm.VDiffEngine.InitDBConfig(tm.DBConfigs)
+		servenv.OnTerm(tm.VDiffEngine.Close)
+	}
+
 	// The following initializations don't need to be done
 	// in any specific order.
 	tm.startShardSync()
@@ -353,6 +364,10 @@ func (tm *TabletManager) Stop() {
 		tm.UpdateStream.Disable()
 	}
 
+	if tm.VDiffEngine != nil {
+		tm.VDiffEngine.Close()
+	}
+
 	if tm.VREngine != nil {
 		tm.VREngine.Close()
 	}
@@ -232,6 +232,7 @@ func (ts *tmState) updateLocked(ctx context.Context) {
 	}
 
 	ts.tm.replManager.SetTabletType(ts.tablet.Type)
+	ts.tm.binlogArchiver.SetTabletType(ts.tablet.Type)
 
 	if ts.tm.UpdateStream != nil {
 		if topo.IsRunningUpdateStream(ts.tablet.Type) {
@@ -241,6 +242,12 @@ func (ts *tmState) updateLocked(ctx context.Context) {
 		}
 	}
 
+	// The vdiffs use the vreplication streams, so the VDiffEngine
+	// is closed before the VREngine, and opened after it.
+	if ts.tm.VDiffEngine != nil && ts.tablet.Type != topodatapb.TabletType_MASTER {
+		ts.tm.VDiffEngine.Close()
+	}
+
 	if ts.tm.VREngine != nil {
 		if ts.tablet.Type == topodatapb.TabletType_MASTER {
 			ts.tm.VREngine.Open(ts.tm.BatchCtx)
@@ -249,6 +256,10 @@ func (ts *tmState) updateLocked(ctx context.Context) {
 		}
 	}
 
+	if ts.tm.VDiffEngine != nil && ts.tablet.Type == topodatapb.TabletType_MASTER {
+		ts.tm.VDiffEngine.Open(ts.tm.BatchCtx)
+	}
+
 	// Open TabletServer last so that it advertises serving after all other services are up.
 	if reason == "" {
 		if err := ts.tm.QueryServiceControl.SetServingType(ts.tablet.Type, terTime, true, ""); err != nil {
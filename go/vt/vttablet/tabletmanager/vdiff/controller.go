@@ -0,0 +1,479 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff/diffutil"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// The states of a vdiff, and of the diff of each of its tables.
+const (
+	statePending   = "pending"
+	stateStarted   = "started"
+	stateStopped   = "stopped"
+	stateCompleted = "completed"
+	stateError     = "error"
+)
+
+const defaultTabletTypes = "master,replica,rdonly"
+
+// controller runs one vdiff. The diff resumes from the last
+// checkpoint of each table, so it can be stopped at any time.
+type controller struct {
+	vde      *Engine
+	id       int64
+	uuid     string
+	workflow string
+	options  *tabletmanagerdatapb.VDiffOptions
+
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	// startedAt and rowsCompared are the progress of this run of the
+	// vdiff, from which the ETA is estimated.
+	startedAt    time.Time
+	rowsCompared sync2.AtomicInt64
+}
+
+// stream is a vreplication stream of the workflow.
+type stream struct {
+	id  int
+	bls *binlogdatapb.BinlogSource
+	pos mysql.Position
+}
+
+func newController(vde *Engine, row sqltypes.RowNamedValues) (*controller, error) {
+	id, err := row.ToInt64("id")
+	if err != nil {
+		return nil, err
+	}
+	options := &tabletmanagerdatapb.VDiffOptions{}
+	if text := row.AsString("options", ""); text != "" {
+		if err := proto.UnmarshalText(text, options); err != nil {
+			return nil, err
+		}
+	}
+	return &controller{
+		vde:      vde,
+		id:       id,
+		uuid:     row.AsString("vdiff_uuid", ""),
+		workflow: row.AsString("workflow", ""),
+		options:  options,
+		done:     make(chan struct{}),
+	}, nil
+}
+
+// start runs the vdiff in the background.
+func (ct *controller) start(ctx context.Context) {
+	ctx, ct.cancel = context.WithCancel(ctx)
+	ct.startedAt = time.Now()
+	go ct.run(ctx)
+}
+
+// Stop stops the vdiff and waits for it to exit. The progress is
+// saved, so it can be resumed later.
+func (ct *controller) Stop() {
+	ct.cancel()
+	<-ct.done
+}
+
+// eta estimates when the vdiff will complete, given the rows left to
+// compare. It returns the zero time if it can't be estimated yet.
+func (ct *controller) eta(rowsLeft int64) time.Time {
+	compared := ct.rowsCompared.Get()
+	if compared == 0 || rowsLeft < 0 {
+		return time.Time{}
+	}
+	elapsed := time.Since(ct.startedAt)
+	return time.Now().Add(time.Duration(float64(elapsed) * float64(rowsLeft) / float64(compared)))
+}
+
+func (ct *controller) run(ctx context.Context) {
+	defer close(ct.done)
+
+	log.Infof("VDiff %s: starting for workflow %s", ct.uuid, ct.workflow)
+	err := ct.diff(ctx)
+	if err == nil {
+		log.Infof("VDiff %s: completed", ct.uuid)
+		return
+	}
+	select {
+	case <-ctx.Done():
+		// The vdiff was stopped, or the engine is closing.
+		// It resumes from the last checkpoint.
+		log.Infof("VDiff %s: stopped: %v", ct.uuid, err)
+		return
+	default:
+	}
+	log.Errorf("VDiff %s: %v", ct.uuid, err)
+	dbClient := ct.vde.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		log.Errorf("VDiff %s: could not save error: %v", ct.uuid, err)
+		return
+	}
+	defer dbClient.Close()
+	query := fmt.Sprintf(sqlUpdateVDiffState, encodeString(stateError), encodeString(binlogplayer.MessageTruncate(err.Error())), ct.id)
+	if _, err := withDDL.Exec(ct.vde.ctx, query, dbClient.ExecuteFetch); err != nil {
+		log.Errorf("VDiff %s: could not save error: %v", ct.uuid, err)
+	}
+}
+
+func (ct *controller) diff(ctx context.Context) error {
+	dbClient := ct.vde.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		return err
+	}
+	defer dbClient.Close()
+
+	if _, err := withDDL.Exec(ctx, fmt.Sprintf(sqlUpdateVDiffStarted, ct.id), dbClient.ExecuteFetch); err != nil {
+		return err
+	}
+	streams, err := ct.readStreams(ctx, dbClient)
+	if err != nil {
+		return err
+	}
+	if len(streams) == 0 {
+		return fmt.Errorf("workflow %s not found in %s", ct.workflow, ct.vde.dbName)
+	}
+	schm, err := ct.vde.mysqld.GetSchema(ctx, ct.vde.dbName, ct.options.Tables, nil, false)
+	if err != nil {
+		return vterrors.Wrap(err, "GetSchema")
+	}
+	plans, err := buildTablePlans(streams[0].bls.Filter, schm, ct.options.Tables)
+	if err != nil {
+		return vterrors.Wrap(err, "buildTablePlans")
+	}
+	differs, err := ct.initTableDiffers(ctx, dbClient, plans)
+	if err != nil {
+		return err
+	}
+	sources, err := ct.pickSources(ctx, streams)
+	if err != nil {
+		return err
+	}
+
+	// Diff the tables in a deterministic order, so that a resumed
+	// vdiff continues where it stopped.
+	tableNames := make([]string, 0, len(differs))
+	for name := range differs {
+		tableNames = append(tableNames, name)
+	}
+	sort.Strings(tableNames)
+	for _, name := range tableNames {
+		if err := ct.diffTable(ctx, dbClient, differs[name], sources); err != nil {
+			return vterrors.Wrapf(err, "table %s", name)
+		}
+	}
+	_, err = withDDL.Exec(ctx, fmt.Sprintf(sqlUpdateVDiffCompleted, ct.id), dbClient.ExecuteFetch)
+	return err
+}
+
+// readStreams reads the vreplication streams of the workflow.
+func (ct *controller) readStreams(ctx context.Context, dbClient binlogplayer.DBClient) ([]*stream, error) {
+	query := fmt.Sprintf(sqlGetVReplicationStreams, encodeString(ct.vde.dbName), encodeString(ct.workflow))
+	qr, err := dbClient.ExecuteFetch(query, 10000)
+	if err != nil {
+		return nil, err
+	}
+	var streams []*stream
+	for _, row := range qr.Rows {
+		id, err := row[0].ToInt64()
+		if err != nil {
+			return nil, err
+		}
+		var bls binlogdatapb.BinlogSource
+		if err := proto.UnmarshalText(row[1].ToString(), &bls); err != nil {
+			return nil, err
+		}
+		pos, err := mysql.DecodePosition(row[2].ToString())
+		if err != nil {
+			return nil, err
+		}
+		streams = append(streams, &stream{id: int(id), bls: &bls, pos: pos})
+	}
+	return streams, nil
+}
+
+// initTableDiffers creates the tableDiffers of the tables that are not
+// completed yet, resuming from their saved progress.
+func (ct *controller) initTableDiffers(ctx context.Context, dbClient binlogplayer.DBClient, plans map[string]*tablePlan) (map[string]*tableDiffer, error) {
+	qr, err := withDDL.Exec(ctx, fmt.Sprintf(sqlGetTableDiffs, ct.id), dbClient.ExecuteFetch)
+	if err != nil {
+		return nil, err
+	}
+	saved := make(map[string]sqltypes.RowNamedValues)
+	for _, row := range sqltypes.ToNamedResult(qr).Rows {
+		saved[row.AsString("table_name", "")] = row
+	}
+
+	differs := make(map[string]*tableDiffer)
+	for name, plan := range plans {
+		td := &tableDiffer{
+			plan:    plan,
+			maxRows: ct.options.MaxRows,
+			checkpoint: func(td *tableDiffer) error {
+				return ct.saveProgress(ctx, dbClient, td)
+			},
+		}
+		row, ok := saved[name]
+		if !ok {
+			tableRows, err := ct.estimateTableRows(dbClient, name)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := withDDL.Exec(ctx, fmt.Sprintf(sqlNewTableDiff, ct.id, encodeString(name), tableRows), dbClient.ExecuteFetch); err != nil {
+				return nil, err
+			}
+			differs[name] = td
+			continue
+		}
+		if row.AsString("state", "") == stateCompleted {
+			continue
+		}
+		if td.lastpk, err = decodeLastPK(row.AsString("lastpk", "")); err != nil {
+			return nil, err
+		}
+		if report := row.AsString("report", ""); report != "" {
+			if err := json.Unmarshal([]byte(report), &td.report); err != nil {
+				return nil, err
+			}
+		}
+		td.savedRows = td.report.ProcessedRows
+		differs[name] = td
+	}
+	return differs, nil
+}
+
+// estimateTableRows returns the number of rows of the table estimated
+// by mysql, which is used to report the progress of the diff.
+func (ct *controller) estimateTableRows(dbClient binlogplayer.DBClient, table string) (int64, error) {
+	qr, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlGetTableRows, encodeString(ct.vde.dbName), encodeString(table)), 1)
+	if err != nil {
+		return 0, err
+	}
+	if len(qr.Rows) != 1 || qr.Rows[0][0].IsNull() {
+		return 0, nil
+	}
+	return qr.Rows[0][0].ToInt64()
+}
+
+func (ct *controller) saveProgress(ctx context.Context, dbClient binlogplayer.DBClient, td *tableDiffer) error {
+	report, err := json.Marshal(td.report)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf(sqlUpdateTableDiffProgress, encodeString(td.encodeLastPK()), td.report.ProcessedRows, encodeString(string(report)), ct.id, encodeString(td.plan.table.Name))
+	// Save the progress even if the context was canceled, which is
+	// when the vdiff is stopped.
+	if _, err := withDDL.Exec(ct.vde.ctx, query, dbClient.ExecuteFetch); err != nil {
+		return err
+	}
+	ct.rowsCompared.Add(td.report.ProcessedRows - td.savedRows)
+	td.savedRows = td.report.ProcessedRows
+	return nil
+}
+
+func (ct *controller) updateTableState(ctx context.Context, dbClient binlogplayer.DBClient, table, state string) error {
+	_, err := withDDL.Exec(ctx, fmt.Sprintf(sqlUpdateTableDiffState, encodeString(state), ct.id, encodeString(table)), dbClient.ExecuteFetch)
+	return err
+}
+
+// pickSources picks a tablet to stream from for each source shard.
+func (ct *controller) pickSources(ctx context.Context, streams []*stream) (map[string]*topodatapb.Tablet, error) {
+	cell := ct.options.SourceCell
+	if cell == "" {
+		cell = ct.vde.tablet.Alias.Cell
+	}
+	tabletTypes := ct.options.TabletTypes
+	if tabletTypes == "" {
+		tabletTypes = defaultTabletTypes
+	}
+	sources := make(map[string]*topodatapb.Tablet)
+	for _, st := range streams {
+		if _, ok := sources[st.bls.Shard]; ok {
+			continue
+		}
+		tp, err := discovery.NewTabletPicker(ct.vde.ts, []string{cell}, st.bls.Keyspace, st.bls.Shard, tabletTypes)
+		if err != nil {
+			return nil, err
+		}
+		tablet, err := tp.PickForStreaming(ctx)
+		if err != nil {
+			return nil, err
+		}
+		sources[st.bls.Shard] = tablet
+	}
+	return sources, nil
+}
+
+// diffTable diffs one table, from where its previous run stopped.
+func (ct *controller) diffTable(ctx context.Context, dbClient binlogplayer.DBClient, td *tableDiffer, sources map[string]*topodatapb.Tablet) error {
+	log.Infof("VDiff %s: starting diff of table %s", ct.uuid, td.plan.table.Name)
+	if err := ct.updateTableState(ctx, dbClient, td.plan.table.Name, stateStarted); err != nil {
+		return err
+	}
+
+	// Canceling the context stops the streams.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sourceStreamers, targetStreamer, err := ct.startStreams(ctx, dbClient, td, sources)
+	if err != nil {
+		return err
+	}
+	var sourcePrimitive engine.Primitive = diffutil.NewMergeSorter(sourceStreamers, td.plan.comparePKs)
+	// If there were aggregate expressions, we have to re-aggregate
+	// the results, which engine.OrderedAggregate can do.
+	if len(td.plan.aggregates) != 0 {
+		sourcePrimitive = &engine.OrderedAggregate{
+			Aggregates: td.plan.aggregates,
+			Keys:       td.plan.comparePKs,
+			Input:      sourcePrimitive,
+		}
+	}
+	targetPrimitive := diffutil.NewMergeSorter([]engine.StreamExecutor{targetStreamer}, td.plan.comparePKs)
+
+	if err := td.diff(diffutil.NewPrimitiveExecutor(ctx, sourcePrimitive), diffutil.NewPrimitiveExecutor(ctx, targetPrimitive)); err != nil {
+		return err
+	}
+	log.Infof("VDiff %s: table %s: %+v", ct.uuid, td.plan.table.Name, td.report)
+	return ct.updateTableState(ctx, dbClient, td.plan.table.Name, stateCompleted)
+}
+
+// startStreams stops the workflow streams, starts the query streams on
+// the sources, fast-forwards the workflow streams to the positions of
+// the source snapshots, starts the query stream on the target, and
+// restarts the workflow streams. After that, the query streams return
+// consistent snapshots of the table.
+func (ct *controller) startStreams(ctx context.Context, dbClient binlogplayer.DBClient, td *tableDiffer, sources map[string]*topodatapb.Tablet) ([]engine.StreamExecutor, *shardStreamer, error) {
+	vre := ct.vde.vre
+	dbName := encodeString(ct.vde.dbName)
+	workflow := encodeString(ct.workflow)
+	if _, err := vre.Exec(fmt.Sprintf("update _vt.vreplication set state='Stopped', message='for vdiff' where db_name=%s and workflow=%s", dbName, workflow)); err != nil {
+		return nil, nil, vterrors.Wrap(err, "stopping the workflow")
+	}
+	defer func() {
+		if _, err := vre.Exec(fmt.Sprintf("update _vt.vreplication set state='Running', message='', stop_pos='' where db_name=%s and workflow=%s", dbName, workflow)); err != nil {
+			log.Errorf("VDiff %s: could not restart workflow %s: %v, please restart it manually", ct.uuid, ct.workflow, err)
+		}
+	}()
+
+	// Record the source positions of the stopped streams.
+	streams, err := ct.readStreams(ctx, dbClient)
+	if err != nil {
+		return nil, nil, err
+	}
+	positions := make(map[string]mysql.Position)
+	for _, st := range streams {
+		if pos, ok := positions[st.bls.Shard]; ok && pos.AtLeast(st.pos) {
+			continue
+		}
+		positions[st.bls.Shard] = st.pos
+	}
+
+	waitTime := time.Duration(ct.options.FilteredReplicationWaitTimeSeconds) * time.Second
+	if waitTime <= 0 {
+		waitTime = 30 * time.Second
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, waitTime)
+	defer cancel()
+
+	// Make sure the sources are past the positions of the streams, and
+	// start their query streams, which record the current positions.
+	sourceQuery, targetQuery := td.plan.queries(td.lastpk)
+	var (
+		mu              sync.Mutex
+		wg              sync.WaitGroup
+		sourceStreamers []engine.StreamExecutor
+		snapshots       = make(map[string]string)
+		firstErr        error
+	)
+	for shard, tablet := range sources {
+		wg.Add(1)
+		go func(shard string, tablet *topodatapb.Tablet) {
+			defer wg.Done()
+			ss, err := func() (*shardStreamer, error) {
+				pos := positions[shard]
+				if pos.IsZero() {
+					return nil, fmt.Errorf("workflow %s: stream from shard %s has not started", ct.workflow, shard)
+				}
+				if err := ct.vde.tmc.WaitForPosition(waitCtx, tablet, mysql.EncodePosition(pos)); err != nil {
+					return nil, vterrors.Wrapf(err, "WaitForPosition for tablet %v", topoproto.TabletAliasString(tablet.Alias))
+				}
+				return startStream(ctx, tablet, tablet.Keyspace, shard, sourceQuery)
+			}()
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			sourceStreamers = append(sourceStreamers, ss)
+			snapshots[shard] = ss.snapshotPosition
+		}(shard, tablet)
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, nil, firstErr
+	}
+
+	// Fast forward the workflow streams to the source snapshots.
+	for _, st := range streams {
+		pos := snapshots[st.bls.Shard]
+		query := fmt.Sprintf("update _vt.vreplication set state='Running', stop_pos=%s, message='synchronizing for vdiff' where id=%d", encodeString(pos), st.id)
+		if _, err := vre.Exec(query); err != nil {
+			return nil, nil, err
+		}
+		if err := vre.WaitForPos(waitCtx, st.id, pos); err != nil {
+			return nil, nil, vterrors.Wrapf(err, "VReplicationWaitForPos for stream %d", st.id)
+		}
+	}
+
+	// Sources and target are in sync. Start the query stream on the target,
+	// after which the workflow streams can be restarted.
+	thisTablet := proto.Clone(ct.vde.tablet).(*topodatapb.Tablet)
+	thisTablet.Type = topodatapb.TabletType_MASTER
+	targetStreamer, err := startStream(ctx, thisTablet, thisTablet.Keyspace, thisTablet.Shard, targetQuery)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sourceStreamers, targetStreamer, nil
+}
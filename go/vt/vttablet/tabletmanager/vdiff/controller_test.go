@@ -0,0 +1,140 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff/diffutil"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+func TestNewController(t *testing.T) {
+	row := vdiffResult(`1|uuid1|wf|pending|tables:"t1" max_rows:10`).Named().Row()
+	ct, err := newController(&Engine{}, row)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, ct.id)
+	assert.Equal(t, "uuid1", ct.uuid)
+	assert.Equal(t, "wf", ct.workflow)
+	assert.Equal(t, []string{"t1"}, ct.options.Tables)
+	assert.EqualValues(t, 10, ct.options.MaxRows)
+
+	row = vdiffResult("1|uuid1|wf|pending|bad options").Named().Row()
+	_, err = newController(&Engine{}, row)
+	require.Error(t, err)
+}
+
+func TestControllerETA(t *testing.T) {
+	ct := &controller{startedAt: time.Now().Add(-10 * time.Second)}
+	assert.True(t, ct.eta(100).IsZero())
+
+	ct.rowsCompared.Set(100)
+	eta := ct.eta(100)
+	assert.WithinDuration(t, time.Now().Add(10*time.Second), eta, time.Second)
+}
+
+// TestControllerResume checks that the progress of a table diff is
+// saved in _vt.vdiff_table, and that a new controller resumes the
+// diff from it.
+func TestControllerResume(t *testing.T) {
+	tp1, err := buildTablePlan(testSchema.TableDefinitions[0], "select * from t1")
+	require.NoError(t, err)
+	tp2, err := buildTablePlan(testSchema.TableDefinitions[1], "select * from t2")
+	require.NoError(t, err)
+
+	// Rows of t2, with the weight_string of c2.
+	fields := sqltypes.MakeTestFields("c1|c2|c3|weight_string(c2)", "int64|varchar|int64|varbinary")
+	newExecutor := func(rows ...string) *diffutil.PrimitiveExecutor {
+		return diffutil.NewPrimitiveExecutor(context.Background(), &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, rows...)}})
+	}
+	tableDiffsFields := sqltypes.MakeTestFields(
+		"table_name|state|lastpk|table_rows|rows_compared|report",
+		"varbinary|varbinary|varbinary|int64|int64|varbinary")
+
+	lastpk := []sqltypes.Value{sqltypes.NewInt64(2), sqltypes.NewVarChar("b")}
+	encodedLastPK := (&tableDiffer{plan: tp2, lastpk: lastpk}).encodeLastPK()
+	report := `{"ProcessedRows":2,"MatchingRows":2,"MismatchedRows":0,"ExtraRowsSource":0,"ExtraRowsTarget":0}`
+
+	// The first run diffs the first two rows, and saves its progress.
+	dbClient := binlogplayer.NewMockDBClient(t)
+	ct := &controller{
+		vde:     &Engine{ctx: context.Background(), dbName: "db"},
+		id:      1,
+		options: &tabletmanagerdatapb.VDiffOptions{MaxRows: 2},
+	}
+	dbClient.ExpectRequest("select table_name, state, lastpk, table_rows, rows_compared, report from _vt.vdiff_table where vdiff_id = 1", sqltypes.MakeTestResult(tableDiffsFields), nil)
+	dbClient.ExpectRequest("select table_rows from information_schema.tables where table_schema = 'db' and table_name = 't2'", sqltypes.MakeTestResult(sqltypes.MakeTestFields("table_rows", "int64"), "3"), nil)
+	dbClient.ExpectRequest("insert into _vt.vdiff_table(vdiff_id, table_name, state, table_rows) values(1, 't2', 'pending', 3)", &sqltypes.Result{}, nil)
+	differs, err := ct.initTableDiffers(context.Background(), dbClient, map[string]*tablePlan{"t2": tp2})
+	require.NoError(t, err)
+	require.Len(t, differs, 1)
+	td := differs["t2"]
+	assert.Nil(t, td.lastpk)
+
+	dbClient.ExpectRequest(fmt.Sprintf("update _vt.vdiff_table set lastpk = %s, rows_compared = 2, report = %s where vdiff_id = 1 and table_name = 't2'",
+		encodeString(encodedLastPK), encodeString(report)), &sqltypes.Result{}, nil)
+	rows := []string{"1|a|1|a", "2|b|2|b", "3|c|3|c"}
+	require.NoError(t, td.diff(newExecutor(rows...), newExecutor(rows...)))
+	dbClient.Wait()
+	assert.Equal(t, lastpk, td.lastpk)
+	assert.EqualValues(t, 2, ct.rowsCompared.Get())
+
+	// A new controller skips the completed tables, and resumes the diff
+	// of t2 after the saved lastpk.
+	dbClient = binlogplayer.NewMockDBClient(t)
+	ct = &controller{
+		vde:     &Engine{ctx: context.Background(), dbName: "db"},
+		id:      1,
+		options: &tabletmanagerdatapb.VDiffOptions{},
+	}
+	dbClient.ExpectRequest("select table_name, state, lastpk, table_rows, rows_compared, report from _vt.vdiff_table where vdiff_id = 1", sqltypes.MakeTestResult(tableDiffsFields,
+		"t1|completed||1|1|",
+		fmt.Sprintf("t2|started|%s|3|2|%s", encodedLastPK, report),
+	), nil)
+	differs, err = ct.initTableDiffers(context.Background(), dbClient, map[string]*tablePlan{"t1": tp1, "t2": tp2})
+	require.NoError(t, err)
+	dbClient.Wait()
+	require.Len(t, differs, 1)
+	td = differs["t2"]
+	assert.Equal(t, lastpk, td.lastpk)
+	assert.Equal(t, diffutil.DiffReport{ProcessedRows: 2, MatchingRows: 2}, td.report)
+	assert.EqualValues(t, 2, td.savedRows)
+
+	source, target := td.plan.queries(td.lastpk)
+	assert.Equal(t, "select c1, c2, c3, weight_string(c2) from t2 where c1 > 2 or c1 = 2 and c2 > 'b' order by c1 asc, c2 asc", source)
+	assert.Equal(t, "select c1, c2, c3, weight_string(c2) from t2 where c1 > 2 or c1 = 2 and c2 > 'b' order by c1 asc, c2 asc", target)
+
+	lastpk = []sqltypes.Value{sqltypes.NewInt64(3), sqltypes.NewVarChar("c")}
+	encodedLastPK = (&tableDiffer{plan: tp2, lastpk: lastpk}).encodeLastPK()
+	report = `{"ProcessedRows":3,"MatchingRows":3,"MismatchedRows":0,"ExtraRowsSource":0,"ExtraRowsTarget":0}`
+	dbClient.ExpectRequest(fmt.Sprintf("update _vt.vdiff_table set lastpk = %s, rows_compared = 3, report = %s where vdiff_id = 1 and table_name = 't2'",
+		encodeString(encodedLastPK), encodeString(report)), &sqltypes.Result{}, nil)
+	require.NoError(t, td.diff(newExecutor("3|c|3|c"), newExecutor("3|c|3|c")))
+	dbClient.Wait()
+	assert.Equal(t, lastpk, td.lastpk)
+	assert.Equal(t, diffutil.DiffReport{ProcessedRows: 3, MatchingRows: 3}, td.report)
+	assert.EqualValues(t, 1, ct.rowsCompared.Get())
+}
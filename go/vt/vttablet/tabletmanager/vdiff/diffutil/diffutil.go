@@ -0,0 +1,229 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package diffutil contains the building blocks shared by the vdiffs
+// that run in the wrangler and the ones that run on the target tablets.
+package diffutil
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// DiffReport is the summary of differences for one table.
+type DiffReport struct {
+	ProcessedRows   int64
+	MatchingRows    int64
+	MismatchedRows  int64
+	ExtraRowsSource int64
+	ExtraRowsTarget int64
+}
+
+// NewMergeSorter creates an engine.MergeSort based on the shard streamers and pk columns.
+func NewMergeSorter(participants []engine.StreamExecutor, comparePKs []int) *engine.MergeSort {
+	prims := make([]engine.StreamExecutor, 0, len(participants))
+	prims = append(prims, participants...)
+	ob := make([]engine.OrderbyParams, 0, len(comparePKs))
+	for _, cpk := range comparePKs {
+		ob = append(ob, engine.OrderbyParams{Col: cpk})
+	}
+	return &engine.MergeSort{
+		Primitives: prims,
+		OrderBy:    ob,
+	}
+}
+
+// FindPKs identifies the pk columns of table in targetSelect, and
+// removes them from compareCols, because pks are compared separately.
+// It returns the order by for the pks, the columns to compare them
+// with, which are taken from compareCols, and the pk columns as
+// selected.
+func FindPKs(table *tabletmanagerdatapb.TableDefinition, targetSelect *sqlparser.Select, compareCols []int) (orderby sqlparser.OrderBy, comparePKs, pkCols []int, err error) {
+	for _, pk := range table.PrimaryKeyColumns {
+		found := false
+		for i, selExpr := range targetSelect.SelectExprs {
+			expr := selExpr.(*sqlparser.AliasedExpr).Expr
+			colname := ""
+			switch ct := expr.(type) {
+			case *sqlparser.ColName:
+				colname = ct.Name.String()
+			case *sqlparser.FuncExpr: //eg. weight_string()
+				//no-op
+			default:
+				log.Warningf("Not considering column %v for PK, type %v not handled", selExpr, ct)
+			}
+			if strings.EqualFold(pk, colname) {
+				comparePKs = append(comparePKs, compareCols[i])
+				pkCols = append(pkCols, i)
+				// We'll be comparing pks separately. So, remove them from compareCols.
+				compareCols[i] = -1
+				found = true
+				break
+			}
+		}
+		if !found {
+			// Unreachable.
+			return nil, nil, nil, fmt.Errorf("column %v not found in table %v", pk, table.Name)
+		}
+		orderby = append(orderby, &sqlparser.Order{
+			Expr:      &sqlparser.ColName{Name: sqlparser.NewColIdent(pk)},
+			Direction: sqlparser.AscOrder,
+		})
+	}
+	return orderby, comparePKs, pkCols, nil
+}
+
+//-----------------------------------------------------------------
+// PrimitiveExecutor
+
+// PrimitiveExecutor starts execution on the top level primitive
+// and provides convenience functions for row-by-row iteration.
+type PrimitiveExecutor struct {
+	prim     engine.Primitive
+	rows     [][]sqltypes.Value
+	resultch chan *sqltypes.Result
+	err      error
+}
+
+// NewPrimitiveExecutor starts streaming the rows of prim, until ctx
+// is canceled.
+func NewPrimitiveExecutor(ctx context.Context, prim engine.Primitive) *PrimitiveExecutor {
+	pe := &PrimitiveExecutor{
+		prim:     prim,
+		resultch: make(chan *sqltypes.Result, 1),
+	}
+	vcursor := &contextVCursor{ctx: ctx}
+	go func() {
+		defer close(pe.resultch)
+		pe.err = pe.prim.StreamExecute(vcursor, make(map[string]*querypb.BindVariable), false, func(qr *sqltypes.Result) error {
+			select {
+			case pe.resultch <- qr:
+			case <-ctx.Done():
+				return vterrors.Wrap(ctx.Err(), "Outer Stream")
+			}
+			return nil
+		})
+	}()
+	return pe
+}
+
+// Next returns the next row, or nil at the end of the stream.
+func (pe *PrimitiveExecutor) Next() ([]sqltypes.Value, error) {
+	for len(pe.rows) == 0 {
+		qr, ok := <-pe.resultch
+		if !ok {
+			return nil, pe.err
+		}
+		pe.rows = qr.Rows
+	}
+
+	row := pe.rows[0]
+	pe.rows = pe.rows[1:]
+	return row, nil
+}
+
+// Drain consumes the rest of the stream, and returns the number of
+// rows that were left.
+func (pe *PrimitiveExecutor) Drain() (int64, error) {
+	var count int64
+	for {
+		row, err := pe.Next()
+		if err != nil {
+			return 0, err
+		}
+		if row == nil {
+			return count, nil
+		}
+		count++
+	}
+}
+
+//-----------------------------------------------------------------
+// contextVCursor
+
+// contextVCursor satisfies VCursor, but only implements Context().
+// MergeSort only requires Context to be implemented.
+type contextVCursor struct {
+	engine.VCursor
+	ctx context.Context
+}
+
+func (vc *contextVCursor) Context() context.Context {
+	return vc.ctx
+}
+
+//-----------------------------------------------------------------
+// Utility functions
+
+// RemoveKeyrange removes the in_keyrange expressions of where, which
+// are not understood by mysql.
+func RemoveKeyrange(where *sqlparser.Where) *sqlparser.Where {
+	if where == nil {
+		return nil
+	}
+	if isFuncKeyrange(where.Expr) {
+		return nil
+	}
+	where.Expr = removeExprKeyrange(where.Expr)
+	return where
+}
+
+func removeExprKeyrange(node sqlparser.Expr) sqlparser.Expr {
+	switch node := node.(type) {
+	case *sqlparser.AndExpr:
+		if isFuncKeyrange(node.Left) {
+			return removeExprKeyrange(node.Right)
+		}
+		if isFuncKeyrange(node.Right) {
+			return removeExprKeyrange(node.Left)
+		}
+		return &sqlparser.AndExpr{
+			Left:  removeExprKeyrange(node.Left),
+			Right: removeExprKeyrange(node.Right),
+		}
+	}
+	return node
+}
+
+func isFuncKeyrange(expr sqlparser.Expr) bool {
+	funcExpr, ok := expr.(*sqlparser.FuncExpr)
+	return ok && funcExpr.Name.EqualString("in_keyrange")
+}
+
+// WrapWeightString returns the weight_string of expr, which is used
+// for the lexical comparison of text columns.
+func WrapWeightString(expr sqlparser.SelectExpr) *sqlparser.AliasedExpr {
+	return &sqlparser.AliasedExpr{
+		Expr: &sqlparser.FuncExpr{
+			Name: sqlparser.NewColIdent("weight_string"),
+			Exprs: []sqlparser.SelectExpr{
+				&sqlparser.AliasedExpr{
+					Expr: expr.(*sqlparser.AliasedExpr).Expr,
+				},
+			},
+		},
+	}
+}
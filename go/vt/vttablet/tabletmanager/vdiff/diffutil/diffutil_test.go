@@ -0,0 +1,185 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diffutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+func TestFindPKs(t *testing.T) {
+	testcases := []struct {
+		name           string
+		table          *tabletmanagerdatapb.TableDefinition
+		targetSelect   *sqlparser.Select
+		compareCols    []int
+		outCompareCols []int
+		outComparePKs  []int
+		outPKCols      []int
+		err            string
+	}{{
+		name: "one pk",
+		table: &tabletmanagerdatapb.TableDefinition{
+			Name:              "t1",
+			Columns:           []string{"c1", "c2"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            sqltypes.MakeTestFields("c1|c2", "int64|int64"),
+		},
+		targetSelect: &sqlparser.Select{
+			SelectExprs: sqlparser.SelectExprs{
+				&sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent("c1")}},
+				&sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent("c2")}},
+			},
+		},
+		compareCols:    []int{0, 1},
+		outCompareCols: []int{-1, 1},
+		outComparePKs:  []int{0},
+		outPKCols:      []int{0},
+	}, {
+		name: "pk with weight_string",
+		table: &tabletmanagerdatapb.TableDefinition{
+			Name:              "t1",
+			Columns:           []string{"c1", "c2", "c3", "c4"},
+			PrimaryKeyColumns: []string{"c1", "c4"},
+			Fields:            sqltypes.MakeTestFields("c1|c2|c3|c4", "int64|int64|varchar|int64"),
+		},
+		targetSelect: &sqlparser.Select{
+			SelectExprs: sqlparser.SelectExprs{
+				&sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent("c1")}},
+				&sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent("c2")}},
+				&sqlparser.AliasedExpr{Expr: &sqlparser.FuncExpr{Name: sqlparser.NewColIdent("c3")}},
+				&sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent("c4")}},
+			},
+		},
+		compareCols:    []int{0, 1, 2, 3},
+		outCompareCols: []int{-1, 1, 2, -1},
+		outComparePKs:  []int{0, 3},
+		outPKCols:      []int{0, 3},
+	}, {
+		name: "missing pk",
+		table: &tabletmanagerdatapb.TableDefinition{
+			Name:              "t1",
+			Columns:           []string{"c1", "c2"},
+			PrimaryKeyColumns: []string{"c3"},
+			Fields:            sqltypes.MakeTestFields("c1|c2", "int64|int64"),
+		},
+		targetSelect: &sqlparser.Select{
+			SelectExprs: sqlparser.SelectExprs{
+				&sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent("c1")}},
+				&sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent("c2")}},
+			},
+		},
+		compareCols:    []int{0, 1},
+		outCompareCols: []int{0, 1},
+		err:            "column c3 not found in table t1",
+	}}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			orderby, comparePKs, pkCols, err := FindPKs(tc.table, tc.targetSelect, tc.compareCols)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.outCompareCols, tc.compareCols)
+			assert.Equal(t, tc.outComparePKs, comparePKs)
+			assert.Equal(t, tc.outPKCols, pkCols)
+			assert.Len(t, orderby, len(tc.table.PrimaryKeyColumns))
+		})
+	}
+}
+
+func TestRemoveKeyrange(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+	}{{
+		in:  "select * from t1 where in_keyrange('-80')",
+		out: "select * from t1",
+	}, {
+		in:  "select * from t1 where c1 = 1 and in_keyrange('-80')",
+		out: "select * from t1 where c1 = 1",
+	}, {
+		in:  "select * from t1 where in_keyrange('-80') and c1 = 1 and c2 = 2",
+		out: "select * from t1 where c1 = 1 and c2 = 2",
+	}, {
+		in:  "select * from t1 where c1 = 1",
+		out: "select * from t1 where c1 = 1",
+	}, {
+		in:  "select * from t1",
+		out: "select * from t1",
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.in, func(t *testing.T) {
+			stmt, err := sqlparser.Parse(tc.in)
+			require.NoError(t, err)
+			sel := stmt.(*sqlparser.Select)
+			sel.Where = RemoveKeyrange(sel.Where)
+			assert.Equal(t, tc.out, sqlparser.String(sel))
+		})
+	}
+}
+
+// fakeStreamer streams a fixed list of results.
+type fakeStreamer struct {
+	results []*sqltypes.Result
+}
+
+func (fs *fakeStreamer) StreamExecute(vcursor engine.VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	for _, result := range fs.results {
+		if err := callback(result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestPrimitiveExecutor(t *testing.T) {
+	fields := sqltypes.MakeTestFields("c1|c2", "int64|int64")
+	newStreamer := func(rows ...string) engine.StreamExecutor {
+		return &fakeStreamer{results: sqltypes.MakeTestStreamingResults(fields, rows...)}
+	}
+	// The merge sort interleaves the rows of both streams by pk.
+	ms := NewMergeSorter([]engine.StreamExecutor{
+		newStreamer("1|1", "---", "4|4"),
+		newStreamer("2|2", "3|3", "---", "5|5"),
+	}, []int{0})
+	pe := NewPrimitiveExecutor(context.Background(), ms)
+
+	for _, want := range []int64{1, 2} {
+		row, err := pe.Next()
+		require.NoError(t, err)
+		assert.Equal(t, []sqltypes.Value{sqltypes.NewInt64(want), sqltypes.NewInt64(want)}, row)
+	}
+	count, err := pe.Drain()
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, count)
+	row, err := pe.Next()
+	require.NoError(t, err)
+	assert.Nil(t, row)
+}
@@ -0,0 +1,349 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package vdiff runs the vdiffs of the vreplication workflows that
+// target a tablet on the tablet itself. The progress of each table is
+// saved in _vt.vdiff_table, so a vdiff can be stopped, and resumes
+// where it stopped when restarted, including after a restart of the
+// tablet.
+package vdiff
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"context"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/withddl"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// The actions of the VDiff RPC.
+const (
+	CreateAction = "create"
+	ShowAction   = "show"
+	StopAction   = "stop"
+	ResumeAction = "resume"
+)
+
+// The special values of the vdiff uuid for ShowAction.
+const (
+	LastVDiff = "last"
+	AllVDiffs = "all"
+)
+
+var withDDL = withddl.New([]string{
+	"create database if not exists _vt",
+	createVDiffTable,
+	createVDiffTableTable,
+})
+
+// Engine runs the vdiffs of the tablet. It's open only on a master.
+type Engine struct {
+	// mu synchronizes isOpen and controllers.
+	mu          sync.Mutex
+	isOpen      bool
+	controllers map[int64]*controller
+
+	// ctx is the root context for all controllers.
+	ctx context.Context
+	// cancel will cancel the root context, thereby all controllers.
+	cancel context.CancelFunc
+
+	ts              *topo.Server
+	tablet          *topodatapb.Tablet
+	mysqld          mysqlctl.MysqlDaemon
+	vre             *vreplication.Engine
+	tmc             tmclient.TabletManagerClient
+	dbClientFactory func() binlogplayer.DBClient
+	dbName          string
+}
+
+// NewEngine creates a new Engine.
+// A nil ts means that the Engine is disabled.
+func NewEngine(ts *topo.Server, tablet *topodatapb.Tablet, mysqld mysqlctl.MysqlDaemon, vre *vreplication.Engine) *Engine {
+	return &Engine{
+		controllers: make(map[int64]*controller),
+		ts:          ts,
+		tablet:      tablet,
+		mysqld:      mysqld,
+		vre:         vre,
+	}
+}
+
+// InitDBConfig should be invoked after the db name is computed.
+func (vde *Engine) InitDBConfig(dbcfgs *dbconfigs.DBConfigs) {
+	// If we're already initilized, it's a test engine. Ignore the call.
+	if vde.dbClientFactory != nil {
+		return
+	}
+	vde.dbClientFactory = func() binlogplayer.DBClient {
+		return binlogplayer.NewDBClient(dbcfgs.FilteredWithDB())
+	}
+	vde.dbName = dbcfgs.DBName
+}
+
+// NewTestEngine creates a new Engine for testing.
+func NewTestEngine(ts *topo.Server, tablet *topodatapb.Tablet, mysqld mysqlctl.MysqlDaemon, vre *vreplication.Engine, tmc tmclient.TabletManagerClient, dbClientFactory func() binlogplayer.DBClient, dbname string) *Engine {
+	return &Engine{
+		controllers:     make(map[int64]*controller),
+		ts:              ts,
+		tablet:          tablet,
+		mysqld:          mysqld,
+		vre:             vre,
+		tmc:             tmc,
+		dbClientFactory: dbClientFactory,
+		dbName:          dbname,
+	}
+}
+
+// Open starts the Engine, and resumes the vdiffs that were running.
+func (vde *Engine) Open(ctx context.Context) {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+
+	if vde.ts == nil || vde.isOpen {
+		return
+	}
+	log.Infof("VDiff Engine: opening")
+	if vde.tmc == nil {
+		vde.tmc = tmclient.NewTabletManagerClient()
+	}
+	vde.ctx, vde.cancel = context.WithCancel(ctx)
+	vde.isOpen = true
+
+	rows, err := vde.readVDiffsToRun(vde.ctx)
+	if err != nil {
+		// The vdiffs can still be resumed with the resume action.
+		log.Errorf("VDiff Engine: could not read the vdiffs to resume: %v", err)
+		return
+	}
+	for _, row := range rows {
+		if err := vde.startController(row); err != nil {
+			log.Errorf("VDiff Engine: could not resume vdiff %v: %v", row, err)
+		}
+	}
+}
+
+// Close stops all the vdiffs, which are resumed on the next Open.
+func (vde *Engine) Close() {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+
+	if !vde.isOpen {
+		return
+	}
+	vde.cancel()
+	for _, ct := range vde.controllers {
+		ct.Stop()
+	}
+	vde.controllers = make(map[int64]*controller)
+	vde.isOpen = false
+	log.Infof("VDiff Engine: closed")
+}
+
+// startController must be called with mu held.
+func (vde *Engine) startController(row sqltypes.RowNamedValues) error {
+	ct, err := newController(vde, row)
+	if err != nil {
+		return err
+	}
+	if old, ok := vde.controllers[ct.id]; ok {
+		old.Stop()
+	}
+	vde.controllers[ct.id] = ct
+	ct.start(vde.ctx)
+	return nil
+}
+
+func (vde *Engine) readVDiffsToRun(ctx context.Context) ([]sqltypes.RowNamedValues, error) {
+	dbClient := vde.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		return nil, err
+	}
+	defer dbClient.Close()
+	qr, err := withDDL.ExecIgnore(ctx, fmt.Sprintf(sqlGetVDiffsToRun, encodeString(vde.dbName)), dbClient.ExecuteFetch)
+	if err != nil {
+		return nil, err
+	}
+	return sqltypes.ToNamedResult(qr).Rows, nil
+}
+
+// PerformVDiffAction performs the action of the request, for the
+// workflow of the request.
+func (vde *Engine) PerformVDiffAction(ctx context.Context, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	if !vde.isOpen {
+		return nil, errors.New("vdiff engine is closed")
+	}
+
+	dbClient := vde.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		return nil, err
+	}
+	defer dbClient.Close()
+
+	resp := &tabletmanagerdatapb.VDiffResponse{VdiffUuid: req.VdiffUuid}
+	switch req.Action {
+	case CreateAction:
+		if req.VdiffUuid == "" {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "a vdiff uuid is required to create a vdiff")
+		}
+		options := req.Options
+		if options == nil {
+			options = &tabletmanagerdatapb.VDiffOptions{}
+		}
+		query := fmt.Sprintf(sqlNewVDiff, encodeString(req.VdiffUuid), encodeString(req.Workflow), encodeString(req.Keyspace),
+			encodeString(vde.tablet.Shard), encodeString(vde.dbName), encodeString(statePending), encodeString(proto.CompactTextString(options)))
+		if _, err := withDDL.Exec(ctx, query, dbClient.ExecuteFetch); err != nil {
+			return nil, err
+		}
+		row, err := vde.getVDiff(ctx, dbClient, req.VdiffUuid)
+		if err != nil {
+			return nil, err
+		}
+		if err := vde.startController(row); err != nil {
+			return nil, err
+		}
+	case ShowAction:
+		var query string
+		dbName, workflow := encodeString(vde.dbName), encodeString(req.Workflow)
+		switch req.VdiffUuid {
+		case LastVDiff, "":
+			query = fmt.Sprintf(sqlShowLastVDiff, dbName, workflow, dbName, workflow)
+		case AllVDiffs:
+			query = fmt.Sprintf(sqlShowAllVDiffs, dbName, workflow)
+		default:
+			query = fmt.Sprintf(sqlShowVDiffByUUID, dbName, workflow, encodeString(req.VdiffUuid))
+		}
+		qr, err := withDDL.Exec(ctx, query, dbClient.ExecuteFetch)
+		if err != nil {
+			return nil, err
+		}
+		resp.Output = sqltypes.ResultToProto3(vde.addETAs(qr))
+	case StopAction:
+		row, err := vde.getVDiff(ctx, dbClient, req.VdiffUuid)
+		if err != nil {
+			return nil, err
+		}
+		id, err := row.ToInt64("id")
+		if err != nil {
+			return nil, err
+		}
+		if state := row.AsString("state", ""); state == stateCompleted {
+			return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "vdiff %s is already completed", req.VdiffUuid)
+		}
+		if ct, ok := vde.controllers[id]; ok {
+			ct.Stop()
+			delete(vde.controllers, id)
+		}
+		if _, err := withDDL.Exec(ctx, fmt.Sprintf(sqlUpdateVDiffState, encodeString(stateStopped), encodeString(""), id), dbClient.ExecuteFetch); err != nil {
+			return nil, err
+		}
+	case ResumeAction:
+		row, err := vde.getVDiff(ctx, dbClient, req.VdiffUuid)
+		if err != nil {
+			return nil, err
+		}
+		id, err := row.ToInt64("id")
+		if err != nil {
+			return nil, err
+		}
+		switch row.AsString("state", "") {
+		case stateCompleted:
+			return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "vdiff %s is already completed", req.VdiffUuid)
+		case stateStarted:
+			if _, ok := vde.controllers[id]; ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "vdiff %s is already running", req.VdiffUuid)
+			}
+		}
+		if _, err := withDDL.Exec(ctx, fmt.Sprintf(sqlUpdateVDiffState, encodeString(statePending), encodeString(""), id), dbClient.ExecuteFetch); err != nil {
+			return nil, err
+		}
+		if err := vde.startController(row); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported vdiff action: %s", req.Action)
+	}
+	return resp, nil
+}
+
+func (vde *Engine) getVDiff(ctx context.Context, dbClient binlogplayer.DBClient, uuid string) (sqltypes.RowNamedValues, error) {
+	qr, err := withDDL.Exec(ctx, fmt.Sprintf(sqlGetVDiffByUUID, encodeString(uuid)), dbClient.ExecuteFetch)
+	if err != nil {
+		return nil, err
+	}
+	row := sqltypes.ToNamedResult(qr).Row()
+	if row == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "vdiff %s not found", uuid)
+	}
+	return row, nil
+}
+
+// addETAs adds an eta column to the result of a show query, with the
+// estimated completion time of the vdiffs that are running. It must
+// be called with mu held.
+func (vde *Engine) addETAs(qr *sqltypes.Result) *sqltypes.Result {
+	named := sqltypes.ToNamedResult(qr)
+	// Sum up the rows left to compare of each vdiff.
+	rowsLeft := make(map[string]int64)
+	for _, row := range named.Rows {
+		if row.AsString("table_state", "") == stateCompleted {
+			continue
+		}
+		uuid := row.AsString("vdiff_uuid", "")
+		if left := row.AsInt64("table_rows", 0) - row.AsInt64("rows_compared", 0); left > 0 {
+			rowsLeft[uuid] += left
+		}
+	}
+	etas := make(map[string]string)
+	for _, ct := range vde.controllers {
+		if _, ok := rowsLeft[ct.uuid]; !ok {
+			continue
+		}
+		if eta := ct.eta(rowsLeft[ct.uuid]); !eta.IsZero() {
+			etas[ct.uuid] = eta.UTC().Format("2006-01-02 15:04:05")
+		}
+	}
+
+	result := &sqltypes.Result{
+		Fields: append(qr.Fields, &querypb.Field{Name: "eta", Type: sqltypes.VarChar}),
+		Rows:   make([][]sqltypes.Value, 0, len(qr.Rows)),
+	}
+	for i, row := range qr.Rows {
+		eta := etas[named.Rows[i].AsString("vdiff_uuid", "")]
+		result.Rows = append(result.Rows, append(row, sqltypes.NewVarChar(eta)))
+	}
+	return result
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// fakeTMClient is not called, because the tests don't get to the
+// point where the vdiffs wait for the source positions.
+type fakeTMClient struct {
+	tmclient.TabletManagerClient
+}
+
+func newTestVDiffEngine(dbClient *binlogplayer.MockDBClient) *Engine {
+	tablet := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 100},
+		Keyspace: "ks",
+		Shard:    "0",
+		Type:     topodatapb.TabletType_MASTER,
+	}
+	dbClientFactory := func() binlogplayer.DBClient { return dbClient }
+	return NewTestEngine(memorytopo.NewServer("cell1"), tablet, nil, nil, &fakeTMClient{}, dbClientFactory, "db")
+}
+
+func vdiffResult(rows ...string) *sqltypes.Result {
+	return sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"id|vdiff_uuid|workflow|state|options",
+		"int64|varbinary|varbinary|varbinary|varbinary"),
+		rows...)
+}
+
+// expectFailedRun adds the queries of a run of vdiff 1 on a workflow
+// that has no streams, which fails after marking the vdiff started.
+func expectFailedRun(dbClient *binlogplayer.MockDBClient) {
+	dbClient.ExpectRequest("update _vt.vdiff set state = 'started', last_error = '', started_at = utc_timestamp() where id = 1", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("select id, source, pos from _vt.vreplication where db_name = 'db' and workflow = 'wf'", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("update _vt.vdiff set state = 'error', last_error = 'workflow wf not found in db' where id = 1", &sqltypes.Result{}, nil)
+}
+
+func TestEngineOpenResumesVDiffs(t *testing.T) {
+	dbClient := binlogplayer.NewMockDBClient(t)
+	vde := newTestVDiffEngine(dbClient)
+
+	dbClient.ExpectRequest("select * from _vt.vdiff where db_name = 'db' and state in ('pending', 'started')", vdiffResult("1|uuid1|wf|started|"), nil)
+	expectFailedRun(dbClient)
+	vde.Open(context.Background())
+	defer vde.Close()
+	dbClient.Wait()
+	assert.Len(t, vde.controllers, 1)
+}
+
+func TestEnginePerformVDiffAction(t *testing.T) {
+	dbClient := binlogplayer.NewMockDBClient(t)
+	vde := newTestVDiffEngine(dbClient)
+
+	_, err := vde.PerformVDiffAction(context.Background(), &tabletmanagerdatapb.VDiffRequest{Action: ShowAction, Workflow: "wf"})
+	require.EqualError(t, err, "vdiff engine is closed")
+
+	dbClient.ExpectRequest("select * from _vt.vdiff where db_name = 'db' and state in ('pending', 'started')", &sqltypes.Result{}, nil)
+	vde.Open(context.Background())
+	defer vde.Close()
+	dbClient.Wait()
+
+	perform := func(action, uuid string) (*tabletmanagerdatapb.VDiffResponse, error) {
+		return vde.PerformVDiffAction(context.Background(), &tabletmanagerdatapb.VDiffRequest{
+			Action:    action,
+			Keyspace:  "ks",
+			Workflow:  "wf",
+			VdiffUuid: uuid,
+		})
+	}
+
+	// create
+	_, err = perform(CreateAction, "")
+	require.EqualError(t, err, "a vdiff uuid is required to create a vdiff")
+
+	dbClient.ExpectRequest("insert into _vt.vdiff(vdiff_uuid, workflow, keyspace, shard, db_name, state, options) values('uuid1', 'wf', 'ks', '0', 'db', 'pending', '')", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("select * from _vt.vdiff where vdiff_uuid = 'uuid1'", vdiffResult("1|uuid1|wf|pending|"), nil)
+	expectFailedRun(dbClient)
+	resp, err := perform(CreateAction, "uuid1")
+	require.NoError(t, err)
+	assert.Equal(t, "uuid1", resp.VdiffUuid)
+	dbClient.Wait()
+
+	// stop
+	dbClient.ExpectRequest("select * from _vt.vdiff where vdiff_uuid = 'uuid1'", vdiffResult("1|uuid1|wf|error|"), nil)
+	dbClient.ExpectRequest("update _vt.vdiff set state = 'stopped', last_error = '' where id = 1", &sqltypes.Result{}, nil)
+	_, err = perform(StopAction, "uuid1")
+	require.NoError(t, err)
+	dbClient.Wait()
+	assert.Len(t, vde.controllers, 0)
+
+	// resume
+	dbClient.ExpectRequest("select * from _vt.vdiff where vdiff_uuid = 'uuid1'", vdiffResult("1|uuid1|wf|stopped|"), nil)
+	dbClient.ExpectRequest("update _vt.vdiff set state = 'pending', last_error = '' where id = 1", &sqltypes.Result{}, nil)
+	expectFailedRun(dbClient)
+	_, err = perform(ResumeAction, "uuid1")
+	require.NoError(t, err)
+	dbClient.Wait()
+	assert.Len(t, vde.controllers, 1)
+
+	// A vdiff that is already running can't be resumed.
+	dbClient.ExpectRequest("select * from _vt.vdiff where vdiff_uuid = 'uuid1'", vdiffResult("1|uuid1|wf|started|"), nil)
+	_, err = perform(ResumeAction, "uuid1")
+	require.EqualError(t, err, "vdiff uuid1 is already running")
+
+	// Completed vdiffs can't be stopped or resumed.
+	for _, action := range []string{StopAction, ResumeAction} {
+		dbClient.ExpectRequest("select * from _vt.vdiff where vdiff_uuid = 'uuid1'", vdiffResult("1|uuid1|wf|completed|"), nil)
+		_, err = perform(action, "uuid1")
+		require.EqualError(t, err, "vdiff uuid1 is already completed")
+	}
+
+	dbClient.ExpectRequest("select * from _vt.vdiff where vdiff_uuid = 'uuid2'", vdiffResult(), nil)
+	_, err = perform(StopAction, "uuid2")
+	require.EqualError(t, err, "vdiff uuid2 not found")
+
+	_, err = perform("badaction", "uuid1")
+	require.EqualError(t, err, "unsupported vdiff action: badaction")
+	dbClient.Wait()
+}
+
+func TestEngineShowVDiffs(t *testing.T) {
+	dbClient := binlogplayer.NewMockDBClient(t)
+	vde := newTestVDiffEngine(dbClient)
+	dbClient.ExpectRequest("select * from _vt.vdiff where db_name = 'db' and state in ('pending', 'started')", &sqltypes.Result{}, nil)
+	vde.Open(context.Background())
+	defer vde.Close()
+	dbClient.Wait()
+
+	// The ETA is only estimated for the vdiffs that are running and
+	// have compared rows.
+	ct := &controller{uuid: "uuid1", done: make(chan struct{}), cancel: func() {}}
+	close(ct.done)
+	ct.rowsCompared.Set(10)
+	vde.controllers[1] = ct
+
+	show := sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"vdiff_uuid|workflow|state|table_name|table_state|table_rows|rows_compared",
+		"varbinary|varbinary|varbinary|varbinary|varbinary|int64|int64"),
+		"uuid1|wf|started|t1|completed|10|10",
+		"uuid1|wf|started|t2|started|100|10",
+		"uuid2|wf|completed|t1|completed|10|10",
+	)
+	testcases := []struct {
+		uuid  string
+		query string
+	}{{
+		uuid:  LastVDiff,
+		query: fmt.Sprintf(sqlShowLastVDiff, "'db'", "'wf'", "'db'", "'wf'"),
+	}, {
+		uuid:  AllVDiffs,
+		query: fmt.Sprintf(sqlShowAllVDiffs, "'db'", "'wf'"),
+	}, {
+		uuid:  "uuid1",
+		query: fmt.Sprintf(sqlShowVDiffByUUID, "'db'", "'wf'", "'uuid1'"),
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.uuid, func(t *testing.T) {
+			dbClient.ExpectRequest(tcase.query, show, nil)
+			resp, err := vde.PerformVDiffAction(context.Background(), &tabletmanagerdatapb.VDiffRequest{
+				Action:    ShowAction,
+				Workflow:  "wf",
+				VdiffUuid: tcase.uuid,
+			})
+			require.NoError(t, err)
+			dbClient.Wait()
+
+			result := sqltypes.Proto3ToResult(resp.Output)
+			require.Len(t, result.Rows, 3)
+			assert.Equal(t, "eta", result.Fields[len(result.Fields)-1].Name)
+			etas := make([]string, 0, len(result.Rows))
+			for _, row := range sqltypes.ToNamedResult(result).Rows {
+				etas = append(etas, row.AsString("eta", ""))
+			}
+			assert.NotEqual(t, "", etas[0])
+			assert.Equal(t, etas[0], etas[1])
+			assert.Equal(t, "", etas[2])
+		})
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+const (
+	createVDiffTable = `create table if not exists _vt.vdiff (
+  id bigint(20) unsigned not null auto_increment,
+  vdiff_uuid varbinary(64) not null,
+  workflow varbinary(1000) not null,
+  keyspace varbinary(256) not null,
+  shard varbinary(255) not null,
+  db_name varbinary(255) not null,
+  state varbinary(64) not null,
+  options varbinary(2048),
+  created_at timestamp not null default current_timestamp,
+  started_at timestamp null default null,
+  completed_at timestamp null default null,
+  last_error varbinary(1024),
+  primary key (id),
+  unique key uuid_idx (vdiff_uuid),
+  key workflow_idx (db_name, workflow))`
+
+	// lastpk is the text encoded query.QueryResult of the pk values of
+	// the last row compared, from which the diff of the table resumes.
+	createVDiffTableTable = `create table if not exists _vt.vdiff_table (
+  vdiff_id bigint(20) unsigned not null,
+  table_name varbinary(128) not null,
+  state varbinary(64) not null,
+  lastpk varbinary(10000),
+  table_rows bigint(20) not null default 0,
+  rows_compared bigint(20) not null default 0,
+  report varbinary(2048),
+  created_at timestamp not null default current_timestamp,
+  updated_at timestamp not null default current_timestamp on update current_timestamp,
+  primary key (vdiff_id, table_name))`
+)
+
+const (
+	sqlNewVDiff                = "insert into _vt.vdiff(vdiff_uuid, workflow, keyspace, shard, db_name, state, options) values(%s, %s, %s, %s, %s, %s, %s)"
+	sqlGetVDiffByUUID          = "select * from _vt.vdiff where vdiff_uuid = %s"
+	sqlGetVDiffsToRun          = "select * from _vt.vdiff where db_name = %s and state in ('pending', 'started')"
+	sqlUpdateVDiffState        = "update _vt.vdiff set state = %s, last_error = %s where id = %d"
+	sqlUpdateVDiffStarted      = "update _vt.vdiff set state = 'started', last_error = '', started_at = utc_timestamp() where id = %d"
+	sqlUpdateVDiffCompleted    = "update _vt.vdiff set state = 'completed', completed_at = utc_timestamp() where id = %d"
+	sqlGetTableDiffs           = "select table_name, state, lastpk, table_rows, rows_compared, report from _vt.vdiff_table where vdiff_id = %d"
+	sqlNewTableDiff            = "insert into _vt.vdiff_table(vdiff_id, table_name, state, table_rows) values(%d, %s, 'pending', %d)"
+	sqlUpdateTableDiffState    = "update _vt.vdiff_table set state = %s where vdiff_id = %d and table_name = %s"
+	sqlUpdateTableDiffProgress = "update _vt.vdiff_table set lastpk = %s, rows_compared = %d, report = %s where vdiff_id = %d and table_name = %s"
+	sqlGetTableRows            = "select table_rows from information_schema.tables where table_schema = %s and table_name = %s"
+	sqlGetVReplicationStreams  = "select id, source, pos from _vt.vreplication where db_name = %s and workflow = %s"
+
+	// The show queries report one row per table of each vdiff.
+	sqlShowVDiffs = `select vd.vdiff_uuid, vd.workflow, vd.state, vd.started_at, vd.completed_at, vd.last_error,
+  vdt.table_name, vdt.state as table_state, vdt.table_rows, vdt.rows_compared, vdt.report
+  from _vt.vdiff as vd left join _vt.vdiff_table as vdt on vd.id = vdt.vdiff_id
+  where vd.db_name = %s and vd.workflow = %s`
+	sqlShowVDiffByUUID = sqlShowVDiffs + " and vd.vdiff_uuid = %s order by vdt.table_name"
+	sqlShowLastVDiff   = sqlShowVDiffs + " and vd.id = (select max(id) from _vt.vdiff where db_name = %s and workflow = %s) order by vdt.table_name"
+	sqlShowAllVDiffs   = sqlShowVDiffs + " order by vd.id, vdt.table_name"
+)
@@ -0,0 +1,211 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff/diffutil"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// checkpointInterval is how often the progress of a table diff is saved.
+// It can be changed to a smaller value for tests.
+var checkpointInterval = 10 * time.Second
+
+// tableDiffer performs the diff of one table, resuming after lastpk.
+type tableDiffer struct {
+	plan *tablePlan
+
+	// lastpk contains the pk values of the last row compared, nil if
+	// no row was compared yet. All the rows up to lastpk were consumed
+	// from both the sources and the target.
+	lastpk []sqltypes.Value
+	// report accumulates over all the runs of the diff.
+	report diffutil.DiffReport
+	// maxRows stops the diff once report.ProcessedRows reaches it,
+	// if greater than 0.
+	maxRows int64
+
+	// checkpoint saves the progress of the diff. It's called at
+	// checkpointInterval, and when the diff returns.
+	checkpoint func(td *tableDiffer) error
+	// savedRows is report.ProcessedRows as of the last checkpoint.
+	savedRows int64
+}
+
+// diff compares the rows of the source and target, in pk order.
+func (td *tableDiffer) diff(sourceExecutor, targetExecutor *diffutil.PrimitiveExecutor) (err error) {
+	defer func() {
+		if cerr := td.checkpoint(td); cerr != nil && err == nil {
+			err = cerr
+		}
+	}()
+
+	tableName := td.plan.table.Name
+	lastCheckpoint := time.Now()
+	var sourceRow, targetRow []sqltypes.Value
+	advanceSource := true
+	advanceTarget := true
+	for {
+		if td.maxRows > 0 && td.report.ProcessedRows >= td.maxRows {
+			log.Infof("VDiff of table %s: stopping, limit of %d rows reached", tableName, td.maxRows)
+			return nil
+		}
+		if time.Since(lastCheckpoint) >= checkpointInterval {
+			if err := td.checkpoint(td); err != nil {
+				return err
+			}
+			lastCheckpoint = time.Now()
+		}
+		if advanceSource {
+			sourceRow, err = sourceExecutor.Next()
+			if err != nil {
+				return err
+			}
+		}
+		if advanceTarget {
+			targetRow, err = targetExecutor.Next()
+			if err != nil {
+				return err
+			}
+		}
+
+		if sourceRow == nil && targetRow == nil {
+			return nil
+		}
+
+		advanceSource = true
+		advanceTarget = true
+		td.report.ProcessedRows++
+
+		// Compare pk values. A missing row sorts after all others.
+		var c int
+		switch {
+		case sourceRow == nil:
+			c = 1
+		case targetRow == nil:
+			c = -1
+		default:
+			c, err = td.compare(sourceRow, targetRow, td.plan.comparePKs)
+			if err != nil {
+				return err
+			}
+		}
+		switch {
+		case c < 0:
+			if td.report.ExtraRowsSource < 10 {
+				log.Errorf("VDiff of table %s: extra row %v on source: %v", tableName, td.report.ExtraRowsSource, sourceRow)
+			}
+			td.report.ExtraRowsSource++
+			td.setLastPK(sourceRow)
+			advanceTarget = false
+			continue
+		case c > 0:
+			if td.report.ExtraRowsTarget < 10 {
+				log.Errorf("VDiff of table %s: extra row %v on target: %v", tableName, td.report.ExtraRowsTarget, targetRow)
+			}
+			td.report.ExtraRowsTarget++
+			td.setLastPK(targetRow)
+			advanceSource = false
+			continue
+		}
+
+		// c == 0
+		// Compare non-pk values.
+		c, err = td.compare(sourceRow, targetRow, td.plan.compareCols)
+		switch {
+		case err != nil:
+			return err
+		case c != 0:
+			if td.report.MismatchedRows < 10 {
+				log.Errorf("VDiff of table %s: different content %v in same PK: %v != %v", tableName, td.report.MismatchedRows, sourceRow, targetRow)
+			}
+			td.report.MismatchedRows++
+		default:
+			td.report.MatchingRows++
+		}
+		td.setLastPK(targetRow)
+	}
+}
+
+func (td *tableDiffer) compare(sourceRow, targetRow []sqltypes.Value, cols []int) (int, error) {
+	for _, col := range cols {
+		if col == -1 {
+			continue
+		}
+		c, err := evalengine.NullsafeCompare(sourceRow[col], targetRow[col])
+		if err != nil {
+			return 0, err
+		}
+		if c != 0 {
+			return c, nil
+		}
+	}
+	return 0, nil
+}
+
+func (td *tableDiffer) setLastPK(row []sqltypes.Value) {
+	lastpk := make([]sqltypes.Value, len(td.plan.pkCols))
+	for i, col := range td.plan.pkCols {
+		lastpk[i] = row[col]
+	}
+	td.lastpk = lastpk
+}
+
+// encodeLastPK returns lastpk as a text encoded query.QueryResult,
+// as saved in _vt.vdiff_table.
+func (td *tableDiffer) encodeLastPK() string {
+	if td.lastpk == nil {
+		return ""
+	}
+	fields := make([]*querypb.Field, len(td.plan.pkCols))
+	for i, col := range td.plan.pkCols {
+		fields[i] = &querypb.Field{
+			Name: sqlparser.String(td.plan.targetSelect.SelectExprs[col]),
+			Type: td.lastpk[i].Type(),
+		}
+	}
+	return proto.CompactTextString(&querypb.QueryResult{
+		Fields: fields,
+		Rows:   []*querypb.Row{sqltypes.RowToProto3(td.lastpk)},
+	})
+}
+
+// decodeLastPK is the inverse of encodeLastPK.
+func decodeLastPK(encoded string) ([]sqltypes.Value, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+	var qr querypb.QueryResult
+	if err := proto.UnmarshalText(encoded, &qr); err != nil {
+		return nil, err
+	}
+	result := sqltypes.Proto3ToResult(&qr)
+	if len(result.Rows) != 1 {
+		return nil, fmt.Errorf("unexpected lastpk: %v", encoded)
+	}
+	return result.Rows[0], nil
+}
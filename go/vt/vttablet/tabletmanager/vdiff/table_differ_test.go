@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff/diffutil"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// fakePrimitive streams a fixed list of results.
+type fakePrimitive struct {
+	engine.Primitive
+	results []*sqltypes.Result
+}
+
+func (fp *fakePrimitive) StreamExecute(vcursor engine.VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	for _, result := range fp.results {
+		if err := callback(result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newTestExecutor(rows ...string) *diffutil.PrimitiveExecutor {
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("c1|c2", "int64|int64"), rows...)
+	return diffutil.NewPrimitiveExecutor(context.Background(), &fakePrimitive{results: []*sqltypes.Result{result}})
+}
+
+func TestTableDiffer(t *testing.T) {
+	tp, err := buildTablePlan(testSchema.TableDefinitions[0], "select * from t1")
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name    string
+		source  []string
+		target  []string
+		maxRows int64
+		report  diffutil.DiffReport
+		lastpk  int64
+	}{{
+		name:   "matching",
+		source: []string{"1|1", "2|2"},
+		target: []string{"1|1", "2|2"},
+		report: diffutil.DiffReport{ProcessedRows: 2, MatchingRows: 2},
+		lastpk: 2,
+	}, {
+		name:   "mismatched",
+		source: []string{"1|1", "2|2"},
+		target: []string{"1|1", "2|3"},
+		report: diffutil.DiffReport{ProcessedRows: 2, MatchingRows: 1, MismatchedRows: 1},
+		lastpk: 2,
+	}, {
+		name:   "extra rows",
+		source: []string{"1|1", "3|3"},
+		target: []string{"2|2", "3|3", "4|4"},
+		report: diffutil.DiffReport{ProcessedRows: 4, MatchingRows: 1, ExtraRowsSource: 1, ExtraRowsTarget: 2},
+		lastpk: 4,
+	}, {
+		name:    "max rows",
+		source:  []string{"1|1", "2|2", "3|3"},
+		target:  []string{"1|1", "2|2", "3|3"},
+		maxRows: 2,
+		report:  diffutil.DiffReport{ProcessedRows: 2, MatchingRows: 2},
+		lastpk:  2,
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			checkpoints := 0
+			td := &tableDiffer{
+				plan:    tp,
+				maxRows: tcase.maxRows,
+				checkpoint: func(td *tableDiffer) error {
+					checkpoints++
+					return nil
+				},
+			}
+			err := td.diff(newTestExecutor(tcase.source...), newTestExecutor(tcase.target...))
+			require.NoError(t, err)
+			assert.Equal(t, tcase.report, td.report)
+			assert.Equal(t, []sqltypes.Value{sqltypes.NewInt64(tcase.lastpk)}, td.lastpk)
+			assert.Equal(t, 1, checkpoints)
+		})
+	}
+}
+
+func TestEncodeLastPK(t *testing.T) {
+	tp, err := buildTablePlan(testSchema.TableDefinitions[1], "select * from t2")
+	require.NoError(t, err)
+	td := &tableDiffer{plan: tp}
+	assert.Equal(t, "", td.encodeLastPK())
+	lastpk, err := decodeLastPK("")
+	require.NoError(t, err)
+	assert.Nil(t, lastpk)
+
+	td.lastpk = []sqltypes.Value{sqltypes.NewInt64(10), sqltypes.NewVarChar("abc")}
+	lastpk, err = decodeLastPK(td.encodeLastPK())
+	require.NoError(t, err)
+	assert.Equal(t, td.lastpk, lastpk)
+}
@@ -0,0 +1,275 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff/diffutil"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// tablePlan is the plan for diffing one table of the workflow.
+type tablePlan struct {
+	table *tabletmanagerdatapb.TableDefinition
+
+	// sourceSelect and targetSelect are the queries that stream the
+	// rows to compare, in pk order. The columns of both queries line up.
+	sourceSelect *sqlparser.Select
+	targetSelect *sqlparser.Select
+
+	// compareCols is the list of non-pk columns to compare.
+	// If the value is -1, it's a pk column and should not be
+	// compared.
+	compareCols []int
+	// comparePKs is the list of pk columns to compare. For text
+	// columns, they point at the weight_string of the column.
+	comparePKs []int
+	// pkCols is the list of the pk columns as selected, which are
+	// the values saved as lastpk.
+	pkCols []int
+
+	// aggregates contains the aggregate functions of the source query,
+	// if any, which need to be re-aggregated across source shards.
+	aggregates []engine.AggregateParams
+}
+
+// buildTablePlans builds the plans for the tables of the filter that
+// have a definition in schm. If tablesToInclude is set, only those
+// tables are diffed.
+func buildTablePlans(filter *binlogdatapb.Filter, schm *tabletmanagerdatapb.SchemaDefinition, tablesToInclude []string) (map[string]*tablePlan, error) {
+	plans := make(map[string]*tablePlan)
+	for _, table := range schm.TableDefinitions {
+		rule, err := vreplication.MatchTable(table.Name, filter)
+		if err != nil {
+			return nil, err
+		}
+		if rule == nil || rule.Filter == "exclude" {
+			continue
+		}
+		if len(tablesToInclude) > 0 && !containsString(tablesToInclude, table.Name) {
+			continue
+		}
+		query := rule.Filter
+		if rule.Filter == "" || key.IsKeyRange(rule.Filter) {
+			buf := sqlparser.NewTrackedBuffer(nil)
+			buf.Myprintf("select * from %v", sqlparser.NewTableIdent(table.Name))
+			query = buf.String()
+		}
+		plans[table.Name], err = buildTablePlan(table, query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(tablesToInclude) > 0 && len(tablesToInclude) != len(plans) {
+		return nil, fmt.Errorf("one or more tables provided are not present in the workflow: %v", tablesToInclude)
+	}
+	return plans, nil
+}
+
+// buildTablePlan builds the plan for one table, from the filter query
+// that vreplication uses for it.
+func buildTablePlan(table *tabletmanagerdatapb.TableDefinition, query string) (*tablePlan, error) {
+	statement, err := sqlparser.Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := statement.(*sqlparser.Select)
+	if !ok {
+		return nil, fmt.Errorf("unexpected: %v", sqlparser.String(statement))
+	}
+	tp := &tablePlan{
+		table: table,
+	}
+	sourceSelect := &sqlparser.Select{}
+	targetSelect := &sqlparser.Select{}
+	for _, selExpr := range sel.SelectExprs {
+		switch selExpr := selExpr.(type) {
+		case *sqlparser.StarExpr:
+			// If it's a '*' expression, expand column list from the schema.
+			for _, fld := range table.Fields {
+				aliased := &sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent(fld.Name)}}
+				sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, aliased)
+				targetSelect.SelectExprs = append(targetSelect.SelectExprs, aliased)
+			}
+		case *sqlparser.AliasedExpr:
+			var targetCol *sqlparser.ColName
+			if !selExpr.As.IsEmpty() {
+				targetCol = &sqlparser.ColName{Name: selExpr.As}
+			} else {
+				if colAs, ok := selExpr.Expr.(*sqlparser.ColName); ok {
+					targetCol = colAs
+				} else {
+					return nil, fmt.Errorf("expression needs an alias: %v", sqlparser.String(selExpr))
+				}
+			}
+			// If the input was "select a as b", then source will use "a" and target will use "b".
+			sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, selExpr)
+			targetSelect.SelectExprs = append(targetSelect.SelectExprs, &sqlparser.AliasedExpr{Expr: targetCol})
+
+			// Check if it's an aggregate expression
+			if expr, ok := selExpr.Expr.(*sqlparser.FuncExpr); ok {
+				switch fname := expr.Name.Lowered(); fname {
+				case "count", "sum":
+					tp.aggregates = append(tp.aggregates, engine.AggregateParams{
+						Opcode: engine.SupportedAggregates[fname],
+						Col:    len(sourceSelect.SelectExprs) - 1,
+					})
+				}
+			}
+		default:
+			return nil, fmt.Errorf("unexpected: %v", sqlparser.String(statement))
+		}
+	}
+	fields := make(map[string]querypb.Type)
+	for _, field := range table.Fields {
+		fields[strings.ToLower(field.Name)] = field.Type
+	}
+
+	// Start with adding all columns for comparison.
+	tp.compareCols = make([]int, len(sourceSelect.SelectExprs))
+	for i := range tp.compareCols {
+		colname := targetSelect.SelectExprs[i].(*sqlparser.AliasedExpr).Expr.(*sqlparser.ColName).Name.Lowered()
+		typ, ok := fields[colname]
+		if !ok {
+			return nil, fmt.Errorf("column %v not found in table %v", colname, table.Name)
+		}
+		tp.compareCols[i] = i
+		if sqltypes.IsText(typ) {
+			// For text columns, we need to additionally pull their weight string values for lexical comparisons.
+			sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, diffutil.WrapWeightString(sourceSelect.SelectExprs[i]))
+			targetSelect.SelectExprs = append(targetSelect.SelectExprs, diffutil.WrapWeightString(targetSelect.SelectExprs[i]))
+			// Update the column number to point at the weight_string column instead.
+			tp.compareCols[i] = len(sourceSelect.SelectExprs) - 1
+		}
+	}
+
+	sourceSelect.From = sel.From
+	// The target table name should the one that matched the rule.
+	// It can be different from the source table.
+	targetSelect.From = sqlparser.TableExprs{
+		&sqlparser.AliasedTableExpr{
+			Expr: &sqlparser.TableName{
+				Name: sqlparser.NewTableIdent(table.Name),
+			},
+		},
+	}
+
+	orderby, comparePKs, pkCols, err := diffutil.FindPKs(table, targetSelect, tp.compareCols)
+	if err != nil {
+		return nil, err
+	}
+	if len(orderby) == 0 {
+		return nil, fmt.Errorf("table %v has no primary key", table.Name)
+	}
+	tp.comparePKs = comparePKs
+	tp.pkCols = pkCols
+	// Remove in_keyrange. It's not understood by mysql.
+	sourceSelect.Where = diffutil.RemoveKeyrange(sel.Where)
+	// The source should also perform the group by.
+	sourceSelect.GroupBy = sel.GroupBy
+	sourceSelect.OrderBy = orderby
+
+	// The target should perform the order by, but not the group by.
+	targetSelect.OrderBy = orderby
+
+	tp.sourceSelect = sourceSelect
+	tp.targetSelect = targetSelect
+	return tp, nil
+}
+
+// queries returns the source and target queries that resume the diff
+// after lastpk. If lastpk is nil, the queries stream the whole table.
+func (tp *tablePlan) queries(lastpk []sqltypes.Value) (source, target string) {
+	if lastpk == nil {
+		return sqlparser.String(tp.sourceSelect), sqlparser.String(tp.targetSelect)
+	}
+	return sqlparser.String(resumeSelect(tp.sourceSelect, tp.pkCols, lastpk)), sqlparser.String(resumeSelect(tp.targetSelect, tp.pkCols, lastpk))
+}
+
+// resumeSelect returns a copy of sel restricted to the rows that come
+// after lastpk in pk order. The condition is expanded from
+// (pk1, pk2) > (v1, v2) into (pk1 > v1) or (pk1 = v1 and pk2 > v2),
+// which mysql can use an index for.
+func resumeSelect(sel *sqlparser.Select, pkCols []int, lastpk []sqltypes.Value) *sqlparser.Select {
+	var cond sqlparser.Expr
+	for i := range pkCols {
+		var term sqlparser.Expr
+		for j := 0; j <= i; j++ {
+			op := sqlparser.EqualOp
+			if j == i {
+				op = sqlparser.GreaterThanOp
+			}
+			cmp := &sqlparser.ComparisonExpr{
+				Operator: op,
+				Left:     sel.SelectExprs[pkCols[j]].(*sqlparser.AliasedExpr).Expr,
+				Right:    valueToExpr(lastpk[j]),
+			}
+			if term == nil {
+				term = cmp
+				continue
+			}
+			term = &sqlparser.AndExpr{Left: term, Right: cmp}
+		}
+		if cond == nil {
+			cond = term
+			continue
+		}
+		cond = &sqlparser.OrExpr{Left: cond, Right: term}
+	}
+	newSel := *sel
+	if sel.Where != nil {
+		newSel.Where = sqlparser.NewWhere(sqlparser.WhereClause, &sqlparser.AndExpr{
+			Left:  sel.Where.Expr,
+			Right: cond,
+		})
+	} else {
+		newSel.Where = sqlparser.NewWhere(sqlparser.WhereClause, cond)
+	}
+	return &newSel
+}
+
+func valueToExpr(v sqltypes.Value) sqlparser.Expr {
+	switch {
+	case v.IsNull():
+		return &sqlparser.NullVal{}
+	case v.IsIntegral():
+		return sqlparser.NewIntLiteral(v.ToBytes())
+	case v.IsFloat() || v.Type() == sqltypes.Decimal:
+		return sqlparser.NewFloatLiteral(v.ToBytes())
+	default:
+		return sqlparser.NewStrLiteral(v.ToBytes())
+	}
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
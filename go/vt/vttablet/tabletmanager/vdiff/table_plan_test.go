@@ -0,0 +1,127 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+var testSchema = &tabletmanagerdatapb.SchemaDefinition{
+	TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+		Name:              "t1",
+		Columns:           []string{"c1", "c2"},
+		PrimaryKeyColumns: []string{"c1"},
+		Fields:            sqltypes.MakeTestFields("c1|c2", "int64|int64"),
+	}, {
+		Name:              "t2",
+		Columns:           []string{"c1", "c2", "c3"},
+		PrimaryKeyColumns: []string{"c1", "c2"},
+		Fields:            sqltypes.MakeTestFields("c1|c2|c3", "int64|varchar|int64"),
+	}, {
+		Name:    "nopk",
+		Columns: []string{"c1", "c2"},
+		Fields:  sqltypes.MakeTestFields("c1|c2", "int64|int64"),
+	}},
+}
+
+func TestBuildTablePlans(t *testing.T) {
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select * from t1 where in_keyrange('-80')",
+		}, {
+			Match: "t2",
+		}},
+	}
+	plans, err := buildTablePlans(filter, testSchema, nil)
+	require.NoError(t, err)
+	require.Len(t, plans, 2)
+
+	source, target := plans["t1"].queries(nil)
+	assert.Equal(t, "select c1, c2 from t1 order by c1 asc", source)
+	assert.Equal(t, "select c1, c2 from t1 order by c1 asc", target)
+	assert.Equal(t, []int{-1, 1}, plans["t1"].compareCols)
+	assert.Equal(t, []int{0}, plans["t1"].comparePKs)
+	assert.Equal(t, []int{0}, plans["t1"].pkCols)
+
+	// The weight_string of the text pk is compared, but the
+	// value is saved as lastpk.
+	assert.Equal(t, []int{-1, -1, 2}, plans["t2"].compareCols)
+	assert.Equal(t, []int{0, 3}, plans["t2"].comparePKs)
+	assert.Equal(t, []int{0, 1}, plans["t2"].pkCols)
+
+	plans, err = buildTablePlans(filter, testSchema, []string{"t2"})
+	require.NoError(t, err)
+	require.Len(t, plans, 1)
+	assert.NotNil(t, plans["t2"])
+
+	_, err = buildTablePlans(filter, testSchema, []string{"t3"})
+	assert.EqualError(t, err, "one or more tables provided are not present in the workflow: [t3]")
+
+	filter = &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match: "nopk",
+		}},
+	}
+	_, err = buildTablePlans(filter, testSchema, nil)
+	assert.EqualError(t, err, "table nopk has no primary key")
+}
+
+func TestTablePlanQueries(t *testing.T) {
+	testcases := []struct {
+		table  *tabletmanagerdatapb.TableDefinition
+		query  string
+		lastpk []sqltypes.Value
+		source string
+		target string
+	}{{
+		table:  testSchema.TableDefinitions[0],
+		query:  "select * from t1",
+		lastpk: []sqltypes.Value{sqltypes.NewInt64(10)},
+		source: "select c1, c2 from t1 where c1 > 10 order by c1 asc",
+		target: "select c1, c2 from t1 where c1 > 10 order by c1 asc",
+	}, {
+		table:  testSchema.TableDefinitions[0],
+		query:  "select c1, c2 from t1 where c2 = 2",
+		lastpk: []sqltypes.Value{sqltypes.NewInt64(10)},
+		source: "select c1, c2 from t1 where c2 = 2 and c1 > 10 order by c1 asc",
+		target: "select c1, c2 from t1 where c1 > 10 order by c1 asc",
+	}, {
+		table:  testSchema.TableDefinitions[1],
+		query:  "select * from t2",
+		lastpk: []sqltypes.Value{sqltypes.NewInt64(10), sqltypes.NewVarChar("a'b")},
+		source: "select c1, c2, c3, weight_string(c2) from t2 where c1 > 10 or c1 = 10 and c2 > 'a\\'b' order by c1 asc, c2 asc",
+		target: "select c1, c2, c3, weight_string(c2) from t2 where c1 > 10 or c1 = 10 and c2 > 'a\\'b' order by c1 asc, c2 asc",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.query, func(t *testing.T) {
+			tp, err := buildTablePlan(tcase.table, tcase.query)
+			require.NoError(t, err)
+			source, target := tp.queries(tcase.lastpk)
+			assert.Equal(t, tcase.source, source)
+			assert.Equal(t, tcase.target, target)
+		})
+	}
+}
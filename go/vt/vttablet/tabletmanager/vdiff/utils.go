@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+//-----------------------------------------------------------------
+// shardStreamer
+
+// shardStreamer streams the rows of a query from one tablet. This works
+// for the sources as well as the target. It satisfies
+// engine.StreamExecutor, and can be added to the Primitives of an
+// engine.MergeSort. A new shardStreamer is started for every table.
+type shardStreamer struct {
+	tablet           *topodatapb.Tablet
+	shard            string
+	snapshotPosition string
+	result           chan *sqltypes.Result
+	err              error
+}
+
+// startStream starts streaming the results of query from tablet,
+// and waits for the snapshot position of the query to be known.
+func startStream(ctx context.Context, tablet *topodatapb.Tablet, keyspace, shard, query string) (*shardStreamer, error) {
+	ss := &shardStreamer{
+		tablet: tablet,
+		shard:  shard,
+		result: make(chan *sqltypes.Result, 1),
+	}
+	gtidch := make(chan string, 1)
+	go ss.stream(ctx, keyspace, query, gtidch)
+
+	// Wait for the gtid to be sent. If it's not received, there was an error
+	// which would be stored in ss.err.
+	gtid, ok := <-gtidch
+	if !ok {
+		return nil, ss.err
+	}
+	ss.snapshotPosition = gtid
+	return ss, nil
+}
+
+// stream is called as a goroutine, and communicates its results through channels.
+// It first sends the snapshot gtid to gtidch.
+// Then it streams results to ss.result.
+// Before returning, it sets ss.err, and closes all channels.
+func (ss *shardStreamer) stream(ctx context.Context, keyspace, query string, gtidch chan string) {
+	defer close(ss.result)
+	defer close(gtidch)
+
+	ss.err = func() error {
+		conn, err := tabletconn.GetDialer()(ss.tablet, grpcclient.FailFast(false))
+		if err != nil {
+			return err
+		}
+		defer conn.Close(ctx)
+
+		target := &querypb.Target{
+			Keyspace:   keyspace,
+			Shard:      ss.shard,
+			TabletType: ss.tablet.Type,
+		}
+		var fields []*querypb.Field
+		return conn.VStreamResults(ctx, target, query, func(vrs *binlogdatapb.VStreamResultsResponse) error {
+			if vrs.Fields != nil {
+				fields = vrs.Fields
+				gtidch <- vrs.Gtid
+			}
+			p3qr := &querypb.QueryResult{
+				Fields: fields,
+				Rows:   vrs.Rows,
+			}
+			result := sqltypes.Proto3ToResult(p3qr)
+			// Fields should be received only once, and sent only once.
+			if vrs.Fields == nil {
+				result.Fields = nil
+			}
+			select {
+			case ss.result <- result:
+			case <-ctx.Done():
+				return vterrors.Wrap(ctx.Err(), "VStreamResults")
+			}
+			return nil
+		})
+	}()
+}
+
+// StreamExecute is part of the engine.StreamExecutor interface.
+func (ss *shardStreamer) StreamExecute(vcursor engine.VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	for result := range ss.result {
+		if err := callback(result); err != nil {
+			return err
+		}
+	}
+	return ss.err
+}
+
+func encodeString(in string) string {
+	var buf strings.Builder
+	sqltypes.NewVarChar(in).EncodeSQL(&buf)
+	return buf.String()
+}
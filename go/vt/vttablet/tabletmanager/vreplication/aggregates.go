@@ -0,0 +1,454 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// This file contains the logic of the aggregates that can't be maintained
+// from the target row alone: MIN, MAX, AVG and COUNT(DISTINCT). Each of
+// them is backed by a companion table in the target database, keyed by
+// the primary key of the target table:
+//   - MIN and MAX: the values of the group, and their number of
+//     occurrences. If the current extreme is deleted, the new one is
+//     re-scanned from the companion table.
+//   - COUNT(DISTINCT): the SHA1 of the values of the group, and their
+//     number of occurrences. Values are compared as binary strings.
+//   - AVG: the sum and the number of the non-NULL values of the group.
+// The companion tables are changed in the same transaction as the target
+// rows, so they're always consistent with the replication position. They
+// are dropped by the Engine when the last stream that uses them is deleted.
+
+// aggregateTablePrefix is the prefix of the companion tables.
+const aggregateTablePrefix = "_vt_agg_"
+
+// isAggregateTable returns true if the table is the companion table
+// of an aggregate. Such tables are never replicated.
+func isAggregateTable(tableName string) bool {
+	return strings.HasPrefix(tableName, aggregateTablePrefix)
+}
+
+// hasAggregateTable returns true if the operation needs a companion table.
+func (op operation) hasAggregateTable() bool {
+	switch op {
+	case opMin, opMax, opAvg, opCountDistinct:
+		return true
+	}
+	return false
+}
+
+// AggregateTable describes the companion table of an aggregate column.
+type AggregateTable struct {
+	Name string
+	// TargetName and Column are the target table and its aggregate column.
+	TargetName string
+	Column     string
+	// KeyColumns are the primary key columns of the target table.
+	KeyColumns []string
+	operation  operation
+}
+
+// createStatement returns the statement that creates the companion
+// table. The types of its columns are the ones of the target table.
+func (at *AggregateTable) createStatement(columns map[string]*sqlparser.ColumnDefinition) (string, error) {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("create table if not exists %v (", sqlparser.NewTableIdent(at.Name))
+	for _, name := range at.KeyColumns {
+		col, ok := columns[strings.ToLower(name)]
+		if !ok {
+			return "", fmt.Errorf("column %s not found in table %s", name, at.TargetName)
+		}
+		if !isIndexable(&col.Type) {
+			return "", fmt.Errorf("group by column %s of table %s has type %s, which can't be part of the primary key of a companion table", name, at.TargetName, col.Type.Type)
+		}
+		buf.Myprintf("%v %v, ", col.Name, keyColumnType(&col.Type))
+	}
+	switch at.operation {
+	case opMin, opMax:
+		col, ok := columns[strings.ToLower(at.Column)]
+		if !ok {
+			return "", fmt.Errorf("column %s not found in table %s", at.Column, at.TargetName)
+		}
+		if !isIndexable(&col.Type) {
+			return "", fmt.Errorf("MIN and MAX don't support column %s of table %s: type %s can't be part of the primary key of a companion table", at.Column, at.TargetName, col.Type.Type)
+		}
+		buf.Myprintf("val %v, ", keyColumnType(&col.Type))
+	case opCountDistinct:
+		buf.WriteString("val binary(20) not null, ")
+	case opAvg:
+		buf.WriteString("total decimal(65,30) not null, ")
+	}
+	buf.WriteString("cnt bigint not null, primary key (")
+	for i, name := range at.KeyColumns {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		buf.Myprintf("%v", sqlparser.NewColIdent(name))
+	}
+	if at.operation != opAvg {
+		buf.WriteString(", val")
+	}
+	buf.WriteString("))")
+	return buf.String(), nil
+}
+
+// isIndexable returns true if a column of that type can be part of a
+// primary key without a prefix length, unlike TEXT and BLOB columns.
+func isIndexable(ct *sqlparser.ColumnType) bool {
+	switch ct.SQLType() {
+	case querypb.Type_TEXT, querypb.Type_BLOB, querypb.Type_JSON, querypb.Type_GEOMETRY:
+		return false
+	}
+	return true
+}
+
+// keyColumnType returns the type of a column of a target table, without
+// the options that don't apply to the key of a companion table.
+func keyColumnType(ct *sqlparser.ColumnType) *sqlparser.ColumnType {
+	return &sqlparser.ColumnType{
+		Type:       ct.Type,
+		NotNull:    true,
+		Length:     ct.Length,
+		Unsigned:   ct.Unsigned,
+		Zerofill:   ct.Zerofill,
+		Scale:      ct.Scale,
+		Charset:    ct.Charset,
+		Collate:    ct.Collate,
+		EnumValues: ct.EnumValues,
+	}
+}
+
+// aggregateTableName returns the name of the companion table of an
+// aggregate column.
+func aggregateTableName(tableName string, colName sqlparser.ColIdent) string {
+	return fmt.Sprintf("%s%s_%s", aggregateTablePrefix, tableName, colName.Lowered())
+}
+
+// aggregateColumns returns the columns of a rule filter that need a
+// companion table.
+func aggregateColumns(filter string) []sqlparser.ColIdent {
+	sel, _, err := analyzeSelectFrom(filter)
+	if err != nil {
+		return nil
+	}
+	tpb := &tablePlanBuilder{
+		sendSelect: &sqlparser.Select{},
+		selColumns: make(map[string]bool),
+	}
+	var cols []sqlparser.ColIdent
+	for _, selExpr := range sel.SelectExprs {
+		cexpr, err := tpb.analyzeExpr(selExpr)
+		if err != nil {
+			continue
+		}
+		if cexpr.operation.hasAggregateTable() {
+			cols = append(cols, cexpr.colName)
+		}
+	}
+	return cols
+}
+
+// hasAggregateTables returns true if a rule of the filter has columns
+// that need a companion table.
+func hasAggregateTables(filter *binlogdatapb.Filter) bool {
+	for _, rule := range filter.GetRules() {
+		if len(aggregateColumns(rule.Filter)) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// aggregateTableNames returns the companion tables of the target tables
+// that match the filter.
+func aggregateTableNames(filter *binlogdatapb.Filter, tableNames []string) (map[string]bool, error) {
+	names := make(map[string]bool)
+	for _, tableName := range tableNames {
+		if isAggregateTable(tableName) {
+			continue
+		}
+		rule, err := MatchTable(tableName, filter)
+		if err != nil {
+			return nil, err
+		}
+		if rule == nil {
+			continue
+		}
+		for _, col := range aggregateColumns(rule.Filter) {
+			names[aggregateTableName(tableName, col)] = true
+		}
+	}
+	return names, nil
+}
+
+// analyzeAggregates validates the aggregates that need a companion table.
+func (tpb *tablePlanBuilder) analyzeAggregates() error {
+	for _, cexpr := range tpb.colExprs {
+		if !cexpr.operation.hasAggregateTable() {
+			continue
+		}
+		if tpb.onInsert != insertOnDup {
+			return fmt.Errorf("aggregate column %v requires a group by", cexpr.colName)
+		}
+		if name := aggregateTableName(tpb.name.String(), cexpr.colName); len(name) > 64 {
+			return fmt.Errorf("name of the companion table of column %v is too long: %s", cexpr.colName, name)
+		}
+	}
+	return nil
+}
+
+// generateAggregates generates the companion tables of the aggregate
+// columns, and the statements that maintain them. The inserts add the
+// value of the after image, and the deletes remove the value of the
+// before image.
+func (tpb *tablePlanBuilder) generateAggregates() (tables []*AggregateTable, inserts, deletes []*sqlparser.ParsedQuery) {
+	var keyColumns []string
+	for _, cexpr := range tpb.pkCols {
+		keyColumns = append(keyColumns, cexpr.colName.String())
+	}
+	for _, cexpr := range tpb.colExprs {
+		if !cexpr.operation.hasAggregateTable() {
+			continue
+		}
+		at := &AggregateTable{
+			Name:       aggregateTableName(tpb.name.String(), cexpr.colName),
+			TargetName: tpb.name.String(),
+			Column:     cexpr.colName.String(),
+			KeyColumns: keyColumns,
+			operation:  cexpr.operation,
+		}
+		tables = append(tables, at)
+		inserts = append(inserts, tpb.generateAggregateInsert(at, cexpr))
+		deletes = append(deletes, tpb.generateAggregateDeletes(at, cexpr)...)
+	}
+	return tables, inserts, deletes
+}
+
+func (tpb *tablePlanBuilder) generateAggregateInsert(at *AggregateTable, cexpr *colExpr) *sqlparser.ParsedQuery {
+	bvf := &bindvarFormatter{mode: bvAfter}
+	buf := sqlparser.NewTrackedBuffer(bvf.formatter)
+	buf.Myprintf("insert into %v(", sqlparser.NewTableIdent(at.Name))
+	for _, pkcol := range tpb.pkCols {
+		buf.Myprintf("%v,", pkcol.colName)
+	}
+	if at.operation == opAvg {
+		buf.WriteString("total,cnt) select ")
+	} else {
+		buf.WriteString("val,cnt) select ")
+	}
+	for _, pkcol := range tpb.pkCols {
+		castIfNecessary(buf, pkcol)
+		buf.WriteString(", ")
+	}
+	if at.operation == opCountDistinct {
+		buf.Myprintf("unhex(sha1(%v)), 1", cexpr.expr)
+	} else {
+		buf.Myprintf("%v, 1", cexpr.expr)
+	}
+	buf.Myprintf(" from dual where %v is not null", cexpr.expr)
+	if tpb.lastpk != nil {
+		buf.WriteString(" and ")
+		tpb.generatePKConstraint(buf, bvf)
+	}
+	if at.operation == opAvg {
+		buf.WriteString(" on duplicate key update total=total+values(total), cnt=cnt+1")
+	} else {
+		buf.WriteString(" on duplicate key update cnt=cnt+1")
+	}
+	return buf.ParsedQuery()
+}
+
+func (tpb *tablePlanBuilder) generateAggregateDeletes(at *AggregateTable, cexpr *colExpr) []*sqlparser.ParsedQuery {
+	bvf := &bindvarFormatter{mode: bvBefore}
+	where := func(buf *sqlparser.TrackedBuffer) {
+		buf.WriteString(" where ")
+		tpb.generateKeyMatch(buf)
+		switch at.operation {
+		case opAvg:
+			buf.Myprintf(" and %v is not null", cexpr.expr)
+		case opCountDistinct:
+			buf.Myprintf(" and val=unhex(sha1(%v))", cexpr.expr)
+		default:
+			buf.Myprintf(" and val=%v", cexpr.expr)
+		}
+		if tpb.lastpk != nil {
+			buf.WriteString(" and ")
+			tpb.generatePKConstraint(buf, bvf)
+		}
+	}
+	table := sqlparser.NewTableIdent(at.Name)
+	if at.operation == opAvg {
+		buf := sqlparser.NewTrackedBuffer(bvf.formatter)
+		buf.Myprintf("update %v set total=total-%v, cnt=cnt-1", table, cexpr.expr)
+		where(buf)
+		return []*sqlparser.ParsedQuery{buf.ParsedQuery()}
+	}
+	// The last occurrence of a value is deleted, the others are counted down.
+	del := sqlparser.NewTrackedBuffer(bvf.formatter)
+	del.Myprintf("delete from %v", table)
+	where(del)
+	del.WriteString(" and cnt=1")
+	update := sqlparser.NewTrackedBuffer(bvf.formatter)
+	update.Myprintf("update %v set cnt=cnt-1", table)
+	where(update)
+	return []*sqlparser.ParsedQuery{del.ParsedQuery(), update.ParsedQuery()}
+}
+
+// generateKeyMatch generates the condition that matches the group of a
+// row in a companion table. The bind vars are generated with the current
+// mode of the formatter.
+func (tpb *tablePlanBuilder) generateKeyMatch(buf *sqlparser.TrackedBuffer) {
+	separator := ""
+	for _, cexpr := range tpb.pkCols {
+		if _, ok := cexpr.expr.(*sqlparser.ColName); ok {
+			buf.Myprintf("%s%v=", separator, cexpr.colName)
+			castIfNecessary(buf, cexpr)
+		} else {
+			buf.Myprintf("%s%v=(", separator, cexpr.colName)
+			castIfNecessary(buf, cexpr)
+			buf.WriteString(")")
+		}
+		separator = " and "
+	}
+}
+
+// generateAggregateLookup generates the subquery that computes the value
+// of an aggregate column from its companion table.
+func (tpb *tablePlanBuilder) generateAggregateLookup(buf *sqlparser.TrackedBuffer, cexpr *colExpr) {
+	table := sqlparser.NewTableIdent(aggregateTableName(tpb.name.String(), cexpr.colName))
+	switch cexpr.operation {
+	case opMin:
+		buf.Myprintf("(select min(val) from %v where ", table)
+	case opMax:
+		buf.Myprintf("(select max(val) from %v where ", table)
+	case opAvg:
+		buf.Myprintf("(select total/nullif(cnt, 0) from %v where ", table)
+	case opCountDistinct:
+		buf.Myprintf("(select count(*) from %v where ", table)
+	}
+	tpb.generateKeyMatch(buf)
+	buf.WriteString(")")
+}
+
+// extremeFunc returns the function that combines the current extreme
+// of a MIN or MAX column with a new value.
+func extremeFunc(op operation) string {
+	if op == opMin {
+		return "least"
+	}
+	return "greatest"
+}
+
+// createAggregateTables creates the companion tables of the aggregate
+// columns of the target tables, if they don't exist yet.
+func (vr *vreplicator) createAggregateTables(ctx context.Context) error {
+	plan, err := buildReplicatorPlan(vr.source.Filter, vr.pkInfoMap, nil)
+	if err != nil {
+		return err
+	}
+	for _, tp := range plan.TargetTables {
+		if len(tp.AggregateTables) == 0 {
+			continue
+		}
+		columns, err := vr.targetColumns(ctx, tp.TargetName)
+		if err != nil {
+			return err
+		}
+		for _, at := range tp.AggregateTables {
+			create, err := at.createStatement(columns)
+			if err != nil {
+				return err
+			}
+			if _, err := vr.dbClient.ExecuteFetch(create, 0); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// unusedAggregateTables returns the companion tables of the streams that
+// are being deleted, except the ones that other streams still use.
+func (vre *Engine) unusedAggregateTables(ids []int) ([]string, error) {
+	deleted := make(map[int]bool, len(ids))
+	found := false
+	for _, id := range ids {
+		deleted[id] = true
+		if ct := vre.controllers[id]; ct != nil && hasAggregateTables(ct.source.Filter) {
+			found = true
+		}
+	}
+	if !found {
+		return nil, nil
+	}
+	schema, err := vre.mysqld.GetSchema(vre.ctx, vre.dbName, nil, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	var tableNames []string
+	existing := make(map[string]bool)
+	for _, td := range schema.TableDefinitions {
+		tableNames = append(tableNames, td.Name)
+		existing[td.Name] = true
+	}
+	used := make(map[string]bool)
+	unused := make(map[string]bool)
+	for id, ct := range vre.controllers {
+		names, err := aggregateTableNames(ct.source.Filter, tableNames)
+		if err != nil {
+			return nil, err
+		}
+		for name := range names {
+			if deleted[id] {
+				unused[name] = true
+			} else {
+				used[name] = true
+			}
+		}
+	}
+	var tables []string
+	for name := range unused {
+		if existing[name] && !used[name] {
+			tables = append(tables, name)
+		}
+	}
+	sort.Strings(tables)
+	return tables, nil
+}
+
+// dropAggregateTables drops the companion tables of deleted streams. The
+// streams are already deleted: errors are only logged.
+func (vre *Engine) dropAggregateTables(dbClient binlogplayer.DBClient, tables []string) {
+	for _, table := range tables {
+		buf := sqlparser.NewTrackedBuffer(nil)
+		buf.Myprintf("drop table if exists %v.%v", sqlparser.NewTableIdent(vre.dbName), sqlparser.NewTableIdent(table))
+		if _, err := dbClient.ExecuteFetch(buf.String(), 1); err != nil {
+			log.Errorf("Could not drop companion table %s: %v", table, err)
+		}
+	}
+}
@@ -94,6 +94,14 @@ func newController(ctx context.Context, params map[string]string, dbClientFactor
 	ct.id = uint32(id)
 	ct.workflow = params["workflow"]
 
+	// source, stopPos
+	// The source of stopped streams is also needed: the Engine drops
+	// the companion tables of the aggregates of the deleted streams.
+	if err := proto.UnmarshalText(params["source"], &ct.source); err != nil {
+		return nil, err
+	}
+	ct.stopPos = params["stop_pos"]
+
 	blpStats.State.Set(params["state"])
 	// Nothing to do if replication is stopped.
 	if params["state"] == binlogplayer.BlpStopped {
@@ -102,12 +110,6 @@ func newController(ctx context.Context, params map[string]string, dbClientFactor
 		return ct, nil
 	}
 
-	// source, stopPos
-	if err := proto.UnmarshalText(params["source"], &ct.source); err != nil {
-		return nil, err
-	}
-	ct.stopPos = params["stop_pos"]
-
 	if ct.source.GetExternalMysql() == "" {
 		// tabletPicker
 		if v := params["cell"]; v != "" {
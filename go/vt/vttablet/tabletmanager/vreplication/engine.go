@@ -29,6 +29,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
 	"vitess.io/vitess/go/vt/withddl"
 
 	"context"
@@ -61,6 +62,10 @@ const (
   table_name varbinary(128),
   lastpk varbinary(2000),
   primary key (vrepl_id, table_name))`
+
+	// alterCopyState adds the position of the snapshot of the tables
+	// that are copied concurrently, see vcopier.copyTables.
+	alterCopyState = `alter table _vt.copy_state add column pos varbinary(10000)`
 )
 
 var withDDL *withddl.WithDDL
@@ -68,7 +73,7 @@ var withDDL *withddl.WithDDL
 func init() {
 	allddls := append([]string{}, binlogplayer.CreateVReplicationTable()...)
 	allddls = append(allddls, binlogplayer.AlterVReplicationTable...)
-	allddls = append(allddls, createReshardingJournalTable, createCopyState)
+	allddls = append(allddls, createReshardingJournalTable, createCopyState, alterCopyState)
 	withDDL = withddl.New(allddls)
 }
 
@@ -109,6 +114,9 @@ type Engine struct {
 
 	journaler map[string]*journalEvent
 	ec        *externalConnector
+
+	// lagThrottler, if set, is checked by the concurrent table copies.
+	lagThrottler *throttle.Throttler
 }
 
 type journalEvent struct {
@@ -131,6 +139,12 @@ func NewEngine(config *tabletenv.TabletConfig, ts *topo.Server, cell string, mys
 	return vre
 }
 
+// SetLagThrottler sets the tablet throttler that the concurrent table
+// copies must check before writing.
+func (vre *Engine) SetLagThrottler(lagThrottler *throttle.Throttler) {
+	vre.lagThrottler = lagThrottler
+}
+
 // InitDBConfig should be invoked after the db name is computed.
 func (vre *Engine) InitDBConfig(dbcfgs *dbconfigs.DBConfigs) {
 	// If we're already initilized, it's a test engine. Ignore the call.
@@ -395,6 +409,10 @@ func (vre *Engine) Exec(query string) (*sqltypes.Result, error) {
 		if len(ids) == 0 {
 			return &sqltypes.Result{}, nil
 		}
+		dropTables, err := vre.unusedAggregateTables(ids)
+		if err != nil {
+			return nil, err
+		}
 		// Stop and delete the current controllers.
 		for _, id := range ids {
 			if ct := vre.controllers[id]; ct != nil {
@@ -424,6 +442,7 @@ func (vre *Engine) Exec(query string) (*sqltypes.Result, error) {
 		if err := dbClient.Commit(); err != nil {
 			return nil, err
 		}
+		vre.dropAggregateTables(dbClient, dropTables)
 		return qr, nil
 	case selectQuery, reshardingJournalQuery:
 		// select and resharding journal queries are passed through.
@@ -34,6 +34,8 @@ import (
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 	"vitess.io/vitess/go/vt/mysqlctl/fakemysqldaemon"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 )
 
 func TestEngineOpen(t *testing.T) {
@@ -298,6 +300,71 @@ func TestEngineExec(t *testing.T) {
 	dbClient.Wait()
 }
 
+func TestEngineDeleteAggregateTables(t *testing.T) {
+	defer func() { globalStats = &vrStats{} }()
+
+	defer deleteTablet(addTablet(100))
+	resetBinlogClient()
+	dbClient := binlogplayer.NewMockDBClient(t)
+	dbClientFactory := func() binlogplayer.DBClient { return dbClient }
+	mysqld := &fakemysqldaemon.FakeMysqlDaemon{
+		MysqlPort: sync2.NewAtomicInt32(3306),
+		Schema: &tabletmanagerdatapb.SchemaDefinition{
+			TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+				{Name: "t1"},
+				{Name: "_vt_agg_t1_lo"},
+			},
+		},
+	}
+
+	vre := NewTestEngine(env.TopoServ, env.Cells[0], mysqld, dbClientFactory, dbClient.DBName(), nil)
+
+	dbClient.ExpectRequest("select * from _vt.vreplication where db_name='db'", &sqltypes.Result{}, nil)
+	vre.Open(context.Background())
+	defer vre.Close()
+
+	// Two stopped streams, one per source shard, materialize t1.
+	source := fmt.Sprintf(`keyspace:"%s" shard:"%%s" filter:<rules:<match:"t1" filter:"select c1, min(c2) as lo from t1 group by c1" > > `, env.KeyspaceName)
+	for _, shard := range []string{"-80", "80-"} {
+		id := len(vre.controllers) + 1
+		dbClient.ExpectRequest("use _vt", &sqltypes.Result{}, nil)
+		dbClient.ExpectRequest("insert into _vt.vreplication values(null)", &sqltypes.Result{InsertID: uint64(id)}, nil)
+		dbClient.ExpectRequest(fmt.Sprintf("select * from _vt.vreplication where id = %d", id), sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id|state|source",
+				"int64|varchar|varchar",
+			),
+			fmt.Sprintf("%d|Stopped|%s", id, fmt.Sprintf(source, shard)),
+		), nil)
+		_, err := vre.Exec("insert into _vt.vreplication values(null)")
+		require.NoError(t, err)
+		dbClient.Wait()
+	}
+
+	// The companion table is still used by the other stream.
+	dbClient.ExpectRequest("use _vt", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("select id from _vt.vreplication where id = 1", testSelectorResponse1, nil)
+	dbClient.ExpectRequest("begin", nil, nil)
+	dbClient.ExpectRequest("delete from _vt.vreplication where id in (1)", testDMLResponse, nil)
+	dbClient.ExpectRequest("delete from _vt.copy_state where vrepl_id in (1)", nil, nil)
+	dbClient.ExpectRequest("commit", nil, nil)
+	_, err := vre.Exec("delete from _vt.vreplication where id = 1")
+	require.NoError(t, err)
+	dbClient.Wait()
+
+	// The last stream that uses it is deleted.
+	dbClient.ExpectRequest("use _vt", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("select id from _vt.vreplication where id = 2", &sqltypes.Result{Rows: [][]sqltypes.Value{{sqltypes.NewInt64(2)}}}, nil)
+	dbClient.ExpectRequest("begin", nil, nil)
+	dbClient.ExpectRequest("delete from _vt.vreplication where id in (2)", testDMLResponse, nil)
+	dbClient.ExpectRequest("delete from _vt.copy_state where vrepl_id in (2)", nil, nil)
+	dbClient.ExpectRequest("commit", nil, nil)
+	dbClient.ExpectRequest("drop table if exists db._vt_agg_t1_lo", &sqltypes.Result{}, nil)
+	_, err = vre.Exec("delete from _vt.vreplication where id = 2")
+	require.NoError(t, err)
+	dbClient.Wait()
+}
+
 func TestEngineBadInsert(t *testing.T) {
 	defer func() { globalStats = &vrStats{} }()
 
@@ -499,6 +566,7 @@ func TestCreateDBAndTable(t *testing.T) {
 		dbClient.ExpectRequestRE("ALTER TABLE _vt.vreplication MODIFY source.*", &sqltypes.Result{}, nil)
 		dbClient.ExpectRequestRE("create table if not exists _vt.resharding_journal.*", &sqltypes.Result{}, nil)
 		dbClient.ExpectRequestRE("create table if not exists _vt.copy_state.*", &sqltypes.Result{}, nil)
+		dbClient.ExpectRequestRE("alter table _vt.copy_state add column pos.*", &sqltypes.Result{}, nil)
 	}
 	expectDDLs()
 	dbClient.ExpectRequest("use _vt", &sqltypes.Result{}, nil)
@@ -552,6 +552,51 @@ func expectNontxQueries(t *testing.T, queries []string) {
 		}
 	}
 }
+
+// expectInterleavedQueries is like expectNontxQueries, but for queries
+// that are executed concurrently: every stream of queries is expected in
+// order, but the queries of different streams can interleave.
+func expectInterleavedQueries(t *testing.T, streams ...[]string) {
+	t.Helper()
+	remaining := 0
+	for _, stream := range streams {
+		remaining += len(stream)
+	}
+	for remaining > 0 {
+		var got string
+		select {
+		case got = <-globalDBQueries:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no query received, expecting one of %v", streams)
+		}
+		if got == "begin" || got == "commit" || got == "rollback" || strings.Contains(got, "update _vt.vreplication set pos") || heartbeatRe.MatchString(got) {
+			continue
+		}
+		matched := false
+		for i, stream := range streams {
+			if len(stream) == 0 {
+				continue
+			}
+			query := stream[0]
+			if query[0] == '/' {
+				result, err := regexp.MatchString(query[1:], got)
+				if err != nil {
+					panic(err)
+				}
+				matched = result
+			} else {
+				matched = (got == query)
+			}
+			if matched {
+				streams[i] = stream[1:]
+				remaining--
+				break
+			}
+		}
+		require.True(t, matched, "got:%s, want one of %v", got, streams)
+	}
+}
+
 func expectData(t *testing.T, table string, values [][]string) {
 	t.Helper()
 	customExpectData(t, table, values, env.Mysqld.FetchSuperQuery)
@@ -169,10 +169,22 @@ type TablePlan struct {
 	Insert *sqlparser.ParsedQuery
 	Update *sqlparser.ParsedQuery
 	Delete *sqlparser.ParsedQuery
-	Fields []*querypb.Field
+	// Replace is used by vplayer instead of Insert to replay the
+	// events that precede the snapshot of a table that was copied
+	// concurrently with others. It's nil if the plan is not an
+	// insertNormal type, or if the table is keyless.
+	Replace *sqlparser.ParsedQuery
+	Fields  []*querypb.Field
 	// PKReferences is used to check if an event changed
 	// a primary key column (row move).
 	PKReferences []string
+	// AggregateTables are the companion tables of the MIN, MAX, AVG
+	// and COUNT(DISTINCT) columns, see aggregates.go. AggregateInserts
+	// and AggregateDeletes maintain them: they're executed before the
+	// statements of the target table, which read the companion tables.
+	AggregateTables  []*AggregateTable
+	AggregateInserts []*sqlparser.ParsedQuery
+	AggregateDeletes []*sqlparser.ParsedQuery
 }
 
 // MarshalJSON performs a custom JSON Marshalling.
@@ -187,6 +199,9 @@ func (tp *TablePlan) MarshalJSON() ([]byte, error) {
 		Update       *sqlparser.ParsedQuery `json:",omitempty"`
 		Delete       *sqlparser.ParsedQuery `json:",omitempty"`
 		PKReferences []string               `json:",omitempty"`
+
+		AggregateInserts []*sqlparser.ParsedQuery `json:",omitempty"`
+		AggregateDeletes []*sqlparser.ParsedQuery `json:",omitempty"`
 	}{
 		TargetName:   tp.TargetName,
 		SendRule:     tp.SendRule.Match,
@@ -197,11 +212,23 @@ func (tp *TablePlan) MarshalJSON() ([]byte, error) {
 		Update:       tp.Update,
 		Delete:       tp.Delete,
 		PKReferences: tp.PKReferences,
+
+		AggregateInserts: tp.AggregateInserts,
+		AggregateDeletes: tp.AggregateDeletes,
 	}
 	return json.Marshal(&v)
 }
 
 func (tp *TablePlan) applyBulkInsert(rows *binlogdatapb.VStreamRowsResponse, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+	if len(tp.AggregateInserts) != 0 {
+		// The companion tables are maintained one row at a time.
+		for _, row := range rows.Rows {
+			if _, err := tp.applyChange(&binlogdatapb.RowChange{After: row}, executor); err != nil {
+				return nil, err
+			}
+		}
+		return &sqltypes.Result{}, nil
+	}
 	bindvars := make(map[string]*querypb.BindVariable, len(tp.Fields))
 	var buf strings.Builder
 	if err := tp.BulkInsertFront.Append(&buf, nil, nil); err != nil {
@@ -244,27 +271,85 @@ func (tp *TablePlan) applyChange(rowChange *binlogdatapb.RowChange, executor fun
 	}
 	switch {
 	case !before && after:
+		if err := execParsedQueries(tp.AggregateInserts, bindvars, executor); err != nil {
+			return nil, err
+		}
 		return execParsedQuery(tp.Insert, bindvars, executor)
 	case before && !after:
 		if tp.Delete == nil {
 			return nil, nil
 		}
+		if err := execParsedQueries(tp.AggregateDeletes, bindvars, executor); err != nil {
+			return nil, err
+		}
 		return execParsedQuery(tp.Delete, bindvars, executor)
 	case before && after:
 		if !tp.pkChanged(bindvars) {
+			if tp.Update == nil {
+				// The change doesn't affect the target row.
+				return nil, nil
+			}
+			if err := execParsedQueries(tp.AggregateDeletes, bindvars, executor); err != nil {
+				return nil, err
+			}
+			if err := execParsedQueries(tp.AggregateInserts, bindvars, executor); err != nil {
+				return nil, err
+			}
 			return execParsedQuery(tp.Update, bindvars, executor)
 		}
 		if tp.Delete != nil {
+			if err := execParsedQueries(tp.AggregateDeletes, bindvars, executor); err != nil {
+				return nil, err
+			}
 			if _, err := execParsedQuery(tp.Delete, bindvars, executor); err != nil {
 				return nil, err
 			}
 		}
+		if err := execParsedQueries(tp.AggregateInserts, bindvars, executor); err != nil {
+			return nil, err
+		}
 		return execParsedQuery(tp.Insert, bindvars, executor)
 	}
 	// Unreachable.
 	return nil, nil
 }
 
+// applyReplayChange applies a change idempotently: the row may already
+// contain it, or later ones. Rows are replaced instead of inserted, and
+// an update is a replace of the new row, preceded by a delete of the old
+// one if the primary key changed. The events must have full row images:
+// an error is returned otherwise.
+func (tp *TablePlan) applyReplayChange(rowChange *binlogdatapb.RowChange, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+	if tp.Replace == nil {
+		return tp.applyChange(rowChange, executor)
+	}
+	for _, row := range []*querypb.Row{rowChange.Before, rowChange.After} {
+		if row != nil && len(row.Lengths) != len(tp.Fields) {
+			return nil, fmt.Errorf("partial row image of table %s: binlog_row_image must be set to 'full' to replay the events of tables copied concurrently", tp.TargetName)
+		}
+	}
+	if rowChange.After == nil {
+		return tp.applyChange(rowChange, executor)
+	}
+	bindvars := make(map[string]*querypb.BindVariable, len(tp.Fields))
+	vals := sqltypes.MakeRowTrusted(tp.Fields, rowChange.After)
+	for i, field := range tp.Fields {
+		bindvars["a_"+field.Name] = sqltypes.ValueBindVariable(vals[i])
+	}
+	if rowChange.Before != nil {
+		vals := sqltypes.MakeRowTrusted(tp.Fields, rowChange.Before)
+		for i, field := range tp.Fields {
+			bindvars["b_"+field.Name] = sqltypes.ValueBindVariable(vals[i])
+		}
+		if tp.pkChanged(bindvars) {
+			if _, err := execParsedQuery(tp.Delete, bindvars, executor); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return execParsedQuery(tp.Replace, bindvars, executor)
+}
+
 func execParsedQuery(pq *sqlparser.ParsedQuery, bindvars map[string]*querypb.BindVariable, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
 	sql, err := pq.GenerateQuery(bindvars, nil)
 	if err != nil {
@@ -273,6 +358,15 @@ func execParsedQuery(pq *sqlparser.ParsedQuery, bindvars map[string]*querypb.Bin
 	return executor(sql)
 }
 
+func execParsedQueries(pqs []*sqlparser.ParsedQuery, bindvars map[string]*querypb.BindVariable, executor func(string) (*sqltypes.Result, error)) error {
+	for _, pq := range pqs {
+		if _, err := execParsedQuery(pq, bindvars, executor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (tp *TablePlan) pkChanged(bindvars map[string]*querypb.BindVariable) bool {
 	for _, pkref := range tp.PKReferences {
 		v1, _ := sqltypes.BindVariableToValue(bindvars["b_"+pkref])
@@ -18,13 +18,16 @@ package vreplication
 
 import (
 	"encoding/json"
+	"fmt"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 type TestReplicatorPlan struct {
@@ -766,3 +769,347 @@ func TestBuildPlayerPlanExclude(t *testing.T) {
 	wantPlan, _ := json.Marshal(want)
 	assert.Equal(t, string(gotPlan), string(wantPlan))
 }
+
+func TestBuildPlayerPlanScalarExpressions(t *testing.T) {
+	PrimaryKeyInfos := map[string][]*PrimaryKeyInfo{
+		"t1": {&PrimaryKeyInfo{Name: "c1"}},
+	}
+	input := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select c1, case when c2 > 0 then concat(c3, '-', c4) else 'none' end as c2, (c2 + 1) * 2 as c3, date_format(date_add(c5, interval 1 day), '%Y-%m') as c4, json_unquote(json_extract(c6, '$.a')) as c5, c6->>'$.b' as c6 from t2",
+		}},
+	}
+	plan, err := buildReplicatorPlan(input, PrimaryKeyInfos, nil)
+	require.NoError(t, err)
+	tplan := plan.TablePlans["t2"]
+	require.NotNil(t, tplan)
+	assert.Equal(t, "select c1, c2, c3, c4, c5, c6 from t2", tplan.SendRule.Filter)
+	assert.Equal(t,
+		"insert into t1(c1,c2,c3,c4,c5,c6) values (:a_c1,case when :a_c2 > 0 then concat(:a_c3, '-', :a_c4) else 'none' end,(:a_c2 + 1) * 2,date_format(date_add(:a_c5, interval 1 day), '%Y-%m'),json_unquote(json_extract(:a_c6, '$.a')),:a_c6 ->> '$.b')",
+		tplan.Insert.Query)
+}
+
+func TestBuildPlayerPlanKeyless(t *testing.T) {
+	pkInfos := map[string][]*PrimaryKeyInfo{
+		"t1": {
+			&PrimaryKeyInfo{Name: "c1", Keyless: true},
+			&PrimaryKeyInfo{Name: "c2", Keyless: true},
+			&PrimaryKeyInfo{Name: "c3", Keyless: true},
+		},
+	}
+	input := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select c1, c2 from t1",
+		}},
+	}
+	plan, err := buildReplicatorPlan(input, pkInfos, nil)
+	require.NoError(t, err)
+	fields := sqltypes.MakeTestFields("c1|c2", "int64|varchar")
+	tp, err := plan.buildExecutionPlan(&binlogdatapb.FieldEvent{TableName: "t1", Fields: fields})
+	require.NoError(t, err)
+
+	assert.Equal(t, "insert into t1(c1,c2) values (:a_c1,:a_c2)", tp.Insert.Query)
+	assert.Equal(t, "delete from t1 where c1<=>:b_c1 and c2<=>:b_c2 limit 1", tp.Delete.Query)
+	assert.Nil(t, tp.Update)
+
+	var queries []string
+	executor := func(query string) (*sqltypes.Result, error) {
+		queries = append(queries, query)
+		return &sqltypes.Result{}, nil
+	}
+	row := func(c1, c2 sqltypes.Value) *querypb.Row {
+		return sqltypes.RowToProto3([]sqltypes.Value{c1, c2})
+	}
+	// A change of the columns that are not replicated is ignored.
+	_, err = tp.applyChange(&binlogdatapb.RowChange{
+		Before: row(sqltypes.NewInt64(1), sqltypes.NULL),
+		After:  row(sqltypes.NewInt64(1), sqltypes.NULL),
+	}, executor)
+	require.NoError(t, err)
+	assert.Empty(t, queries)
+
+	_, err = tp.applyChange(&binlogdatapb.RowChange{
+		Before: row(sqltypes.NewInt64(1), sqltypes.NULL),
+		After:  row(sqltypes.NewInt64(1), sqltypes.NewVarChar("a")),
+	}, executor)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"delete from t1 where c1<=>1 and c2<=>null limit 1",
+		"insert into t1(c1,c2) values (1,'a')",
+	}, queries)
+
+	// A keyless table can only be replicated if some of its columns are.
+	input.Rules[0].Filter = "select c1+1 as c4 from t1"
+	_, err = buildReplicatorPlan(input, pkInfos, nil)
+	assert.EqualError(t, err, "table t1 has no key column in the select list")
+}
+
+func TestBuildPlayerPlanReplace(t *testing.T) {
+	pkInfos := map[string][]*PrimaryKeyInfo{
+		"t1": {&PrimaryKeyInfo{Name: "c1"}},
+	}
+	input := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select c1, c2 from t1",
+		}},
+	}
+	plan, err := buildReplicatorPlan(input, pkInfos, nil)
+	require.NoError(t, err)
+	fields := sqltypes.MakeTestFields("c1|c2", "int64|varchar")
+	tp, err := plan.buildExecutionPlan(&binlogdatapb.FieldEvent{TableName: "t1", Fields: fields})
+	require.NoError(t, err)
+	assert.Equal(t, "replace into t1(c1,c2) values (:a_c1,:a_c2)", tp.Replace.Query)
+
+	var queries []string
+	executor := func(query string) (*sqltypes.Result, error) {
+		queries = append(queries, query)
+		return &sqltypes.Result{}, nil
+	}
+	row := func(c1 int64, c2 string) *querypb.Row {
+		return sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(c1), sqltypes.NewVarChar(c2)})
+	}
+	changes := []*binlogdatapb.RowChange{
+		{After: row(1, "a")},
+		{Before: row(1, "a"), After: row(1, "b")},
+		{Before: row(1, "b"), After: row(2, "b")},
+		{Before: row(2, "b")},
+	}
+	for _, change := range changes {
+		_, err := tp.applyReplayChange(change, executor)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []string{
+		"replace into t1(c1,c2) values (1,'a')",
+		"replace into t1(c1,c2) values (1,'b')",
+		"delete from t1 where c1=1",
+		"replace into t1(c1,c2) values (2,'b')",
+		"delete from t1 where c1=2",
+	}, queries)
+
+	// Partial row images can't be replayed.
+	partial := sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1)})
+	queries = nil
+	for _, change := range []*binlogdatapb.RowChange{
+		{After: partial},
+		{Before: partial, After: row(1, "b")},
+		{Before: partial},
+	} {
+		_, err := tp.applyReplayChange(change, executor)
+		assert.EqualError(t, err, "partial row image of table t1: binlog_row_image must be set to 'full' to replay the events of tables copied concurrently")
+	}
+	assert.Empty(t, queries)
+
+	// With a lastpk, only the rows that were copied are replaced.
+	lastpk := sqltypes.MakeTestResult(sqltypes.MakeTestFields("c1", "int64"), "5")
+	plan, err = buildReplicatorPlan(input, pkInfos, map[string]*sqltypes.Result{"t1": lastpk})
+	require.NoError(t, err)
+	tp, err = plan.buildExecutionPlan(&binlogdatapb.FieldEvent{TableName: "t1", Fields: fields})
+	require.NoError(t, err)
+	assert.Equal(t, "replace into t1(c1,c2) select :a_c1, :a_c2 from dual where (:a_c1) <= (5)", tp.Replace.Query)
+
+	// Plans that aggregate rows can't be replayed.
+	input.Rules[0].Filter = "select c1, count(*) as c3 from t1 group by c1"
+	plan, err = buildReplicatorPlan(input, pkInfos, nil)
+	require.NoError(t, err)
+	assert.Nil(t, plan.TargetTables["t1"].Replace)
+}
+
+func TestBuildPlayerPlanAggregates(t *testing.T) {
+	pkInfos := map[string][]*PrimaryKeyInfo{
+		"t1":           {&PrimaryKeyInfo{Name: "c1"}},
+		"_vt_agg_t1_a": {&PrimaryKeyInfo{Name: "c1"}},
+	}
+	input := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "/.*",
+			Filter: "select c1, min(c2) as lo, max(c2) as hi, avg(c3) as a, count(distinct c2) as d from t1 group by c1",
+		}},
+	}
+	plan, err := buildReplicatorPlan(input, pkInfos, nil)
+	require.NoError(t, err)
+	// Companion tables are not replicated.
+	require.Len(t, plan.TargetTables, 1)
+	fields := sqltypes.MakeTestFields("c1|c2|c3", "int64|varchar|int64")
+	tp, err := plan.buildExecutionPlan(&binlogdatapb.FieldEvent{TableName: "t1", Fields: fields})
+	require.NoError(t, err)
+
+	var tables []string
+	for _, at := range tp.AggregateTables {
+		tables = append(tables, at.Name)
+	}
+	assert.Equal(t, []string{"_vt_agg_t1_lo", "_vt_agg_t1_hi", "_vt_agg_t1_a", "_vt_agg_t1_d"}, tables)
+	columns := parseColumns(t, "create table t1 (c1 int not null auto_increment, lo varchar(10) default 'x', hi varchar(10), a decimal(10,4), d bigint)")
+	create, err := tp.AggregateTables[0].createStatement(columns)
+	require.NoError(t, err)
+	assert.Equal(t, "create table if not exists _vt_agg_t1_lo (c1 int not null, val varchar(10) not null, cnt bigint not null, primary key (c1, val))", create)
+	create, err = tp.AggregateTables[2].createStatement(columns)
+	require.NoError(t, err)
+	assert.Equal(t, "create table if not exists _vt_agg_t1_a (c1 int not null, total decimal(65,30) not null, cnt bigint not null, primary key (c1))", create)
+	create, err = tp.AggregateTables[3].createStatement(columns)
+	require.NoError(t, err)
+	assert.Equal(t, "create table if not exists _vt_agg_t1_d (c1 int not null, val binary(20) not null, cnt bigint not null, primary key (c1, val))", create)
+
+	// TEXT and BLOB columns can't be part of the primary key of a companion table.
+	_, err = tp.AggregateTables[0].createStatement(parseColumns(t, "create table t1 (c1 int, lo text, hi text)"))
+	assert.EqualError(t, err, "MIN and MAX don't support column lo of table t1: type text can't be part of the primary key of a companion table")
+	_, err = tp.AggregateTables[2].createStatement(parseColumns(t, "create table t1 (c1 blob, a decimal(10,4))"))
+	assert.EqualError(t, err, "group by column c1 of table t1 has type blob, which can't be part of the primary key of a companion table")
+
+	var queries []string
+	executor := func(query string) (*sqltypes.Result, error) {
+		queries = append(queries, query)
+		return &sqltypes.Result{}, nil
+	}
+	row := func(c1 int64, c2 string, c3 int64) *querypb.Row {
+		return sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(c1), sqltypes.NewVarChar(c2), sqltypes.NewInt64(c3)})
+	}
+
+	_, err = tp.applyChange(&binlogdatapb.RowChange{After: row(1, "a", 2)}, executor)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"insert into _vt_agg_t1_lo(c1,val,cnt) select 1, 'a', 1 from dual where 'a' is not null on duplicate key update cnt=cnt+1",
+		"insert into _vt_agg_t1_hi(c1,val,cnt) select 1, 'a', 1 from dual where 'a' is not null on duplicate key update cnt=cnt+1",
+		"insert into _vt_agg_t1_a(c1,total,cnt) select 1, 2, 1 from dual where 2 is not null on duplicate key update total=total+values(total), cnt=cnt+1",
+		"insert into _vt_agg_t1_d(c1,val,cnt) select 1, unhex(sha1('a')), 1 from dual where 'a' is not null on duplicate key update cnt=cnt+1",
+		"insert into t1(c1,lo,hi,a,d) values (1,'a','a',2,if('a' is null, 0, 1)) on duplicate key update " +
+			"lo=least(ifnull(lo, values(lo)), ifnull(values(lo), lo)), " +
+			"hi=greatest(ifnull(hi, values(hi)), ifnull(values(hi), hi)), " +
+			"a=(select total/nullif(cnt, 0) from _vt_agg_t1_a where c1=1), " +
+			"d=(select count(*) from _vt_agg_t1_d where c1=1)",
+	}, queries)
+
+	queries = nil
+	_, err = tp.applyChange(&binlogdatapb.RowChange{Before: row(1, "a", 2)}, executor)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"delete from _vt_agg_t1_lo where c1=1 and val='a' and cnt=1",
+		"update _vt_agg_t1_lo set cnt=cnt-1 where c1=1 and val='a'",
+		"delete from _vt_agg_t1_hi where c1=1 and val='a' and cnt=1",
+		"update _vt_agg_t1_hi set cnt=cnt-1 where c1=1 and val='a'",
+		"update _vt_agg_t1_a set total=total-2, cnt=cnt-1 where c1=1 and 2 is not null",
+		"delete from _vt_agg_t1_d where c1=1 and val=unhex(sha1('a')) and cnt=1",
+		"update _vt_agg_t1_d set cnt=cnt-1 where c1=1 and val=unhex(sha1('a'))",
+		"update t1 set " +
+			"lo=if(lo='a', (select min(val) from _vt_agg_t1_lo where c1=1), lo), " +
+			"hi=if(hi='a', (select max(val) from _vt_agg_t1_hi where c1=1), hi), " +
+			"a=(select total/nullif(cnt, 0) from _vt_agg_t1_a where c1=1), " +
+			"d=(select count(*) from _vt_agg_t1_d where c1=1) where c1=1",
+	}, queries)
+
+	queries = nil
+	_, err = tp.applyChange(&binlogdatapb.RowChange{Before: row(1, "a", 2), After: row(1, "b", 4)}, executor)
+	require.NoError(t, err)
+	require.Len(t, queries, 12)
+	assert.Equal(t, "update t1 set "+
+		"lo=if(lo='a', (select min(val) from _vt_agg_t1_lo where c1=1), least(ifnull(lo, 'b'), ifnull('b', lo))), "+
+		"hi=if(hi='a', (select max(val) from _vt_agg_t1_hi where c1=1), greatest(ifnull(hi, 'b'), ifnull('b', hi))), "+
+		"a=(select total/nullif(cnt, 0) from _vt_agg_t1_a where c1=1), "+
+		"d=(select count(*) from _vt_agg_t1_d where c1=1) where c1=1", queries[11])
+
+	// The companion tables only contain the rows that were copied.
+	lastpk := sqltypes.MakeTestResult(sqltypes.MakeTestFields("c1", "int64"), "5")
+	plan, err = buildReplicatorPlan(input, pkInfos, map[string]*sqltypes.Result{"t1": lastpk})
+	require.NoError(t, err)
+	tp = plan.TargetTables["t1"]
+	assert.Equal(t, "insert into _vt_agg_t1_a(c1,total,cnt) select :a_c1, :a_c3, 1 from dual where :a_c3 is not null and (:a_c1) <= (5) on duplicate key update total=total+values(total), cnt=cnt+1", tp.AggregateInserts[2].Query)
+	assert.Equal(t, "update _vt_agg_t1_a set total=total-:b_c3, cnt=cnt-1 where c1=:b_c1 and :b_c3 is not null and (:b_c1) <= (5)", tp.AggregateDeletes[4].Query)
+
+	input.Rules[0].Filter = "select c1, min(c2) as lo from t1"
+	_, err = buildReplicatorPlan(input, pkInfos, nil)
+	assert.EqualError(t, err, "aggregate column lo requires a group by")
+	input.Rules[0].Filter = "select c1, max(distinct c2) as hi from t1 group by c1"
+	_, err = buildReplicatorPlan(input, pkInfos, nil)
+	assert.EqualError(t, err, "unexpected: max(distinct c2)")
+}
+
+func TestAggregateTableNames(t *testing.T) {
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select c1, min(c2) as lo, count(*) as cnt, avg(c3) as a from t1 group by c1",
+		}, {
+			Match:  "/t2.*",
+			Filter: "select c1, count(distinct c2) as d from t2 group by c1",
+		}, {
+			Match:  "t3",
+			Filter: "select * from t3",
+		}},
+	}
+	assert.True(t, hasAggregateTables(filter))
+	names, err := aggregateTableNames(filter, []string{"t1", "t2", "t2b", "t3", "_vt_agg_t1_lo"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{
+		"_vt_agg_t1_lo": true,
+		"_vt_agg_t1_a":  true,
+		"_vt_agg_t2_d":  true,
+		"_vt_agg_t2b_d": true,
+	}, names)
+
+	assert.False(t, hasAggregateTables(&binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select c1, sum(c2) as s from t1 group by c1",
+		}, {
+			Match: "/.*",
+		}},
+	}))
+}
+
+func TestBuildPlayerPlanFunctions(t *testing.T) {
+	PrimaryKeyInfos := map[string][]*PrimaryKeyInfo{
+		"t1": {&PrimaryKeyInfo{Name: "c1"}},
+	}
+	// Functions are evaluated by the target, which accepts user
+	// defined functions as well as the builtin ones.
+	input := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select c1, foo(c2) as c2, concat(c2, 'a') as c3, unix_timestamp(c4) as c4 from t1",
+		}},
+	}
+	plan, err := buildReplicatorPlan(input, PrimaryKeyInfos, nil)
+	require.NoError(t, err)
+	tplan := plan.TablePlans["t1"]
+	require.NotNil(t, tplan)
+	assert.Equal(t, "select c1, c2, c4 from t1", tplan.SendRule.Filter)
+	assert.Equal(t,
+		"insert into t1(c1,c2,c3,c4) values (:a_c1,foo(:a_c2),concat(:a_c2, 'a'),unix_timestamp(:a_c4))",
+		tplan.Insert.Query)
+
+	// Non-deterministic functions would produce different values
+	// in the copy and the replication phases.
+	testcases := []struct {
+		expr string
+		err  string
+	}{{
+		expr: "concat(c2, now())",
+		err:  "non-deterministic function is not supported: now()",
+	}, {
+		expr: "rand()",
+		err:  "non-deterministic function is not supported: rand()",
+	}, {
+		expr: "UUID()",
+		err:  "non-deterministic function is not supported: UUID()",
+	}, {
+		expr: "unix_timestamp()",
+		err:  "non-deterministic function is not supported: unix_timestamp()",
+	}, {
+		expr: "current_timestamp",
+		err:  "non-deterministic function is not supported: current_timestamp()",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.expr, func(t *testing.T) {
+			input := &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t1",
+					Filter: fmt.Sprintf("select c1, %s as c2 from t1", tcase.expr),
+				}},
+			}
+			_, err := buildReplicatorPlan(input, PrimaryKeyInfos, nil)
+			require.EqualError(t, err, tcase.err)
+		})
+	}
+}
@@ -0,0 +1,372 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+// This file contains the logic of the SAFE on_ddl action. A DDL of the
+// source is applied to the target tables if it's additive: new columns
+// and indexes, and column types that can hold more values. Any other
+// change of a replicated table stops the stream. DDLs of the tables that
+// are not replicated are ignored.
+
+// notAdditiveError is the error of a DDL that can't be applied safely to
+// the target. The other errors of buildSafeDDL are transient, and the DDL
+// is retried.
+type notAdditiveError struct {
+	msg string
+}
+
+func (e *notAdditiveError) Error() string {
+	return e.msg
+}
+
+func notAdditivef(format string, args ...interface{}) error {
+	return &notAdditiveError{msg: fmt.Sprintf(format, args...)}
+}
+
+// columnsFunc returns the current column definitions of a target table,
+// keyed by lower case name.
+type columnsFunc func(tableName string) (map[string]*sqlparser.ColumnDefinition, error)
+
+// buildSafeDDL returns the statement that applies a DDL of the source to
+// the target table, or an error that explains why it can't be applied
+// safely, which is a *notAdditiveError. It returns an empty statement if the DDL doesn't affect the
+// target.
+// All the tables of the filter are considered, including the ones that
+// are not copied yet.
+func buildSafeDDL(statement string, filter *binlogdatapb.Filter, pkInfoMap map[string][]*PrimaryKeyInfo, targetColumns columnsFunc) (string, error) {
+	stmt, err := sqlparser.Parse(statement)
+	if err != nil {
+		return "", fmt.Errorf("cannot parse DDL: %v", err)
+	}
+	plan, err := buildReplicatorPlan(filter, pkInfoMap, nil)
+	if err != nil {
+		return "", err
+	}
+	switch stmt := stmt.(type) {
+	case *sqlparser.AlterTable:
+		tp, ok := plan.TablePlans[stmt.Table.Name.String()]
+		if !ok {
+			return "", nil
+		}
+		if !stmt.FullyParsed || stmt.PartitionSpec != nil {
+			return "", notAdditivef("DDL of table %s is not supported", stmt.Table.Name.String())
+		}
+		alter, err := buildSafeAlter(stmt, tp, filter, targetColumns)
+		if err != nil || alter == nil {
+			return "", err
+		}
+		return sqlparser.String(alter), nil
+	case *sqlparser.CreateTable:
+		rule, err := MatchTable(stmt.Table.Name.String(), filter)
+		if err != nil {
+			return "", err
+		}
+		if rule != nil && rule.Filter != ExcludeStr {
+			return "", notAdditivef("table %s was created, and must be added to the workflow", stmt.Table.Name.String())
+		}
+		return "", nil
+	case sqlparser.DDLStatement:
+		for _, table := range stmt.AffectedTables() {
+			if _, ok := plan.TablePlans[table.Name.String()]; ok {
+				return "", notAdditivef("DDL of table %s is not additive", table.Name.String())
+			}
+		}
+		return "", nil
+	}
+	return "", nil
+}
+
+// buildSafeAlter builds the alter of a target table. The options of the
+// source alter are copied as is if the target has the same columns as
+// the source, which is the case of "select *" plans. Otherwise, only the
+// type changes of the columns that are copied as is are applied, to the
+// corresponding target columns. It returns nil if there's nothing to
+// alter.
+func buildSafeAlter(stmt *sqlparser.AlterTable, tp *TablePlan, filter *binlogdatapb.Filter, targetColumns columnsFunc) (*sqlparser.AlterTable, error) {
+	// The plans of "select *" are only complete once the fields are known.
+	selectAll := tp.Insert == nil
+	var mapping *columnMapping
+	if !selectAll {
+		var err error
+		if mapping, err = newColumnMapping(tp.TargetName, filter); err != nil {
+			return nil, err
+		}
+	}
+	var columns map[string]*sqlparser.ColumnDefinition
+	sourceName := stmt.Table.Name.String()
+
+	var options []sqlparser.AlterOption
+	for _, option := range stmt.AlterOptions {
+		var newCol *sqlparser.ColumnDefinition
+		var first, after *sqlparser.ColName
+		switch option := option.(type) {
+		case *sqlparser.AddColumns, *sqlparser.AlterColumn:
+			if selectAll {
+				options = append(options, option)
+			}
+			continue
+		case *sqlparser.AddIndexDefinition:
+			if option.IndexDefinition.Info.Primary {
+				return nil, notAdditivef("DDL of table %s changes the primary key", sourceName)
+			}
+			if selectAll {
+				options = append(options, option)
+			}
+			continue
+		case sqlparser.AlgorithmValue, *sqlparser.LockOption, *sqlparser.Force, *sqlparser.Validation:
+			// These only affect how the source is altered.
+			continue
+		case *sqlparser.ModifyColumn:
+			newCol, first, after = option.NewColDefinition, option.First, option.After
+		case *sqlparser.ChangeColumn:
+			if !option.OldColumn.Name.Equal(option.NewColDefinition.Name) {
+				return nil, notAdditivef("DDL of table %s renames column %s", sourceName, option.OldColumn.Name.String())
+			}
+			newCol, first, after = option.NewColDefinition, option.First, option.After
+		default:
+			return nil, notAdditivef("DDL of table %s is not additive: %s", sourceName, sqlparser.String(option))
+		}
+
+		targetNames := []string{newCol.Name.String()}
+		if !selectAll {
+			var inExpr bool
+			targetNames, inExpr = mapping.targets(newCol.Name)
+			if inExpr {
+				return nil, notAdditivef("DDL of table %s changes column %s, which is used in an expression of table %s", sourceName, newCol.Name.String(), tp.TargetName)
+			}
+			first, after = nil, nil
+		}
+		if len(targetNames) == 0 {
+			continue
+		}
+		if columns == nil {
+			var err error
+			if columns, err = targetColumns(tp.TargetName); err != nil {
+				return nil, err
+			}
+		}
+		for _, targetName := range targetNames {
+			oldCol, ok := columns[strings.ToLower(targetName)]
+			if !ok {
+				return nil, fmt.Errorf("column %s not found in table %s", targetName, tp.TargetName)
+			}
+			if !isWiderType(&oldCol.Type, &newCol.Type) {
+				return nil, notAdditivef("DDL of table %s changes the type of column %s from %s to %s", sourceName, newCol.Name.String(), sqlparser.String(&oldCol.Type), sqlparser.String(&newCol.Type))
+			}
+			options = append(options, &sqlparser.ModifyColumn{
+				NewColDefinition: &sqlparser.ColumnDefinition{
+					Name: sqlparser.NewColIdent(targetName),
+					Type: newCol.Type,
+				},
+				First: first,
+				After: after,
+			})
+		}
+	}
+	if len(options) == 0 {
+		return nil, nil
+	}
+	return &sqlparser.AlterTable{
+		Table:        sqlparser.TableName{Name: sqlparser.NewTableIdent(tp.TargetName)},
+		AlterOptions: options,
+		FullyParsed:  true,
+	}, nil
+}
+
+// targetColumns returns the column definitions of a target table, as
+// reported by mysqld.
+func (vr *vreplicator) targetColumns(ctx context.Context, tableName string) (map[string]*sqlparser.ColumnDefinition, error) {
+	schema, err := vr.mysqld.GetSchema(ctx, vr.dbClient.DBName(), []string{tableName}, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(schema.TableDefinitions) == 0 {
+		return nil, fmt.Errorf("table %s not found in the target schema", tableName)
+	}
+	stmt, err := sqlparser.Parse(schema.TableDefinitions[0].Schema)
+	if err != nil {
+		return nil, err
+	}
+	create, ok := stmt.(*sqlparser.CreateTable)
+	if !ok || create.TableSpec == nil {
+		return nil, fmt.Errorf("unexpected schema of table %s: %s", tableName, schema.TableDefinitions[0].Schema)
+	}
+	columns := make(map[string]*sqlparser.ColumnDefinition, len(create.TableSpec.Columns))
+	for _, col := range create.TableSpec.Columns {
+		columns[col.Name.Lowered()] = col
+	}
+	return columns, nil
+}
+
+// columnMapping maps the source columns of a rule that has explicit
+// columns to the target columns.
+type columnMapping struct {
+	exprs sqlparser.SelectExprs
+}
+
+func newColumnMapping(targetName string, filter *binlogdatapb.Filter) (*columnMapping, error) {
+	for _, rule := range filter.Rules {
+		if rule.Match != targetName {
+			continue
+		}
+		sel, _, err := analyzeSelectFrom(rule.Filter)
+		if err != nil {
+			return nil, err
+		}
+		return &columnMapping{exprs: sel.SelectExprs}, nil
+	}
+	return nil, fmt.Errorf("rule not found for table %s", targetName)
+}
+
+// targets returns the target columns that are plain copies of a source
+// column, and whether the source column is used in other expressions.
+func (cm *columnMapping) targets(source sqlparser.ColIdent) (targetNames []string, inExpr bool) {
+	for _, selExpr := range cm.exprs {
+		aliased, ok := selExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			continue
+		}
+		if col, ok := aliased.Expr.(*sqlparser.ColName); ok {
+			if col.Name.Equal(source) {
+				if aliased.As.IsEmpty() {
+					targetNames = append(targetNames, col.Name.String())
+				} else {
+					targetNames = append(targetNames, aliased.As.String())
+				}
+			}
+			continue
+		}
+		_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+			if col, ok := node.(*sqlparser.ColName); ok && col.Name.Equal(source) {
+				inExpr = true
+			}
+			return !inExpr, nil
+		}, aliased.Expr)
+	}
+	return targetNames, inExpr
+}
+
+// Types of the same family, from narrowest to widest.
+var (
+	intTypes  = []string{"tinyint", "smallint", "mediumint", "int", "bigint"}
+	textTypes = []string{"tinytext", "text", "mediumtext", "longtext"}
+	blobTypes = []string{"tinyblob", "blob", "mediumblob", "longblob"}
+)
+
+// isWiderType returns true if the values of the old type can all be
+// stored in the new type without change. Defaults and comments are not
+// compared.
+func isWiderType(oldType, newType *sqlparser.ColumnType) bool {
+	if !oldType.NotNull && newType.NotNull {
+		return false
+	}
+	if !strings.EqualFold(oldType.Charset, newType.Charset) || !strings.EqualFold(oldType.Collate, newType.Collate) {
+		return false
+	}
+	oldName, newName := normalizeType(oldType.Type), normalizeType(newType.Type)
+	if oldType.Zerofill != newType.Zerofill {
+		return false
+	}
+	if oldRank, newRank := typeRank(intTypes, oldName), typeRank(intTypes, newName); oldRank >= 0 && newRank >= 0 {
+		switch {
+		case oldType.Unsigned == newType.Unsigned:
+			return newRank >= oldRank
+		case oldType.Unsigned && !newType.Unsigned:
+			return newRank > oldRank
+		}
+		return false
+	}
+	if oldType.Unsigned != newType.Unsigned {
+		return false
+	}
+	for _, family := range [][]string{textTypes, blobTypes} {
+		if oldRank, newRank := typeRank(family, oldName), typeRank(family, newName); oldRank >= 0 && newRank >= 0 {
+			return newRank >= oldRank
+		}
+	}
+	if oldName == "float" && newName == "double" && oldType.Length == nil && newType.Length == nil {
+		return true
+	}
+	if oldName != newName {
+		return false
+	}
+	switch oldName {
+	case "varchar", "varbinary", "bit", "datetime", "timestamp", "time":
+		return literalInt(newType.Length, 0) >= literalInt(oldType.Length, 0)
+	case "decimal":
+		oldPrecision, oldScale := literalInt(oldType.Length, 10), literalInt(oldType.Scale, 0)
+		newPrecision, newScale := literalInt(newType.Length, 10), literalInt(newType.Scale, 0)
+		return newScale >= oldScale && newPrecision-newScale >= oldPrecision-oldScale
+	case "enum", "set":
+		// Values can only be added at the end: the others keep their index.
+		if len(newType.EnumValues) < len(oldType.EnumValues) {
+			return false
+		}
+		for i, val := range oldType.EnumValues {
+			if newType.EnumValues[i] != val {
+				return false
+			}
+		}
+		return true
+	}
+	// Other types can't change, except for the options.
+	return literalInt(newType.Length, 0) == literalInt(oldType.Length, 0) && literalInt(newType.Scale, 0) == literalInt(oldType.Scale, 0)
+}
+
+func normalizeType(typ string) string {
+	typ = strings.ToLower(typ)
+	switch typ {
+	case "integer":
+		return "int"
+	case "numeric", "dec", "fixed":
+		return "decimal"
+	case "real":
+		return "double"
+	}
+	return typ
+}
+
+func typeRank(family []string, typ string) int {
+	for i, name := range family {
+		if name == typ {
+			return i
+		}
+	}
+	return -1
+}
+
+func literalInt(lit *sqlparser.Literal, def int) int {
+	if lit == nil {
+		return def
+	}
+	v, err := strconv.Atoi(string(lit.Val))
+	if err != nil {
+		return def
+	}
+	return v
+}
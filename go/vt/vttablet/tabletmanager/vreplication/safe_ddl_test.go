@@ -0,0 +1,205 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+func parseColumns(t *testing.T, create string) map[string]*sqlparser.ColumnDefinition {
+	t.Helper()
+	stmt, err := sqlparser.Parse(create)
+	require.NoError(t, err)
+	columns := make(map[string]*sqlparser.ColumnDefinition)
+	for _, col := range stmt.(*sqlparser.CreateTable).TableSpec.Columns {
+		columns[col.Name.Lowered()] = col
+	}
+	return columns
+}
+
+func TestBuildSafeDDL(t *testing.T) {
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t2",
+			Filter: "select id, val as v, concat(val2, 'x') as c from src2",
+		}, {
+			Match: "/.*",
+		}},
+	}
+	pkInfos := map[string][]*PrimaryKeyInfo{
+		"t1": {{Name: "id"}},
+		"t2": {{Name: "id"}},
+	}
+	targetColumns := func(tableName string) (map[string]*sqlparser.ColumnDefinition, error) {
+		switch tableName {
+		case "t1":
+			return parseColumns(t, "create table t1 (id int not null, c1 int, c2 varchar(10))"), nil
+		case "t2":
+			return parseColumns(t, "create table t2 (id int not null, v int, c varchar(20))"), nil
+		}
+		return nil, nil
+	}
+
+	testcases := []struct {
+		ddl  string
+		want string
+		err  string
+	}{{
+		ddl:  "alter table t1 add column c3 varchar(10)",
+		want: "alter table t1 add column c3 varchar(10)",
+	}, {
+		ddl:  "alter table t1 add index c2_idx (c2), algorithm = inplace",
+		want: "alter table t1 add index c2_idx (c2)",
+	}, {
+		ddl: "alter table t1 modify c1 bigint, modify column c2 varchar(20) not null",
+		err: "DDL of table t1 changes the type of column c2 from varchar(10) to varchar(20) not null",
+	}, {
+		ddl:  "alter table t1 modify c1 bigint, change c2 c2 varchar(20) after c1",
+		want: "alter table t1 modify column c1 bigint, modify column c2 varchar(20) after c1",
+	}, {
+		ddl: "alter table t1 modify c1 tinyint",
+		err: "DDL of table t1 changes the type of column c1 from int to tinyint",
+	}, {
+		ddl: "alter table t1 change c2 c3 varchar(10)",
+		err: "DDL of table t1 renames column c2",
+	}, {
+		ddl: "alter table t1 drop column c2",
+		err: "DDL of table t1 is not additive: drop column c2",
+	}, {
+		ddl: "alter table t1 add primary key (c1)",
+		err: "DDL of table t1 changes the primary key",
+	}, {
+		// Only the columns that are copied as is are altered.
+		ddl:  "alter table src2 modify val bigint, add column val3 int",
+		want: "alter table t2 modify column v bigint",
+	}, {
+		ddl: "alter table src2 modify val2 varchar(30)",
+		err: "DDL of table src2 changes column val2, which is used in an expression of table t2",
+	}, {
+		ddl: "alter table src2 add index val_idx (val)",
+	}, {
+		ddl: "drop table t1",
+		err: "DDL of table t1 is not additive",
+	}, {
+		ddl: "rename table src2 to src3",
+		err: "DDL of table src2 is not additive",
+	}, {
+		ddl: "create table t3 (id int)",
+		err: "table t3 was created, and must be added to the workflow",
+	}, {
+		// DDLs of the tables that are not replicated are ignored.
+		ddl: "alter table t2 drop column val",
+	}, {
+		ddl: "drop table t2",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.ddl, func(t *testing.T) {
+			got, err := buildSafeDDL(tcase.ddl, filter, pkInfos, targetColumns)
+			if tcase.err != "" {
+				assert.EqualError(t, err, tcase.err)
+				assert.IsType(t, &notAdditiveError{}, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tcase.want, got)
+		})
+	}
+}
+
+func TestBuildSafeDDLTransientErrors(t *testing.T) {
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match: "/.*",
+		}},
+	}
+	pkInfos := map[string][]*PrimaryKeyInfo{
+		"t1": {{Name: "id"}},
+	}
+	failingColumns := func(tableName string) (map[string]*sqlparser.ColumnDefinition, error) {
+		return nil, errors.New("schema unavailable")
+	}
+	missingColumns := func(tableName string) (map[string]*sqlparser.ColumnDefinition, error) {
+		return parseColumns(t, "create table t1 (id int not null)"), nil
+	}
+
+	testcases := []struct {
+		ddl           string
+		targetColumns columnsFunc
+		err           string
+	}{{
+		ddl:           "alter table t1 modify c1 bigint",
+		targetColumns: failingColumns,
+		err:           "schema unavailable",
+	}, {
+		ddl:           "alter table t1 modify c1 bigint",
+		targetColumns: missingColumns,
+		err:           "column c1 not found in table t1",
+	}, {
+		ddl:           "alter table t1 modify c1 bigint int",
+		targetColumns: missingColumns,
+		err:           "cannot parse DDL",
+	}}
+	for _, tcase := range testcases {
+		_, err := buildSafeDDL(tcase.ddl, filter, pkInfos, tcase.targetColumns)
+		require.Error(t, err, tcase.ddl)
+		assert.Contains(t, err.Error(), tcase.err, tcase.ddl)
+		var notAdditive *notAdditiveError
+		assert.False(t, errors.As(err, &notAdditive), "%s: %v must be transient", tcase.ddl, err)
+	}
+}
+
+func TestIsWiderType(t *testing.T) {
+	testcases := []struct {
+		from, to string
+		want     bool
+	}{
+		{"int", "bigint", true},
+		{"bigint", "int", false},
+		{"int unsigned", "bigint", true},
+		{"int unsigned", "int", false},
+		{"int", "int unsigned", false},
+		{"int not null", "int", true},
+		{"int", "int not null", false},
+		{"varchar(10)", "varchar(20)", true},
+		{"varchar(20)", "varchar(10)", false},
+		{"varchar(10)", "varchar(10) character set latin1", false},
+		{"char(10)", "varchar(10)", false},
+		{"binary(10)", "binary(20)", false},
+		{"decimal(10,2)", "decimal(12,2)", true},
+		{"decimal(10,2)", "decimal(10,3)", false},
+		{"text", "mediumtext", true},
+		{"blob", "tinyblob", false},
+		{"float", "double", true},
+		{"enum('a','b')", "enum('a','b','c')", true},
+		{"enum('a','b')", "enum('b','a')", false},
+		{"datetime", "datetime(3)", true},
+		{"json", "json", true},
+	}
+	for _, tcase := range testcases {
+		from := parseColumns(t, "create table t (c "+tcase.from+")")["c"]
+		to := parseColumns(t, "create table t (c "+tcase.to+")")["c"]
+		assert.Equal(t, tcase.want, isWiderType(&from.Type, &to.Type), "%s to %s", tcase.from, tcase.to)
+	}
+}
@@ -51,6 +51,8 @@ type tablePlanBuilder struct {
 	pkCols     []*colExpr
 	lastpk     *sqltypes.Result
 	pkInfos    []*PrimaryKeyInfo
+	// keyless is set if the target table has no usable key, see isKeyless.
+	keyless bool
 }
 
 // colExpr describes the processing to be performed to
@@ -61,6 +63,7 @@ type colExpr struct {
 	// operation==opExpr: full expression is set
 	// operation==opCount: nothing is set.
 	// operation==opSum: for 'sum(a)', expr is set to 'a'.
+	// operation==opMin, opMax, opAvg and opCountDistinct: like opSum.
 	operation operation
 	// expr stores the expected field name from vstreamer and dictates
 	// the generated bindvar names, like a_col or b_col.
@@ -82,6 +85,10 @@ const (
 	opExpr = operation(iota)
 	opCount
 	opSum
+	opMin
+	opMax
+	opAvg
+	opCountDistinct
 )
 
 // insertType describes the type of insert statement to generate.
@@ -109,7 +116,9 @@ const (
 // a table-specific rule is built to be sent to the source. We don't send the
 // original rule to the source because it may not match the same tables as the
 // target.
-// pkInfoMap specifies the list of primary key columns for each table.
+// pkInfoMap specifies the list of primary key columns for each table. For the
+// tables without a primary key, it lists the columns of their best unique key,
+// or all the columns of the keyless tables.
 // copyState is a map of tables that have not been fully copied yet.
 // If a table is not present in copyState, then it has been fully copied. If so,
 // all replication events are applied. The table still has to match a Filter.Rule.
@@ -128,6 +137,9 @@ func buildReplicatorPlan(filter *binlogdatapb.Filter, pkInfoMap map[string][]*Pr
 		PKInfoMap:     pkInfoMap,
 	}
 	for tableName := range pkInfoMap {
+		if isAggregateTable(tableName) {
+			continue
+		}
 		lastpk, ok := copyState[tableName]
 		if ok && lastpk == nil {
 			// Don't replicate uncopied tables.
@@ -252,6 +264,9 @@ func buildTablePlan(tableName, filter string, pkInfoMap map[string][]*PrimaryKey
 	if err := tpb.analyzePK(pkInfoMap); err != nil {
 		return nil, err
 	}
+	if err := tpb.analyzeAggregates(); err != nil {
+		return nil, err
+	}
 
 	// if there are no columns being selected the select expression can be empty, so we "select 1" so we have a valid
 	// select to get a row back
@@ -288,6 +303,7 @@ func (tpb *tablePlanBuilder) generate() *TablePlan {
 	sort.Strings(pkrefs)
 
 	bvf := &bindvarFormatter{}
+	aggregateTables, aggregateInserts, aggregateDeletes := tpb.generateAggregates()
 
 	return &TablePlan{
 		TargetName:       tpb.name.String(),
@@ -298,7 +314,11 @@ func (tpb *tablePlanBuilder) generate() *TablePlan {
 		Insert:           tpb.generateInsertStatement(),
 		Update:           tpb.generateUpdateStatement(),
 		Delete:           tpb.generateDeleteStatement(),
+		Replace:          tpb.generateReplaceStatement(),
 		PKReferences:     pkrefs,
+		AggregateTables:  aggregateTables,
+		AggregateInserts: aggregateInserts,
+		AggregateDeletes: aggregateDeletes,
 	}
 }
 
@@ -358,36 +378,33 @@ func (tpb *tablePlanBuilder) analyzeExpr(selExpr sqlparser.SelectExpr) (*colExpr
 		references: make(map[string]bool),
 	}
 	if expr, ok := aliased.Expr.(*sqlparser.FuncExpr); ok {
-		if expr.Distinct {
+		fname := expr.Name.Lowered()
+		if expr.Distinct && fname != "count" {
 			return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
 		}
-		switch fname := expr.Name.Lowered(); fname {
+		switch fname {
 		case "count":
+			if expr.Distinct {
+				cexpr.operation = opCountDistinct
+				return tpb.analyzeAggregateArg(cexpr, expr)
+			}
 			if _, ok := expr.Exprs[0].(*sqlparser.StarExpr); !ok {
 				return nil, fmt.Errorf("only count(*) is supported: %v", sqlparser.String(expr))
 			}
 			cexpr.operation = opCount
 			return cexpr, nil
 		case "sum":
-			if len(expr.Exprs) != 1 {
-				return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			aInner, ok := expr.Exprs[0].(*sqlparser.AliasedExpr)
-			if !ok {
-				return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			innerCol, ok := aInner.Expr.(*sqlparser.ColName)
-			if !ok {
-				return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			if !innerCol.Qualifier.IsEmpty() {
-				return nil, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(innerCol))
-			}
 			cexpr.operation = opSum
-			cexpr.expr = innerCol
-			tpb.addCol(innerCol.Name)
-			cexpr.references[innerCol.Name.Lowered()] = true
-			return cexpr, nil
+			return tpb.analyzeAggregateArg(cexpr, expr)
+		case "min":
+			cexpr.operation = opMin
+			return tpb.analyzeAggregateArg(cexpr, expr)
+		case "max":
+			cexpr.operation = opMax
+			return tpb.analyzeAggregateArg(cexpr, expr)
+		case "avg":
+			cexpr.operation = opAvg
+			return tpb.analyzeAggregateArg(cexpr, expr)
 		case "keyspace_id":
 			if len(expr.Exprs) != 0 {
 				return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
@@ -414,6 +431,15 @@ func (tpb *tablePlanBuilder) analyzeExpr(selExpr sqlparser.SelectExpr) (*colExpr
 			if node.IsAggregate() {
 				return false, fmt.Errorf("unexpected: %v", sqlparser.String(node))
 			}
+			if isNonDeterministic(node) {
+				return false, fmt.Errorf("non-deterministic function is not supported: %v", sqlparser.String(node))
+			}
+		case *sqlparser.CurTimeFuncExpr:
+			return false, fmt.Errorf("non-deterministic function is not supported: %v", sqlparser.String(node))
+		case *sqlparser.GroupConcatExpr:
+			return false, fmt.Errorf("unexpected: %v", sqlparser.String(node))
+		case *sqlparser.MatchExpr, *sqlparser.ValuesFuncExpr, *sqlparser.Default:
+			return false, fmt.Errorf("unsupported expression: %v", sqlparser.String(node))
 		}
 		return true, nil
 	}, aliased.Expr)
@@ -424,6 +450,51 @@ func (tpb *tablePlanBuilder) analyzeExpr(selExpr sqlparser.SelectExpr) (*colExpr
 	return cexpr, nil
 }
 
+// analyzeAggregateArg sets the argument of an aggregate function, which
+// must be a column.
+func (tpb *tablePlanBuilder) analyzeAggregateArg(cexpr *colExpr, expr *sqlparser.FuncExpr) (*colExpr, error) {
+	if len(expr.Exprs) != 1 {
+		return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+	}
+	aInner, ok := expr.Exprs[0].(*sqlparser.AliasedExpr)
+	if !ok {
+		return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+	}
+	innerCol, ok := aInner.Expr.(*sqlparser.ColName)
+	if !ok {
+		return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+	}
+	if !innerCol.Qualifier.IsEmpty() {
+		return nil, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(innerCol))
+	}
+	cexpr.expr = innerCol
+	tpb.addCol(innerCol.Name)
+	cexpr.references[innerCol.Name.Lowered()] = true
+	return cexpr, nil
+}
+
+// nonDeterministicFuncs lists the functions whose result depends on when
+// or where they're evaluated. The select expressions of a filter are
+// evaluated by the target when applying the rows, and replaying an event
+// must produce the same result as the copy phase did.
+var nonDeterministicFuncs = map[string]bool{
+	"connection_id": true, "curdate": true, "current_date": true, "current_time": true, "current_timestamp": true,
+	"current_user": true, "curtime": true, "database": true, "found_rows": true, "last_insert_id": true,
+	"localtime": true, "localtimestamp": true, "now": true, "rand": true, "row_count": true,
+	"schema": true, "session_user": true, "sleep": true, "sysdate": true, "system_user": true,
+	"user": true, "utc_date": true, "utc_time": true, "utc_timestamp": true, "uuid": true,
+	"uuid_short": true, "version": true,
+}
+
+func isNonDeterministic(node *sqlparser.FuncExpr) bool {
+	if !node.Qualifier.IsEmpty() {
+		return false
+	}
+	fname := node.Name.Lowered()
+	// Without an argument, unix_timestamp returns the current time.
+	return nonDeterministicFuncs[fname] || (fname == "unix_timestamp" && len(node.Exprs) == 0)
+}
+
 // addCol adds the specified column to the send query
 // if it's not already present.
 func (tpb *tablePlanBuilder) addCol(ident sqlparser.ColIdent) {
@@ -473,9 +544,15 @@ func (tpb *tablePlanBuilder) analyzePK(pkInfoMap map[string][]*PrimaryKeyInfo) e
 	if !ok {
 		return fmt.Errorf("table %s not found in schema", tpb.name)
 	}
+	tpb.keyless = isKeyless(pkcols)
 	for _, pkcol := range pkcols {
 		cexpr := tpb.findCol(sqlparser.NewColIdent(pkcol.Name))
 		if cexpr == nil {
+			if tpb.keyless {
+				// The rows of a keyless table are matched on the
+				// columns that are replicated.
+				continue
+			}
 			return fmt.Errorf("primary key column %v not found in select list", pkcol)
 		}
 		if cexpr.operation != opExpr {
@@ -486,6 +563,9 @@ func (tpb *tablePlanBuilder) analyzePK(pkInfoMap map[string][]*PrimaryKeyInfo) e
 		cexpr.columnType = pkcol.ColumnType
 		tpb.pkCols = append(tpb.pkCols, cexpr)
 	}
+	if tpb.keyless && len(tpb.pkCols) == 0 {
+		return fmt.Errorf("table %s has no key column in the select list", tpb.name)
+	}
 	return nil
 }
 
@@ -517,6 +597,30 @@ func (tpb *tablePlanBuilder) generateInsertStatement() *sqlparser.ParsedQuery {
 	return buf.ParsedQuery()
 }
 
+// generateReplaceStatement generates the statement of TablePlan.Replace.
+func (tpb *tablePlanBuilder) generateReplaceStatement() *sqlparser.ParsedQuery {
+	if tpb.onInsert != insertNormal || tpb.keyless {
+		return nil
+	}
+	bvf := &bindvarFormatter{}
+	buf := sqlparser.NewTrackedBuffer(bvf.formatter)
+
+	buf.Myprintf("replace into %v(", tpb.name)
+	separator := ""
+	for _, cexpr := range tpb.colExprs {
+		buf.Myprintf("%s%v", separator, cexpr.colName)
+		separator = ","
+	}
+	buf.Myprintf(")")
+	if tpb.lastpk == nil {
+		buf.Myprintf(" values ")
+		tpb.generateValuesPart(buf, bvf)
+	} else {
+		tpb.generateSelectPart(buf, bvf)
+	}
+	return buf.ParsedQuery()
+}
+
 func (tpb *tablePlanBuilder) generateInsertPart(buf *sqlparser.TrackedBuffer) *sqlparser.ParsedQuery {
 	if tpb.onInsert == insertIgnore {
 		buf.Myprintf("insert ignore into %v(", tpb.name)
@@ -550,6 +654,10 @@ func (tpb *tablePlanBuilder) generateValuesPart(buf *sqlparser.TrackedBuffer, bv
 		case opSum:
 			// NULL values must be treated as 0 for SUM.
 			buf.Myprintf("ifnull(%v, 0)", cexpr.expr)
+		case opMin, opMax, opAvg:
+			buf.Myprintf("%v", cexpr.expr)
+		case opCountDistinct:
+			buf.Myprintf("if(%v is null, 0, 1)", cexpr.expr)
 		}
 	}
 	buf.Myprintf(")")
@@ -570,6 +678,10 @@ func (tpb *tablePlanBuilder) generateSelectPart(buf *sqlparser.TrackedBuffer, bv
 			buf.WriteString("1")
 		case opSum:
 			buf.Myprintf("ifnull(%v, 0)", cexpr.expr)
+		case opMin, opMax, opAvg:
+			buf.Myprintf("%v", cexpr.expr)
+		case opCountDistinct:
+			buf.Myprintf("if(%v is null, 0, 1)", cexpr.expr)
 		}
 	}
 	buf.WriteString(" from dual where ")
@@ -604,6 +716,11 @@ func (tpb *tablePlanBuilder) generateOnDupPart(buf *sqlparser.TrackedBuffer) *sq
 		case opSum:
 			buf.Myprintf("%v", cexpr.colName)
 			buf.Myprintf("+ifnull(values(%v), 0)", cexpr.colName)
+		case opMin, opMax:
+			buf.Myprintf("%s(ifnull(%v, values(%v)), ifnull(values(%v), %v))", extremeFunc(cexpr.operation), cexpr.colName, cexpr.colName, cexpr.colName, cexpr.colName)
+		case opAvg, opCountDistinct:
+			// The companion table already contains the new value.
+			tpb.generateAggregateLookup(buf, cexpr)
 		}
 	}
 	return buf.ParsedQuery()
@@ -613,6 +730,11 @@ func (tpb *tablePlanBuilder) generateUpdateStatement() *sqlparser.ParsedQuery {
 	if tpb.onInsert == insertIgnore {
 		return tpb.generateInsertStatement()
 	}
+	if tpb.keyless {
+		// All the columns of a keyless table are in its key: a change
+		// is applied as a delete followed by an insert.
+		return nil
+	}
 	bvf := &bindvarFormatter{}
 	buf := sqlparser.NewTrackedBuffer(bvf.formatter)
 	buf.Myprintf("update %v set ", tpb.name)
@@ -635,6 +757,16 @@ func (tpb *tablePlanBuilder) generateUpdateStatement() *sqlparser.ParsedQuery {
 			buf.Myprintf("-ifnull(%v, 0)", cexpr.expr)
 			bvf.mode = bvAfter
 			buf.Myprintf("+ifnull(%v, 0)", cexpr.expr)
+		case opMin, opMax:
+			// The extreme is re-scanned only if it was changed.
+			bvf.mode = bvBefore
+			buf.Myprintf("if(%v=%v, ", cexpr.colName, cexpr.expr)
+			tpb.generateAggregateLookup(buf, cexpr)
+			bvf.mode = bvAfter
+			buf.Myprintf(", %s(ifnull(%v, %v), ifnull(%v, %v)))", extremeFunc(cexpr.operation), cexpr.colName, cexpr.expr, cexpr.expr, cexpr.colName)
+		case opAvg, opCountDistinct:
+			bvf.mode = bvBefore
+			tpb.generateAggregateLookup(buf, cexpr)
 		}
 	}
 	tpb.generateWhere(buf, bvf)
@@ -665,6 +797,13 @@ func (tpb *tablePlanBuilder) generateDeleteStatement() *sqlparser.ParsedQuery {
 				buf.Myprintf("%v-1", cexpr.colName)
 			case opSum:
 				buf.Myprintf("%v-ifnull(%v, 0)", cexpr.colName, cexpr.expr)
+			case opMin, opMax:
+				// The extreme is re-scanned only if it was deleted.
+				buf.Myprintf("if(%v=%v, ", cexpr.colName, cexpr.expr)
+				tpb.generateAggregateLookup(buf, cexpr)
+				buf.Myprintf(", %v)", cexpr.colName)
+			case opAvg, opCountDistinct:
+				tpb.generateAggregateLookup(buf, cexpr)
 			}
 		}
 		tpb.generateWhere(buf, bvf)
@@ -688,13 +827,18 @@ func (tpb *tablePlanBuilder) generateWhere(buf *sqlparser.TrackedBuffer, bvf *bi
 	buf.WriteString(" where ")
 	bvf.mode = bvBefore
 	separator := ""
+	// The columns of keyless tables can be NULL.
+	equal := "="
+	if tpb.keyless {
+		equal = "<=>"
+	}
 	for _, cexpr := range tpb.pkCols {
 		if _, ok := cexpr.expr.(*sqlparser.ColName); ok {
-			buf.Myprintf("%s%v=", separator, cexpr.colName)
+			buf.Myprintf("%s%v%s", separator, cexpr.colName, equal)
 			castIfNecessary(buf, cexpr)
 		} else {
 			// Parenthesize non-trivial expressions.
-			buf.Myprintf("%s%v=(", separator, cexpr.colName)
+			buf.Myprintf("%s%v%s(", separator, cexpr.colName, equal)
 			castIfNecessary(buf, cexpr)
 			buf.Myprintf(")")
 		}
@@ -704,6 +848,11 @@ func (tpb *tablePlanBuilder) generateWhere(buf *sqlparser.TrackedBuffer, bvf *bi
 		buf.WriteString(" and ")
 		tpb.generatePKConstraint(buf, bvf)
 	}
+	if tpb.keyless {
+		// A keyless table can contain duplicate rows: only one of
+		// them is changed by an event.
+		buf.WriteString(" limit 1")
+	}
 }
 
 func (tpb *tablePlanBuilder) getCharsetAndCollation(pkname string) (charSet string, collation string) {
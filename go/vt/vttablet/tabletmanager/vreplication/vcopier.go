@@ -18,10 +18,13 @@ package vreplication
 
 import (
 	"bytes"
+	"flag"
 	"fmt"
 	"io"
+	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"context"
@@ -31,16 +34,34 @@ import (
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
+var copyConcurrency = flag.Int("vreplication_copy_concurrency", 1, "Maximum number of tables that a VReplication stream copies at a time. The concurrent copies are throttled by the tablet throttler.")
+
+const (
+	// throttlerAppName is the name of vcopier for the tablet throttler.
+	throttlerAppName = "vcopier"
+	// throttleCheckDuration is how long the concurrent copies wait
+	// before checking the throttler again.
+	throttleCheckDuration = 250 * time.Millisecond
+)
+
+var throttleFlags = &throttle.CheckFlags{}
+
 type vcopier struct {
 	vr        *vreplicator
 	tablePlan *TablePlan
+	// windows contains the positions of the snapshots of the tables
+	// that were copied concurrently, until the stream reaches them.
+	windows map[string]mysql.Position
 }
 
 func newVCopier(vr *vreplicator) *vcopier {
@@ -103,19 +124,31 @@ func (vc *vcopier) initTablesForCopy(ctx context.Context) error {
 // copyNext also builds the copyState metadata that contains the tables and their last
 // primary key that was copied. A nil Result means that nothing has been copied.
 // A table that was fully copied is removed from copyState.
+// If -vreplication_copy_concurrency is more than 1, steps 2 to 4 are
+// replaced by copyTables, which copies several tables at a time.
 func (vc *vcopier) copyNext(ctx context.Context, settings binlogplayer.VRSettings) error {
-	qr, err := vc.vr.dbClient.Execute(fmt.Sprintf("select table_name, lastpk from _vt.copy_state where vrepl_id=%d", vc.vr.id))
+	qr, err := withDDL.Exec(ctx, fmt.Sprintf("select table_name, lastpk, pos from _vt.copy_state where vrepl_id=%d", vc.vr.id), vc.vr.dbClient.ExecuteFetch)
 	if err != nil {
 		return err
 	}
-	var tableToCopy string
+	var tablesToCopy []string
 	copyState := make(map[string]*sqltypes.Result)
+	vc.windows = make(map[string]mysql.Position)
 	for _, row := range qr.Rows {
 		tableName := row[0].ToString()
 		lastpk := row[1].ToString()
-		if tableToCopy == "" {
-			tableToCopy = tableName
+		if !row[2].IsNull() {
+			pos, err := mysql.DecodePosition(row[2].ToString())
+			if err != nil {
+				return err
+			}
+			vc.windows[tableName] = pos
+			if lastpk == "" {
+				// The table was fully copied, only its window is left.
+				continue
+			}
 		}
+		tablesToCopy = append(tablesToCopy, tableName)
 		copyState[tableName] = nil
 		if lastpk != "" {
 			var r querypb.QueryResult
@@ -125,12 +158,31 @@ func (vc *vcopier) copyNext(ctx context.Context, settings binlogplayer.VRSetting
 			copyState[tableName] = sqltypes.Proto3ToResult(&r)
 		}
 	}
-	if len(copyState) == 0 {
+	if len(qr.Rows) == 0 {
 		return fmt.Errorf("unexpected: there are no tables to copy")
 	}
+	if err := vc.startFromWindows(); err != nil {
+		return err
+	}
+	if len(tablesToCopy) == 0 {
+		return vc.closeWindows(ctx, copyState)
+	}
+	tableToCopy := tablesToCopy[0]
+	if copyState[tableToCopy] != nil && isKeyless(vc.vr.pkInfoMap[tableToCopy]) {
+		if err := vc.restartKeylessCopy(ctx, tableToCopy); err != nil {
+			return err
+		}
+		copyState[tableToCopy] = nil
+	}
 	if err := vc.catchup(ctx, copyState); err != nil {
 		return err
 	}
+	if err := vc.clearWindows(copyState); err != nil {
+		return err
+	}
+	if tables := vc.concurrentTables(tablesToCopy); len(tables) > 1 {
+		return vc.copyTables(ctx, tables, copyState)
+	}
 	return vc.copyTable(ctx, tableToCopy, copyState)
 }
 
@@ -157,7 +209,7 @@ func (vc *vcopier) catchup(ctx context.Context, copyState map[string]*sqltypes.R
 	// Start vreplication.
 	errch := make(chan error, 1)
 	go func() {
-		errch <- newVPlayer(vc.vr, settings, copyState, mysql.Position{}, "catchup").play(ctx)
+		errch <- vc.newVPlayer(settings, copyState, mysql.Position{}, "catchup").play(ctx)
 	}()
 
 	// Wait for catchup.
@@ -209,7 +261,13 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 		return fmt.Errorf("plan not found for table: %s, current plans are: %#v", tableName, plan.TargetTables)
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, copyTimeout)
+	// Keyless tables are copied in a single pass, see isKeyless.
+	var cancel context.CancelFunc
+	if isKeyless(vc.vr.pkInfoMap[tableName]) {
+		ctx, cancel = context.WithCancel(ctx)
+	} else {
+		ctx, cancel = context.WithTimeout(ctx, copyTimeout)
+	}
 	defer cancel()
 
 	var lastpkpb *querypb.QueryResult
@@ -320,6 +378,29 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 	return nil
 }
 
+// restartKeylessCopy deletes the rows of a keyless table that were
+// copied before the copy was interrupted. A keyless table can't be copied
+// from its lastpk, because its rows may be duplicated or contain NULL
+// values.
+func (vc *vcopier) restartKeylessCopy(ctx context.Context, tableName string) error {
+	log.Infof("Copy of keyless table %s was interrupted, restarting it", tableName)
+	if err := vc.vr.dbClient.Begin(); err != nil {
+		return err
+	}
+	defer vc.vr.dbClient.Rollback()
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("delete from %v", sqlparser.NewTableIdent(tableName))
+	if _, err := vc.vr.dbClient.ExecuteWithRetry(ctx, buf.String()); err != nil {
+		return err
+	}
+	buf = sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("update _vt.copy_state set lastpk=null where vrepl_id=%s and table_name=%s", strconv.Itoa(int(vc.vr.id)), encodeString(tableName))
+	if _, err := vc.vr.dbClient.Execute(buf.String()); err != nil {
+		return err
+	}
+	return vc.vr.dbClient.Commit()
+}
+
 func (vc *vcopier) fastForward(ctx context.Context, copyState map[string]*sqltypes.Result, gtid string) error {
 	defer func() {
 		vc.vr.stats.PhaseTimings.Record("fastforward", time.Now())
@@ -337,5 +418,332 @@ func (vc *vcopier) fastForward(ctx context.Context, copyState map[string]*sqltyp
 		_, err := vc.vr.dbClient.Execute(update)
 		return err
 	}
-	return newVPlayer(vc.vr, settings, copyState, pos, "fastforward").play(ctx)
+	return vc.newVPlayer(settings, copyState, pos, "fastforward").play(ctx)
+}
+
+// newVPlayer creates a vplayer that replays the events of the tables
+// that are in their window idempotently.
+func (vc *vcopier) newVPlayer(settings binlogplayer.VRSettings, copyState map[string]*sqltypes.Result, pausePos mysql.Position, phase string) *vplayer {
+	vp := newVPlayer(vc.vr, settings, copyState, pausePos, phase)
+	vp.windows = vc.windows
+	return vp
+}
+
+// concurrentTables returns the tables that copyTables can copy. Only
+// the tables whose events can be replayed idempotently are eligible:
+// the others, like keyless tables or the ones with aggregates, are
+// left to copyTable. It returns nil if tables are copied one at a time.
+func (vc *vcopier) concurrentTables(tablesToCopy []string) []string {
+	if *copyConcurrency <= 1 {
+		return nil
+	}
+	plan, err := buildReplicatorPlan(vc.vr.source.Filter, vc.vr.pkInfoMap, nil)
+	if err != nil {
+		// copyTable will report the error.
+		return nil
+	}
+	var tables []string
+	for _, tableName := range tablesToCopy {
+		tp, ok := plan.TargetTables[tableName]
+		if !ok || isKeyless(vc.vr.pkInfoMap[tableName]) {
+			continue
+		}
+		// Plans of "select *" are only complete after the fields are
+		// known, and they're always insertNormal.
+		if tp.Insert != nil && tp.Replace == nil {
+			continue
+		}
+		tables = append(tables, tableName)
+	}
+	return tables
+}
+
+// copyTables copies up to -vreplication_copy_concurrency tables at a
+// time, each on its own connection and from its own snapshot. Unlike
+// copyTable, the stream is not fast-forwarded to the snapshots. Instead,
+// the position of the snapshot of each table is saved in copy_state as
+// its window: the events that precede it are already in the copied
+// rows, and vplayer replays them idempotently until the stream reaches
+// the window.
+func (vc *vcopier) copyTables(ctx context.Context, tables []string, copyState map[string]*sqltypes.Result) error {
+	defer func() {
+		vc.vr.stats.PhaseTimings.Record("copy", time.Now())
+		vc.vr.stats.CopyLoopCount.Add(1)
+	}()
+
+	plan, err := buildReplicatorPlan(vc.vr.source.Filter, vc.vr.pkInfoMap, nil)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, copyTimeout)
+	defer cancel()
+
+	tableCh := make(chan string, len(tables))
+	for _, tableName := range tables {
+		tableCh <- tableName
+	}
+	close(tableCh)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	rec := &concurrency.FirstErrorRecorder{}
+	for i := 0; i < *copyConcurrency && i < len(tables); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for tableName := range tableCh {
+				if ctx.Err() != nil {
+					return
+				}
+				pos, err := vc.copyTableConcurrently(ctx, plan, tableName, copyState[tableName])
+				if err != nil {
+					rec.RecordError(vterrors.Wrapf(err, "copy of table %s", tableName))
+					cancel()
+					return
+				}
+				if !pos.IsZero() {
+					mu.Lock()
+					vc.windows[tableName] = pos
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if rec.HasErrors() {
+		return rec.Error()
+	}
+	return vc.startFromWindows()
+}
+
+// copyTableConcurrently copies the next set of rows of a table for
+// copyTables. Each packet received is committed with the lastpk and the
+// position of the snapshot, which it returns. When the table is fully
+// copied, its lastpk is cleared, but its copy_state row is kept until
+// the stream reaches the window, see clearWindows.
+func (vc *vcopier) copyTableConcurrently(ctx context.Context, plan *ReplicatorPlan, tableName string, lastpkqr *sqltypes.Result) (mysql.Position, error) {
+	if !vc.throttle(ctx) {
+		return mysql.Position{}, nil
+	}
+	log.Infof("Copying table %s concurrently, lastpk: %v", tableName, lastpkqr)
+
+	initialPlan, ok := plan.TargetTables[tableName]
+	if !ok {
+		return mysql.Position{}, fmt.Errorf("plan not found for table: %s, current plans are: %#v", tableName, plan.TargetTables)
+	}
+
+	dbClient := newVDBClient(vc.vr.vre.dbClientFactory(), vc.vr.stats)
+	if err := dbClient.Connect(); err != nil {
+		return mysql.Position{}, vterrors.Wrap(err, "can't connect to database")
+	}
+	defer dbClient.Close()
+	defer dbClient.Rollback()
+	if _, err := dbClient.Execute("set foreign_key_checks=0;"); err != nil {
+		return mysql.Position{}, err
+	}
+
+	var lastpkpb *querypb.QueryResult
+	if lastpkqr != nil {
+		lastpkpb = sqltypes.ResultToProto3(lastpkqr)
+	}
+
+	var tablePlan *TablePlan
+	var pkfields []*querypb.Field
+	var updateCopyState *sqlparser.ParsedQuery
+	var bv map[string]*querypb.BindVariable
+	var pos mysql.Position
+	err := vc.vr.sourceVStreamer.VStreamRows(ctx, initialPlan.SendRule.Filter, lastpkpb, func(rows *binlogdatapb.VStreamRowsResponse) error {
+		select {
+		case <-ctx.Done():
+			return io.EOF
+		default:
+		}
+		if tablePlan == nil {
+			if len(rows.Fields) == 0 {
+				return fmt.Errorf("expecting field event first, got: %v", rows)
+			}
+			var err error
+			pos, err = mysql.DecodePosition(rows.Gtid)
+			if err != nil {
+				return err
+			}
+			fieldEvent := &binlogdatapb.FieldEvent{
+				TableName: initialPlan.SendRule.Match,
+				Fields:    rows.Fields,
+			}
+			tablePlan, err = plan.buildExecutionPlan(fieldEvent)
+			if err != nil {
+				return err
+			}
+			pkfields = rows.Pkfields
+			buf := sqlparser.NewTrackedBuffer(nil)
+			buf.Myprintf("update _vt.copy_state set lastpk=%a, pos=%s where vrepl_id=%s and table_name=%s", ":lastpk", encodeString(rows.Gtid), strconv.Itoa(int(vc.vr.id)), encodeString(tableName))
+			updateCopyState = buf.ParsedQuery()
+		}
+		if len(rows.Rows) == 0 {
+			return nil
+		}
+		if !vc.throttle(ctx) {
+			return io.EOF
+		}
+		if err := dbClient.Begin(); err != nil {
+			return err
+		}
+		_, err := tablePlan.applyBulkInsert(rows, func(sql string) (*sqltypes.Result, error) {
+			start := time.Now()
+			qr, err := dbClient.ExecuteWithRetry(ctx, sql)
+			if err != nil {
+				return nil, err
+			}
+			vc.vr.stats.QueryTimings.Record("copy", start)
+
+			vc.vr.stats.CopyRowCount.Add(int64(qr.RowsAffected))
+			vc.vr.stats.QueryCount.Add("copy", 1)
+
+			return qr, err
+		})
+		if err != nil {
+			return err
+		}
+
+		var buf bytes.Buffer
+		err = proto.CompactText(&buf, &querypb.QueryResult{
+			Fields: pkfields,
+			Rows:   []*querypb.Row{rows.Lastpk},
+		})
+		if err != nil {
+			return err
+		}
+		bv = map[string]*querypb.BindVariable{
+			"lastpk": {
+				Type:  sqltypes.VarBinary,
+				Value: buf.Bytes(),
+			},
+		}
+		updateState, err := updateCopyState.GenerateQuery(bv, nil)
+		if err != nil {
+			return err
+		}
+		if _, err := dbClient.Execute(updateState); err != nil {
+			return err
+		}
+		return dbClient.Commit()
+	})
+	// If there was a timeout, return without an error. The position
+	// is only saved if rows were copied.
+	select {
+	case <-ctx.Done():
+		log.Infof("Copy of %v stopped at lastpk: %v", tableName, bv)
+		if bv == nil {
+			return mysql.Position{}, nil
+		}
+		return pos, nil
+	default:
+	}
+	if err != nil {
+		return mysql.Position{}, err
+	}
+	if tablePlan == nil {
+		return mysql.Position{}, fmt.Errorf("no fields received for table %s", tableName)
+	}
+	log.Infof("Copy of %v finished at lastpk: %v", tableName, bv)
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("update _vt.copy_state set lastpk=null, pos=%s where vrepl_id=%s and table_name=%s", encodeString(mysql.EncodePosition(pos)), strconv.Itoa(int(vc.vr.id)), encodeString(tableName))
+	if _, err := dbClient.Execute(buf.String()); err != nil {
+		return mysql.Position{}, err
+	}
+	return pos, nil
+}
+
+// throttle waits until the tablet throttler lets the concurrent copies
+// write. It returns false if ctx is done first.
+func (vc *vcopier) throttle(ctx context.Context) bool {
+	if vc.vr.vre == nil || vc.vr.vre.lagThrottler == nil {
+		return true
+	}
+	for {
+		checkResult := vc.vr.vre.lagThrottler.Check(ctx, throttlerAppName, "", throttleFlags)
+		if checkResult.StatusCode == http.StatusOK {
+			return true
+		}
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(throttleCheckDuration):
+		}
+	}
+}
+
+// startFromWindows sets the position of a stream that has none to the
+// earliest window: the rows of all the tables that were copied so far
+// contain the events up to there.
+func (vc *vcopier) startFromWindows() error {
+	if len(vc.windows) == 0 {
+		return nil
+	}
+	settings, err := binlogplayer.ReadVRSettings(vc.vr.dbClient, vc.vr.id)
+	if err != nil {
+		return err
+	}
+	if !settings.StartPos.IsZero() {
+		return nil
+	}
+	var start mysql.Position
+	for _, pos := range vc.windows {
+		if start.IsZero() || start.AtLeast(pos) {
+			start = pos
+		}
+	}
+	update := binlogplayer.GenerateUpdatePos(vc.vr.id, start, time.Now().Unix(), 0)
+	_, err = vc.vr.dbClient.Execute(update)
+	return err
+}
+
+// clearWindows closes the windows that the stream has reached: the
+// events of those tables are applied normally from there on. The
+// copy_state rows of the tables that were fully copied are deleted.
+func (vc *vcopier) clearWindows(copyState map[string]*sqltypes.Result) error {
+	if len(vc.windows) == 0 {
+		return nil
+	}
+	settings, err := binlogplayer.ReadVRSettings(vc.vr.dbClient, vc.vr.id)
+	if err != nil {
+		return err
+	}
+	for tableName, pos := range vc.windows {
+		if !settings.StartPos.AtLeast(pos) {
+			continue
+		}
+		buf := sqlparser.NewTrackedBuffer(nil)
+		if _, ok := copyState[tableName]; ok {
+			buf.Myprintf("update _vt.copy_state set pos=null where vrepl_id=%s and table_name=%s", strconv.Itoa(int(vc.vr.id)), encodeString(tableName))
+		} else {
+			buf.Myprintf("delete from _vt.copy_state where vrepl_id=%s and table_name=%s", strconv.Itoa(int(vc.vr.id)), encodeString(tableName))
+		}
+		if _, err := vc.vr.dbClient.Execute(buf.String()); err != nil {
+			return err
+		}
+		delete(vc.windows, tableName)
+	}
+	return nil
+}
+
+// closeWindows fast-forwards the stream to the last window once all the
+// tables were copied, which ends the copy phase.
+func (vc *vcopier) closeWindows(ctx context.Context, copyState map[string]*sqltypes.Result) error {
+	var last mysql.Position
+	for _, pos := range vc.windows {
+		if !last.AtLeast(pos) {
+			last = pos
+		}
+	}
+	settings, err := binlogplayer.ReadVRSettings(vc.vr.dbClient, vc.vr.id)
+	if err != nil {
+		return err
+	}
+	if err := vc.newVPlayer(settings, copyState, last, "fastforward").play(ctx); err != nil {
+		return err
+	}
+	return vc.clearWindows(copyState)
 }
@@ -847,8 +847,8 @@ func TestPlayerCopyTableContinuation(t *testing.T) {
 	))
 	lastpk.RowsAffected = 0
 	execStatements(t, []string{
-		fmt.Sprintf("insert into _vt.copy_state values(%d, '%s', %s)", qr.InsertID, "dst1", encodeString(fmt.Sprintf("%v", lastpk))),
-		fmt.Sprintf("insert into _vt.copy_state values(%d, '%s', null)", qr.InsertID, "not_copied"),
+		fmt.Sprintf("insert into _vt.copy_state(vrepl_id, table_name, lastpk) values(%d, '%s', %s)", qr.InsertID, "dst1", encodeString(fmt.Sprintf("%v", lastpk))),
+		fmt.Sprintf("insert into _vt.copy_state(vrepl_id, table_name, lastpk) values(%d, '%s', null)", qr.InsertID, "not_copied"),
 	})
 	id := qr.InsertID
 	_, err = playerEngine.Exec(fmt.Sprintf("update _vt.vreplication set state='Copying', pos=%s where id=%d", encodeString(pos), id))
@@ -964,7 +964,7 @@ func TestPlayerCopyWildcardTableContinuation(t *testing.T) {
 	))
 	lastpk.RowsAffected = 0
 	execStatements(t, []string{
-		fmt.Sprintf("insert into _vt.copy_state values(%d, '%s', %s)", qr.InsertID, "dst", encodeString(fmt.Sprintf("%v", lastpk))),
+		fmt.Sprintf("insert into _vt.copy_state(vrepl_id, table_name, lastpk) values(%d, '%s', %s)", qr.InsertID, "dst", encodeString(fmt.Sprintf("%v", lastpk))),
 	})
 	id := qr.InsertID
 	_, err = playerEngine.Exec(fmt.Sprintf("update _vt.vreplication set state='Copying', pos=%s where id=%d", encodeString(pos), id))
@@ -1180,3 +1180,332 @@ func TestPlayerCopyTableCancel(t *testing.T) {
 		{"2", "bbb"},
 	})
 }
+
+func TestPlayerCopyTablesConcurrently(t *testing.T) {
+	defer deleteTablet(addTablet(100))
+
+	savedCopyConcurrency := *copyConcurrency
+	*copyConcurrency = 2
+	defer func() { *copyConcurrency = savedCopyConcurrency }()
+
+	execStatements(t, []string{
+		"create table src1(id int, val varbinary(128), primary key(id))",
+		"insert into src1 values(2, 'bbb'), (1, 'aaa')",
+		fmt.Sprintf("create table %s.dst1(id int, val varbinary(128), primary key(id))", vrepldb),
+		"create table src2(id int, val varbinary(128), primary key(id))",
+		"insert into src2 values(1, 'ccc')",
+		fmt.Sprintf("create table %s.dst2(id int, val varbinary(128), primary key(id))", vrepldb),
+	})
+	defer execStatements(t, []string{
+		"drop table src1",
+		fmt.Sprintf("drop table %s.dst1", vrepldb),
+		"drop table src2",
+		fmt.Sprintf("drop table %s.dst2", vrepldb),
+	})
+	env.SchemaEngine.Reload(context.Background())
+
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "dst1",
+			Filter: "select * from src1",
+		}, {
+			Match:  "dst2",
+			Filter: "select * from src2",
+		}},
+	}
+
+	bls := &binlogdatapb.BinlogSource{
+		Keyspace: env.KeyspaceName,
+		Shard:    env.ShardName,
+		Filter:   filter,
+		OnDdl:    binlogdatapb.OnDDLAction_IGNORE,
+	}
+	query := binlogplayer.CreateVReplicationState("test", bls, "", binlogplayer.VReplicationInit, playerEngine.dbName)
+	qr, err := playerEngine.Exec(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		query := fmt.Sprintf("delete from _vt.vreplication where id = %d", qr.InsertID)
+		if _, err := playerEngine.Exec(query); err != nil {
+			t.Fatal(err)
+		}
+		expectDeleteQueries(t)
+	}()
+
+	expectDBClientQueries(t, []string{
+		"/insert into _vt.vreplication",
+		"/update _vt.vreplication set message='Picked source tablet.*",
+		// Create the list of tables to copy and transition to Copying state.
+		"begin",
+		"/insert into _vt.copy_state",
+		"/update _vt.vreplication set state='Copying'",
+		"commit",
+	})
+	// Both tables are copied at the same time. Each packet saves the
+	// position of the snapshot of its table, which is kept as the window
+	// of the table once it's fully copied.
+	expectInterleavedQueries(t, []string{
+		"insert into dst1(id,val) values (1,'aaa'), (2,'bbb')",
+		`/update _vt.copy_state set lastpk='fields:<name:\\"id\\" type:INT32 > rows:<lengths:1 values:\\"2\\" > ', pos='.+' where vrepl_id=.* and table_name='dst1'`,
+		"/update _vt.copy_state set lastpk=null, pos='.+' where vrepl_id=.* and table_name='dst1'",
+	}, []string{
+		"insert into dst2(id,val) values (1,'ccc')",
+		`/update _vt.copy_state set lastpk='fields:<name:\\"id\\" type:INT32 > rows:<lengths:1 values:\\"1\\" > ', pos='.+' where vrepl_id=.* and table_name='dst2'`,
+		"/update _vt.copy_state set lastpk=null, pos='.+' where vrepl_id=.* and table_name='dst2'",
+	})
+	// All tables copied: the stream is fast-forwarded to the last window,
+	// which closes the windows of both tables.
+	expectInterleavedQueries(t, []string{
+		"/delete from _vt.copy_state.*dst1",
+	}, []string{
+		"/delete from _vt.copy_state.*dst2",
+	})
+	expectNontxQueries(t, []string{
+		"/update _vt.vreplication set state='Running'",
+	})
+	expectData(t, "dst1", [][]string{
+		{"1", "aaa"},
+		{"2", "bbb"},
+	})
+	expectData(t, "dst2", [][]string{
+		{"1", "ccc"},
+	})
+	validateCopyRowCountStat(t, 3)
+}
+
+// TestPlayerCopyTablesConcurrentlyContinuation resumes an interrupted
+// concurrent copy: dst1 was partially copied, dst2 was fully copied but
+// its window is still open, and dst3 wasn't copied yet.
+func TestPlayerCopyTablesConcurrentlyContinuation(t *testing.T) {
+	defer deleteTablet(addTablet(100))
+
+	savedCopyConcurrency := *copyConcurrency
+	*copyConcurrency = 2
+	defer func() { *copyConcurrency = savedCopyConcurrency }()
+
+	execStatements(t, []string{
+		"create table src1(id int, val varbinary(128), primary key(id))",
+		"insert into src1 values(1, 'aaa'), (2, 'bbb')",
+		fmt.Sprintf("create table %s.dst1(id int, val varbinary(128), primary key(id))", vrepldb),
+		fmt.Sprintf("insert into %s.dst1 values(1, 'aaa')", vrepldb),
+		"create table src2(id int, val varbinary(128), primary key(id))",
+		"insert into src2 values(1, 'aaa')",
+		fmt.Sprintf("create table %s.dst2(id int, val varbinary(128), primary key(id))", vrepldb),
+		fmt.Sprintf("insert into %s.dst2 values(1, 'aaa')", vrepldb),
+		"create table src3(id int, val varbinary(128), primary key(id))",
+		"insert into src3 values(1, 'aaa')",
+		fmt.Sprintf("create table %s.dst3(id int, val varbinary(128), primary key(id))", vrepldb),
+	})
+	defer execStatements(t, []string{
+		"drop table src1",
+		fmt.Sprintf("drop table %s.dst1", vrepldb),
+		"drop table src2",
+		fmt.Sprintf("drop table %s.dst2", vrepldb),
+		"drop table src3",
+		fmt.Sprintf("drop table %s.dst3", vrepldb),
+	})
+	env.SchemaEngine.Reload(context.Background())
+
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "dst1",
+			Filter: "select * from src1",
+		}, {
+			Match:  "dst2",
+			Filter: "select * from src2",
+		}, {
+			Match:  "dst3",
+			Filter: "select * from src3",
+		}},
+	}
+	// The windows of dst1 and dst2 were both opened at pos.
+	pos := masterPosition(t)
+	execStatements(t, []string{
+		"update src1 set val='updated' where id=1",
+		"update src2 set val='updated' where id=1",
+	})
+
+	bls := &binlogdatapb.BinlogSource{
+		Keyspace: env.KeyspaceName,
+		Shard:    env.ShardName,
+		Filter:   filter,
+		OnDdl:    binlogdatapb.OnDDLAction_IGNORE,
+	}
+	query := binlogplayer.CreateVReplicationState("test", bls, "", binlogplayer.BlpStopped, playerEngine.dbName)
+	qr, err := playerEngine.Exec(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lastpk := sqltypes.ResultToProto3(sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id",
+			"int32",
+		),
+		"1",
+	))
+	lastpk.RowsAffected = 0
+	execStatements(t, []string{
+		fmt.Sprintf("insert into _vt.copy_state(vrepl_id, table_name, lastpk, pos) values(%d, '%s', %s, %s)", qr.InsertID, "dst1", encodeString(fmt.Sprintf("%v", lastpk)), encodeString(pos)),
+		fmt.Sprintf("insert into _vt.copy_state(vrepl_id, table_name, lastpk, pos) values(%d, '%s', null, %s)", qr.InsertID, "dst2", encodeString(pos)),
+		fmt.Sprintf("insert into _vt.copy_state(vrepl_id, table_name, lastpk, pos) values(%d, '%s', null, null)", qr.InsertID, "dst3"),
+	})
+	id := qr.InsertID
+	// The stream has no position yet: it starts from the earliest window.
+	_, err = playerEngine.Exec(fmt.Sprintf("update _vt.vreplication set state='Copying' where id=%d", id))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		query := fmt.Sprintf("delete from _vt.vreplication where id = %d", id)
+		if _, err := playerEngine.Exec(query); err != nil {
+			t.Fatal(err)
+		}
+		expectDeleteQueries(t)
+	}()
+
+	for q := range globalDBQueries {
+		if strings.HasPrefix(q, "update") {
+			break
+		}
+	}
+
+	expectNontxQueries(t, []string{
+		"/update _vt.vreplication set message='Picked source tablet.*",
+		// Catchup from the earliest window: the windows are already
+		// reached, so the events are applied normally.
+		"update dst1 set val='updated' where id=1 and (1) <= (1)",
+		"update dst2 set val='updated' where id=1",
+	})
+	// The windows of dst1 and dst2 are closed: dst1 remains to be
+	// copied while dst2 is done.
+	expectInterleavedQueries(t, []string{
+		"/update _vt.copy_state set pos=null where vrepl_id=.* and table_name='dst1'",
+	}, []string{
+		"/delete from _vt.copy_state.*dst2",
+	})
+	// dst1 resumes from its lastpk, concurrently with dst3.
+	expectInterleavedQueries(t, []string{
+		"insert into dst1(id,val) values (2,'bbb')",
+		`/update _vt.copy_state set lastpk='fields:<name:\\"id\\" type:INT32 > rows:<lengths:1 values:\\"2\\" > ', pos='.+' where vrepl_id=.* and table_name='dst1'`,
+		"/update _vt.copy_state set lastpk=null, pos='.+' where vrepl_id=.* and table_name='dst1'",
+	}, []string{
+		"insert into dst3(id,val) values (1,'aaa')",
+		`/update _vt.copy_state set lastpk='fields:<name:\\"id\\" type:INT32 > rows:<lengths:1 values:\\"1\\" > ', pos='.+' where vrepl_id=.* and table_name='dst3'`,
+		"/update _vt.copy_state set lastpk=null, pos='.+' where vrepl_id=.* and table_name='dst3'",
+	})
+	expectInterleavedQueries(t, []string{
+		"/delete from _vt.copy_state.*dst1",
+	}, []string{
+		"/delete from _vt.copy_state.*dst3",
+	})
+	expectNontxQueries(t, []string{
+		"/update _vt.vreplication set state='Running'",
+	})
+	expectData(t, "dst1", [][]string{
+		{"1", "updated"},
+		{"2", "bbb"},
+	})
+	expectData(t, "dst2", [][]string{
+		{"1", "updated"},
+	})
+	expectData(t, "dst3", [][]string{
+		{"1", "aaa"},
+	})
+}
+
+// TestPlayerCopyTablesConcurrentlyCloseWindows verifies that the events
+// that precede the window of a table are replayed idempotently, and that
+// the window is closed once the stream reaches it.
+func TestPlayerCopyTablesConcurrentlyCloseWindows(t *testing.T) {
+	defer deleteTablet(addTablet(100))
+
+	savedCopyConcurrency := *copyConcurrency
+	*copyConcurrency = 2
+	defer func() { *copyConcurrency = savedCopyConcurrency }()
+
+	execStatements(t, []string{
+		"create table src1(id int, val varbinary(128), primary key(id))",
+		"insert into src1 values(1, 'aaa'), (2, 'bbb')",
+		fmt.Sprintf("create table %s.dst1(id int, val varbinary(128), primary key(id))", vrepldb),
+	})
+	defer execStatements(t, []string{
+		"drop table src1",
+		fmt.Sprintf("drop table %s.dst1", vrepldb),
+	})
+	env.SchemaEngine.Reload(context.Background())
+
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "dst1",
+			Filter: "select * from src1",
+		}},
+	}
+	startPos := masterPosition(t)
+	execStatements(t, []string{
+		"insert into src1 values(3, 'ccc')",
+		"update src1 set val='updated' where id=2",
+		"update src1 set id=4 where id=1",
+	})
+	// dst1 was copied from a snapshot at window: its rows already
+	// contain the events above.
+	window := masterPosition(t)
+	execStatements(t, []string{
+		fmt.Sprintf("insert into %s.dst1 values(2, 'updated'), (3, 'ccc'), (4, 'aaa')", vrepldb),
+		"insert into src1 values(5, 'eee')",
+	})
+
+	bls := &binlogdatapb.BinlogSource{
+		Keyspace: env.KeyspaceName,
+		Shard:    env.ShardName,
+		Filter:   filter,
+		OnDdl:    binlogdatapb.OnDDLAction_IGNORE,
+	}
+	query := binlogplayer.CreateVReplicationState("test", bls, "", binlogplayer.BlpStopped, playerEngine.dbName)
+	qr, err := playerEngine.Exec(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	execStatements(t, []string{
+		fmt.Sprintf("insert into _vt.copy_state(vrepl_id, table_name, lastpk, pos) values(%d, '%s', null, %s)", qr.InsertID, "dst1", encodeString(window)),
+	})
+	id := qr.InsertID
+	_, err = playerEngine.Exec(fmt.Sprintf("update _vt.vreplication set state='Copying', pos=%s where id=%d", encodeString(startPos), id))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		query := fmt.Sprintf("delete from _vt.vreplication where id = %d", id)
+		if _, err := playerEngine.Exec(query); err != nil {
+			t.Fatal(err)
+		}
+		expectDeleteQueries(t)
+	}()
+
+	for q := range globalDBQueries {
+		if strings.HasPrefix(q, "update") {
+			break
+		}
+	}
+
+	expectNontxQueries(t, []string{
+		"/update _vt.vreplication set message='Picked source tablet.*",
+		// The stream is fast-forwarded to the window, replaying the
+		// events that are already in the copied rows.
+		"replace into dst1(id,val) values (3,'ccc')",
+		"replace into dst1(id,val) values (2,'updated')",
+		"delete from dst1 where id=1",
+		"replace into dst1(id,val) values (4,'aaa')",
+		// The window is reached and closed.
+		"/delete from _vt.copy_state.*dst1",
+		"/update _vt.vreplication set state='Running'",
+		// Past the window, the events are applied normally.
+		"insert into dst1(id,val) values (5,'eee')",
+	})
+	expectData(t, "dst1", [][]string{
+		{"2", "updated"},
+		{"3", "ccc"},
+		{"4", "aaa"},
+		{"5", "eee"},
+	})
+}
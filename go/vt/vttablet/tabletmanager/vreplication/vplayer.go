@@ -31,6 +31,7 @@ import (
 
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 )
@@ -42,6 +43,10 @@ type vplayer struct {
 	stopPos   mysql.Position
 	saveStop  bool
 	copyState map[string]*sqltypes.Result
+	// windows contains the positions of the snapshots of the tables
+	// that were copied concurrently. The events of those tables that
+	// precede their snapshot are replayed idempotently.
+	windows map[string]mysql.Position
 
 	replicatorPlan *ReplicatorPlan
 	tablePlans     map[string]*TablePlan
@@ -208,8 +213,14 @@ func (vp *vplayer) applyRowEvent(ctx context.Context, rowEvent *binlogdatapb.Row
 	if tplan == nil {
 		return fmt.Errorf("unexpected event on table %s", rowEvent.TableName)
 	}
+	applyChange := tplan.applyChange
+	// The position of a transaction is only known at its commit: the
+	// current one is in the snapshot if the previous one isn't its end.
+	if window, ok := vp.windows[tplan.TargetName]; ok && !vp.pos.AtLeast(window) {
+		applyChange = tplan.applyReplayChange
+	}
 	for _, change := range rowEvent.RowChanges {
-		_, err := tplan.applyChange(change, func(sql string) (*sqltypes.Result, error) {
+		_, err := applyChange(change, func(sql string) (*sqltypes.Result, error) {
 			stats := NewVrLogStats("ROWCHANGE")
 			start := time.Now()
 			qr, err := vp.vr.dbClient.ExecuteWithRetry(ctx, sql)
@@ -552,6 +563,44 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 			if posReached {
 				return io.EOF
 			}
+		case binlogdatapb.OnDDLAction_SAFE:
+			statement, err := buildSafeDDL(event.Statement, vp.vr.source.Filter, vp.vr.pkInfoMap, func(tableName string) (map[string]*sqlparser.ColumnDefinition, error) {
+				return vp.vr.targetColumns(ctx, tableName)
+			})
+			var notAdditive *notAdditiveError
+			if errors.As(err, &notAdditive) {
+				// Like for STOP, the position is saved after the DDL: the
+				// target tables must be altered before restarting.
+				if err := vp.vr.dbClient.Begin(); err != nil {
+					return err
+				}
+				if _, err := vp.updatePos(event.Timestamp); err != nil {
+					return err
+				}
+				if err := vp.vr.setState(binlogplayer.BlpStopped, fmt.Sprintf("Stopped at DDL %s: %v", event.Statement, err)); err != nil {
+					return err
+				}
+				if err := vp.vr.dbClient.Commit(); err != nil {
+					return err
+				}
+				return io.EOF
+			}
+			if err != nil {
+				return err
+			}
+			if statement != "" {
+				if _, err := vp.vr.dbClient.ExecuteWithRetry(ctx, statement); err != nil {
+					return err
+				}
+				stats.Send(statement)
+			}
+			posReached, err := vp.updatePos(event.Timestamp)
+			if err != nil {
+				return err
+			}
+			if posReached {
+				return io.EOF
+			}
 		}
 	case binlogdatapb.VEventType_JOURNAL:
 		if vp.vr.dbClient.InTransaction {
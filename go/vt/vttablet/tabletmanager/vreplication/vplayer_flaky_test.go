@@ -1547,6 +1547,71 @@ func TestPlayerDDL(t *testing.T) {
 	cancel()
 }
 
+func TestPlayerDDLSafe(t *testing.T) {
+	defer deleteTablet(addTablet(100))
+	execStatements(t, []string{
+		"create table t1(id int, val varchar(128), primary key(id))",
+		fmt.Sprintf("create table %s.t1(id int, val varchar(128), primary key(id))", vrepldb),
+	})
+	defer execStatements(t, []string{
+		"drop table t1",
+		fmt.Sprintf("drop table %s.t1", vrepldb),
+	})
+	env.SchemaEngine.Reload(context.Background())
+
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match: "/.*",
+		}},
+	}
+	bls := &binlogdatapb.BinlogSource{
+		Keyspace: env.KeyspaceName,
+		Shard:    env.ShardName,
+		Filter:   filter,
+		OnDdl:    binlogdatapb.OnDDLAction_SAFE,
+	}
+	cancel, _ := startVReplication(t, bls, "")
+	execStatements(t, []string{"insert into t1 values(1, 'a')"})
+	expectDBClientQueries(t, []string{
+		"begin",
+		"insert into t1(id,val) values (1,'a')",
+		"/update _vt.vreplication set pos=",
+		"commit",
+	})
+
+	// Additive DDLs are applied to the target.
+	execStatements(t, []string{"alter table t1 modify column val varchar(256)"})
+	expectDBClientQueries(t, []string{
+		"alter table t1 modify column val varchar(256)",
+		"/update _vt.vreplication set pos=",
+		// The apply of the DDL on target generates an "other" event.
+		"/update _vt.vreplication set pos=",
+	})
+	cancel()
+
+	// A DDL that cannot be built because the target is not in the expected
+	// state is retried instead of stopping the workflow.
+	execStatements(t, []string{"alter table t1 add column val2 varchar(128)"})
+	cancel, _ = startVReplication(t, bls, "")
+	execStatements(t, []string{"alter table t1 modify column val2 varchar(256)"})
+	expectDBClientQueries(t, []string{
+		"/update _vt.vreplication set message='Error: .*column val2 not found in table t1",
+	})
+	cancel()
+
+	// DDLs that are not additive stop the workflow at the DDL.
+	cancel, _ = startVReplication(t, bls, "")
+	execStatements(t, []string{"alter table t1 drop column val"})
+	pos := masterPosition(t)
+	expectDBClientQueries(t, []string{
+		"begin",
+		fmt.Sprintf("/update _vt.vreplication set pos='%s'", pos),
+		"/update _vt.vreplication set state='Stopped'",
+		"commit",
+	})
+	cancel()
+}
+
 func TestPlayerStopPos(t *testing.T) {
 	defer deleteTablet(addTablet(100))
 
@@ -33,6 +33,7 @@ import (
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/vstreamer"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 )
@@ -81,7 +82,8 @@ type vreplicator struct {
 //   "select id, count(*), sum(price) from t group by id".
 //   Only "in_keyrange" expressions are supported in the where clause.
 //   The select expressions can be any valid non-aggregate expressions,
-//   or count(*), or sum(col).
+//   or count(*), sum(col), min(col), max(col), avg(col) or
+//   count(distinct col).
 //   If the target column name does not match the source expression, an
 //   alias like "a+b as targetcol" must be used.
 //   More advanced constructs can be used. Please see the table plan builder
@@ -136,6 +138,9 @@ func (vr *vreplicator) replicate(ctx context.Context) error {
 		return err
 	}
 	vr.pkInfoMap = pkInfo
+	if err := vr.createAggregateTables(ctx); err != nil {
+		return err
+	}
 	if err := vr.getSettingFKCheck(); err != nil {
 		return err
 	}
@@ -199,6 +204,20 @@ type PrimaryKeyInfo struct {
 	Collation  string
 	DataType   string
 	ColumnType string
+	// Keyless is set on all the columns of a table that has neither a
+	// primary key nor a unique key with NOT NULL columns. All its
+	// columns are used as its key, see isKeyless.
+	Keyless bool
+}
+
+// isKeyless returns true if the key of a table is made of all its
+// columns. Keyless tables are replicated through a slow path: rows are
+// matched with the null-safe equality on all the columns, and are
+// updated or deleted one at a time, since they may be duplicated. They
+// are copied in a single pass, which restarts from the beginning if it's
+// interrupted.
+func isKeyless(pkInfos []*PrimaryKeyInfo) bool {
+	return len(pkInfos) != 0 && pkInfos[0].Keyless
 }
 
 func (vr *vreplicator) buildPkInfoMap(ctx context.Context) (map[string][]*PrimaryKeyInfo, error) {
@@ -220,10 +239,19 @@ func (vr *vreplicator) buildPkInfoMap(ctx context.Context) (map[string][]*Primar
 		}
 
 		var pks []string
+		keyless := false
 		if len(td.PrimaryKeyColumns) != 0 {
 			pks = td.PrimaryKeyColumns
 		} else {
-			pks = td.Columns
+			ukqr, err := vr.mysqld.FetchSuperQuery(ctx, vstreamer.UniqueKeyQuery(vr.dbClient.DBName(), td.Name))
+			if err != nil {
+				return nil, err
+			}
+			pks = vstreamer.BestUniqueKey(ukqr)
+			if len(pks) == 0 {
+				pks = td.Columns
+				keyless = true
+			}
 		}
 		var pkInfos []*PrimaryKeyInfo
 		for _, pk := range pks {
@@ -261,6 +289,7 @@ func (vr *vreplicator) buildPkInfoMap(ctx context.Context) (map[string][]*Primar
 				Collation:  collation,
 				DataType:   dataType,
 				ColumnType: columnType,
+				Keyless:    keyless,
 			})
 		}
 		pkInfoMap[td.Name] = pkInfos
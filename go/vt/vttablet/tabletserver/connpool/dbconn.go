@@ -57,6 +57,10 @@ type DBConn struct {
 	// err will be set if a query is killed through a Kill.
 	errmu sync.Mutex
 	err   error
+
+	// fairShareSlot is true if the conn holds a slot of the fair share
+	// scheduler of its pool.
+	fairShareSlot bool
 }
 
 // NewDBConn creates a new DBConn. It triggers a CheckMySQL if creation fails.
@@ -302,8 +306,10 @@ func (dbc *DBConn) Recycle() {
 	case dbc.pool == nil:
 		dbc.Close()
 	case dbc.conn.IsClosed():
+		dbc.pool.releaseFairShare(dbc)
 		dbc.pool.Put(nil)
 	default:
+		dbc.pool.releaseFairShare(dbc)
 		dbc.pool.Put(dbc)
 	}
 }
@@ -313,6 +319,7 @@ func (dbc *DBConn) Taint() {
 	if dbc.pool == nil {
 		return
 	}
+	dbc.pool.releaseFairShare(dbc)
 	dbc.pool.Put(nil)
 	dbc.pool = nil
 }
@@ -30,6 +30,7 @@ import (
 	"vitess.io/vitess/go/vt/dbconnpool"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/fairshare"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
@@ -57,6 +58,11 @@ type Pool struct {
 	waiterCount        sync2.AtomicInt64
 	dbaPool            *dbconnpool.ConnectionPool
 	appDebugParams     dbconfigs.Connector
+
+	// scheduler queues the callers waiting for a connection per caller,
+	// if fair share scheduling is enabled.
+	scheduler   *fairshare.Scheduler
+	fairShareBy string
 }
 
 // NewPool creates a new Pool. The name is used
@@ -76,6 +82,16 @@ func NewPool(env tabletenv.Env, name string, cfg tabletenv.ConnPoolConfig) *Pool
 	if name == "" {
 		return cp
 	}
+	if config := env.Config(); config != nil && config.FairShare.Enable {
+		fs := config.FairShare
+		weights, err := fairshare.ParseWeights(fs.Weights)
+		if err != nil {
+			log.Errorf("Ignoring fair share weights of pool %s: %v", name, err)
+		}
+		cp.scheduler = fairshare.NewScheduler(cfg.Size, weights)
+		cp.fairShareBy = fs.By
+		env.Exporter().NewGaugeFunc(name+"FairShareWaiting", "Number of requests queued by fair share scheduling", cp.FairShareWaiting)
+	}
 	env.Exporter().NewGaugeFunc(name+"Capacity", "Tablet server conn pool capacity", cp.Capacity)
 	env.Exporter().NewGaugeFunc(name+"Available", "Tablet server conn pool available", cp.Available)
 	env.Exporter().NewGaugeFunc(name+"Active", "Tablet server conn pool active", cp.Active)
@@ -161,6 +177,11 @@ func (cp *Pool) Get(ctx context.Context) (*DBConn, error) {
 	if p == nil {
 		return nil, ErrConnPoolClosed
 	}
+	// Low priority requests don't wait for a connection of an exhausted pool.
+	if tabletenv.IsLowPriority(ctx) && (p.Available() == 0 || cp.FairShareWaiting() > 0) {
+		cp.env.Stats().LowPriorityShed.Add(cp.name, 1)
+		return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "pool %s exhausted, low priority request shed", cp.name)
+	}
 	span.Annotate("capacity", p.Capacity())
 	span.Annotate("in_use", p.InUse())
 	span.Annotate("available", p.Available())
@@ -171,11 +192,44 @@ func (cp *Pool) Get(ctx context.Context) (*DBConn, error) {
 		ctx, cancel = context.WithTimeout(ctx, cp.timeout)
 		defer cancel()
 	}
+	if cp.scheduler != nil {
+		if err := cp.acquireFairShare(ctx); err != nil {
+			return nil, err
+		}
+	}
 	r, err := p.Get(ctx)
 	if err != nil {
+		if cp.scheduler != nil {
+			cp.scheduler.Release()
+		}
 		return nil, err
 	}
-	return r.(*DBConn), nil
+	conn := r.(*DBConn)
+	conn.fairShareSlot = cp.scheduler != nil
+	return conn, nil
+}
+
+// acquireFairShare waits for the turn of the caller to get a connection.
+// It returns the same errors as the underlying resource pool when ctx is done.
+func (cp *Pool) acquireFairShare(ctx context.Context) error {
+	if ctx.Err() != nil {
+		return pools.ErrCtxTimeout
+	}
+	start := time.Now()
+	if err := cp.scheduler.Acquire(ctx, fairshare.CallerKey(ctx, cp.fairShareBy)); err != nil {
+		return pools.ErrTimeout
+	}
+	cp.env.Stats().WaitTimings.Record(cp.name+"FairShareWaitTime", start)
+	return nil
+}
+
+// releaseFairShare gives back the fair share slot of a connection which
+// is returned to the pool.
+func (cp *Pool) releaseFairShare(conn *DBConn) {
+	if conn.fairShareSlot {
+		conn.fairShareSlot = false
+		cp.scheduler.Release()
+	}
 }
 
 // Put puts a connection into the pool.
@@ -202,6 +256,9 @@ func (cp *Pool) SetCapacity(capacity int) (err error) {
 		}
 	}
 	cp.capacity = capacity
+	if cp.scheduler != nil {
+		cp.scheduler.SetCapacity(capacity)
+	}
 	return nil
 }
 
@@ -297,6 +354,14 @@ func (cp *Pool) IdleTimeout() time.Duration {
 	return p.IdleTimeout()
 }
 
+// FairShareWaiting returns the number of requests queued by fair share scheduling.
+func (cp *Pool) FairShareWaiting() int64 {
+	if cp.scheduler == nil {
+		return 0
+	}
+	return int64(cp.scheduler.Waiting())
+}
+
 // IdleClosed returns the number of closed connections for the pool.
 func (cp *Pool) IdleClosed() int64 {
 	p := cp.pool()
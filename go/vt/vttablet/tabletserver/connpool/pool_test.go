@@ -110,6 +110,59 @@ func TestConnPoolMaxWaiters(t *testing.T) {
 	wg.Wait()
 }
 
+func TestConnPoolFairShare(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	config := tabletenv.NewDefaultConfig()
+	config.FairShare.Enable = true
+	config.FairShare.Weights = "billing:2"
+	connPool := NewPool(tabletenv.NewEnv(config, "PoolTest"), "TestPool", tabletenv.ConnPoolConfig{
+		Size: 1,
+	})
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+
+	callerCtx := func(principal string) context.Context {
+		return callerid.NewContext(context.Background(), callerid.NewEffectiveCallerID(principal, "", ""), nil)
+	}
+	dbConn, err := connPool.Get(callerCtx("reports"))
+	require.NoError(t, err)
+
+	// a caller waiting for its turn times out like a pool waiter
+	ctx, cancel := context.WithTimeout(callerCtx("reports"), 10*time.Millisecond)
+	defer cancel()
+	_, err = connPool.Get(ctx)
+	assert.EqualError(t, err, "resource pool timed out")
+	assert.EqualValues(t, 0, connPool.FairShareWaiting())
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		c1, err := connPool.Get(callerCtx("billing"))
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		c1.Recycle()
+	}()
+	for connPool.FairShareWaiting() != 1 {
+		runtime.Gosched()
+	}
+
+	// The recycled conn is handed to the waiting caller.
+	dbConn.Recycle()
+	wg.Wait()
+	dbConn, err = connPool.Get(callerCtx("reports"))
+	require.NoError(t, err)
+	// Tainting a conn also gives back its turn.
+	dbConn.Taint()
+	dbConn.Recycle()
+	dbConn, err = connPool.Get(callerCtx("reports"))
+	require.NoError(t, err)
+	dbConn.Recycle()
+}
+
 func TestConnPoolGetEmptyDebugConfig(t *testing.T) {
 	db := fakesqldb.New(t)
 	debugConn := db.ConnParamsWithUname("")
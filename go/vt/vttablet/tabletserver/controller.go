@@ -51,6 +51,9 @@ type Controller interface {
 	// InitDBConfig sets up the db config vars.
 	InitDBConfig(target querypb.Target, dbConfigs *dbconfigs.DBConfigs, mysqlDaemon mysqlctl.MysqlDaemon) error
 
+	// CurrentTarget returns the current target of the tablet.
+	CurrentTarget() querypb.Target
+
 	// SetServingType transitions the query service to the required serving type.
 	// Returns true if the state of QueryService or the tablet type changed.
 	SetServingType(tabletType topodatapb.TabletType, terTimestamp time.Time, serving bool, reason string) error
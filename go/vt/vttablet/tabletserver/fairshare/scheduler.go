@@ -0,0 +1,264 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fairshare provides the weighted fair queueing of the requests
+// waiting for a connection of the vttablet pools.
+// See the Scheduler struct for details.
+package fairshare
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"vitess.io/vitess/go/vt/callerid"
+)
+
+// Keys by which the callers are told apart.
+const (
+	ByUsername     = "username"
+	ByPrincipal    = "principal"
+	ByComponent    = "component"
+	BySubcomponent = "subcomponent"
+)
+
+const unknown = "unknown"
+
+// purgeInterval is the number of grants after which the idle flows are
+// dropped.
+const purgeInterval = 1000
+
+// Scheduler hands out a fixed number of slots, e.g. the capacity of a
+// connection pool, to callers identified by a key.
+// When all slots are in use, the callers are queued per key and a freed slot
+// is granted using start-time fair queueing: every request gets a virtual
+// start time, which is the later of the current virtual time and the virtual
+// finish time of the previous request of the same key. Its virtual finish
+// time is its start time plus the inverse of the weight of its key. The
+// waiting request with the earliest start time is granted first.
+// As a result, when the slots are contended, each key gets a share of the
+// grants proportional to its weight, regardless of how many requests it
+// queues. A key which floods the scheduler only delays its own requests.
+type Scheduler struct {
+	weights       map[string]float64
+	defaultWeight float64
+
+	mu          sync.Mutex
+	capacity    int
+	inUse       int
+	waiting     int
+	virtualTime float64
+	flows       map[string]*flow
+	grants      int
+}
+
+// flow holds the state of a key.
+type flow struct {
+	weight     float64
+	lastFinish float64
+	waiters    []*waiter
+}
+
+type waiter struct {
+	start float64
+	ready chan struct{}
+	// granted is protected by Scheduler.mu.
+	granted bool
+}
+
+// NewScheduler creates a Scheduler with the given number of slots.
+// weights maps keys to their weight. Keys without a weight have a weight of 1.
+func NewScheduler(capacity int, weights map[string]float64) *Scheduler {
+	return &Scheduler{
+		weights:       weights,
+		defaultWeight: 1,
+		capacity:      capacity,
+		flows:         make(map[string]*flow),
+	}
+}
+
+// Acquire waits for a slot for key. It returns ctx.Err() if ctx is done
+// before a slot was granted. If it returns nil, Release must be called once
+// the slot is no longer used.
+func (s *Scheduler) Acquire(ctx context.Context, key string) error {
+	s.mu.Lock()
+	f := s.flow(key)
+	start := s.virtualTime
+	if f.lastFinish > start {
+		start = f.lastFinish
+	}
+	f.lastFinish = start + 1/f.weight
+	if s.inUse < s.capacity && s.waiting == 0 {
+		s.grantLocked(start)
+		s.mu.Unlock()
+		return nil
+	}
+	w := &waiter{start: start, ready: make(chan struct{})}
+	f.waiters = append(f.waiters, w)
+	s.waiting++
+	s.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		return nil
+	case <-ctx.Done():
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if w.granted {
+		// The slot was granted while ctx was done, give it back.
+		s.releaseLocked()
+		return ctx.Err()
+	}
+	for i, other := range f.waiters {
+		if other == w {
+			f.waiters = append(f.waiters[:i], f.waiters[i+1:]...)
+			s.waiting--
+			break
+		}
+	}
+	return ctx.Err()
+}
+
+// Release frees a slot granted by Acquire.
+func (s *Scheduler) Release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.releaseLocked()
+}
+
+// SetCapacity changes the number of slots. If it is reduced, the slots in use
+// are not revoked, the new requests wait until enough of them are released.
+func (s *Scheduler) SetCapacity(capacity int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.capacity = capacity
+	s.dispatchLocked()
+}
+
+// Waiting returns the number of requests waiting for a slot.
+func (s *Scheduler) Waiting() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.waiting
+}
+
+func (s *Scheduler) flow(key string) *flow {
+	f, ok := s.flows[key]
+	if !ok {
+		weight, ok := s.weights[key]
+		if !ok {
+			weight = s.defaultWeight
+		}
+		f = &flow{weight: weight}
+		s.flows[key] = f
+	}
+	return f
+}
+
+func (s *Scheduler) releaseLocked() {
+	s.inUse--
+	s.dispatchLocked()
+}
+
+// dispatchLocked grants the free slots to the waiters with the earliest
+// start times.
+func (s *Scheduler) dispatchLocked() {
+	for s.inUse < s.capacity && s.waiting > 0 {
+		var next *flow
+		for _, f := range s.flows {
+			if len(f.waiters) == 0 {
+				continue
+			}
+			if next == nil || f.waiters[0].start < next.waiters[0].start {
+				next = f
+			}
+		}
+		w := next.waiters[0]
+		next.waiters[0] = nil
+		next.waiters = next.waiters[1:]
+		s.waiting--
+		w.granted = true
+		s.grantLocked(w.start)
+		close(w.ready)
+	}
+}
+
+func (s *Scheduler) grantLocked(start float64) {
+	s.inUse++
+	if start > s.virtualTime {
+		s.virtualTime = start
+	}
+	s.grants++
+	if s.grants%purgeInterval == 0 {
+		s.purgeLocked()
+	}
+}
+
+// purgeLocked drops the flows which have no waiters and are not ahead of the
+// virtual time: a new request of their key would start at the virtual time
+// anyway.
+func (s *Scheduler) purgeLocked() {
+	for key, f := range s.flows {
+		if len(f.waiters) == 0 && f.lastFinish <= s.virtualTime {
+			delete(s.flows, key)
+		}
+	}
+}
+
+// ParseWeights parses a comma separated list of key:weight pairs.
+func ParseWeights(s string) (map[string]float64, error) {
+	weights := make(map[string]float64)
+	for _, token := range strings.Split(s, ",") {
+		token = strings.TrimSpace(token)
+		if token == "" {
+			continue
+		}
+		i := strings.LastIndex(token, ":")
+		if i <= 0 {
+			return nil, fmt.Errorf("expected key:weight, got %q", token)
+		}
+		weight, err := strconv.ParseFloat(token[i+1:], 64)
+		if err != nil || weight <= 0 {
+			return nil, fmt.Errorf("weight of %s must be a positive number, got %q", token[:i], token[i+1:])
+		}
+		weights[token[:i]] = weight
+	}
+	return weights, nil
+}
+
+// CallerKey returns the key of the caller of ctx, according to by, which is
+// one of the By* constants.
+func CallerKey(ctx context.Context, by string) string {
+	var key string
+	switch by {
+	case ByUsername:
+		key = callerid.GetUsername(callerid.ImmediateCallerIDFromContext(ctx))
+	case ByPrincipal:
+		key = callerid.GetPrincipal(callerid.EffectiveCallerIDFromContext(ctx))
+	case ByComponent:
+		key = callerid.GetComponent(callerid.EffectiveCallerIDFromContext(ctx))
+	case BySubcomponent:
+		key = callerid.GetSubcomponent(callerid.EffectiveCallerIDFromContext(ctx))
+	}
+	if key == "" {
+		return unknown
+	}
+	return key
+}
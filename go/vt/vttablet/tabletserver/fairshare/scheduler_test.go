@@ -0,0 +1,151 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fairshare
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/callerid"
+)
+
+// waitForWaiting waits until n requests are queued by s.
+func waitForWaiting(t *testing.T, s *Scheduler, n int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for s.Waiting() != n {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %d waiters, got %d", n, s.Waiting())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestSchedulerWeightedOrder(t *testing.T) {
+	ctx := context.Background()
+	s := NewScheduler(1, map[string]float64{"heavy": 3})
+
+	// hold the only slot, then queue requests of both keys
+	require.NoError(t, s.Acquire(ctx, "init"))
+	granted := make(chan string, 20)
+	queue := func(key string) {
+		go func() {
+			if err := s.Acquire(ctx, key); err == nil {
+				granted <- key
+			}
+		}()
+	}
+	for i := 0; i < 8; i++ {
+		queue("noisy")
+		waitForWaiting(t, s, i+1)
+	}
+	for i := 0; i < 8; i++ {
+		queue("heavy")
+		waitForWaiting(t, s, i+9)
+	}
+
+	var got []string
+	s.Release()
+	for i := 0; i < 8; i++ {
+		got = append(got, <-granted)
+		s.Release()
+	}
+	// noisy queued first, but heavy has three times its weight
+	heavy := 0
+	for _, key := range got {
+		if key == "heavy" {
+			heavy++
+		}
+	}
+	assert.Equal(t, 6, heavy, "grants: %v", got)
+}
+
+func TestSchedulerContextDone(t *testing.T) {
+	s := NewScheduler(1, nil)
+	require.NoError(t, s.Acquire(context.Background(), "a"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := s.Acquire(ctx, "b")
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, 0, s.Waiting())
+
+	// the slot of the canceled request is not leaked
+	s.Release()
+	require.NoError(t, s.Acquire(context.Background(), "b"))
+	s.Release()
+	assert.Equal(t, 0, s.inUse)
+}
+
+func TestSchedulerSetCapacity(t *testing.T) {
+	ctx := context.Background()
+	s := NewScheduler(1, nil)
+	require.NoError(t, s.Acquire(ctx, "a"))
+
+	done := make(chan error)
+	go func() {
+		done <- s.Acquire(ctx, "b")
+	}()
+	waitForWaiting(t, s, 1)
+
+	// raising the capacity grants the waiting request
+	s.SetCapacity(2)
+	require.NoError(t, <-done)
+	assert.Equal(t, 0, s.Waiting())
+
+	// lowering it only delays the new requests
+	s.SetCapacity(1)
+	go func() {
+		done <- s.Acquire(ctx, "c")
+	}()
+	waitForWaiting(t, s, 1)
+	s.Release()
+	assert.Equal(t, 1, s.Waiting())
+	s.Release()
+	require.NoError(t, <-done)
+}
+
+func TestParseWeights(t *testing.T) {
+	weights, err := ParseWeights("")
+	require.NoError(t, err)
+	assert.Empty(t, weights)
+
+	weights, err = ParseWeights("billing:3, reports:0.5,user:host:2")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"billing": 3, "reports": 0.5, "user:host": 2}, weights)
+
+	_, err = ParseWeights("billing")
+	assert.EqualError(t, err, `expected key:weight, got "billing"`)
+	_, err = ParseWeights("billing:0")
+	assert.EqualError(t, err, `weight of billing must be a positive number, got "0"`)
+}
+
+func TestCallerKey(t *testing.T) {
+	ctx := callerid.NewContext(context.Background(),
+		callerid.NewEffectiveCallerID("principal", "component", "subcomponent"),
+		callerid.NewImmediateCallerID("username"))
+
+	assert.Equal(t, "principal", CallerKey(ctx, ByPrincipal))
+	assert.Equal(t, "component", CallerKey(ctx, ByComponent))
+	assert.Equal(t, "subcomponent", CallerKey(ctx, BySubcomponent))
+	assert.Equal(t, "username", CallerKey(ctx, ByUsername))
+	assert.Equal(t, "unknown", CallerKey(context.Background(), ByPrincipal))
+}
@@ -178,6 +178,7 @@ func (hs *healthStreamer) ChangeState(tabletType topodatapb.TabletType, terTimes
 
 	hs.state.RealtimeStats.SecondsBehindMasterFilteredReplication, hs.state.RealtimeStats.BinlogPlayersCount = blpFunc()
 	hs.state.RealtimeStats.Qps = hs.stats.QPSRates.TotalRate()
+	hs.state.RealtimeStats.ErrorRate = hs.stats.ErrorRates.TotalRate()
 
 	shr := proto.Clone(hs.state).(*querypb.StreamHealthResponse)
 
@@ -166,6 +166,24 @@ func (mc *cache) Pop() *MessageRow {
 	}
 }
 
+// Requeue puts back popped messages that could not be sent yet.
+// Unlike Add, it ignores the size limit because the messages
+// were already accounted for.
+func (mc *cache) Requeue(mrs []*MessageRow) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	for _, mr := range mrs {
+		id := mr.Row[0].ToString()
+		if !mc.inFlight[id] {
+			// Discarded while it was out of the queue.
+			continue
+		}
+		delete(mc.inFlight, id)
+		heap.Push(&mc.sendQueue, mr)
+		mc.inQueue[id] = mr
+	}
+}
+
 // Discard forgets the specified id.
 func (mc *cache) Discard(ids []string) {
 	mc.mu.Lock()
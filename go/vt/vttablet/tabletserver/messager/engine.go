@@ -39,6 +39,7 @@ type TabletService interface {
 	tabletenv.Env
 	PostponeMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (count int64, err error)
 	PurgeMessages(ctx context.Context, target *querypb.Target, name string, timeCutoff int64) (count int64, err error)
+	DeadLetterMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (count int64, err error)
 }
 
 // VStreamer defines  the functions of VStreamer
@@ -62,13 +63,15 @@ type Engine struct {
 
 // NewEngine creates a new Engine.
 func NewEngine(tsv TabletService, se *schema.Engine, vs VStreamer) *Engine {
-	return &Engine{
+	me := &Engine{
 		tsv:          tsv,
 		se:           se,
 		vs:           vs,
 		postponeSema: sync2.NewSemaphore(tsv.Config().MessagePostponeParallelism, 0),
 		managers:     make(map[string]*messageManager),
 	}
+	tsv.Exporter().HandleFunc("/messagez", me.handleMessagez)
+	return me
 }
 
 // Open starts the Engine service.
@@ -134,6 +137,18 @@ func (me *Engine) GenerateAckQuery(name string, ids []string) (string, map[strin
 	return query, bv, nil
 }
 
+// MessagesAcked notifies the manager of a table that messages were acked,
+// which releases their partition keys.
+func (me *Engine) MessagesAcked(name string, ids []string) {
+	me.mu.Lock()
+	mm := me.managers[name]
+	me.mu.Unlock()
+	if mm == nil {
+		return
+	}
+	mm.MessagesAcked(ids)
+}
+
 // GeneratePostponeQuery returns the query and bind vars for postponing a message.
 func (me *Engine) GeneratePostponeQuery(name string, ids []string) (string, map[string]*querypb.BindVariable, error) {
 	me.mu.Lock()
@@ -146,6 +161,18 @@ func (me *Engine) GeneratePostponeQuery(name string, ids []string) (string, map[
 	return query, bv, nil
 }
 
+// GenerateDeadLetterQueries returns the queries for dead-lettering messages.
+// They must be executed in the same transaction.
+func (me *Engine) GenerateDeadLetterQueries(name string, ids []string) ([]*querypb.BoundQuery, error) {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+	mm := me.managers[name]
+	if mm == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s not found in schema", name)
+	}
+	return mm.GenerateDeadLetterQueries(ids), nil
+}
+
 // GeneratePurgeQuery returns the query and bind vars for purging messages.
 func (me *Engine) GeneratePurgeQuery(name string, timeCutoff int64) (string, map[string]*querypb.BindVariable, error) {
 	me.mu.Lock()
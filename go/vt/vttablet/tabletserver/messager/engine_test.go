@@ -17,13 +17,19 @@ limitations under the License.
 package messager
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 
 	"context"
 
+	"github.com/stretchr/testify/assert"
+
 	"vitess.io/vitess/go/mysql/fakesqldb"
 	"vitess.io/vitess/go/sqltypes"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
@@ -169,6 +175,30 @@ func TestEngineGenerate(t *testing.T) {
 	}
 }
 
+func TestMessagez(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	engine := newTestEngine(db)
+	defer engine.Close()
+	dlqTable := newMMTable()
+	dlqTable.MessageInfo.MaxAttempts = 5
+	dlqTable.MessageInfo.DeadLetterTable = "t1_dlq"
+	engine.schemaChanged(map[string]*schema.Table{"t1": dlqTable}, []string{"t1"}, nil, nil)
+	engine.vs.(*fakeVStreamer).setPollerResponse([]*binlogdatapb.VStreamResultsResponse{{
+		Fields: testFields,
+		Rows: []*querypb.Row{
+			sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewVarBinary("10"), sqltypes.NewVarBinary("failed message")}),
+		},
+	}})
+
+	w := httptest.NewRecorder()
+	engine.handleMessagez(w, httptest.NewRequest(http.MethodGet, "/messagez", nil))
+	body := w.Body.String()
+	assert.Contains(t, body, "<td>5</td>")
+	assert.Contains(t, body, "<td>t1_dlq</td>")
+	assert.Contains(t, body, "10 failed message")
+}
+
 func newTestEngine(db *fakesqldb.DB) *Engine {
 	config := tabletenv.NewDefaultConfig()
 	tsv := &fakeTabletServer{
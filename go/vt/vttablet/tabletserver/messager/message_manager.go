@@ -18,7 +18,9 @@ package messager
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
+	"hash/fnv"
 	"io"
 	"math/rand"
 	"sync"
@@ -93,6 +95,8 @@ func (rcv *messageReceiver) Send(qr *sqltypes.Result) error {
 type receiverWithStatus struct {
 	receiver *messageReceiver
 	busy     bool
+	// id identifies the receiver for partitioned delivery.
+	id int64
 }
 
 // messageManager manages messages for a message table.
@@ -158,6 +162,27 @@ type receiverWithStatus struct {
 // If, for some reason, a client is closed, the load balancer resets
 // by starting with the first non-busy client.
 //
+// Partitioned delivery
+// If the table has a partition column, its receivers form a consumer
+// group: every partition key is owned by one receiver, which is chosen
+// by rendezvous hashing of the key over the current receivers. So, when a
+// receiver joins or leaves, only the keys it gains or loses move.
+// Only one message per key is outstanding at any time: the next message
+// of a key is sent only after the current one is acked or dead-lettered.
+// If it's not acked in time, it's resent, possibly to a new owner. Within
+// a key, messages are sent in order of id.
+// Keys are released by acks through MessageAck, by the vstream, and by
+// the poller, which verifies that the outstanding messages are still
+// pending.
+//
+// Dead letters
+// If the table has a max number of attempts, a message that was sent
+// that many times without being acked is not sent any more. Instead,
+// it's moved to the dead letter table if there's one, or marked failed
+// by setting its time_next to null. Dead-lettered messages are kept for
+// inspection: neither the failed messages nor the rows of the dead letter
+// table are purged. The application must delete them once they're handled.
+//
 // The Purge thread
 // This thread is mostly independent. It wakes up periodically
 // to delete old rows that were successfully acked.
@@ -171,20 +196,37 @@ type messageManager struct {
 	purgeAfter   time.Duration
 	minBackoff   time.Duration
 	maxBackoff   time.Duration
+	maxAttempts  int
+	deadLetter   sqlparser.TableIdent
 	batchSize    int
 	pollerTicks  *timer.Timer
 	purgeTicks   *timer.Timer
 	postponeSema *sync2.Semaphore
 
+	// partitionColumn is the index of the partition column
+	// in the message rows, or -1 if there's none.
+	partitionColumn int
+
 	mu     sync.Mutex
 	isOpen bool
-	// cond waits on curReceiver == -1 || cache.IsEmpty():
-	// No current receivers available or cache is empty.
+	// cond waits on curReceiver == -1 || cache.IsEmpty() || stalled:
+	// No current receivers available, cache is empty, or no cached
+	// message can be sent.
 	cond            sync.Cond
 	cache           *cache
 	receivers       []*receiverWithStatus
 	curReceiver     int
 	messagesPending bool
+	lastReceiverID  int64
+
+	// inFlightKeys maps the partition keys to the id of their
+	// outstanding message, and inFlightIDs is the reverse map.
+	inFlightKeys map[string]string
+	inFlightIDs  map[string]string
+	// stalled is set by the partitioned send loop if none of the
+	// cached messages can be sent. It's reset by any change that can
+	// unblock a message.
+	stalled bool
 
 	// streamMu keeps the cache and database consistent with each other.
 	// Specifically:
@@ -214,6 +256,9 @@ type messageManager struct {
 	ackQuery                  *sqlparser.ParsedQuery
 	postponeQuery             *sqlparser.ParsedQuery
 	purgeQuery                *sqlparser.ParsedQuery
+	deadLetterQueries         []*sqlparser.ParsedQuery
+	readDeadLetters           *sqlparser.ParsedQuery
+	readInFlight              *sqlparser.ParsedQuery
 }
 
 // newMessageManager creates a new message manager.
@@ -231,14 +276,27 @@ func newMessageManager(tsv TabletService, vs VStreamer, table *schema.Table, pos
 		purgeAfter:      table.MessageInfo.PurgeAfterDuration,
 		minBackoff:      table.MessageInfo.MinBackoff,
 		maxBackoff:      table.MessageInfo.MaxBackoff,
+		maxAttempts:     table.MessageInfo.MaxAttempts,
+		deadLetter:      sqlparser.NewTableIdent(table.MessageInfo.DeadLetterTable),
 		batchSize:       table.MessageInfo.BatchSize,
 		cache:           newCache(table.MessageInfo.CacheSize),
 		pollerTicks:     timer.NewTimer(table.MessageInfo.PollInterval),
 		purgeTicks:      timer.NewTimer(table.MessageInfo.PollInterval),
 		postponeSema:    postponeSema,
 		messagesPending: true,
+		partitionColumn: -1,
+		inFlightKeys:    make(map[string]string),
+		inFlightIDs:     make(map[string]string),
 	}
 	mm.cond.L = &mm.mu
+	if col := table.MessageInfo.PartitionColumn; col != "" {
+		for i, field := range table.MessageInfo.Fields {
+			if field.Name == col {
+				mm.partitionColumn = i
+				break
+			}
+		}
+	}
 
 	columnList := buildSelectColumnList(table)
 	vsQuery := fmt.Sprintf("select priority, time_next, epoch, time_acked, %s from %v", columnList, mm.name)
@@ -248,9 +306,20 @@ func newMessageManager(tsv TabletService, vs VStreamer, table *schema.Table, pos
 			Filter: vsQuery,
 		}},
 	}
-	mm.readByPriorityAndTimeNext = sqlparser.BuildParsedQuery(
-		"select priority, time_next, epoch, time_acked, %s from %v where time_next < %a order by priority, time_next desc limit %a",
-		columnList, mm.name, ":time_next", ":max")
+	if mm.partitionColumn == -1 {
+		mm.readByPriorityAndTimeNext = sqlparser.BuildParsedQuery(
+			"select priority, time_next, epoch, time_acked, %s from %v where time_next < %a order by priority, time_next desc limit %a",
+			columnList, mm.name, ":time_next", ":max")
+	} else {
+		// The messages of a key must be read in order of id,
+		// or the cache may miss the next one to send.
+		mm.readByPriorityAndTimeNext = sqlparser.BuildParsedQuery(
+			"select priority, time_next, epoch, time_acked, %s from %v where time_next < %a order by id limit %a",
+			columnList, mm.name, ":time_next", ":max")
+		mm.readInFlight = sqlparser.BuildParsedQuery(
+			"select id from %v where id in %a and time_acked is null and time_next is not null",
+			mm.name, "::ids")
+	}
 	mm.ackQuery = sqlparser.BuildParsedQuery(
 		"update %v set time_acked = %a, time_next = null where id in %a and time_acked is null",
 		mm.name, ":time_acked", "::ids")
@@ -259,6 +328,23 @@ func newMessageManager(tsv TabletService, vs VStreamer, table *schema.Table, pos
 
 	mm.postponeQuery = buildPostponeQuery(mm.name, mm.minBackoff, mm.maxBackoff)
 
+	if mm.deadLetter.IsEmpty() {
+		mm.deadLetterQueries = []*sqlparser.ParsedQuery{sqlparser.BuildParsedQuery(
+			"update %v set time_next = null where id in %a and time_acked is null", mm.name, "::ids")}
+		mm.readDeadLetters = sqlparser.BuildParsedQuery(
+			"select %s from %v where time_next is null and time_acked is null limit %a", columnList, mm.name, ":max")
+	} else {
+		mm.deadLetterQueries = []*sqlparser.ParsedQuery{
+			sqlparser.BuildParsedQuery(
+				"insert into %v(%s) select %s from %v where id in %a and time_acked is null",
+				mm.deadLetter, columnList, columnList, mm.name, "::ids"),
+			sqlparser.BuildParsedQuery(
+				"delete from %v where id in %a and time_acked is null", mm.name, "::ids"),
+		}
+		mm.readDeadLetters = sqlparser.BuildParsedQuery(
+			"select %s from %v limit %a", columnList, mm.deadLetter, ":max")
+	}
+
 	return mm
 }
 
@@ -386,8 +472,10 @@ func (mm *messageManager) Subscribe(ctx context.Context, send func(*sqltypes.Res
 		return done
 	}
 
+	mm.lastReceiverID++
 	withStatus := &receiverWithStatus{
 		receiver: receiver,
+		id:       mm.lastReceiverID,
 	}
 	if len(mm.receivers) == 0 {
 		mm.startVStream()
@@ -397,6 +485,8 @@ func (mm *messageManager) Subscribe(ctx context.Context, send func(*sqltypes.Res
 	if mm.curReceiver == -1 {
 		mm.rescanReceivers(-1)
 	}
+	// The partitions are rebalanced: the new receiver may own some keys.
+	mm.unstall()
 
 	// Track the context and unsubscribe if it gets cancelled.
 	go func() {
@@ -422,6 +512,9 @@ func (mm *messageManager) unsubscribe(receiver *messageReceiver) {
 	}
 	// curReceiver is obsolete. Recompute.
 	mm.rescanReceivers(-1)
+	// The partitions are rebalanced: the keys of the receiver move
+	// to the others.
+	mm.unstall()
 	// If there are no receivers. Shut down the cache.
 	if len(mm.receivers) == 0 {
 		mm.stopVStream()
@@ -451,6 +544,15 @@ func (mm *messageManager) rescanReceivers(start int) {
 	mm.curReceiver = -1
 }
 
+// unstall wakes up the send loop if it's waiting for a
+// partitioned message to become sendable.
+func (mm *messageManager) unstall() {
+	if mm.stalled {
+		mm.stalled = false
+		mm.cond.Broadcast()
+	}
+}
+
 // Add adds the message to the cache. It returns true
 // if successful. If the message is already present,
 // it still returns true.
@@ -470,6 +572,7 @@ func (mm *messageManager) Add(mr *MessageRow) bool {
 		mm.messagesPending = true
 		return false
 	}
+	mm.unstall()
 	return true
 }
 
@@ -509,24 +612,38 @@ func (mm *messageManager) runSend() {
 			}
 
 			// If there are no receivers or cache is empty, we wait.
-			if mm.curReceiver == -1 || mm.cache.IsEmpty() {
+			if mm.curReceiver == -1 || mm.cache.IsEmpty() || mm.stalled {
 				mm.cond.Wait()
 				continue
 			}
 
+			if mm.partitionColumn != -1 {
+				mm.sendPartitioned()
+				continue
+			}
+
 			// Fetch rows from cache.
 			lateCount := int64(0)
+			var deadIDs []string
 			for i := 0; i < mm.batchSize; i++ {
 				mr := mm.cache.Pop()
 				if mr == nil {
 					break
 				}
+				if mm.maxAttempts > 0 && mr.Epoch >= int64(mm.maxAttempts) {
+					deadIDs = append(deadIDs, mr.Row[0].ToString())
+					continue
+				}
 				if mr.Epoch >= 1 {
 					lateCount++
 				}
 				rows = append(rows, mr.Row)
 			}
 			MessageStats.Add([]string{mm.name.String(), "Delayed"}, lateCount)
+			if deadIDs != nil {
+				mm.wg.Add(1)
+				go mm.deadLetterMessages(deadIDs)
+			}
 
 			// If we have rows to send, break out of this loop.
 			if rows != nil {
@@ -546,6 +663,131 @@ func (mm *messageManager) runSend() {
 	}
 }
 
+// sendPartitioned sends a batch to every available receiver that owns
+// sendable messages. It must be called with mu held. Since all cached
+// messages are considered, the send loop is stalled afterwards.
+func (mm *messageManager) sendPartitioned() {
+	// next is the message to send for every key, and keys
+	// preserves the order in which the keys were popped.
+	next := make(map[string]*MessageRow)
+	var keys []string
+	var held []*MessageRow
+	var deadIDs []string
+	for {
+		mr := mm.cache.Pop()
+		if mr == nil {
+			break
+		}
+		id := mr.Row[0].ToString()
+		if mm.maxAttempts > 0 && mr.Epoch >= int64(mm.maxAttempts) {
+			deadIDs = append(deadIDs, id)
+			continue
+		}
+		key := mr.Row[mm.partitionColumn].ToString()
+		if inFlight, ok := mm.inFlightKeys[key]; ok && inFlight != id {
+			held = append(held, mr)
+			continue
+		}
+		cur, ok := next[key]
+		switch {
+		case !ok:
+			next[key] = mr
+			keys = append(keys, key)
+		case idLess(mr, cur):
+			held = append(held, cur)
+			next[key] = mr
+		default:
+			held = append(held, mr)
+		}
+	}
+
+	batches := make(map[*receiverWithStatus][][]sqltypes.Value)
+	lateCount := int64(0)
+	for _, key := range keys {
+		mr := next[key]
+		receiver := mm.partitionOwner(key)
+		if receiver.busy || len(batches[receiver]) >= mm.batchSize {
+			held = append(held, mr)
+			continue
+		}
+		batches[receiver] = append(batches[receiver], mr.Row)
+		id := mr.Row[0].ToString()
+		mm.inFlightKeys[key] = id
+		mm.inFlightIDs[id] = key
+		if mr.Epoch >= 1 {
+			lateCount++
+		}
+	}
+	mm.cache.Requeue(held)
+	MessageStats.Add([]string{mm.name.String(), "Delayed"}, lateCount)
+	if deadIDs != nil {
+		mm.wg.Add(1)
+		go mm.deadLetterMessages(deadIDs)
+	}
+
+	for receiver, rows := range batches {
+		MessageStats.Add([]string{mm.name.String(), "Sent"}, int64(len(rows)))
+		receiver.busy = true
+		mm.wg.Add(1)
+		go mm.send(receiver, &sqltypes.Result{Rows: rows})
+	}
+	if len(batches) != 0 {
+		mm.rescanReceivers(-1)
+	}
+	mm.stalled = true
+}
+
+// partitionOwner returns the receiver that owns the partition key.
+// There must be at least one receiver.
+func (mm *messageManager) partitionOwner(key string) *receiverWithStatus {
+	var owner *receiverWithStatus
+	var max uint64
+	var buf [8]byte
+	for _, rcv := range mm.receivers {
+		h := fnv.New64a()
+		h.Write([]byte(key))
+		binary.BigEndian.PutUint64(buf[:], uint64(rcv.id))
+		h.Write(buf[:])
+		if sum := h.Sum64(); owner == nil || sum > max {
+			owner, max = rcv, sum
+		}
+	}
+	return owner
+}
+
+// idLess returns true if the id of mr1 is lower than the id of mr2.
+func idLess(mr1, mr2 *MessageRow) bool {
+	cmp, err := evalengine.NullsafeCompare(mr1.Row[0], mr2.Row[0])
+	if err != nil {
+		return mr1.Row[0].ToString() < mr2.Row[0].ToString()
+	}
+	return cmp < 0
+}
+
+// MessagesAcked releases the partition keys of the messages
+// that were acked or dead-lettered.
+func (mm *messageManager) MessagesAcked(ids []string) {
+	if mm.partitionColumn == -1 {
+		return
+	}
+	mm.mu.Lock()
+	defer mm.mu.Unlock()
+	mm.releaseKeys(ids)
+}
+
+// releaseKeys must be called with mu held.
+func (mm *messageManager) releaseKeys(ids []string) {
+	for _, id := range ids {
+		key, ok := mm.inFlightIDs[id]
+		if !ok {
+			continue
+		}
+		delete(mm.inFlightIDs, id)
+		delete(mm.inFlightKeys, key)
+		mm.unstall()
+	}
+}
+
 func (mm *messageManager) send(receiver *receiverWithStatus, qr *sqltypes.Result) {
 	defer func() {
 		mm.tsv.LogError()
@@ -577,6 +819,7 @@ func (mm *messageManager) send(receiver *receiverWithStatus, qr *sqltypes.Result
 		if mm.curReceiver == -1 {
 			mm.rescanReceivers(-1)
 		}
+		mm.unstall()
 	}()
 
 	if err := receiver.receiver.Send(qr); err != nil {
@@ -588,6 +831,39 @@ func (mm *messageManager) send(receiver *receiverWithStatus, qr *sqltypes.Result
 	mm.postpone(mm.tsv, mm.name.String(), mm.ackWaitTime, ids)
 }
 
+// deadLetterMessages dead-letters the messages which reached the max number
+// of attempts.
+func (mm *messageManager) deadLetterMessages(ids []string) {
+	defer func() {
+		mm.tsv.LogError()
+		mm.wg.Done()
+	}()
+
+	defer func() {
+		// Same as send: hold streamMu to prevent the poller from
+		// requeueing a snapshot of the rows.
+		mm.streamMu.Lock()
+		defer mm.streamMu.Unlock()
+		mm.cache.Discard(ids)
+	}()
+
+	if !mm.postponeSema.Acquire() {
+		// Unreachable.
+		return
+	}
+	defer mm.postponeSema.Release()
+	ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), mm.ackWaitTime)
+	defer cancel()
+	count, err := mm.tsv.DeadLetterMessages(ctx, nil, mm.name.String(), ids)
+	if err != nil {
+		MessageStats.Add([]string{mm.name.String(), "DeadLetterFailed"}, 1)
+		log.Errorf("Unable to dead-letter messages of %v: %v", mm.name, err)
+		return
+	}
+	MessageStats.Add([]string{mm.name.String(), "DeadLettered"}, count)
+	mm.MessagesAcked(ids)
+}
+
 func (mm *messageManager) postpone(tsv TabletService, name string, ackWaitTime time.Duration, ids []string) {
 	// Use the semaphore to limit parallelism.
 	if !mm.postponeSema.Acquire() {
@@ -725,7 +1001,12 @@ func (mm *messageManager) processRowEvent(fields []*querypb.Field, rowEvent *bin
 		if err != nil {
 			return err
 		}
-		if mr.TimeAcked != 0 || mr.TimeNext > now {
+		// A null time_next means that the message was acked or dead-lettered.
+		if mr.TimeAcked != 0 || row[1].IsNull() {
+			mm.MessagesAcked([]string{mr.Row[0].ToString()})
+			continue
+		}
+		if mr.TimeNext > now {
 			continue
 		}
 		mm.Add(mr)
@@ -757,6 +1038,9 @@ func (mm *messageManager) runPoller() {
 	if err != nil {
 		return
 	}
+	if mm.partitionColumn != -1 {
+		mm.verifyInFlight(ctx)
+	}
 
 	// Obtain mu lock to verify and preserve that len(receivers) != 0.
 	mm.mu.Lock()
@@ -773,6 +1057,7 @@ func (mm *messageManager) runPoller() {
 	if len(qr.Rows) != 0 {
 		// We've most likely added items.
 		// Wake up the sender.
+		mm.stalled = false
 		defer mm.cond.Broadcast()
 	}
 	for _, row := range qr.Rows {
@@ -789,6 +1074,47 @@ func (mm *messageManager) runPoller() {
 	}
 }
 
+// verifyInFlight releases the partition keys whose outstanding message
+// is not pending any more. This covers acks that bypassed MessageAck
+// and were missed by the vstream.
+func (mm *messageManager) verifyInFlight(ctx context.Context) {
+	mm.mu.Lock()
+	ids := make([]string, 0, len(mm.inFlightIDs))
+	for id := range mm.inFlightIDs {
+		ids = append(ids, id)
+	}
+	mm.mu.Unlock()
+	if len(ids) == 0 {
+		return
+	}
+
+	query, err := mm.readInFlight.GenerateQuery(map[string]*querypb.BindVariable{
+		"ids": idsBindVariable(ids),
+	}, nil)
+	if err != nil {
+		mm.tsv.Stats().InternalErrors.Add("Messages", 1)
+		log.Errorf("Error reading in-flight rows from message table: %v", err)
+		return
+	}
+	pending := make(map[string]bool)
+	err = mm.vs.StreamResults(ctx, query, func(response *binlogdatapb.VStreamResultsResponse) error {
+		for _, row := range response.Rows {
+			pending[sqltypes.MakeRowTrusted(response.Fields, row)[0].ToString()] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return
+	}
+	var released []string
+	for _, id := range ids {
+		if !pending[id] {
+			released = append(released, id)
+		}
+	}
+	mm.MessagesAcked(released)
+}
+
 func (mm *messageManager) runPurge() {
 	go purge(mm.tsv, mm.name.String(), mm.purgeAfter, mm.purgeTicks.Interval())
 }
@@ -818,41 +1144,20 @@ func purge(tsv TabletService, name string, purgeAfter, purgeInterval time.Durati
 
 // GenerateAckQuery returns the query and bind vars for acking a message.
 func (mm *messageManager) GenerateAckQuery(ids []string) (string, map[string]*querypb.BindVariable) {
-	idbvs := &querypb.BindVariable{
-		Type:   querypb.Type_TUPLE,
-		Values: make([]*querypb.Value, 0, len(ids)),
-	}
-	for _, id := range ids {
-		idbvs.Values = append(idbvs.Values, &querypb.Value{
-			Type:  querypb.Type_VARBINARY,
-			Value: []byte(id),
-		})
-	}
 	return mm.ackQuery.Query, map[string]*querypb.BindVariable{
 		"time_acked": sqltypes.Int64BindVariable(time.Now().UnixNano()),
-		"ids":        idbvs,
+		"ids":        idsBindVariable(ids),
 	}
 }
 
 // GeneratePostponeQuery returns the query and bind vars for postponing a message.
 func (mm *messageManager) GeneratePostponeQuery(ids []string) (string, map[string]*querypb.BindVariable) {
-	idbvs := &querypb.BindVariable{
-		Type:   querypb.Type_TUPLE,
-		Values: make([]*querypb.Value, 0, len(ids)),
-	}
-	for _, id := range ids {
-		idbvs.Values = append(idbvs.Values, &querypb.Value{
-			Type:  querypb.Type_VARBINARY,
-			Value: []byte(id),
-		})
-	}
-
 	bvs := map[string]*querypb.BindVariable{
 		"time_now":    sqltypes.Int64BindVariable(time.Now().UnixNano()),
 		"wait_time":   sqltypes.Int64BindVariable(int64(mm.ackWaitTime)),
 		"min_backoff": sqltypes.Int64BindVariable(int64(mm.minBackoff)),
 		"jitter":      sqltypes.Float64BindVariable(.666666 + rand.Float64()*.666666),
-		"ids":         idbvs,
+		"ids":         idsBindVariable(ids),
 	}
 
 	if mm.maxBackoff > 0 {
@@ -862,6 +1167,22 @@ func (mm *messageManager) GeneratePostponeQuery(ids []string) (string, map[strin
 	return mm.postponeQuery.Query, bvs
 }
 
+// GenerateDeadLetterQueries returns the queries for dead-lettering messages.
+// They must be executed in the same transaction.
+func (mm *messageManager) GenerateDeadLetterQueries(ids []string) []*querypb.BoundQuery {
+	bvs := map[string]*querypb.BindVariable{
+		"ids": idsBindVariable(ids),
+	}
+	queries := make([]*querypb.BoundQuery, 0, len(mm.deadLetterQueries))
+	for _, query := range mm.deadLetterQueries {
+		queries = append(queries, &querypb.BoundQuery{
+			Sql:           query.Query,
+			BindVariables: bvs,
+		})
+	}
+	return queries
+}
+
 // GeneratePurgeQuery returns the query and bind vars for purging messages.
 func (mm *messageManager) GeneratePurgeQuery(timeCutoff int64) (string, map[string]*querypb.BindVariable) {
 	return mm.purgeQuery.Query, map[string]*querypb.BindVariable{
@@ -938,3 +1259,41 @@ func (mm *messageManager) readPending(ctx context.Context, bindVars map[string]*
 	}
 	return qr, err
 }
+
+// ReadDeadLetters returns up to max dead-lettered messages.
+func (mm *messageManager) ReadDeadLetters(ctx context.Context, max int) (*sqltypes.Result, error) {
+	query, err := mm.readDeadLetters.GenerateQuery(map[string]*querypb.BindVariable{
+		"max": sqltypes.Int64BindVariable(int64(max)),
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	qr := &sqltypes.Result{}
+	err = mm.vs.StreamResults(ctx, query, func(response *binlogdatapb.VStreamResultsResponse) error {
+		if response.Fields != nil {
+			qr.Fields = response.Fields
+		}
+		for _, row := range response.Rows {
+			qr.Rows = append(qr.Rows, sqltypes.MakeRowTrusted(qr.Fields, row))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return qr, nil
+}
+
+func idsBindVariable(ids []string) *querypb.BindVariable {
+	idbvs := &querypb.BindVariable{
+		Type:   querypb.Type_TUPLE,
+		Values: make([]*querypb.Value, 0, len(ids)),
+	}
+	for _, id := range ids {
+		idbvs.Values = append(idbvs.Values, &querypb.Value{
+			Type:  querypb.Type_VARBINARY,
+			Value: []byte(id),
+		})
+	}
+	return idbvs
+}
@@ -299,6 +299,180 @@ func TestMessageManagerSend(t *testing.T) {
 	<-r1.ch
 }
 
+func TestMessageManagerDeadLetter(t *testing.T) {
+	tsv := newFakeTabletServer()
+	table := newMMTable()
+	table.MessageInfo.MaxAttempts = 2
+	mm := newMessageManager(tsv, newFakeVStreamer(), table, sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+
+	r1 := newTestReceiver(1)
+	mm.Subscribe(context.Background(), r1.rcv)
+	<-r1.ch
+
+	ch := make(chan string, 20)
+	tsv.SetChannel(ch)
+	// The message was already sent twice: it's dead-lettered instead of sent.
+	mm.Add(&MessageRow{Epoch: 2, Row: []sqltypes.Value{sqltypes.NewVarBinary("1")}})
+	assert.Equal(t, "deadletter", <-ch)
+	assert.EqualValues(t, 0, tsv.postponeCount.Get())
+
+	// The message was sent once: it's sent again.
+	mm.Add(&MessageRow{Epoch: 1, Row: []sqltypes.Value{sqltypes.NewVarBinary("2")}})
+	want := &sqltypes.Result{
+		Rows: [][]sqltypes.Value{{sqltypes.NewVarBinary("2")}},
+	}
+	assert.Equal(t, want, <-r1.ch)
+	assert.Equal(t, "postpone", <-ch)
+	assert.EqualValues(t, 1, tsv.deadLetterCount.Get())
+}
+
+func TestMessageManagerPartitioned(t *testing.T) {
+	table := newMMTable()
+	table.MessageInfo.PartitionColumn = "message"
+	table.MessageInfo.BatchSize = 10
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), table, sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+
+	r1 := newTestReceiver(20)
+	mm.Subscribe(context.Background(), r1.rcv)
+	<-r1.ch
+
+	addMessage := func(id, key string) {
+		mm.Add(&MessageRow{Row: []sqltypes.Value{sqltypes.NewVarBinary(id), sqltypes.NewVarBinary(key)}})
+	}
+	// receive returns the ids received by tr, and the key of every id.
+	receive := func(tr *testReceiver, n int, keys map[string]string) []string {
+		var ids []string
+		for len(ids) < n {
+			select {
+			case qr := <-tr.ch:
+				for _, row := range qr.Rows {
+					ids = append(ids, row[0].ToString())
+					keys[row[0].ToString()] = row[1].ToString()
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatalf("timed out waiting for %d messages, got %v", n, ids)
+			}
+		}
+		return ids
+	}
+	assertIdle := func(tr *testReceiver) {
+		select {
+		case qr := <-tr.ch:
+			t.Errorf("unexpected messages: %v", qr.Rows)
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+
+	// Only the first message of a key is sent, in order of id.
+	addMessage("2", "a")
+	addMessage("1", "a")
+	addMessage("3", "b")
+	keys := make(map[string]string)
+	assert.ElementsMatch(t, []string{"1", "3"}, receive(r1, 2, keys))
+	assertIdle(r1)
+
+	// The next message of the key is sent after the ack.
+	mm.MessagesAcked([]string{"1"})
+	assert.Equal(t, []string{"2"}, receive(r1, 1, keys))
+	mm.MessagesAcked([]string{"2", "3"})
+
+	// The keys are shared by the receivers.
+	r2 := newTestReceiver(20)
+	ctx, cancel := context.WithCancel(context.Background())
+	mm.Subscribe(ctx, r2.rcv)
+	<-r2.ch
+	var ids []string
+	for i := 10; i < 20; i++ {
+		id := fmt.Sprintf("%d", i)
+		ids = append(ids, id)
+		addMessage(id, fmt.Sprintf("k%d", i%10))
+	}
+	r1IDs := receive(r1, 1, keys)
+	r2IDs := receive(r2, 1, keys)
+	for len(r1IDs)+len(r2IDs) < 10 {
+		select {
+		case qr := <-r1.ch:
+			for _, row := range qr.Rows {
+				r1IDs = append(r1IDs, row[0].ToString())
+				keys[row[0].ToString()] = row[1].ToString()
+			}
+		case qr := <-r2.ch:
+			for _, row := range qr.Rows {
+				r2IDs = append(r2IDs, row[0].ToString())
+				keys[row[0].ToString()] = row[1].ToString()
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for messages, got %v and %v", r1IDs, r2IDs)
+		}
+	}
+	assert.NotEmpty(t, r1IDs)
+	assert.NotEmpty(t, r2IDs)
+	mm.MessagesAcked(ids)
+
+	// The same keys go to the same receivers.
+	for i := 20; i < 30; i++ {
+		addMessage(fmt.Sprintf("%d", i), fmt.Sprintf("k%d", i%10))
+	}
+	ownerKeys := func(ids []string) []string {
+		var ks []string
+		for _, id := range ids {
+			ks = append(ks, keys[id])
+		}
+		return ks
+	}
+	r1Keys, r2Keys := ownerKeys(r1IDs), ownerKeys(r2IDs)
+	assert.ElementsMatch(t, r1Keys, ownerKeys(receive(r1, len(r1IDs), keys)))
+	assert.ElementsMatch(t, r2Keys, ownerKeys(receive(r2, len(r2IDs), keys)))
+	ids = nil
+	for i := 20; i < 30; i++ {
+		ids = append(ids, fmt.Sprintf("%d", i))
+	}
+	mm.MessagesAcked(ids)
+
+	// The keys of a receiver that leaves are moved to the others.
+	cancel()
+	for mm.receiverCount() != 1 {
+		runtime.Gosched()
+		time.Sleep(10 * time.Millisecond)
+	}
+	for i := 30; i < 40; i++ {
+		addMessage(fmt.Sprintf("%d", i), fmt.Sprintf("k%d", i%10))
+	}
+	assert.ElementsMatch(t, append(r1Keys, r2Keys...), ownerKeys(receive(r1, 10, keys)))
+}
+
+func TestMessageManagerPartitionedPoller(t *testing.T) {
+	table := newMMTable()
+	table.MessageInfo.PartitionColumn = "message"
+	fvs := newFakeVStreamer()
+	mm := newMessageManager(newFakeTabletServer(), fvs, table, sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+
+	r1 := newTestReceiver(20)
+	mm.Subscribe(context.Background(), r1.rcv)
+	<-r1.ch
+
+	mm.Add(&MessageRow{Row: []sqltypes.Value{sqltypes.NewVarBinary("1"), sqltypes.NewVarBinary("a")}})
+	<-r1.ch
+	mm.Add(&MessageRow{Row: []sqltypes.Value{sqltypes.NewVarBinary("2"), sqltypes.NewVarBinary("a")}})
+
+	// The poller finds that message 1 is not pending any more:
+	// its key is released.
+	fvs.setPollerResponse([]*binlogdatapb.VStreamResultsResponse{{
+		Fields: testDBFields,
+	}})
+	mm.pollerTicks.Trigger()
+	want := &sqltypes.Result{
+		Rows: [][]sqltypes.Value{{sqltypes.NewVarBinary("2"), sqltypes.NewVarBinary("a")}},
+	}
+	assert.Equal(t, want, <-r1.ch)
+}
+
 func TestMessageManagerPostponeThrottle(t *testing.T) {
 	tsv := newFakeTabletServer()
 	mm := newMessageManager(tsv, newFakeVStreamer(), newMMTable(), sync2.NewSemaphore(1, 0))
@@ -790,6 +964,37 @@ func TestMMGenerate(t *testing.T) {
 	}
 }
 
+func TestMMGenerateDeadLetter(t *testing.T) {
+	wantids := sqltypes.TestBindVariable([]interface{}{"1", "2"})
+
+	// Without a dead letter table, the messages are marked failed.
+	table := newMMTable()
+	table.MessageInfo.MaxAttempts = 3
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), table, sync2.NewSemaphore(1, 0))
+	want := []*querypb.BoundQuery{{
+		Sql:           "update foo set time_next = null where id in ::ids and time_acked is null",
+		BindVariables: map[string]*querypb.BindVariable{"ids": wantids},
+	}}
+	utils.MustMatch(t, want, mm.GenerateDeadLetterQueries([]string{"1", "2"}), "did not match")
+	assert.Equal(t, "select id, message from foo where time_next is null and time_acked is null limit :max", mm.readDeadLetters.Query)
+	// Failed messages are not acked, so they're kept until the
+	// application deletes them.
+	query, _ := mm.GeneratePurgeQuery(3)
+	assert.Equal(t, "delete from foo where time_acked < :time_acked limit 500", query)
+
+	table.MessageInfo.DeadLetterTable = "foo_dlq"
+	mm = newMessageManager(newFakeTabletServer(), newFakeVStreamer(), table, sync2.NewSemaphore(1, 0))
+	want = []*querypb.BoundQuery{{
+		Sql:           "insert into foo_dlq(id, message) select id, message from foo where id in ::ids and time_acked is null",
+		BindVariables: map[string]*querypb.BindVariable{"ids": wantids},
+	}, {
+		Sql:           "delete from foo where id in ::ids and time_acked is null",
+		BindVariables: map[string]*querypb.BindVariable{"ids": wantids},
+	}}
+	utils.MustMatch(t, want, mm.GenerateDeadLetterQueries([]string{"1", "2"}), "did not match")
+	assert.Equal(t, "select id, message from foo_dlq limit :max", mm.readDeadLetters.Query)
+}
+
 func TestMMGenerateWithBackoff(t *testing.T) {
 	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), newMMTableWithBackoff(), sync2.NewSemaphore(1, 0))
 	mm.Open()
@@ -827,8 +1032,9 @@ func TestMMGenerateWithBackoff(t *testing.T) {
 
 type fakeTabletServer struct {
 	tabletenv.Env
-	postponeCount sync2.AtomicInt64
-	purgeCount    sync2.AtomicInt64
+	postponeCount   sync2.AtomicInt64
+	purgeCount      sync2.AtomicInt64
+	deadLetterCount sync2.AtomicInt64
 
 	mu sync.Mutex
 	ch chan string
@@ -871,6 +1077,17 @@ func (fts *fakeTabletServer) PurgeMessages(ctx context.Context, target *querypb.
 	return 0, nil
 }
 
+func (fts *fakeTabletServer) DeadLetterMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (count int64, err error) {
+	fts.deadLetterCount.Add(1)
+	fts.mu.Lock()
+	ch := fts.ch
+	fts.mu.Unlock()
+	if ch != nil {
+		ch <- "deadletter"
+	}
+	return int64(len(ids)), nil
+}
+
 type fakeVStreamer struct {
 	streamInvocations sync2.AtomicInt64
 	mu                sync.Mutex
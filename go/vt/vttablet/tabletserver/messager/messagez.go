@@ -0,0 +1,119 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messager
+
+import (
+	"context"
+	"html/template"
+	"net/http"
+	"sort"
+	"time"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logz"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+const (
+	// messagezMaxDeadLetters is the max number of dead-lettered messages
+	// displayed per table.
+	messagezMaxDeadLetters = 100
+	messagezTimeout        = 10 * time.Second
+)
+
+var (
+	messagezHeader = []byte(`
+		<tr>
+			<th>Table</th>
+			<th>Max Attempts</th>
+			<th>Dead Letter Table</th>
+			<th>Dead-lettered Messages</th>
+		</tr>
+	`)
+	messagezTmpl = template.Must(template.New("example").Parse(`
+		<tr class="low">
+			<td>{{.Name}}</td>
+			<td>{{if .MaxAttempts}}{{.MaxAttempts}}{{else}}unlimited{{end}}</td>
+			<td>{{if .DeadLetterTable}}{{.DeadLetterTable}}{{else}}none (marked failed, not purged){{end}}</td>
+			<td>{{if .Err}}{{.Err}}{{else}}{{range .Fields}}{{.}} {{end}}<br>{{range .Rows}}{{range .}}{{.}} {{end}}<br>{{end}}{{end}}</td>
+		</tr>
+	`))
+)
+
+type messagezRow struct {
+	Name            string
+	MaxAttempts     int
+	DeadLetterTable string
+	Fields          []string
+	Rows            [][]string
+	Err             error
+}
+
+// handleMessagez displays the dead-letter settings of the message tables,
+// and their dead-lettered messages.
+func (me *Engine) handleMessagez(w http.ResponseWriter, r *http.Request) {
+	if err := acl.CheckAccessHTTP(r, acl.DEBUGGING); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	me.mu.Lock()
+	if !me.isOpen {
+		me.mu.Unlock()
+		w.Write([]byte("messager engine is closed, probably because this is not a master"))
+		return
+	}
+	managers := make([]*messageManager, 0, len(me.managers))
+	for _, mm := range me.managers {
+		managers = append(managers, mm)
+	}
+	me.mu.Unlock()
+	sort.Slice(managers, func(i, j int) bool {
+		return managers[i].name.String() < managers[j].name.String()
+	})
+
+	ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), messagezTimeout)
+	defer cancel()
+	logz.StartHTMLTable(w)
+	defer logz.EndHTMLTable(w)
+	w.Write(messagezHeader)
+	for _, mm := range managers {
+		row := messagezRow{
+			Name:            mm.name.String(),
+			MaxAttempts:     mm.maxAttempts,
+			DeadLetterTable: mm.deadLetter.String(),
+		}
+		qr, err := mm.ReadDeadLetters(ctx, messagezMaxDeadLetters)
+		if err != nil {
+			row.Err = err
+		} else {
+			for _, field := range qr.Fields {
+				row.Fields = append(row.Fields, field.Name)
+			}
+			for _, values := range qr.Rows {
+				strs := make([]string, 0, len(values))
+				for _, value := range values {
+					strs = append(strs, value.ToString())
+				}
+				row.Rows = append(row.Rows, strs)
+			}
+		}
+		if err := messagezTmpl.Execute(w, row); err != nil {
+			log.Errorf("messagez: couldn't execute template: %v", err)
+		}
+	}
+}
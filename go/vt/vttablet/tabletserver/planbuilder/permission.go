@@ -18,6 +18,7 @@ package planbuilder
 
 import (
 	"fmt"
+	"sort"
 
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/tableacl"
@@ -117,3 +118,217 @@ func buildTableNamePermissions(node sqlparser.TableName, role tableacl.Role, per
 	})
 	return permissions
 }
+
+// BuildReadColumns returns the lower case names of the columns read by
+// the query, for each table that requires the READER or WRITER role. The
+// columns of the WHERE, SET and ON DUPLICATE KEY UPDATE expressions of DMLs
+// are reads too, since the number of affected rows reveals their values,
+// but the updated and inserted columns are not. A column whose
+// qualifier can't be resolved to a single table is attributed to all the
+// tables, so the result may contain more columns than the query actually
+// reads from a table, but never less. The "*" column stands for all
+// the columns of a table.
+func BuildReadColumns(stmt sqlparser.Statement, permissions []Permission) map[string][]string {
+	var tables []string
+	for _, perm := range permissions {
+		if perm.Role == tableacl.READER || perm.Role == tableacl.WRITER {
+			tables = append(tables, perm.TableName)
+		}
+	}
+	if len(tables) == 0 {
+		return nil
+	}
+
+	// qualifiers maps the table aliases and names to the tables.
+	qualifiers := make(map[string]map[string]bool)
+	// columns maps the qualifiers of the columns to their names.
+	columns := make(map[string]map[string]bool)
+	addColumn := func(qualifier, column string) {
+		if columns[qualifier] == nil {
+			columns[qualifier] = make(map[string]bool)
+		}
+		columns[qualifier][column] = true
+	}
+	var visit func(node sqlparser.SQLNode) (bool, error)
+	visit = func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.AliasedTableExpr:
+			if tableName, ok := node.Expr.(sqlparser.TableName); ok {
+				qualifier := tableName.Name.String()
+				if !node.As.IsEmpty() {
+					qualifier = node.As.String()
+				}
+				if qualifiers[qualifier] == nil {
+					qualifiers[qualifier] = make(map[string]bool)
+				}
+				qualifiers[qualifier][tableName.Name.String()] = true
+			}
+		case *sqlparser.ColName:
+			addColumn(node.Qualifier.Name.String(), node.Name.Lowered())
+		case *sqlparser.StarExpr:
+			addColumn(node.TableName.Name.String(), "*")
+		case *sqlparser.FuncExpr:
+			// The star of count(*) doesn't read any column.
+			for _, expr := range node.Exprs {
+				if aliased, ok := expr.(*sqlparser.AliasedExpr); ok {
+					_ = sqlparser.Walk(visit, aliased.Expr)
+				}
+			}
+			return false, nil
+		case *sqlparser.UpdateExpr:
+			// The updated columns are not read.
+			_ = sqlparser.Walk(visit, node.Expr)
+			return false, nil
+		}
+		return true, nil
+	}
+	_ = sqlparser.Walk(visit, stmt)
+
+	readColumns := make(map[string][]string)
+	for _, table := range tables {
+		if _, ok := readColumns[table]; ok {
+			continue
+		}
+		tableColumns := make(map[string]bool)
+		for qualifier, names := range columns {
+			if qualifier != "" && len(qualifiers[qualifier]) == 1 && !qualifiers[qualifier][table] {
+				continue
+			}
+			for name := range names {
+				tableColumns[name] = true
+			}
+		}
+		list := make([]string, 0, len(tableColumns))
+		for name := range tableColumns {
+			list = append(list, name)
+		}
+		sort.Strings(list)
+		readColumns[table] = list
+	}
+	return readColumns
+}
+
+// AddRowFilters adds the row filter predicates of the tables to the
+// statement, so that it only reads, updates or deletes the rows that
+// match them. The unqualified columns of a predicate are qualified with
+// the alias of the table it filters. The predicates of the tables on
+// the outer side of an outer join are added to its join condition.
+func AddRowFilters(stmt sqlparser.Statement, filters map[string][]*tableacl.RowFilter) error {
+	// The nodes are collected before being modified, because
+	// the predicates can contain subqueries.
+	var nodes []sqlparser.SQLNode
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node.(type) {
+		case *sqlparser.Select, *sqlparser.Update, *sqlparser.Delete:
+			nodes = append(nodes, node)
+		}
+		return true, nil
+	}, stmt)
+
+	for _, node := range nodes {
+		var where sqlparser.Expr
+		switch node := node.(type) {
+		case *sqlparser.Select:
+			if err := addTableExprsRowFilters(node.From, filters, &where); err != nil {
+				return err
+			}
+			if where != nil {
+				node.AddWhere(where)
+			}
+		case *sqlparser.Update:
+			if err := addTableExprsRowFilters(node.TableExprs, filters, &where); err != nil {
+				return err
+			}
+			node.Where = addWhere(node.Where, where)
+		case *sqlparser.Delete:
+			if err := addTableExprsRowFilters(node.TableExprs, filters, &where); err != nil {
+				return err
+			}
+			node.Where = addWhere(node.Where, where)
+		}
+	}
+	return nil
+}
+
+func addTableExprsRowFilters(node sqlparser.TableExprs, filters map[string][]*tableacl.RowFilter, where *sqlparser.Expr) error {
+	for _, node := range node {
+		if err := addTableExprRowFilters(node, filters, where); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addTableExprRowFilters(node sqlparser.TableExpr, filters map[string][]*tableacl.RowFilter, where *sqlparser.Expr) error {
+	switch node := node.(type) {
+	case *sqlparser.AliasedTableExpr:
+		// Derived tables are filtered as Select nodes.
+		tableName, ok := node.Expr.(sqlparser.TableName)
+		if !ok {
+			return nil
+		}
+		qualifier := sqlparser.TableName{Name: tableName.Name, Qualifier: tableName.Qualifier}
+		if !node.As.IsEmpty() {
+			qualifier = sqlparser.TableName{Name: node.As}
+		}
+		for _, rowFilter := range filters[tableName.Name.String()] {
+			expr, err := rowFilter.Expr()
+			if err != nil {
+				return err
+			}
+			qualifyColumns(expr, qualifier)
+			*where = andExpr(*where, expr)
+		}
+	case *sqlparser.ParenTableExpr:
+		return addTableExprsRowFilters(node.Exprs, filters, where)
+	case *sqlparser.JoinTableExpr:
+		left, right := where, where
+		if node.Condition.Using == nil {
+			switch node.Join {
+			case sqlparser.LeftJoinType:
+				right = &node.Condition.On
+			case sqlparser.RightJoinType:
+				left = &node.Condition.On
+			}
+		}
+		if err := addTableExprRowFilters(node.LeftExpr, filters, left); err != nil {
+			return err
+		}
+		return addTableExprRowFilters(node.RightExpr, filters, right)
+	}
+	return nil
+}
+
+// qualifyColumns qualifies the unqualified columns of the expression,
+// except for the ones of its subqueries.
+func qualifyColumns(expr sqlparser.Expr, qualifier sqlparser.TableName) {
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.ColName:
+			if node.Qualifier.IsEmpty() {
+				node.Qualifier = qualifier
+			}
+		case *sqlparser.Subquery:
+			return false, nil
+		}
+		return true, nil
+	}, expr)
+}
+
+func andExpr(left, right sqlparser.Expr) sqlparser.Expr {
+	if left == nil {
+		return right
+	}
+	return &sqlparser.AndExpr{Left: left, Right: right}
+}
+
+func addWhere(where *sqlparser.Where, expr sqlparser.Expr) *sqlparser.Where {
+	if expr == nil {
+		return where
+	}
+	if where == nil {
+		return &sqlparser.Where{Type: sqlparser.WhereClause, Expr: expr}
+	}
+	where.Expr = andExpr(where.Expr, expr)
+	return where
+}
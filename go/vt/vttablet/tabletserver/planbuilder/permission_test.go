@@ -188,3 +188,108 @@ func TestBuildPermissions(t *testing.T) {
 		}
 	}
 }
+
+func TestBuildReadColumns(t *testing.T) {
+	tcases := []struct {
+		input  string
+		output map[string][]string
+	}{{
+		input:  "select * from t",
+		output: map[string][]string{"t": {"*"}},
+	}, {
+		input:  "select count(*), A from t where b = 1 order by c",
+		output: map[string][]string{"t": {"a", "b", "c"}},
+	}, {
+		input:  "select t1.a, u.b, c from t1 join t2 as u on t1.id = u.id",
+		output: map[string][]string{"t1": {"a", "c", "id"}, "t2": {"b", "c", "id"}},
+	}, {
+		input:  "select u.* from t1 join t2 as u",
+		output: map[string][]string{"t1": {}, "t2": {"*"}},
+	}, {
+		input:  "select d.a from (select a from t1) as d",
+		output: map[string][]string{"t1": {"a"}},
+	}, {
+		input:  "update t1 set a = (select b from t2) where c = 1",
+		output: map[string][]string{"t1": {"b", "c"}, "t2": {"b", "c"}},
+	}, {
+		input:  "update t1 set a = 1 where b like '1%'",
+		output: map[string][]string{"t1": {"b"}},
+	}, {
+		input:  "delete from t1 where b = 1",
+		output: map[string][]string{"t1": {"b"}},
+	}, {
+		input:  "insert into t1(a) values (1)",
+		output: map[string][]string{"t1": {}},
+	}, {
+		input:  "insert into t1(a) values (1) on duplicate key update a = b + 1",
+		output: map[string][]string{"t1": {"b"}},
+	}, {
+		input:  "create table t1(a int)",
+		output: nil,
+	}}
+
+	for _, tcase := range tcases {
+		stmt, err := sqlparser.Parse(tcase.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := BuildReadColumns(stmt, BuildPermissions(stmt))
+		if !reflect.DeepEqual(got, tcase.output) {
+			t.Errorf("BuildReadColumns(%s): %v, want %v", tcase.input, got, tcase.output)
+		}
+	}
+}
+
+func TestAddRowFilters(t *testing.T) {
+	filters := map[string][]*tableacl.RowFilter{
+		"t1": {{Predicate: "region = 'eu' or region is null"}},
+		"t2": {{Predicate: "deleted = 0"}, {Predicate: "a.owner = 'me'"}},
+	}
+	tcases := []struct {
+		input  string
+		output string
+	}{{
+		input:  "select * from t1",
+		output: "select * from t1 where t1.region = 'eu' or t1.region is null",
+	}, {
+		input:  "select * from t1 as a where id = 1 or id = 2",
+		output: "select * from t1 as a where (id = 1 or id = 2) and (a.region = 'eu' or a.region is null)",
+	}, {
+		input:  "select * from t2 as b join t3 on b.id = t3.id",
+		output: "select * from t2 as b join t3 on b.id = t3.id where b.deleted = 0 and a.owner = 'me'",
+	}, {
+		input:  "select * from t3 left join t1 on t3.id = t1.id",
+		output: "select * from t3 left join t1 on t3.id = t1.id and (t1.region = 'eu' or t1.region is null)",
+	}, {
+		input:  "select * from t3 where id in (select id from t1)",
+		output: "select * from t3 where id in (select id from t1 where t1.region = 'eu' or t1.region is null)",
+	}, {
+		input:  "select * from t3 union select * from t1",
+		output: "select * from t3 union select * from t1 where t1.region = 'eu' or t1.region is null",
+	}, {
+		input:  "update t1 set a = 1 where id = 1",
+		output: "update t1 set a = 1 where id = 1 and (t1.region = 'eu' or t1.region is null)",
+	}, {
+		input:  "delete from t1",
+		output: "delete from t1 where t1.region = 'eu' or t1.region is null",
+	}, {
+		input:  "insert into t3 select * from t1",
+		output: "insert into t3 select * from t1 where t1.region = 'eu' or t1.region is null",
+	}, {
+		input:  "select * from t3",
+		output: "select * from t3",
+	}}
+
+	for _, tcase := range tcases {
+		stmt, err := sqlparser.Parse(tcase.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := AddRowFilters(stmt, filters); err != nil {
+			t.Fatal(err)
+		}
+		if got := sqlparser.String(stmt); got != tcase.output {
+			t.Errorf("AddRowFilters(%s): %s, want %s", tcase.input, got, tcase.output)
+		}
+	}
+}
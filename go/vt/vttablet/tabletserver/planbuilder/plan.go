@@ -139,6 +139,10 @@ type Plan struct {
 	// Permissions stores the permissions for the tables accessed in the query.
 	Permissions []Permission
 
+	// ReadColumns stores the columns read from each table,
+	// for the column ACLs.
+	ReadColumns map[string][]string
+
 	// FieldQuery is used to fetch field info
 	FieldQuery *sqlparser.ParsedQuery
 
@@ -221,6 +225,7 @@ func Build(statement sqlparser.Statement, tables map[string]*schema.Table, isRes
 		return nil, err
 	}
 	plan.Permissions = BuildPermissions(statement)
+	plan.ReadColumns = BuildReadColumns(statement, plan.Permissions)
 	return plan, nil
 }
 
@@ -255,6 +260,7 @@ func BuildStreaming(sql string, tables map[string]*schema.Table, isReservedConn
 	default:
 		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "'%v' not allowed for streaming", sqlparser.String(stmt))
 	}
+	plan.ReadColumns = BuildReadColumns(statement, plan.Permissions)
 
 	return plan, nil
 }
@@ -275,6 +281,9 @@ func BuildMessageStreaming(name string, tables map[string]*schema.Table) (*Plan,
 		TableName: plan.Table.Name.String(),
 		Role:      tableacl.WRITER,
 	}}
+	plan.ReadColumns = map[string][]string{
+		plan.Table.Name.String(): {"*"},
+	}
 	return plan, nil
 }
 
@@ -21,6 +21,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -349,6 +351,73 @@ func (qe *QueryEngine) GetStreamPlan(sql string, isReservedConn bool) (*TabletPl
 	return plan, nil
 }
 
+// GetRowFilteredPlan returns the plan of the query with the row filters
+// added to it. The original plan, which is not filtered, was returned by
+// GetPlan or GetStreamPlan. The filtered plans are cached with the others,
+// under a key that can't be the key of a query.
+func (qe *QueryEngine) GetRowFilteredPlan(ctx context.Context, sql string, original *TabletPlan, filters map[string][]*tableacl.RowFilter) (*TabletPlan, error) {
+	span, _ := trace.NewSpan(ctx, "QueryEngine.GetRowFilteredPlan")
+	defer span.Finish()
+
+	streaming := original.PlanID == planbuilder.PlanSelectStream
+	key := rowFilteredPlanKey(sql, filters)
+	if !streaming {
+		if plan := qe.getQuery(key); plan != nil {
+			return plan, nil
+		}
+	}
+
+	qe.mu.RLock()
+	defer qe.mu.RUnlock()
+	statement, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	if err := planbuilder.AddRowFilters(statement, filters); err != nil {
+		return nil, err
+	}
+	// The original plan already passed the checks
+	// for connection pooling.
+	var splan *planbuilder.Plan
+	if streaming {
+		splan, err = planbuilder.BuildStreaming(sqlparser.String(statement), qe.tables, true)
+	} else {
+		splan, err = planbuilder.Build(statement, qe.tables, true, qe.env.Config().DB.DBName)
+	}
+	if err != nil {
+		return nil, err
+	}
+	plan := &TabletPlan{
+		Plan:   splan,
+		Fields: original.Fields,
+		Rules:  original.Rules,
+	}
+	plan.buildAuthorized()
+	// The filtered plan is cached only if the original plan was.
+	if !streaming && qe.peekQuery(sql) == original {
+		qe.plans.Set(key, plan)
+	}
+	return plan, nil
+}
+
+// rowFilteredPlanKey returns the cache key of the plan of the query
+// with the row filters.
+func rowFilteredPlanKey(sql string, filters map[string][]*tableacl.RowFilter) string {
+	tables := make([]string, 0, len(filters))
+	for table := range filters {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	var buf strings.Builder
+	buf.WriteString(sql)
+	for _, table := range tables {
+		for _, rowFilter := range filters[table] {
+			fmt.Fprintf(&buf, "\x00%s\x00%s", table, rowFilter.Predicate)
+		}
+	}
+	return buf.String()
+}
+
 // GetMessageStreamPlan builds a plan for Message streaming.
 func (qe *QueryEngine) GetMessageStreamPlan(name string) (*TabletPlan, error) {
 	qe.mu.RLock()
@@ -128,6 +128,7 @@ func TestGetMessageStreamPlan(t *testing.T) {
 			TableName: "msg",
 			Role:      tableacl.WRITER,
 		}},
+		ReadColumns: map[string][]string{"msg": {"*"}},
 	}
 	if !reflect.DeepEqual(plan.Plan, wantPlan) {
 		t.Errorf("GetMessageStreamPlan(msg): %v, want %v", plan.Plan, wantPlan)
@@ -57,6 +57,10 @@ type QueryExecutor struct {
 	logStats       *tabletenv.LogStats
 	tsv            *TabletServer
 	tabletType     topodatapb.TabletType
+
+	// maxExecutionTime and olap are set by the query rules.
+	maxExecutionTime time.Duration
+	olap             bool
 }
 
 var sequenceFields = []*querypb.Field{
@@ -311,12 +315,8 @@ func (qre *QueryExecutor) checkPermissions() error {
 		remoteAddr = ci.RemoteAddr()
 		username = ci.Username()
 	}
-	action, desc := qre.plan.Rules.GetAction(remoteAddr, username, qre.bindVars)
-	switch action {
-	case rules.QRFail:
-		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: %s", desc)
-	case rules.QRFailRetry:
-		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", desc)
+	if err := qre.applyRules(qre.plan.Rules.Match(remoteAddr, username, qre.bindVars), username); err != nil {
+		return err
 	}
 
 	// Skip ACL check for queries against the dummy dual table
@@ -351,12 +351,104 @@ func (qre *QueryExecutor) checkPermissions() error {
 		}
 	}
 
+	// Like the access checks, the row filters are enforced
+	// only with a strict table ACL.
+	if qre.tsv.qe.strictTableACL && !qre.tsv.qe.enableTableACLDryRun {
+		return qre.applyRowFilters(callerID)
+	}
+	return nil
+}
+
+// applyRowFilters replaces the plan with one that only accesses
+// the rows that match the row filters of the caller, if any.
+func (qre *QueryExecutor) applyRowFilters(callerID *querypb.VTGateCallerID) error {
+	var filters map[string][]*tableacl.RowFilter
+	for i, auth := range qre.plan.Authorized {
+		tableName := qre.plan.Permissions[i].TableName
+		if _, ok := filters[tableName]; ok {
+			continue
+		}
+		if rowFilters := auth.ApplicableRowFilters(callerID); len(rowFilters) != 0 {
+			if filters == nil {
+				filters = make(map[string][]*tableacl.RowFilter)
+			}
+			filters[tableName] = rowFilters
+		}
+	}
+	if len(filters) == 0 {
+		return nil
+	}
+	if qre.plan.PlanID == planbuilder.PlanMessageStream {
+		errStr := fmt.Sprintf("table acl error: %q %v cannot run %v on table %q, which has row filters", callerID.Username, callerID.Groups, qre.plan.PlanID, qre.plan.TableName())
+		qre.tsv.qe.accessCheckerLogger.Infof("%s", errStr)
+		return vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "%s", errStr)
+	}
+	plan, err := qre.tsv.qe.GetRowFilteredPlan(qre.ctx, qre.query, qre.plan, filters)
+	if err != nil {
+		return err
+	}
+	qre.tsv.Stats().TableaclRowFiltered.Add([]string{qre.plan.PlanID.String(), callerID.Username}, 1)
+	qre.plan = plan
+	return nil
+}
+
+// applyRules performs the actions of the query rules that triggered.
+// If the last one fails the query, the actions of the others are skipped.
+func (qre *QueryExecutor) applyRules(matched []*rules.Rule, username string) error {
+	if len(matched) == 0 {
+		return nil
+	}
+	if last := matched[len(matched)-1]; last.Action().IsTerminal() {
+		return qre.applyRule(last, username)
+	}
+	for _, qr := range matched {
+		if err := qre.applyRule(qr, username); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyRule performs the action of a query rule that triggered.
+func (qre *QueryExecutor) applyRule(qr *rules.Rule, username string) error {
+	switch qr.Action() {
+	case rules.QRFail:
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: %s", qr.Description)
+	case rules.QRFailRetry:
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", qr.Description)
+	case rules.QRRateLimit:
+		// The budget of a caller is the one of the effective user, if known.
+		if callerID := callerid.ImmediateCallerIDFromContext(qre.ctx); callerID != nil {
+			username = callerID.Username
+		}
+		if !qr.Allow(username) {
+			return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "rate limited due to rule: %s", qr.Description)
+		}
+	case rules.QRDelay:
+		timer := time.NewTimer(qr.Delay())
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-qre.ctx.Done():
+			return vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "context expired while delayed due to rule: %s", qr.Description)
+		}
+	case rules.QRMaxExecutionTime:
+		qre.maxExecutionTime = qr.MaxExecutionTime()
+	case rules.QROLAP:
+		qre.olap = true
+	}
 	return nil
 }
 
 func (qre *QueryExecutor) checkAccess(authorized *tableacl.ACLResult, tableName string, callerID *querypb.VTGateCallerID) error {
 	statsKey := []string{tableName, authorized.GroupName, qre.plan.PlanID.String(), callerID.Username}
+	errStr := ""
 	if !authorized.IsMember(callerID) {
+		errStr = fmt.Sprintf("table acl error: %q %v cannot run %v on table %q", callerID.Username, callerID.Groups, qre.plan.PlanID, tableName)
+	} else if denied := authorized.DeniedColumns(callerID, qre.plan.ReadColumns[tableName]); len(denied) != 0 {
+		errStr = fmt.Sprintf("table acl error: %q %v cannot read columns %s of table %q", callerID.Username, callerID.Groups, strings.Join(denied, ", "), tableName)
+	}
+	if errStr != "" {
 		if qre.tsv.qe.enableTableACLDryRun {
 			qre.tsv.Stats().TableaclPseudoDenied.Add(statsKey, 1)
 			return nil
@@ -368,7 +460,6 @@ func (qre *QueryExecutor) checkAccess(authorized *tableacl.ACLResult, tableName
 		}
 
 		if qre.tsv.qe.strictTableACL {
-			errStr := fmt.Sprintf("table acl error: %q %v cannot run %v on table %q", callerID.Username, callerID.Groups, qre.plan.PlanID, tableName)
 			qre.tsv.Stats().TableaclDenied.Add(statsKey, 1)
 			qre.tsv.qe.accessCheckerLogger.Infof("%s", errStr)
 			return vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "%s", errStr)
@@ -519,17 +610,48 @@ func (qre *QueryExecutor) execSelect() (*sqltypes.Result, error) {
 		newResult.Fields = qre.plan.Fields
 		return &newResult, nil
 	}
+	sql, _, err := qre.generateFinalSQL(qre.plan.FullQuery, qre.bindVars)
+	if err != nil {
+		return nil, err
+	}
+	if qre.olap {
+		return qre.execOLAP(sql)
+	}
 	conn, err := qre.getConn()
 	if err != nil {
 		return nil, err
 	}
 	defer conn.Recycle()
+	return qre.execDBConn(conn, sql, true)
+}
 
-	sql, _, err := qre.generateFinalSQL(qre.plan.FullQuery, qre.bindVars)
+// execOLAP executes a select with a connection of the OLAP pool, and
+// gathers the streamed results. Like execDBConn, it fails as soon as
+// there are more rows than the max result size.
+func (qre *QueryExecutor) execOLAP(sql string) (*sqltypes.Result, error) {
+	conn, err := qre.getStreamConn()
 	if err != nil {
 		return nil, err
 	}
-	return qre.execDBConn(conn, sql, true)
+	defer conn.Recycle()
+
+	maxrows := qre.tsv.qe.maxResultSize.Get()
+	result := &sqltypes.Result{}
+	err = qre.execStreamSQL(conn, sql, func(qr *sqltypes.Result) error {
+		if qr.Fields != nil {
+			result.Fields = qr.Fields
+		}
+		result.Rows = append(result.Rows, qr.Rows...)
+		if count := int64(len(result.Rows)); count > maxrows {
+			return qre.verifyRowCount(count, maxrows)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	result.RowsAffected = uint64(len(result.Rows))
+	return result, nil
 }
 
 func (qre *QueryExecutor) execDMLLimit(conn *StatefulConnection) (*sqltypes.Result, error) {
@@ -612,14 +734,7 @@ func (qre *QueryExecutor) qFetch(logStats *tabletenv.LogStats, parsedQuery *sqlp
 		q, original := qre.tsv.qe.consolidator.Create(string(sqlWithoutComments))
 		if original {
 			defer q.Broadcast()
-			conn, err := qre.getConn()
-
-			if err != nil {
-				q.Err = err
-			} else {
-				defer conn.Recycle()
-				q.Result, q.Err = qre.execDBConn(conn, sql, false)
-			}
+			q.Result, q.Err = qre.fetch(sql)
 		} else {
 			logStats.QuerySources |= tabletenv.QuerySourceConsolidator
 			startTime := time.Now()
@@ -631,16 +746,21 @@ func (qre *QueryExecutor) qFetch(logStats *tabletenv.LogStats, parsedQuery *sqlp
 		}
 		return q.Result.(*sqltypes.Result), nil
 	}
+	return qre.fetch(sql)
+}
+
+// fetch executes the query with a connection of the pool
+// required by the query rules.
+func (qre *QueryExecutor) fetch(sql string) (*sqltypes.Result, error) {
+	if qre.olap {
+		return qre.execOLAP(sql)
+	}
 	conn, err := qre.getConn()
 	if err != nil {
 		return nil, err
 	}
 	defer conn.Recycle()
-	res, err := qre.execDBConn(conn, sql, false)
-	if err != nil {
-		return nil, err
-	}
-	return res, nil
+	return qre.execDBConn(conn, sql, false)
 }
 
 // txFetch fetches from a TxConnection.
@@ -668,6 +788,9 @@ func (qre *QueryExecutor) generateFinalSQL(parsedQuery *sqlparser.ParsedQuery, b
 	if err != nil {
 		return "", "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s", err)
 	}
+	if qre.maxExecutionTime > 0 {
+		query = addMaxExecutionTime(query, qre.maxExecutionTime)
+	}
 	withoutComments := query
 	buf.WriteString(query)
 	buf.WriteString(qre.marginComments.Trailing)
@@ -675,6 +798,36 @@ func (qre *QueryExecutor) generateFinalSQL(parsedQuery *sqlparser.ParsedQuery, b
 	return fullSQL, withoutComments, nil
 }
 
+// addMaxExecutionTime adds a MAX_EXECUTION_TIME optimizer hint to a select.
+// For a union, the hint is added to its first select, and applies to the
+// whole statement. Other statements are returned unchanged, because MySQL
+// only supports the hint for selects.
+func addMaxExecutionTime(query string, timeout time.Duration) string {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return query
+	}
+	sel, ok := stmt.(sqlparser.SelectStatement)
+	if !ok {
+		return query
+	}
+	for {
+		switch node := sel.(type) {
+		case *sqlparser.Union:
+			sel = node.FirstStatement
+			continue
+		case *sqlparser.ParenSelect:
+			sel = node.Select
+			continue
+		case *sqlparser.Select:
+			hint := []byte(fmt.Sprintf("/*+ MAX_EXECUTION_TIME(%d) */", timeout.Milliseconds()))
+			node.Comments = append(sqlparser.Comments{hint}, node.Comments...)
+			return sqlparser.String(stmt)
+		}
+		return query
+	}
+}
+
 func (qre *QueryExecutor) getSelectLimit() int64 {
 	maxRows := qre.tsv.qe.maxResultSize.Get()
 	sqlLimit := qre.options.GetSqlSelectLimit()
@@ -23,6 +23,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
 
@@ -989,6 +990,144 @@ func TestQueryExecutorTableAclDryRun(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorTableAclColumns(t *testing.T) {
+	aclName := fmt.Sprintf("simpleacl-test-%d", rand.Int63())
+	tableacl.Register(aclName, &simpleacl.Factory{})
+	tableacl.SetDefaultACL(aclName)
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	db.AddQuery("select pk, `name` from test_table limit 10001", &sqltypes.Result{})
+	db.AddQuery("select pk, `name` from test_table where 1 != 1", &sqltypes.Result{})
+	db.AddQuery("select * from test_table limit 10001", &sqltypes.Result{})
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	config := &tableaclpb.Config{
+		TableGroups: []*tableaclpb.TableGroupSpec{{
+			Name:                 "group01",
+			TableNamesOrPrefixes: []string{"test_table"},
+			Readers:              []string{"u1", "u2"},
+			Writers:              []string{"u1", "u2"},
+			ColumnAcls: []*tableaclpb.ColumnACL{{
+				Columns: []string{"addr"},
+				Readers: []string{"u1"},
+			}},
+		}},
+	}
+	require.NoError(t, tableacl.InitFromProto(config))
+
+	u1 := callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "u1"})
+	u2 := callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "u2"})
+	tsv := newTestTabletServer(u1, enableStrictTableACL, db)
+	defer tsv.StopService()
+
+	_, err := newTestQueryExecutor(u1, tsv, "select * from test_table", 0).Execute()
+	require.NoError(t, err)
+	_, err = newTestQueryExecutor(u2, tsv, "select pk, name from test_table", 0).Execute()
+	require.NoError(t, err)
+
+	// u2 can't read the restricted column.
+	_, err = newTestQueryExecutor(u2, tsv, "select * from test_table", 0).Execute()
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_PERMISSION_DENIED, vterrors.Code(err))
+	assert.Contains(t, err.Error(), `cannot read columns addr of table "test_table"`)
+
+	// DMLs can write the restricted column, but can't read it, because
+	// the number of affected rows reveals its values.
+	require.NoError(t, newTestQueryExecutor(u2, tsv, "update test_table set addr = 1 where pk = 1", 0).checkPermissions())
+	require.NoError(t, newTestQueryExecutor(u2, tsv, "insert into test_table(pk, addr) values (1, 1)", 0).checkPermissions())
+	for _, query := range []string{
+		"update test_table set name = 1 where addr like '1%'",
+		"update test_table set name = addr",
+		"delete from test_table where addr = 1",
+		"insert into test_table(pk, addr) values (1, 1) on duplicate key update name = addr",
+	} {
+		err = newTestQueryExecutor(u2, tsv, query, 0).checkPermissions()
+		require.Error(t, err, query)
+		assert.Equal(t, vtrpcpb.Code_PERMISSION_DENIED, vterrors.Code(err), query)
+		assert.Contains(t, err.Error(), `cannot read columns addr of table "test_table"`, query)
+		require.NoError(t, newTestQueryExecutor(u1, tsv, query, 0).checkPermissions(), query)
+	}
+}
+
+func TestQueryExecutorTableAclRowFilters(t *testing.T) {
+	aclName := fmt.Sprintf("simpleacl-test-%d", rand.Int63())
+	tableacl.Register(aclName, &simpleacl.Factory{})
+	tableacl.SetDefaultACL(aclName)
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table where pk = 1"
+	unfiltered := &sqltypes.Result{
+		Fields: getTestTableFields(),
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewInt32(1), sqltypes.NewInt32(10), sqltypes.NewInt32(100)},
+			{sqltypes.NewInt32(1), sqltypes.NewInt32(20), sqltypes.NewInt32(200)},
+		},
+	}
+	filtered := &sqltypes.Result{
+		Fields: getTestTableFields(),
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewInt32(1), sqltypes.NewInt32(10), sqltypes.NewInt32(100)},
+		},
+	}
+	db.AddQuery("select * from test_table where pk = 1 limit 10001", unfiltered)
+	db.AddQuery("select * from test_table where pk = 1 and test_table.`name` = 10 limit 10001", filtered)
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	config := &tableaclpb.Config{
+		TableGroups: []*tableaclpb.TableGroupSpec{{
+			Name:                 "group01",
+			TableNamesOrPrefixes: []string{"test_table", "msg"},
+			Readers:              []string{"u1", "u2"},
+			Writers:              []string{"u1", "u2"},
+			RowFilters: []*tableaclpb.RowFilter{{
+				Principals: []string{"u2"},
+				Predicate:  "name = 10",
+			}},
+		}},
+	}
+	require.NoError(t, tableacl.InitFromProto(config))
+
+	u1 := callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "u1"})
+	u2 := callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "u2"})
+	tsv := newTestTabletServer(u1, enableStrictTableACL, db)
+	defer tsv.StopService()
+	statsKey := strings.Join([]string{planbuilder.PlanSelect.String(), "u2"}, ".")
+	before := tsv.stats.TableaclRowFiltered.Counts()[statsKey]
+
+	got, err := newTestQueryExecutor(u1, tsv, query, 0).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, unfiltered.Rows, got.Rows)
+
+	// The filtered plan is cached separately.
+	for i := 0; i < 2; i++ {
+		got, err = newTestQueryExecutor(u2, tsv, query, 0).Execute()
+		require.NoError(t, err)
+		assert.Equal(t, filtered.Rows, got.Rows)
+	}
+	assert.Equal(t, before+2, tsv.stats.TableaclRowFiltered.Counts()[statsKey])
+	assert.NotNil(t, tsv.qe.peekQuery(query))
+
+	// The row filters of a message table can't be enforced
+	// on its message stream.
+	plan, err := tsv.qe.GetMessageStreamPlan("msg")
+	require.NoError(t, err)
+	qre := &QueryExecutor{
+		query:    "stream from msg",
+		plan:     plan,
+		ctx:      u2,
+		logStats: tabletenv.NewLogStats(u2, "TestQueryExecutor"),
+		tsv:      tsv,
+	}
+	err = qre.MessageStream(func(*sqltypes.Result) error { return nil })
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_PERMISSION_DENIED, vterrors.Code(err))
+	assert.Contains(t, err.Error(), "which has row filters")
+}
+
 func TestQueryExecutorBlacklistQRFail(t *testing.T) {
 	db := setUpQueryExecutorTest(t)
 	defer db.Close()
@@ -1096,6 +1235,183 @@ func TestQueryExecutorBlacklistQRRetry(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorRuleActions(t *testing.T) {
+	query := "select * from test_table where name = 1 limit 1000"
+	execQuery := "select * from test_table where `name` = 1 limit 1000"
+	hintedQuery := "select /*+ MAX_EXECUTION_TIME(1500) */ * from test_table where `name` = 1 limit 1000"
+	expected := &sqltypes.Result{
+		Fields:       getTestTableFields(),
+		Rows:         [][]sqltypes.Value{{sqltypes.NewInt32(1), sqltypes.NewInt32(1), sqltypes.NewInt32(1)}},
+		RowsAffected: 1,
+	}
+
+	testcases := []struct {
+		name      string
+		action    rules.Action
+		setupRule func(qr *rules.Rule) error
+		// wantQuery is the query sent to MySQL.
+		wantQuery string
+		// wantCodes are the error codes of consecutive executions.
+		wantCodes []vtrpcpb.Code
+	}{{
+		name:   "rate limit",
+		action: rules.QRRateLimit,
+		setupRule: func(qr *rules.Rule) error {
+			return qr.SetRateLimit(0.001, 2, false)
+		},
+		wantQuery: execQuery,
+		wantCodes: []vtrpcpb.Code{vtrpcpb.Code_OK, vtrpcpb.Code_OK, vtrpcpb.Code_RESOURCE_EXHAUSTED},
+	}, {
+		name:   "delay",
+		action: rules.QRDelay,
+		setupRule: func(qr *rules.Rule) error {
+			return qr.SetDelay(time.Millisecond)
+		},
+		wantQuery: execQuery,
+		wantCodes: []vtrpcpb.Code{vtrpcpb.Code_OK},
+	}, {
+		name:   "max execution time",
+		action: rules.QRMaxExecutionTime,
+		setupRule: func(qr *rules.Rule) error {
+			return qr.SetMaxExecutionTime(1500 * time.Millisecond)
+		},
+		wantQuery: hintedQuery,
+		wantCodes: []vtrpcpb.Code{vtrpcpb.Code_OK},
+	}, {
+		name:      "olap",
+		action:    rules.QROLAP,
+		setupRule: func(qr *rules.Rule) error { return nil },
+		wantQuery: execQuery,
+		wantCodes: []vtrpcpb.Code{vtrpcpb.Code_OK},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			db := setUpQueryExecutorTest(t)
+			defer db.Close()
+			// Other queries fail.
+			db.AddQuery(tcase.wantQuery, expected)
+
+			qr := rules.NewQueryRule(tcase.name, tcase.name, tcase.action)
+			qr.AddPlanCond(planbuilder.PlanSelect)
+			require.NoError(t, tcase.setupRule(qr))
+			qrs := rules.New()
+			qrs.Add(qr)
+
+			ctx := callinfo.NewContext(context.Background(), &fakecallinfo.FakeCallInfo{Remote: "127.0.0.1", User: "u1"})
+			tsv := newTestTabletServer(ctx, noFlags, db)
+			defer tsv.StopService()
+			rulesName := "ruleActions"
+			tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+			tsv.qe.queryRuleSources.RegisterSource(rulesName)
+			defer tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+			require.NoError(t, tsv.qe.queryRuleSources.SetRules(rulesName, qrs))
+
+			for i, want := range tcase.wantCodes {
+				qre := newTestQueryExecutor(ctx, tsv, query, 0)
+				got, err := qre.Execute()
+				require.Equal(t, want, vterrors.Code(err), "execution %d: %v", i, err)
+				if err == nil {
+					assert.Equal(t, expected.Rows, got.Rows, "execution %d", i)
+				}
+			}
+		})
+	}
+}
+
+func TestQueryExecutorRuleActionsCombined(t *testing.T) {
+	query := "select * from test_table where name = 1 limit 1000"
+	hintedQuery := "select /*+ MAX_EXECUTION_TIME(1500) */ * from test_table where `name` = 1 limit 1000"
+	expected := &sqltypes.Result{
+		Fields: getTestTableFields(),
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewInt32(1), sqltypes.NewInt32(1), sqltypes.NewInt32(1)},
+			{sqltypes.NewInt32(2), sqltypes.NewInt32(1), sqltypes.NewInt32(2)},
+		},
+		RowsAffected: 2,
+	}
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	db.AddQuery(hintedQuery, expected)
+
+	delay := rules.NewQueryRule("delay", "delay", rules.QRDelay)
+	require.NoError(t, delay.SetDelay(time.Millisecond))
+	maxExecutionTime := rules.NewQueryRule("max execution time", "max execution time", rules.QRMaxExecutionTime)
+	require.NoError(t, maxExecutionTime.SetMaxExecutionTime(1500*time.Millisecond))
+	olap := rules.NewQueryRule("olap", "olap", rules.QROLAP)
+	fail := rules.NewQueryRule("fail", "fail", rules.QRFail)
+
+	ctx := callinfo.NewContext(context.Background(), &fakecallinfo.FakeCallInfo{Remote: "127.0.0.1", User: "u1"})
+	ctx = callerid.NewContext(ctx, nil, &querypb.VTGateCallerID{Username: "u1"})
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	rulesName := "ruleActionsCombined"
+	tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	tsv.qe.queryRuleSources.RegisterSource(rulesName)
+	defer tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	setRules := func(qrs ...*rules.Rule) {
+		t.Helper()
+		rs := rules.New()
+		for _, qr := range qrs {
+			rs.Add(qr)
+		}
+		require.NoError(t, tsv.qe.queryRuleSources.SetRules(rulesName, rs))
+		tsv.qe.ClearQueryPlanCache()
+	}
+
+	// A non-terminal rule doesn't hide a failing rule that follows it.
+	setRules(delay, fail)
+	_, err := newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err), "%v", err)
+
+	// All the non-terminal actions are applied.
+	setRules(delay, maxExecutionTime, olap)
+	got, err := newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, expected.Rows, got.Rows)
+
+	// The results gathered from the OLAP pool are limited too.
+	tsv.qe.maxResultSize.Set(1)
+	_, err = newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "row count exceeded 1")
+}
+
+func TestAddMaxExecutionTime(t *testing.T) {
+	testcases := []struct {
+		in, out string
+	}{{
+		in:  "select a from t",
+		out: "select /*+ MAX_EXECUTION_TIME(250) */ a from t",
+	}, {
+		in:  "SELECT a from t",
+		out: "select /*+ MAX_EXECUTION_TIME(250) */ a from t",
+	}, {
+		in:  "select\n\ta from t",
+		out: "select /*+ MAX_EXECUTION_TIME(250) */ a from t",
+	}, {
+		in:  "select /* comment */ a from t",
+		out: "select /*+ MAX_EXECUTION_TIME(250) */ /* comment */ a from t",
+	}, {
+		in:  "select a from t union select b from u",
+		out: "select /*+ MAX_EXECUTION_TIME(250) */ a from t union select b from u",
+	}, {
+		in:  "(select a from t) union (select b from u)",
+		out: "(select /*+ MAX_EXECUTION_TIME(250) */ a from t) union (select b from u)",
+	}, {
+		in:  "select a from (select b from u) as t",
+		out: "select /*+ MAX_EXECUTION_TIME(250) */ a from (select b from u) as t",
+	}, {
+		in:  "update t set a = 1",
+		out: "update t set a = 1",
+	}, {
+		in:  "sel",
+		out: "sel",
+	}}
+	for _, tcase := range testcases {
+		assert.Equal(t, tcase.out, addMaxExecutionTime(tcase.in, 250*time.Millisecond))
+	}
+}
+
 type executorFlags int64
 
 const (
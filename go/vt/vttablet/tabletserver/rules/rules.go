@@ -23,6 +23,10 @@ import (
 	"reflect"
 	"regexp"
 	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
 
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
@@ -99,16 +103,27 @@ func (qrs *Rules) Find(name string) (qr *Rule) {
 func (qrs *Rules) Delete(name string) (qr *Rule) {
 	for i, qr := range qrs.rules {
 		if qr.Name == name {
-			for j := i; j < len(qrs.rules)-i-1; j++ {
-				qrs.rules[j] = qrs.rules[j+1]
-			}
-			qrs.rules = qrs.rules[:len(qrs.rules)-1]
+			qrs.rules = append(qrs.rules[:i], qrs.rules[i+1:]...)
 			return qr
 		}
 	}
 	return nil
 }
 
+// DeleteExpired deletes the rules that expired at now, and returns them.
+func (qrs *Rules) DeleteExpired(now time.Time) (expired []*Rule) {
+	kept := qrs.rules[:0]
+	for _, qr := range qrs.rules {
+		if qr.Expired(now) {
+			expired = append(expired, qr)
+			continue
+		}
+		kept = append(kept, qr)
+	}
+	qrs.rules = kept
+	return expired
+}
+
 // UnmarshalJSON unmarshals Rules.
 func (qrs *Rules) UnmarshalJSON(data []byte) (err error) {
 	var rulesInfo []map[string]interface{}
@@ -156,13 +171,34 @@ func (qrs *Rules) FilterByPlan(query string, planid planbuilder.PlanType, tableN
 }
 
 // GetAction runs the input against the rules engine and returns the action to be performed.
+// It's the action of the rule that fails the query if there's one, or else the action
+// of the first rule that triggers.
 func (qrs *Rules) GetAction(ip, user string, bindVars map[string]*querypb.BindVariable) (action Action, desc string) {
+	matched := qrs.Match(ip, user, bindVars)
+	if len(matched) == 0 {
+		return QRContinue, ""
+	}
+	qr := matched[len(matched)-1]
+	if !qr.act.IsTerminal() {
+		qr = matched[0]
+	}
+	return qr.act, qr.Description
+}
+
+// Match runs the input against the rules engine and returns the rules
+// that trigger, in order, up to the first one whose action is terminal.
+// The parameters of the actions are the ones of the returned rules.
+func (qrs *Rules) Match(ip, user string, bindVars map[string]*querypb.BindVariable) []*Rule {
+	var matched []*Rule
 	for _, qr := range qrs.rules {
 		if act := qr.GetAction(ip, user, bindVars); act != QRContinue {
-			return act, qr.Description
+			matched = append(matched, qr)
+			if act.IsTerminal() {
+				break
+			}
 		}
 	}
-	return QRContinue, ""
+	return matched
 }
 
 //-----------------------------------------------
@@ -179,6 +215,15 @@ type Rule struct {
 	Description string
 	Name        string
 
+	// AddedBy and AddedAt record who added the rule, and when.
+	// They're only informational.
+	AddedBy string
+	AddedAt time.Time
+
+	// ExpiresAt is the time after which the rule never fires.
+	// The rule never expires if it is zero.
+	ExpiresAt time.Time
+
 	// All defined conditions must match for the rule to fire (AND).
 
 	// Regexp conditions. nil conditions are ignored (TRUE).
@@ -195,6 +240,21 @@ type Rule struct {
 
 	// Action to be performed on trigger
 	act Action
+
+	// Parameters of the QRRateLimit action: the rate in queries per second,
+	// the burst, and whether each caller gets its own budget.
+	rateLimit float64
+	burst     int
+	perCaller bool
+	// limiter is shared by the copies of the rule, so that the rate applies
+	// to all the plans the rule was filtered into.
+	limiter *ruleLimiter
+
+	// Parameter of the QRDelay action.
+	delay time.Duration
+
+	// Parameter of the QRMaxExecutionTime action.
+	maxExecutionTime time.Duration
 }
 
 type namedRegexp struct {
@@ -228,13 +288,21 @@ func (qr *Rule) Equal(other *Rule) bool {
 	}
 	return (qr.Description == other.Description &&
 		qr.Name == other.Name &&
+		qr.AddedBy == other.AddedBy &&
+		qr.AddedAt.Equal(other.AddedAt) &&
+		qr.ExpiresAt.Equal(other.ExpiresAt) &&
 		qr.requestIP.Equal(other.requestIP) &&
 		qr.user.Equal(other.user) &&
 		qr.query.Equal(other.query) &&
 		reflect.DeepEqual(qr.plans, other.plans) &&
 		reflect.DeepEqual(qr.tableNames, other.tableNames) &&
 		reflect.DeepEqual(qr.bindVarConds, other.bindVarConds) &&
-		qr.act == other.act)
+		qr.act == other.act &&
+		qr.rateLimit == other.rateLimit &&
+		qr.burst == other.burst &&
+		qr.perCaller == other.perCaller &&
+		qr.delay == other.delay &&
+		qr.maxExecutionTime == other.maxExecutionTime)
 }
 
 // Copy performs a deep copy of a Rule.
@@ -242,10 +310,20 @@ func (qr *Rule) Copy() (newqr *Rule) {
 	newqr = &Rule{
 		Description: qr.Description,
 		Name:        qr.Name,
+		AddedBy:     qr.AddedBy,
+		AddedAt:     qr.AddedAt,
+		ExpiresAt:   qr.ExpiresAt,
 		requestIP:   qr.requestIP,
 		user:        qr.user,
 		query:       qr.query,
 		act:         qr.act,
+
+		rateLimit:        qr.rateLimit,
+		burst:            qr.burst,
+		perCaller:        qr.perCaller,
+		limiter:          qr.limiter,
+		delay:            qr.delay,
+		maxExecutionTime: qr.maxExecutionTime,
 	}
 	if qr.plans != nil {
 		newqr.plans = make([]planbuilder.PlanType, len(qr.plans))
@@ -267,6 +345,15 @@ func (qr *Rule) MarshalJSON() ([]byte, error) {
 	b := bytes.NewBuffer(nil)
 	safeEncode(b, `{"Description":`, qr.Description)
 	safeEncode(b, `,"Name":`, qr.Name)
+	if qr.AddedBy != "" {
+		safeEncode(b, `,"AddedBy":`, qr.AddedBy)
+	}
+	if !qr.AddedAt.IsZero() {
+		safeEncode(b, `,"AddedAt":`, qr.AddedAt.UTC().Format(time.RFC3339))
+	}
+	if !qr.ExpiresAt.IsZero() {
+		safeEncode(b, `,"ExpiresAt":`, qr.ExpiresAt.UTC().Format(time.RFC3339))
+	}
 	if qr.requestIP.Regexp != nil {
 		safeEncode(b, `,"RequestIP":`, qr.requestIP)
 	}
@@ -288,6 +375,19 @@ func (qr *Rule) MarshalJSON() ([]byte, error) {
 	if qr.act != QRContinue {
 		safeEncode(b, `,"Action":`, qr.act)
 	}
+	if qr.rateLimit != 0 {
+		safeEncode(b, `,"RateLimit":`, qr.rateLimit)
+		safeEncode(b, `,"Burst":`, qr.burst)
+		if qr.perCaller {
+			safeEncode(b, `,"PerCaller":`, qr.perCaller)
+		}
+	}
+	if qr.delay != 0 {
+		safeEncode(b, `,"Delay":`, qr.delay.String())
+	}
+	if qr.maxExecutionTime != 0 {
+		safeEncode(b, `,"MaxExecutionTime":`, qr.maxExecutionTime.String())
+	}
 	_, _ = b.WriteString("}")
 	return b.Bytes(), nil
 }
@@ -392,6 +492,134 @@ Error:
 	return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid operator %v for type %T (%v)", op, value, value)
 }
 
+// SetRateLimit sets the parameters of the QRRateLimit action. If burst is
+// not positive, it defaults to one second worth of queries.
+func (qr *Rule) SetRateLimit(qps float64, burst int, perCaller bool) error {
+	if qps <= 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "rate limit must be positive: %v", qps)
+	}
+	if burst <= 0 {
+		burst = int(qps)
+		if burst < 1 {
+			burst = 1
+		}
+	}
+	qr.rateLimit = qps
+	qr.burst = burst
+	qr.perCaller = perCaller
+	qr.limiter = newRuleLimiter(qps, burst)
+	return nil
+}
+
+// SetDelay sets the parameter of the QRDelay action.
+func (qr *Rule) SetDelay(delay time.Duration) error {
+	if delay <= 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "delay must be positive: %v", delay)
+	}
+	qr.delay = delay
+	return nil
+}
+
+// SetMaxExecutionTime sets the parameter of the QRMaxExecutionTime action.
+// MySQL only supports a precision of a millisecond.
+func (qr *Rule) SetMaxExecutionTime(timeout time.Duration) error {
+	if timeout < time.Millisecond {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "max execution time must be at least 1ms: %v", timeout)
+	}
+	qr.maxExecutionTime = timeout
+	return nil
+}
+
+// Expired returns true if the rule expired at now.
+func (qr *Rule) Expired(now time.Time) bool {
+	return !qr.ExpiresAt.IsZero() && !now.Before(qr.ExpiresAt)
+}
+
+// Action returns the action of the rule.
+func (qr *Rule) Action() Action {
+	return qr.act
+}
+
+// Delay returns how long the queries are delayed by a QRDelay rule.
+func (qr *Rule) Delay() time.Duration {
+	return qr.delay
+}
+
+// MaxExecutionTime returns the MAX_EXECUTION_TIME forced on the selects
+// by a QRMaxExecutionTime rule.
+func (qr *Rule) MaxExecutionTime() time.Duration {
+	return qr.maxExecutionTime
+}
+
+// Allow returns false if a query of user exceeds the rate of a QRRateLimit
+// rule. Queries of rules without a rate limit are always allowed.
+func (qr *Rule) Allow(user string) bool {
+	if qr.limiter == nil {
+		return true
+	}
+	if !qr.perCaller {
+		user = ""
+	}
+	return qr.limiter.allow(user)
+}
+
+// ruleLimiter is a set of token buckets, one per caller. The bucket of a
+// caller that was idle long enough to be refilled is the same as a new one,
+// so such buckets are evicted once per refill period.
+type ruleLimiter struct {
+	limit  rate.Limit
+	burst  int
+	refill time.Duration
+	now    func() time.Time
+
+	mu        sync.Mutex
+	limiters  map[string]*callerLimiter
+	lastSweep time.Time
+}
+
+type callerLimiter struct {
+	*rate.Limiter
+	lastUsed time.Time
+}
+
+func newRuleLimiter(qps float64, burst int) *ruleLimiter {
+	return &ruleLimiter{
+		limit:    rate.Limit(qps),
+		burst:    burst,
+		refill:   time.Duration(float64(burst) / qps * float64(time.Second)),
+		now:      time.Now,
+		limiters: make(map[string]*callerLimiter),
+	}
+}
+
+func (rl *ruleLimiter) allow(key string) bool {
+	now := rl.now()
+	rl.mu.Lock()
+	if now.Sub(rl.lastSweep) >= rl.refill {
+		for k, limiter := range rl.limiters {
+			if now.Sub(limiter.lastUsed) >= rl.refill {
+				delete(rl.limiters, k)
+			}
+		}
+		rl.lastSweep = now
+	}
+	limiter, ok := rl.limiters[key]
+	if !ok {
+		limiter = &callerLimiter{Limiter: rate.NewLimiter(rl.limit, rl.burst)}
+		rl.limiters[key] = limiter
+	}
+	limiter.lastUsed = now
+	rl.mu.Unlock()
+	return limiter.AllowN(now, 1)
+}
+
+// size returns the number of callers whose buckets are tracked.
+func (rl *ruleLimiter) size() int {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	return len(rl.limiters)
+}
+
 // FilterByPlan returns a new Rule if the query and planid match.
 // The new Rule will contain all the original constraints other
 // than the plan and query. If the plan and query don't match the Rule,
@@ -415,6 +643,9 @@ func (qr *Rule) FilterByPlan(query string, planid planbuilder.PlanType, tableNam
 
 // GetAction returns the action for a single rule.
 func (qr *Rule) GetAction(ip, user string, bindVars map[string]*querypb.BindVariable) Action {
+	if qr.Expired(time.Now()) {
+		return QRContinue
+	}
 	if !reMatch(qr.requestIP.Regexp, ip) {
 		return QRContinue
 	}
@@ -480,18 +711,36 @@ const (
 	QRContinue = Action(iota)
 	QRFail
 	QRFailRetry
+	// QRRateLimit fails the queries above the rate of the rule.
+	QRRateLimit
+	// QRDelay delays the queries by a fixed duration.
+	QRDelay
+	// QRMaxExecutionTime forces a MAX_EXECUTION_TIME hint on the selects.
+	QRMaxExecutionTime
+	// QROLAP executes the queries with the connections of the OLAP pool.
+	QROLAP
 )
 
+var actionNames = map[Action]string{
+	QRFail:             "FAIL",
+	QRFailRetry:        "FAIL_RETRY",
+	QRRateLimit:        "RATE_LIMIT",
+	QRDelay:            "DELAY",
+	QRMaxExecutionTime: "MAX_EXECUTION_TIME",
+	QROLAP:             "OLAP",
+}
+
+// IsTerminal returns true if the action always fails the queries, in
+// which case the rules that follow are not evaluated. A QRRateLimit rule
+// only fails the queries above its rate, so it's not terminal.
+func (act Action) IsTerminal() bool {
+	return act == QRFail || act == QRFailRetry
+}
+
 // MarshalJSON marshals to JSON.
 func (act Action) MarshalJSON() ([]byte, error) {
-	// If we add more actions, we'll need to use a map.
-	var str string
-	switch act {
-	case QRFail:
-		str = "FAIL"
-	case QRFailRetry:
-		str = "FAIL_RETRY"
-	default:
+	str, ok := actionNames[act]
+	if !ok {
 		str = "INVALID"
 	}
 	return json.Marshal(str)
@@ -776,16 +1025,41 @@ func MapStrOperator(strop string) (op Operator, err error) {
 // BuildQueryRule builds a query rule from a ruleInfo.
 func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 	qr = NewQueryRule("", "", QRFail)
+	var rateLimit float64
+	var burst int64
+	var perCaller bool
+	var delay, maxExecutionTime time.Duration
 	for k, v := range ruleInfo {
 		var sv string
 		var lv []interface{}
+		var nv json.Number
 		var ok bool
 		switch k {
-		case "Name", "Description", "RequestIP", "User", "Query", "Action":
+		case "Name", "Description", "RequestIP", "User", "Query", "Action", "AddedBy":
 			sv, ok = v.(string)
 			if !ok {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for %s", k)
 			}
+		case "AddedAt", "ExpiresAt":
+			sv, ok = v.(string)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want RFC3339 time for %s", k)
+			}
+		case "Delay", "MaxExecutionTime":
+			sv, ok = v.(string)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want duration string for %s", k)
+			}
+		case "RateLimit", "Burst":
+			nv, ok = v.(json.Number)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want number for %s", k)
+			}
+		case "PerCaller":
+			perCaller, ok = v.(bool)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want bool for %s", k)
+			}
 		case "Plans", "BindVarConds", "TableNames":
 			lv, ok = v.([]interface{})
 			if !ok {
@@ -799,6 +1073,18 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 			qr.Name = sv
 		case "Description":
 			qr.Description = sv
+		case "AddedBy":
+			qr.AddedBy = sv
+		case "AddedAt":
+			qr.AddedAt, err = time.Parse(time.RFC3339, sv)
+			if err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid AddedAt: %v", err)
+			}
+		case "ExpiresAt":
+			qr.ExpiresAt, err = time.Parse(time.RFC3339, sv)
+			if err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid ExpiresAt: %v", err)
+			}
 		case "RequestIP":
 			err = qr.SetIPCond(sv)
 			if err != nil {
@@ -846,16 +1132,65 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 				}
 			}
 		case "Action":
-			switch sv {
-			case "FAIL":
-				qr.act = QRFail
-			case "FAIL_RETRY":
-				qr.act = QRFailRetry
-			default:
+			qr.act = QRContinue
+			for act, name := range actionNames {
+				if name == sv {
+					qr.act = act
+				}
+			}
+			if qr.act == QRContinue {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Action %s", sv)
 			}
+		case "RateLimit":
+			rateLimit, err = nv.Float64()
+			if err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want float for RateLimit: %s", nv)
+			}
+		case "Burst":
+			burst, err = nv.Int64()
+			if err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want int for Burst: %s", nv)
+			}
+		case "Delay":
+			delay, err = time.ParseDuration(sv)
+			if err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Delay: %v", err)
+			}
+		case "MaxExecutionTime":
+			maxExecutionTime, err = time.ParseDuration(sv)
+			if err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid MaxExecutionTime: %v", err)
+			}
 		}
 	}
+	// The parameters of an action are only allowed with that action.
+	switch {
+	case qr.act == QRRateLimit:
+		err = qr.SetRateLimit(rateLimit, int(burst), perCaller)
+	case rateLimit != 0 || burst != 0 || perCaller:
+		err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "RateLimit, Burst and PerCaller require the RATE_LIMIT action")
+	}
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case qr.act == QRDelay:
+		err = qr.SetDelay(delay)
+	case delay != 0:
+		err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Delay requires the DELAY action")
+	}
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case qr.act == QRMaxExecutionTime:
+		err = qr.SetMaxExecutionTime(maxExecutionTime)
+	case maxExecutionTime != 0:
+		err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "MaxExecutionTime requires the MAX_EXECUTION_TIME action")
+	}
+	if err != nil {
+		return nil, err
+	}
 	return qr, nil
 }
 
@@ -19,10 +19,12 @@ package rules
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"reflect"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -672,6 +674,21 @@ var invalidjsons = []InvalidJSONCase{
 	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "OnMismatch": true, "Operator": "NOMATCH", "Value": "["}]}]`, "processing [: error parsing regexp: missing closing ]: `[$`"},
 	{`[{"Action": 1 }]`, "want string for Action"},
 	{`[{"Action": "foo" }]`, "invalid Action foo"},
+	{`[{"AddedBy": 1 }]`, "want string for AddedBy"},
+	{`[{"ExpiresAt": 1 }]`, "want RFC3339 time for ExpiresAt"},
+	{`[{"ExpiresAt": "tomorrow" }]`, "invalid ExpiresAt: parsing time \"tomorrow\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"tomorrow\" as \"2006\""},
+	{`[{"AddedAt": "yesterday" }]`, "invalid AddedAt: parsing time \"yesterday\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"yesterday\" as \"2006\""},
+	{`[{"Action": "RATE_LIMIT" }]`, "rate limit must be positive: 0"},
+	{`[{"Action": "RATE_LIMIT", "RateLimit": "1" }]`, "want number for RateLimit"},
+	{`[{"Action": "RATE_LIMIT", "RateLimit": 1, "Burst": 1.5 }]`, "want int for Burst: 1.5"},
+	{`[{"Action": "RATE_LIMIT", "RateLimit": 1, "PerCaller": 1 }]`, "want bool for PerCaller"},
+	{`[{"Action": "FAIL", "RateLimit": 1 }]`, "RateLimit, Burst and PerCaller require the RATE_LIMIT action"},
+	{`[{"Action": "DELAY" }]`, "delay must be positive: 0s"},
+	{`[{"Action": "DELAY", "Delay": 1 }]`, "want duration string for Delay"},
+	{`[{"Action": "DELAY", "Delay": "1" }]`, "invalid Delay: time: missing unit in duration \"1\""},
+	{`[{"Action": "FAIL", "Delay": "1s" }]`, "Delay requires the DELAY action"},
+	{`[{"Action": "MAX_EXECUTION_TIME", "MaxExecutionTime": "1us" }]`, "max execution time must be at least 1ms: 1µs"},
+	{`[{"Action": "FAIL", "MaxExecutionTime": "1s" }]`, "MaxExecutionTime requires the MAX_EXECUTION_TIME action"},
 }
 
 func TestInvalidJSON(t *testing.T) {
@@ -709,6 +726,223 @@ func TestBuildQueryRuleActionFail(t *testing.T) {
 	}
 }
 
+func TestBuildQueryRuleActionParams(t *testing.T) {
+	testcases := []struct {
+		input string
+		check func(t *testing.T, qr *Rule)
+	}{{
+		input: `{"Name": "r1", "Action": "RATE_LIMIT", "RateLimit": 2.5, "Burst": 5, "PerCaller": true}`,
+		check: func(t *testing.T, qr *Rule) {
+			if qr.Action() != QRRateLimit || qr.rateLimit != 2.5 || qr.burst != 5 || !qr.perCaller {
+				t.Errorf("rate limit: %+v", qr)
+			}
+		},
+	}, {
+		input: `{"Name": "r1", "Action": "RATE_LIMIT", "RateLimit": 2.5}`,
+		check: func(t *testing.T, qr *Rule) {
+			if qr.burst != 2 {
+				t.Errorf("default burst: %d, want 2", qr.burst)
+			}
+		},
+	}, {
+		input: `{"Name": "r1", "Action": "DELAY", "Delay": "250ms"}`,
+		check: func(t *testing.T, qr *Rule) {
+			if qr.Action() != QRDelay || qr.Delay() != 250*time.Millisecond {
+				t.Errorf("delay: %+v", qr)
+			}
+		},
+	}, {
+		input: `{"Name": "r1", "Action": "MAX_EXECUTION_TIME", "MaxExecutionTime": "2s"}`,
+		check: func(t *testing.T, qr *Rule) {
+			if qr.Action() != QRMaxExecutionTime || qr.MaxExecutionTime() != 2*time.Second {
+				t.Errorf("max execution time: %+v", qr)
+			}
+		},
+	}, {
+		input: `{"Name": "r1", "Action": "OLAP"}`,
+		check: func(t *testing.T, qr *Rule) {
+			if qr.Action() != QROLAP {
+				t.Errorf("olap: %+v", qr)
+			}
+		},
+	}}
+	for _, tcase := range testcases {
+		qrs := New()
+		if err := qrs.UnmarshalJSON([]byte("[" + tcase.input + "]")); err != nil {
+			t.Fatalf("UnmarshalJSON(%s): %v", tcase.input, err)
+		}
+		tcase.check(t, qrs.rules[0])
+
+		// The rules must survive a round trip through JSON.
+		data, err := json.Marshal(qrs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		qrs2 := New()
+		if err := qrs2.UnmarshalJSON(data); err != nil {
+			t.Fatalf("UnmarshalJSON(%s): %v", data, err)
+		}
+		if !qrs.Equal(qrs2) {
+			t.Errorf("round trip of %s: got %s", tcase.input, data)
+		}
+	}
+}
+
+func TestRuleExpiry(t *testing.T) {
+	now := time.Now()
+	qrs := New()
+	for i, expiresAt := range []time.Time{{}, now.Add(-time.Second), now.Add(time.Hour)} {
+		qr := NewQueryRule("expiry", fmt.Sprintf("r%d", i), QRFail)
+		qr.SetUserCond(fmt.Sprintf("u%d", i))
+		qr.ExpiresAt = expiresAt
+		qrs.Add(qr)
+	}
+
+	// Expired rules never fire.
+	for user, want := range map[string]Action{"u0": QRFail, "u1": QRContinue, "u2": QRFail} {
+		if got, _ := qrs.GetAction("", user, nil); got != want {
+			t.Errorf("GetAction(%s): %v, want %v", user, got, want)
+		}
+	}
+
+	expired := qrs.DeleteExpired(now)
+	if len(expired) != 1 || expired[0].Name != "r1" {
+		t.Errorf("DeleteExpired: %v, want r1", expired)
+	}
+	if qrs.Find("r0") == nil || qrs.Find("r1") != nil || qrs.Find("r2") == nil {
+		t.Errorf("rules after DeleteExpired: %v", qrs)
+	}
+	if qr := qrs.Delete("r2"); qr == nil || qrs.Find("r2") != nil || qrs.Find("r0") == nil {
+		t.Errorf("rules after Delete: %v", qrs)
+	}
+}
+
+func TestRuleAudit(t *testing.T) {
+	input := `[{"Name": "r1", "AddedBy": "alice", "AddedAt": "2021-03-01T10:00:00Z", "ExpiresAt": "2021-03-01T11:00:00Z", "Action": "FAIL"}]`
+	qrs := New()
+	if err := qrs.UnmarshalJSON([]byte(input)); err != nil {
+		t.Fatal(err)
+	}
+	qr := qrs.Find("r1")
+	wantExpiry := time.Date(2021, 3, 1, 11, 0, 0, 0, time.UTC)
+	if qr.AddedBy != "alice" || !qr.ExpiresAt.Equal(wantExpiry) || !qr.AddedAt.Equal(wantExpiry.Add(-time.Hour)) {
+		t.Errorf("audit: %+v", qr)
+	}
+	if !qr.Copy().Equal(qr) {
+		t.Errorf("Copy: %+v, want %+v", qr.Copy(), qr)
+	}
+
+	data, err := json.Marshal(qrs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := compacted(string(data)); got != compacted(`[{"Description":"","Name":"r1","AddedBy":"alice","AddedAt":"2021-03-01T10:00:00Z","ExpiresAt":"2021-03-01T11:00:00Z","Action":"FAIL"}]`) {
+		t.Errorf("MarshalJSON: %s", got)
+	}
+}
+
+func TestRuleAllow(t *testing.T) {
+	qr := NewQueryRule("rate limit", "r1", QRRateLimit)
+	if err := qr.SetRateLimit(0.001, 2, false); err != nil {
+		t.Fatal(err)
+	}
+	// The limiter is shared by the copies of a rule.
+	qrCopy := qr.FilterByPlan("select * from a", planbuilder.PlanSelect, "a")
+	got := []bool{qr.Allow("u1"), qrCopy.Allow("u2"), qr.Allow("u3")}
+	if want := []bool{true, true, false}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Allow: %v, want %v", got, want)
+	}
+
+	qr = NewQueryRule("rate limit per caller", "r2", QRRateLimit)
+	if err := qr.SetRateLimit(0.001, 1, true); err != nil {
+		t.Fatal(err)
+	}
+	got = []bool{qr.Allow("u1"), qr.Allow("u2"), qr.Allow("u1")}
+	if want := []bool{true, true, false}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Allow per caller: %v, want %v", got, want)
+	}
+
+	if !NewQueryRule("no limit", "r3", QRDelay).Allow("u1") {
+		t.Errorf("Allow without a rate limit: false, want true")
+	}
+}
+
+func TestRulesMatchNonTerminal(t *testing.T) {
+	delay := NewQueryRule("delay", "r1", QRDelay)
+	if err := delay.SetDelay(time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	fail := NewQueryRule("fail", "r2", QRFail)
+	olap := NewQueryRule("olap", "r3", QROLAP)
+
+	// The rules that follow a non-terminal rule are evaluated.
+	qrs := New()
+	qrs.Add(delay)
+	qrs.Add(fail)
+	qrs.Add(olap)
+	if got := qrs.Match("", "u1", nil); !reflect.DeepEqual(got, []*Rule{delay, fail}) {
+		t.Errorf("Match: %v, want [r1 r2]", got)
+	}
+	if action, desc := qrs.GetAction("", "u1", nil); action != QRFail || desc != "fail" {
+		t.Errorf("GetAction: %v %s, want FAIL fail", action, desc)
+	}
+
+	// The rules that follow a terminal rule are not.
+	qrs = New()
+	qrs.Add(fail)
+	qrs.Add(delay)
+	if got := qrs.Match("", "u1", nil); !reflect.DeepEqual(got, []*Rule{fail}) {
+		t.Errorf("Match: %v, want [r2]", got)
+	}
+
+	qrs = New()
+	qrs.Add(delay)
+	qrs.Add(olap)
+	if got := qrs.Match("", "u1", nil); !reflect.DeepEqual(got, []*Rule{delay, olap}) {
+		t.Errorf("Match: %v, want [r1 r3]", got)
+	}
+	if action, _ := qrs.GetAction("", "u1", nil); action != QRDelay {
+		t.Errorf("GetAction: %v, want DELAY", action)
+	}
+}
+
+func TestRuleLimiterEviction(t *testing.T) {
+	now := time.Unix(1000, 0)
+	rl := newRuleLimiter(1, 2)
+	rl.now = func() time.Time { return now }
+	if rl.refill != 2*time.Second {
+		t.Fatalf("refill: %v, want 2s", rl.refill)
+	}
+
+	rl.allow("u1")
+	rl.allow("u2")
+	if got := rl.size(); got != 2 {
+		t.Errorf("size: %d, want 2", got)
+	}
+
+	// u2 is still within the refill period.
+	now = now.Add(time.Second)
+	rl.allow("u2")
+	now = now.Add(1500 * time.Millisecond)
+	rl.allow("u3")
+	if got := rl.size(); got != 2 {
+		t.Errorf("size: %d, want 2", got)
+	}
+	if _, ok := rl.limiters["u1"]; ok {
+		t.Errorf("u1 was not evicted")
+	}
+
+	// An evicted caller gets a full bucket, as it would have.
+	now = now.Add(10 * time.Second)
+	got := []bool{rl.allow("u2"), rl.allow("u2"), rl.allow("u2")}
+	if want := []bool{true, true, false}; !reflect.DeepEqual(got, want) {
+		t.Errorf("allow: %v, want %v", got, want)
+	}
+	if got := rl.size(); got != 1 {
+		t.Errorf("size: %d, want 1", got)
+	}
+}
+
 func TestBadAddBindVarCond(t *testing.T) {
 	qr1 := NewQueryRule("rule 1", "r1", QRFail)
 	err := qr1.AddBindVarCond("a", true, false, QRMatch, uint64(1))
@@ -110,6 +110,9 @@ func loadMessageInfo(ta *Table, comment string) error {
 
 	ta.MessageInfo.MaxBackoff, _ = getDuration(keyvals, "vt_max_backoff")
 
+	ta.MessageInfo.MaxAttempts, _ = getNum(keyvals, "vt_max_attempts")
+	ta.MessageInfo.DeadLetterTable = keyvals["vt_dead_letter_table"]
+
 	for _, col := range requiredCols {
 		num := ta.FindColumn(sqlparser.NewColIdent(col))
 		if num == -1 {
@@ -124,6 +127,20 @@ func loadMessageInfo(ta *Table, comment string) error {
 		}
 		ta.MessageInfo.Fields = append(ta.MessageInfo.Fields, field)
 	}
+
+	if col := keyvals["vt_partition_column"]; col != "" {
+		found := false
+		for _, field := range ta.MessageInfo.Fields {
+			if strings.EqualFold(field.Name, col) {
+				ta.MessageInfo.PartitionColumn = field.Name
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("partition column %s is not a user-defined column of message table: %s", col, ta.Name.String())
+		}
+	}
 	return nil
 }
 
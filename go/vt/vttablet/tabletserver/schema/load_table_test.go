@@ -141,6 +141,23 @@ func TestLoadTableMessage(t *testing.T) {
 	want.MessageInfo.MaxBackoff = 100 * time.Second
 	assert.Equal(t, want, table)
 
+	// Test loading max attempts and dead letter table
+	table, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_min_backoff=10,vt_max_backoff=100,vt_max_attempts=5,vt_dead_letter_table=test_table_dlq", db)
+	require.NoError(t, err)
+	want.MessageInfo.MaxAttempts = 5
+	want.MessageInfo.DeadLetterTable = "test_table_dlq"
+	assert.Equal(t, want, table)
+
+	// Test loading the partition column
+	table, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_min_backoff=10,vt_max_backoff=100,vt_max_attempts=5,vt_dead_letter_table=test_table_dlq,vt_partition_column=MESSAGE", db)
+	require.NoError(t, err)
+	want.MessageInfo.PartitionColumn = "message"
+	assert.Equal(t, want, table)
+
+	// The partition column must be user-defined
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_partition_column=epoch", db)
+	assert.EqualError(t, err, "partition column epoch is not a user-defined column of message table: test_table")
+
 	// Missing property
 	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30", db)
 	wanterr := "not specified for message table"
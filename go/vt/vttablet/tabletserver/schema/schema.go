@@ -100,6 +100,22 @@ type MessageInfo struct {
 	// MaxBackoff specifies the longest duration message manager
 	// should wait before rescheduling a message
 	MaxBackoff time.Duration
+
+	// MaxAttempts specifies how many times a message is sent
+	// before it's dead-lettered. 0 means no limit.
+	MaxAttempts int
+
+	// DeadLetterTable specifies the table to which dead-lettered
+	// messages are moved. It must have the user-defined columns
+	// of the message table. If empty, dead-lettered messages are
+	// marked failed in the message table instead.
+	DeadLetterTable string
+
+	// PartitionColumn specifies the user-defined column that
+	// partitions the messages. If set, messages with the same
+	// value are delivered in order of id, one at a time, and
+	// always to the same subscriber.
+	PartitionColumn string
 }
 
 // NewTable creates a new Table.
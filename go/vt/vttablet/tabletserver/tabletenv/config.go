@@ -29,6 +29,7 @@ import (
 	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/throttler"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/fairshare"
 )
 
 // These constants represent values for various config parameters.
@@ -122,7 +123,9 @@ func init() {
 	flag.BoolVar(&deprecatedAutocommit, "enable-autocommit", true, "This flag is deprecated. Autocommit is always allowed.")
 	flag.BoolVar(&currentConfig.TwoPCEnable, "twopc_enable", defaultConfig.TwoPCEnable, "if the flag is on, 2pc is enabled. Other 2pc flags must be supplied.")
 	flag.StringVar(&currentConfig.TwoPCCoordinatorAddress, "twopc_coordinator_address", defaultConfig.TwoPCCoordinatorAddress, "address of the (VTGate) process(es) that will be used to notify of abandoned transactions.")
-	SecondsVar(&currentConfig.TwoPCAbandonAge, "twopc_abandon_age", defaultConfig.TwoPCAbandonAge, "time in seconds. Any unresolved transaction older than this time will be sent to the coordinator to be resolved.")
+	SecondsVar(&currentConfig.TwoPCAbandonAge, "twopc_abandon_age", defaultConfig.TwoPCAbandonAge, "time in seconds. Any unresolved transaction older than this time will be sent to the coordinator to be resolved, or resolved by the tablet if -twopc_resolve is set.")
+	flag.BoolVar(&currentConfig.TwoPCResolve, "twopc_resolve", defaultConfig.TwoPCResolve, "if the flag is on, abandoned transactions are resolved by the tablet that manages their metadata instead of being sent to the coordinator.")
+	SecondsVar(&currentConfig.TwoPCConcludeDelay, "twopc_conclude_delay", defaultConfig.TwoPCConcludeDelay, "time in seconds. The metadata of a transaction resolved by the tablet is kept for this long before it's deleted. Requires -twopc_resolve.")
 	flag.BoolVar(&currentConfig.EnableTxThrottler, "enable-tx-throttler", defaultConfig.EnableTxThrottler, "If true replication-lag-based throttling on transactions will be enabled.")
 	flag.StringVar(&currentConfig.TxThrottlerConfig, "tx-throttler-config", defaultConfig.TxThrottlerConfig, "The configuration of the transaction throttler as a text formatted throttlerdata.Configuration protocol buffer message")
 	flagutil.StringListVar(&currentConfig.TxThrottlerHealthCheckCells, "tx-throttler-healthcheck-cells", defaultConfig.TxThrottlerHealthCheckCells, "A comma-separated list of cells. Only tabletservers running in these cells will be monitored for replication lag by the transaction throttler.")
@@ -141,6 +144,10 @@ func init() {
 	flag.BoolVar(&currentConfig.TransactionLimitByComponent, "transaction_limit_by_component", defaultConfig.TransactionLimitByComponent, "Include CallerID.component when considering who the user is for the purpose of transaction limit.")
 	flag.BoolVar(&currentConfig.TransactionLimitBySubcomponent, "transaction_limit_by_subcomponent", defaultConfig.TransactionLimitBySubcomponent, "Include CallerID.subcomponent when considering who the user is for the purpose of transaction limit.")
 
+	flag.BoolVar(&currentConfig.FairShare.Enable, "enable_fair_share_pools", defaultConfig.FairShare.Enable, "If true, requests waiting for a connection of the query, stream and transaction pools are queued per caller and served by weighted fair queueing, instead of first come first served. A caller flooding a pool then only delays its own requests.")
+	flag.StringVar(&currentConfig.FairShare.By, "fair_share_by", defaultConfig.FairShare.By, "Caller ID field which tells the callers apart for fair share scheduling: username (VTGateCallerID.username), principal, component or subcomponent (CallerID fields). For instance, subcomponent can carry a workload tag.")
+	flag.StringVar(&currentConfig.FairShare.Weights, "fair_share_weights", defaultConfig.FairShare.Weights, "Comma separated list of caller:weight pairs for fair share scheduling. A caller with weight 2 gets twice the connections of a caller with weight 1 when the pools are contended. Callers not listed have a weight of 1.")
+
 	flag.BoolVar(&enableHeartbeat, "heartbeat_enable", false, "If true, vttablet records (if master) or checks (if replica) the current time of a replication heartbeat in the table _vt.heartbeat. The result is used to inform the serving state of the vttablet via healthchecks.")
 	flag.DurationVar(&heartbeatInterval, "heartbeat_interval", 1*time.Second, "How frequently to read and write replication heartbeat.")
 	flag.BoolVar(&currentConfig.EnableLagThrottler, "enable-lag-throttler", defaultConfig.EnableLagThrottler, "If true, vttablet will run a throttler service, and will implicitly enable heartbeats")
@@ -258,6 +265,8 @@ type TabletConfig struct {
 	TwoPCEnable             bool    `json:"-"`
 	TwoPCCoordinatorAddress string  `json:"-"`
 	TwoPCAbandonAge         Seconds `json:"-"`
+	TwoPCResolve            bool    `json:"-"`
+	TwoPCConcludeDelay      Seconds `json:"-"`
 
 	EnableTxThrottler           bool     `json:"-"`
 	TxThrottlerConfig           string   `json:"-"`
@@ -267,6 +276,8 @@ type TabletConfig struct {
 
 	TransactionLimitConfig `json:"-"`
 
+	FairShare FairShareConfig `json:"-"`
+
 	EnforceStrictTransTables bool `json:"-"`
 }
 
@@ -329,6 +340,16 @@ type TransactionLimitConfig struct {
 	TransactionLimitBySubcomponent bool
 }
 
+// FairShareConfig contains the config for the fair share scheduling of the
+// requests waiting for a connection of the pools.
+type FairShareConfig struct {
+	Enable bool
+	// By is the caller ID field which tells the callers apart.
+	By string
+	// Weights is a comma separated list of caller:weight pairs.
+	Weights string
+}
+
 // NewCurrentConfig returns a copy of the current config.
 func NewCurrentConfig() *TabletConfig {
 	return currentConfig.Clone()
@@ -353,6 +374,9 @@ func (c *TabletConfig) Verify() error {
 	if err := c.verifyTransactionLimitConfig(); err != nil {
 		return err
 	}
+	if err := c.verifyFairShareConfig(); err != nil {
+		return err
+	}
 	if v := c.HotRowProtection.MaxQueueSize; v <= 0 {
 		return fmt.Errorf("-hot_row_protection_max_queue_size must be > 0 (specified value: %v)", v)
 	}
@@ -398,6 +422,22 @@ func (c *TabletConfig) verifyTransactionLimitConfig() error {
 	return nil
 }
 
+// verifyFairShareConfig checks FairShareConfig for sanity
+func (c *TabletConfig) verifyFairShareConfig() error {
+	if !c.FairShare.Enable {
+		return nil
+	}
+	switch c.FairShare.By {
+	case fairshare.ByUsername, fairshare.ByPrincipal, fairshare.ByComponent, fairshare.BySubcomponent:
+	default:
+		return fmt.Errorf("-fair_share_by must be one of username, principal, component or subcomponent (specified value: %v)", c.FairShare.By)
+	}
+	if _, err := fairshare.ParseWeights(c.FairShare.Weights); err != nil {
+		return fmt.Errorf("invalid -fair_share_weights: %v", err)
+	}
+	return nil
+}
+
 // Some of these values are for documentation purposes.
 // They actually get overwritten during Init.
 var defaultConfig = TabletConfig{
@@ -460,6 +500,11 @@ var defaultConfig = TabletConfig{
 
 	TransactionLimitConfig: defaultTransactionLimitConfig(),
 
+	FairShare: FairShareConfig{
+		Enable: false,
+		By:     fairshare.ByPrincipal,
+	},
+
 	EnforceStrictTransTables: true,
 }
 
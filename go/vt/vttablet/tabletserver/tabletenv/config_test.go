@@ -203,7 +203,10 @@ func TestFlags(t *testing.T) {
 			TransactionLimitByPrincipal: true,
 		},
 		EnforceStrictTransTables: true,
-		DB:                       &dbconfigs.DBConfigs{},
+		FairShare: FairShareConfig{
+			By: "principal",
+		},
+		DB: &dbconfigs.DBConfigs{},
 	}
 	assert.Equal(t, want.DB, currentConfig.DB)
 	assert.Equal(t, want, currentConfig)
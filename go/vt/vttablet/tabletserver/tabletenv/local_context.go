@@ -18,10 +18,14 @@ package tabletenv
 
 import (
 	"context"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 type localContextKey int
 
+type priorityContextKey int
+
 // LocalContext returns a context that's local to the process.
 func LocalContext() context.Context {
 	return context.WithValue(context.Background(), localContextKey(0), 0)
@@ -31,3 +35,15 @@ func LocalContext() context.Context {
 func IsLocalContext(ctx context.Context) bool {
 	return ctx.Value(localContextKey(0)) != nil
 }
+
+// WithPriority returns a context which carries the priority of a request.
+// The pools shed the LOW priority requests first.
+func WithPriority(ctx context.Context, priority querypb.ExecuteOptions_Priority) context.Context {
+	return context.WithValue(ctx, priorityContextKey(0), priority)
+}
+
+// IsLowPriority returns true if the context carries the LOW priority.
+func IsLowPriority(ctx context.Context) bool {
+	priority, _ := ctx.Value(priorityContextKey(0)).(querypb.ExecuteOptions_Priority)
+	return priority == querypb.ExecuteOptions_LOW
+}
@@ -32,8 +32,10 @@ type Stats struct {
 	WaitTimings            *servenv.TimingsWrapper        // waits like Consolidations etc
 	KillCounters           *stats.CountersWithSingleLabel // Connection and transaction kills
 	ErrorCounters          *stats.CountersWithSingleLabel
+	ErrorRates             *stats.Rates // Human readable error rates
 	InternalErrors         *stats.CountersWithSingleLabel
 	Warnings               *stats.CountersWithSingleLabel
+	LowPriorityShed        *stats.CountersWithSingleLabel // Low priority requests shed under pressure
 	Unresolved             *stats.GaugesWithSingleLabel   // For now, only Prepares are tracked
 	UserTableQueryCount    *stats.CountersWithMultiLabels // Per CallerID/table counts
 	UserTableQueryTimesNs  *stats.CountersWithMultiLabels // Per CallerID/table latencies
@@ -43,6 +45,7 @@ type Stats struct {
 	TableaclAllowed        *stats.CountersWithMultiLabels // Number of allows
 	TableaclDenied         *stats.CountersWithMultiLabels // Number of denials
 	TableaclPseudoDenied   *stats.CountersWithMultiLabels // Number of pseudo denials
+	TableaclRowFiltered    *stats.CountersWithMultiLabels // Number of queries restricted by row filters
 
 	UserActiveReservedCount *stats.CountersWithSingleLabel // Per CallerID active reserved connection counts
 	UserReservedCount       *stats.CountersWithSingleLabel // Per CallerID reserved connection counts
@@ -78,8 +81,9 @@ func NewStats(exporter *servenv.Exporter) *Stats {
 			vtrpcpb.Code_UNAVAILABLE.String(),
 			vtrpcpb.Code_DATA_LOSS.String(),
 		),
-		InternalErrors:         exporter.NewCountersWithSingleLabel("InternalErrors", "Internal component errors", "type", "Task", "StrayTransactions", "Panic", "HungQuery", "Schema", "TwopcCommit", "TwopcResurrection", "WatchdogFail", "Messages"),
+		InternalErrors:         exporter.NewCountersWithSingleLabel("InternalErrors", "Internal component errors", "type", "Task", "StrayTransactions", "Panic", "HungQuery", "Schema", "TwopcCommit", "TwopcResurrection", "TwopcResolve", "WatchdogFail", "Messages"),
 		Warnings:               exporter.NewCountersWithSingleLabel("Warnings", "Warnings", "type", "ResultsExceeded"),
+		LowPriorityShed:        exporter.NewCountersWithSingleLabel("LowPriorityShed", "Low priority requests shed under pressure", "source"),
 		Unresolved:             exporter.NewGaugesWithSingleLabel("Unresolved", "Unresolved items", "item_type", "Prepares"),
 		UserTableQueryCount:    exporter.NewCountersWithMultiLabels("UserTableQueryCount", "Queries received for each CallerID/table combination", []string{"TableName", "CallerID", "Type"}),
 		UserTableQueryTimesNs:  exporter.NewCountersWithMultiLabels("UserTableQueryTimesNs", "Total latency for each CallerID/table combination", []string{"TableName", "CallerID", "Type"}),
@@ -89,11 +93,13 @@ func NewStats(exporter *servenv.Exporter) *Stats {
 		TableaclAllowed:        exporter.NewCountersWithMultiLabels("TableACLAllowed", "ACL acceptances", []string{"TableName", "TableGroup", "PlanID", "Username"}),
 		TableaclDenied:         exporter.NewCountersWithMultiLabels("TableACLDenied", "ACL denials", []string{"TableName", "TableGroup", "PlanID", "Username"}),
 		TableaclPseudoDenied:   exporter.NewCountersWithMultiLabels("TableACLPseudoDenied", "ACL pseudodenials", []string{"TableName", "TableGroup", "PlanID", "Username"}),
+		TableaclRowFiltered:    exporter.NewCountersWithMultiLabels("TableACLRowFiltered", "Queries restricted by ACL row filters", []string{"PlanID", "Username"}),
 
 		UserActiveReservedCount: exporter.NewCountersWithSingleLabel("UserActiveReservedCount", "active reserved connection for each CallerID", "CallerID"),
 		UserReservedCount:       exporter.NewCountersWithSingleLabel("UserReservedCount", "reserved connection received for each CallerID", "CallerID"),
 		UserReservedTimesNs:     exporter.NewCountersWithSingleLabel("UserReservedTimesNs", "Total reserved connection latency for each CallerID", "CallerID"),
 	}
 	stats.QPSRates = exporter.NewRates("QPS", stats.QueryTimings, 15*60/5, 5*time.Second)
+	stats.ErrorRates = exporter.NewRates("ErrorRates", stats.ErrorCounters, 15*60/5, 5*time.Second)
 	return stats
 }
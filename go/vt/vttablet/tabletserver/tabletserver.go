@@ -36,6 +36,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/textutil"
 	"vitess.io/vitess/go/tb"
 	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/callerid"
@@ -73,6 +74,10 @@ var logPoolFull = logutil.NewThrottledLogger("PoolFull", 1*time.Minute)
 
 var logComputeRowSerializerKey = logutil.NewThrottledLogger("ComputeRowSerializerKey", 1*time.Minute)
 
+// lowPriorityAppName is the throttler app name of the LOW priority requests.
+// Throttling this app sheds all of them.
+const lowPriorityAppName = "low-priority-queries"
+
 // TabletServer implements the RPC interface for the query service.
 // TabletServer is initialized in the following sequence:
 // NewTabletServer->InitDBConfig->SetServingType.
@@ -169,6 +174,7 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 	tsv.qe = NewQueryEngine(tsv, tsv.se)
 	tsv.txThrottler = txthrottler.NewTxThrottler(tsv.config, topoServer)
 	tsv.te = NewTxEngine(tsv)
+	tsv.te.dialParticipant = newParticipantDialer(topoServer)
 	tsv.messager = messager.NewEngine(tsv, tsv.se, tsv.vstreamer)
 
 	tabletTypeFunc := func() topodatapb.TabletType {
@@ -332,6 +338,11 @@ func (tsv *TabletServer) InitACL(tableACLConfigFile string, enforceTableACLConfi
 	}
 }
 
+// CurrentTarget returns the current target of the tabletserver.
+func (tsv *TabletServer) CurrentTarget() querypb.Target {
+	return tsv.sm.Target()
+}
+
 // SetServingType changes the serving type of the tabletserver. It starts or
 // stops internal services as deemed necessary.
 // Returns true if the state of QueryService or the tablet type changed.
@@ -1030,6 +1041,7 @@ func (tsv *TabletServer) MessageAck(ctx context.Context, target *querypb.Target,
 		return 0, err
 	}
 	messager.MessageStats.Add([]string{name, "Acked"}, count)
+	tsv.messager.MessagesAcked(name, sids)
 	return count, nil
 }
 
@@ -1049,14 +1061,35 @@ func (tsv *TabletServer) PurgeMessages(ctx context.Context, target *querypb.Targ
 	})
 }
 
+// DeadLetterMessages moves the list of messages for a given message table
+// to its dead letter table, or marks them failed if it has none.
+// It returns the number of messages successfully dead-lettered.
+func (tsv *TabletServer) DeadLetterMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (count int64, err error) {
+	return tsv.execDMLs(ctx, target, func() ([]*querypb.BoundQuery, error) {
+		return tsv.messager.GenerateDeadLetterQueries(name, ids)
+	})
+}
+
 func (tsv *TabletServer) execDML(ctx context.Context, target *querypb.Target, queryGenerator func() (string, map[string]*querypb.BindVariable, error)) (count int64, err error) {
+	return tsv.execDMLs(ctx, target, func() ([]*querypb.BoundQuery, error) {
+		query, bv, err := queryGenerator()
+		if err != nil {
+			return nil, err
+		}
+		return []*querypb.BoundQuery{{Sql: query, BindVariables: bv}}, nil
+	})
+}
+
+// execDMLs executes the generated queries in a transaction, and returns
+// the number of rows affected by the last one.
+func (tsv *TabletServer) execDMLs(ctx context.Context, target *querypb.Target, queryGenerator func() ([]*querypb.BoundQuery, error)) (count int64, err error) {
 	if err = tsv.sm.StartRequest(ctx, target, false /* allowOnShutdown */); err != nil {
 		return 0, err
 	}
 	defer tsv.sm.EndRequest()
 	defer tsv.handlePanicAndSendLogStats("ack", nil, nil)
 
-	query, bv, err := queryGenerator()
+	queries, err := queryGenerator()
 	if err != nil {
 		return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
 	}
@@ -1072,15 +1105,21 @@ func (tsv *TabletServer) execDML(ctx context.Context, target *querypb.Target, qu
 			tsv.Rollback(ctx, target, transactionID)
 		}
 	}()
-	qr, err := tsv.Execute(ctx, target, query, bv, transactionID, 0, nil)
-	if err != nil {
-		return 0, err
+	var qr *sqltypes.Result
+	for _, query := range queries {
+		qr, err = tsv.Execute(ctx, target, query.Sql, query.BindVariables, transactionID, 0, nil)
+		if err != nil {
+			return 0, err
+		}
 	}
 	if _, err = tsv.Commit(ctx, target, transactionID); err != nil {
 		transactionID = 0
 		return 0, err
 	}
 	transactionID = 0
+	if qr == nil {
+		return 0, nil
+	}
 	return int64(qr.RowsAffected), nil
 }
 
@@ -1234,6 +1273,13 @@ func (tsv *TabletServer) execRequest(
 		tsv.sm.EndRequest()
 	}()
 
+	if options.GetPriority() == querypb.ExecuteOptions_LOW {
+		if err = tsv.checkLowPriority(ctx); err != nil {
+			return tsv.convertAndLogError(ctx, sql, bindVariables, err, logStats)
+		}
+		ctx = tabletenv.WithPriority(ctx, querypb.ExecuteOptions_LOW)
+	}
+
 	err = exec(ctx, logStats)
 	if err != nil {
 		return tsv.convertAndLogError(ctx, sql, bindVariables, err, logStats)
@@ -1591,6 +1637,7 @@ func (tsv *TabletServer) registerThrottlerCheckHandler() {
 		}
 		flags := &throttle.CheckFlags{
 			LowPriority: (r.URL.Query().Get("p") == "low"),
+			Metrics:     textutil.SplitDelimitedList(r.URL.Query().Get("m")),
 		}
 		checkResult := tsv.lagThrottler.Check(ctx, appName, remoteAddr, flags)
 		if checkResult.StatusCode == http.StatusNotFound && flags.OKIfNotExists {
@@ -1759,6 +1806,18 @@ func queryAsString(sql string, bindVariables map[string]*querypb.BindVariable) s
 	return buf.String()
 }
 
+// checkLowPriority sheds a LOW priority request while the tablet throttler
+// throttles, or denies low priority apps because it recently throttled others.
+func (tsv *TabletServer) checkLowPriority(ctx context.Context) error {
+	checkResult := tsv.lagThrottler.Check(ctx, lowPriorityAppName, "", &throttle.CheckFlags{LowPriority: true})
+	switch checkResult.StatusCode {
+	case http.StatusTooManyRequests, http.StatusExpectationFailed:
+		tsv.stats.LowPriorityShed.Add("Throttler", 1)
+		return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "low priority request shed by the throttler")
+	}
+	return nil
+}
+
 // withTimeout returns a context based on the specified timeout.
 // If the context is local or if timeout is 0, the
 // original context is returned as is.
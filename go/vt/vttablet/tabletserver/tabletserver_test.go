@@ -377,6 +377,40 @@ func TestTabletServerBeginFail(t *testing.T) {
 	require.EqualError(t, err, "transaction pool aborting request due to already expired context", "Begin err")
 }
 
+func TestTabletServerLowPriority(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.TxPool.Size = 1
+	db, tsv := setupTabletServerTestCustom(t, config, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	lowPriority := &querypb.ExecuteOptions{Priority: querypb.ExecuteOptions_LOW}
+	transactionID, _, err := tsv.Begin(ctx, &target, nil)
+	require.NoError(t, err)
+
+	// a low priority transaction doesn't wait for the exhausted pool
+	_, _, err = tsv.Begin(ctx, &target, lowPriority)
+	require.EqualError(t, err, "pool TransactionPool exhausted, low priority request shed")
+	assert.EqualValues(t, 1, tsv.stats.LowPriorityShed.Counts()["TransactionPool"])
+
+	_, err = tsv.Rollback(ctx, &target, transactionID)
+	require.NoError(t, err)
+	transactionID, _, err = tsv.Begin(ctx, &target, lowPriority)
+	require.NoError(t, err)
+	_, err = tsv.Rollback(ctx, &target, transactionID)
+	require.NoError(t, err)
+
+	// low priority queries are shed while the throttler throttles them
+	config.EnableLagThrottler = true
+	tsv.lagThrottler.ThrottleApp(lowPriorityAppName, time.Now().Add(time.Hour), 1)
+	_, err = tsv.Execute(ctx, &target, "select 42", nil, 0, 0, lowPriority)
+	require.EqualError(t, err, "low priority request shed by the throttler")
+	assert.EqualValues(t, 1, tsv.stats.LowPriorityShed.Counts()["Throttler"])
+	_, err = tsv.Execute(ctx, &target, "select 42", nil, 0, 0, nil)
+	require.NoError(t, err)
+}
+
 func TestTabletServerCommitTransaction(t *testing.T) {
 	db, tsv := setupTabletServerTest(t, "")
 	defer tsv.StopService()
@@ -1661,6 +1695,22 @@ func TestPurgeMessages(t *testing.T) {
 	}
 }
 
+func TestDeadLetterMessages(t *testing.T) {
+	_, tsv, db := newTestTxExecutor(t)
+	defer db.Close()
+	defer tsv.StopService()
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+
+	_, err := tsv.DeadLetterMessages(ctx, &target, "nonmsg", []string{"1", "2"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "message table nonmsg not found in schema")
+
+	db.AddQuery("update msg set time_next = null where id in ('1', '2') and time_acked is null limit 10001", &sqltypes.Result{RowsAffected: 2})
+	count, err := tsv.DeadLetterMessages(ctx, &target, "msg", []string{"1", "2"})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+}
+
 func TestHandleExecUnknownError(t *testing.T) {
 	logStats := tabletenv.NewLogStats(ctx, "TestHandleExecError")
 	config := tabletenv.NewDefaultConfig()
@@ -79,3 +79,18 @@ func NewSimpleMetricResult(value float64) MetricResult {
 func (metricResult *simpleMetricResult) Get() (float64, error) {
 	return metricResult.Value, nil
 }
+
+// errorMetricResult is a result indicating the metric could not be read
+type errorMetricResult struct {
+	err error
+}
+
+// NewErrorMetricResult creates an errorMetricResult
+func NewErrorMetricResult(err error) MetricResult {
+	return &errorMetricResult{err: err}
+}
+
+// Get implements MetricResult
+func (metricResult *errorMetricResult) Get() (float64, error) {
+	return 0, metricResult.err
+}
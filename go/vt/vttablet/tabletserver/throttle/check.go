@@ -33,6 +33,7 @@ type CheckFlags struct {
 	OverrideThreshold float64
 	LowPriority       bool
 	OKIfNotExists     bool
+	Metrics           []string // names of metrics to check, e.g. "lag", "threads_running"; empty means "lag"
 }
 
 // StandardCheckFlags have no special hints
@@ -109,6 +110,12 @@ func (check *ThrottlerCheck) Check(ctx context.Context, appName string, storeTyp
 				return check.throttler.getMySQLClusterMetrics(ctx, storeName)
 			}
 		}
+	case osStoreType:
+		{
+			metricResultFunc = func() (metricResult base.MetricResult, threshold float64) {
+				return check.throttler.getOSMetrics(ctx, storeName)
+			}
+		}
 	}
 	if metricResultFunc == nil {
 		return NoSuchMetricCheckResult
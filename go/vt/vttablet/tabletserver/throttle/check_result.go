@@ -19,6 +19,8 @@ type CheckResult struct {
 	Threshold  float64 `json:"Threshold"`
 	Error      error   `json:"-"`
 	Message    string  `json:"Message"`
+
+	Metrics map[string]*CheckResult `json:"Metrics,omitempty"` // per-metric results, when checking multiple metrics
 }
 
 // NewCheckResult returns a CheckResult
@@ -0,0 +1,176 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/textutil"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/base"
+)
+
+const (
+	// LagMetricName is the name of the replication lag metric, which is always collected
+	LagMetricName = "lag"
+	// CustomMetricName is the name of the metric collected by -throttle_custom_query
+	CustomMetricName = "custom"
+
+	threadsRunningMetricName    = "threads_running"
+	historyListLengthMetricName = "history_list_length"
+	loadAvgMetricName           = "loadavg"
+
+	mysqlStoreType = "mysql"
+	osStoreType    = "os"
+
+	loadAvgFile = "/proc/loadavg"
+)
+
+var throttleMetrics = flag.String("throttle_metrics", "", "Comma separated list of additional metrics collected by the throttler, each with its threshold. Supported: threads_running, history_list_length, loadavg (per CPU). example: 'threads_running=100,loadavg=1.5'")
+var throttleCustomQuery = flag.String("throttle_custom_query", "", "Query collected by the throttler as the 'custom' metric. Either a 'select' returning a single numeric value, or a 'show global' returning a name and a value. The query runs as the vt_tablet_throttler user: a 'select' can only read _vt.heartbeat, information_schema (for which the user is granted PROCESS), and performance_schema.global_status and global_variables")
+var throttleCustomQueryThreshold = flag.Float64("throttle_custom_query_threshold", 0, "Threshold for the 'custom' throttler metric")
+
+// mysqlMetricQueries maps the built-in MySQL metrics onto the queries that probe them
+var mysqlMetricQueries = map[string]string{
+	threadsRunningMetricName:    `show global status like 'threads_running'`,
+	historyListLengthMetricName: `select count from information_schema.innodb_metrics where name='trx_rseg_history_len'`,
+}
+
+// metricDefinition describes a metric the throttler collects, where it is collected from,
+// and the threshold beyond which apps are throttled
+type metricDefinition struct {
+	storeType string
+	storeName string
+	query     string
+	threshold float64
+	// needsProcess is set if the query requires the PROCESS privilege,
+	// which the throttler user is then granted.
+	needsProcess bool
+}
+
+// fullName returns the name under which the metric is aggregated, e.g. "mysql/local"
+func (def *metricDefinition) fullName() string {
+	return fmt.Sprintf("%s/%s", def.storeType, def.storeName)
+}
+
+// parseMetricDefinitions builds the definitions of all collected metrics, mapped by the name
+// by which apps request them. Replication lag is always included.
+func parseMetricDefinitions(lagThreshold float64, metricsList string, customQuery string, customThreshold float64) (map[string]*metricDefinition, error) {
+	defs := map[string]*metricDefinition{
+		LagMetricName: {storeType: mysqlStoreType, storeName: localStoreName, query: replicationLagQuery, threshold: lagThreshold},
+	}
+	for _, token := range textutil.SplitDelimitedList(metricsList) {
+		parts := strings.SplitN(token, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("expected <metric>=<threshold> in throttler metric: %s", token)
+		}
+		name := strings.ToLower(strings.TrimSpace(parts[0]))
+		threshold, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+		if err != nil || threshold <= 0 {
+			return nil, fmt.Errorf("invalid threshold for throttler metric %s: %s", name, parts[1])
+		}
+		if _, ok := defs[name]; ok {
+			return nil, fmt.Errorf("duplicate throttler metric: %s", name)
+		}
+		switch {
+		case name == loadAvgMetricName:
+			defs[name] = &metricDefinition{storeType: osStoreType, storeName: name, threshold: threshold}
+		case mysqlMetricQueries[name] != "":
+			needsProcess, err := checkMetricQuery(mysqlMetricQueries[name])
+			if err != nil {
+				return nil, err
+			}
+			defs[name] = &metricDefinition{storeType: mysqlStoreType, storeName: name, query: mysqlMetricQueries[name], threshold: threshold, needsProcess: needsProcess}
+		default:
+			return nil, fmt.Errorf("unknown throttler metric: %s", name)
+		}
+	}
+	if customQuery != "" {
+		lowerQuery := strings.ToLower(strings.TrimSpace(customQuery))
+		if !strings.HasPrefix(lowerQuery, "select") && !strings.HasPrefix(lowerQuery, "show global") {
+			return nil, fmt.Errorf("unsupported throttler custom query: %s", customQuery)
+		}
+		if customThreshold <= 0 {
+			return nil, fmt.Errorf("throttler custom query requires a positive threshold")
+		}
+		needsProcess, err := checkMetricQuery(strings.TrimSpace(customQuery))
+		if err != nil {
+			return nil, err
+		}
+		defs[CustomMetricName] = &metricDefinition{storeType: mysqlStoreType, storeName: CustomMetricName, query: strings.TrimSpace(customQuery), threshold: customThreshold, needsProcess: needsProcess}
+	}
+	return defs, nil
+}
+
+// checkMetricQuery makes sure that the throttler user can run a metric query.
+// The user is granted SELECT on _vt.heartbeat only, plus PROCESS if a query
+// reads from information_schema, which is returned in needsProcess. The
+// global status and variables need no privilege.
+func checkMetricQuery(query string) (needsProcess bool, err error) {
+	if strings.HasPrefix(strings.ToLower(query), "show global") {
+		return false, nil
+	}
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return false, vterrors.Wrapf(err, "invalid throttler query: %s", query)
+	}
+	err = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		tableExpr, ok := node.(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return true, nil
+		}
+		tableName, ok := tableExpr.Expr.(sqlparser.TableName)
+		if !ok {
+			return true, nil
+		}
+		schema, table := tableName.Qualifier.String(), tableName.Name.String()
+		switch {
+		case schema == "" && strings.EqualFold(table, "dual"):
+		case strings.EqualFold(schema, "_vt") && strings.EqualFold(table, "heartbeat"):
+		case strings.EqualFold(schema, "information_schema"):
+			needsProcess = true
+		case strings.EqualFold(schema, "performance_schema") && (strings.EqualFold(table, "global_status") || strings.EqualFold(table, "global_variables")):
+		default:
+			return false, fmt.Errorf("throttler user %s can't read %s in query: %s", throttlerUser, sqlparser.String(tableName), query)
+		}
+		return true, nil
+	}, stmt)
+	return needsProcess, err
+}
+
+// readLoadAvg returns the 1 minute load average of this host, divided by the number of CPUs
+func readLoadAvg() base.MetricResult {
+	content, err := ioutil.ReadFile(loadAvgFile)
+	if err != nil {
+		return base.NewErrorMetricResult(err)
+	}
+	fields := strings.Fields(string(content))
+	if len(fields) == 0 {
+		return base.NewErrorMetricResult(fmt.Errorf("unexpected content in %s: %s", loadAvgFile, content))
+	}
+	loadAvg, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return base.NewErrorMetricResult(err)
+	}
+	return base.NewSimpleMetricResult(loadAvg / float64(runtime.NumCPU()))
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/base"
+)
+
+func TestParseMetricDefinitions(t *testing.T) {
+	defs, err := parseMetricDefinitions(1, "", "", 0)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]*metricDefinition{
+		LagMetricName: {storeType: mysqlStoreType, storeName: localStoreName, query: replicationLagQuery, threshold: 1},
+	}, defs)
+
+	defs, err = parseMetricDefinitions(2, "threads_running=100, history_list_length=50000,LoadAvg=1.5", "show global status like 'threads_connected'", 500)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]*metricDefinition{
+		LagMetricName:               {storeType: mysqlStoreType, storeName: localStoreName, query: replicationLagQuery, threshold: 2},
+		threadsRunningMetricName:    {storeType: mysqlStoreType, storeName: threadsRunningMetricName, query: mysqlMetricQueries[threadsRunningMetricName], threshold: 100},
+		historyListLengthMetricName: {storeType: mysqlStoreType, storeName: historyListLengthMetricName, query: mysqlMetricQueries[historyListLengthMetricName], threshold: 50000, needsProcess: true},
+		loadAvgMetricName:           {storeType: osStoreType, storeName: loadAvgMetricName, threshold: 1.5},
+		CustomMetricName:            {storeType: mysqlStoreType, storeName: CustomMetricName, query: "show global status like 'threads_connected'", threshold: 500},
+	}, defs)
+	assert.Equal(t, "os/loadavg", defs[loadAvgMetricName].fullName())
+
+	invalid := []struct {
+		metrics         string
+		customQuery     string
+		customThreshold float64
+		err             string
+	}{
+		{metrics: "threads_running", err: "expected <metric>=<threshold> in throttler metric: threads_running"},
+		{metrics: "threads_running=x", err: "invalid threshold for throttler metric threads_running: x"},
+		{metrics: "threads_running=-1", err: "invalid threshold for throttler metric threads_running: -1"},
+		{metrics: "threads_running=1,threads_running=2", err: "duplicate throttler metric: threads_running"},
+		{metrics: "lag=2", err: "duplicate throttler metric: lag"},
+		{metrics: "disk=2", err: "unknown throttler metric: disk"},
+		{customQuery: "delete from t", customThreshold: 1, err: "unsupported throttler custom query: delete from t"},
+		{customQuery: "select 1", err: "throttler custom query requires a positive threshold"},
+		{customQuery: "select count(*) from t", customThreshold: 1, err: "throttler user vt_tablet_throttler can't read t in query: select count(*) from t"},
+		{customQuery: "select count(*) from _vt.vreplication", customThreshold: 1, err: "throttler user vt_tablet_throttler can't read _vt.vreplication in query: select count(*) from _vt.vreplication"},
+		{customQuery: "select max(ts) from _vt.heartbeat where ts > (select min(id) from mysql.user)", customThreshold: 1, err: "throttler user vt_tablet_throttler can't read mysql.user in query: select max(ts) from _vt.heartbeat where ts > (select min(id) from mysql.user)"},
+		{customQuery: "select from", customThreshold: 1, err: "invalid throttler query: select from: syntax error at position 12 near 'from'"},
+	}
+	for _, tcase := range invalid {
+		_, err := parseMetricDefinitions(1, tcase.metrics, tcase.customQuery, tcase.customThreshold)
+		assert.EqualError(t, err, tcase.err, tcase.metrics)
+	}
+}
+
+func TestCheckMetricQuery(t *testing.T) {
+	testcases := []struct {
+		query        string
+		needsProcess bool
+	}{
+		{query: "show global status like 'threads_connected'"},
+		{query: "select 1"},
+		{query: "select unix_timestamp(now(6))-max(ts/1000000000) from _vt.heartbeat"},
+		{query: "select variable_value from performance_schema.global_status where variable_name = 'threads_connected'"},
+		{query: "select count(*) from information_schema.processlist where command != 'Sleep'", needsProcess: true},
+		{query: "select h.ts from _vt.heartbeat as h join information_schema.innodb_metrics as m", needsProcess: true},
+	}
+	for _, tcase := range testcases {
+		needsProcess, err := checkMetricQuery(tcase.query)
+		require.NoError(t, err, tcase.query)
+		assert.Equal(t, tcase.needsProcess, needsProcess, tcase.query)
+	}
+}
+
+func newTestThrottler(t *testing.T, metrics string) *Throttler {
+	config := tabletenv.NewDefaultConfig()
+	config.EnableLagThrottler = true
+	throttler := &Throttler{
+		env:                                tabletenv.NewEnv(config, "ThrottlerTest"),
+		throttledApps:                      cache.New(cache.NoExpiration, 10*time.Second),
+		mysqlClusterThresholds:             cache.New(cache.NoExpiration, 0),
+		aggregatedMetrics:                  cache.New(aggregatedMetricsExpiration, aggregatedMetricsCleanup),
+		recentApps:                         cache.New(recentAppsExpiration, time.Minute),
+		metricsHealth:                      cache.New(cache.NoExpiration, 0),
+		nonLowPriorityAppRequestsThrottled: cache.New(nonDeprioritizedAppMapExpiration, nonDeprioritizedAppMapInterval),
+	}
+	defs, err := parseMetricDefinitions(1, metrics, "", 0)
+	require.NoError(t, err)
+	throttler.metrics = defs
+	for _, def := range defs {
+		if def.storeType == mysqlStoreType {
+			throttler.mysqlClusterThresholds.Set(def.storeName, def.threshold, cache.DefaultExpiration)
+		}
+	}
+	throttler.check = NewThrottlerCheck(throttler)
+	return throttler
+}
+
+func TestCheckMetrics(t *testing.T) {
+	ctx := context.Background()
+	throttler := newTestThrottler(t, "threads_running=100,loadavg=2")
+	throttler.aggregatedMetrics.Set("mysql/local", base.NewSimpleMetricResult(0.5), cache.DefaultExpiration)
+	throttler.aggregatedMetrics.Set("mysql/threads_running", base.NewSimpleMetricResult(150), cache.DefaultExpiration)
+	throttler.aggregatedMetrics.Set("os/loadavg", base.NewSimpleMetricResult(1.2), cache.DefaultExpiration)
+
+	// default is replication lag
+	result := throttler.Check(ctx, "test", "", &CheckFlags{})
+	assert.Equal(t, http.StatusOK, result.StatusCode)
+	assert.Equal(t, 0.5, result.Value)
+	assert.Equal(t, 1.0, result.Threshold)
+	assert.Nil(t, result.Metrics)
+
+	result = throttler.Check(ctx, "test", "", &CheckFlags{Metrics: []string{"loadavg"}})
+	assert.Equal(t, http.StatusOK, result.StatusCode)
+	assert.Equal(t, 1.2, result.Value)
+	assert.Equal(t, 2.0, result.Threshold)
+
+	result = throttler.Check(ctx, "test", "", &CheckFlags{Metrics: []string{"threads_running"}, ReadCheck: true})
+	assert.Equal(t, http.StatusTooManyRequests, result.StatusCode)
+	assert.Equal(t, 150.0, result.Value)
+	assert.Equal(t, 100.0, result.Threshold)
+
+	result = throttler.Check(ctx, "test", "", &CheckFlags{Metrics: []string{"history_list_length"}})
+	assert.Equal(t, http.StatusNotFound, result.StatusCode)
+
+	// the first metric which is not OK determines the result
+	result = throttler.Check(ctx, "test", "", &CheckFlags{Metrics: []string{"lag", "threads_running", "loadavg"}, ReadCheck: true})
+	assert.Equal(t, http.StatusTooManyRequests, result.StatusCode)
+	assert.Equal(t, 150.0, result.Value)
+	require.Len(t, result.Metrics, 3)
+	assert.Equal(t, http.StatusOK, result.Metrics["lag"].StatusCode)
+	assert.Equal(t, http.StatusTooManyRequests, result.Metrics["threads_running"].StatusCode)
+	assert.Equal(t, http.StatusOK, result.Metrics["loadavg"].StatusCode)
+
+	result = throttler.Check(ctx, "test", "", &CheckFlags{Metrics: []string{"lag", "loadavg"}})
+	assert.Equal(t, http.StatusOK, result.StatusCode)
+	assert.Equal(t, 0.5, result.Value)
+	assert.Len(t, result.Metrics, 2)
+}
@@ -21,6 +21,7 @@ import (
 	"vitess.io/vitess/go/timer"
 	"vitess.io/vitess/go/vt/dbconnpool"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
@@ -30,6 +31,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/config"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/mysql"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/patrickmn/go-cache"
 )
 
@@ -43,6 +45,7 @@ const (
 	aggregatedMetricsExpiration   = 5 * time.Second
 	aggregatedMetricsCleanup      = 1 * time.Second
 	throttledAppsSnapshotInterval = 5 * time.Second
+	configWatchRetryInterval      = 10 * time.Second
 	recentAppsExpiration          = time.Hour * 24
 
 	nonDeprioritizedAppMapExpiration = time.Second
@@ -57,7 +60,7 @@ const (
 	localStoreName = "local"
 )
 
-var throttleThreshold = flag.Duration("throttle_threshold", 1*time.Second, "Replication lag threshold for throttling")
+var throttleThreshold = flag.Duration("throttle_threshold", 1*time.Second, "Replication lag threshold for throttling. Overridden by the threshold of the keyspace's throttler config in topo")
 var throttleTabletTypes = flag.String("throttle_tablet_types", "replica", "Comma separated VTTablet types to be considered by the throttler. default: 'replica'. example: 'replica,rdonly'. 'replica' aways implicitly included")
 
 var (
@@ -71,6 +74,9 @@ var (
 	sqlGrantThrottlerUser = []string{
 		`GRANT SELECT ON _vt.heartbeat TO %s`,
 	}
+	// reading information_schema tables like innodb_metrics requires the PROCESS privilege
+	sqlGrantThrottlerUserProcess = `GRANT PROCESS ON *.* TO %s`
+
 	replicationLagQuery = `select unix_timestamp(now(6))-max(ts/1000000000) from _vt.heartbeat`
 )
 
@@ -94,6 +100,7 @@ type Throttler struct {
 	ts             *topo.Server
 
 	throttleTabletTypesMap map[topodatapb.TabletType]bool
+	metrics                map[string]*metricDefinition
 
 	mysqlThrottleMetricChan chan *mysql.MySQLThrottleMetric
 	mysqlInventoryChan      chan *mysql.Inventory
@@ -115,6 +122,12 @@ type Throttler struct {
 
 	nonLowPriorityAppRequestsThrottled *cache.Cache
 	httpClient                         *http.Client
+
+	// topoConfig is the keyspace's ThrottlerConfig, watched while leader.
+	// nil when the keyspace has no ThrottlerConfig.
+	topoConfig        *topodatapb.ThrottlerConfig
+	topoThrottledApps map[string]bool
+	topoConfigMutex   sync.RWMutex
 }
 
 // ThrottlerStatus published some status values from the throttler
@@ -125,6 +138,7 @@ type ThrottlerStatus struct {
 	IsLeader  bool
 	IsOpen    bool
 	IsDormant bool
+	IsEnabled bool
 
 	AggregatedMetrics map[string]base.MetricResult
 	MetricsHealth     base.MetricHealthMap
@@ -163,6 +177,7 @@ func NewThrottler(env tabletenv.Env, ts *topo.Server, tabletTypeFunc func() topo
 		httpClient: base.SetupHTTPClient(0),
 	}
 	throttler.initThrottleTabletTypes()
+	throttler.initMetrics()
 	throttler.ThrottleApp("abusing-app", time.Now().Add(time.Hour*24*365*10), defaultThrottleRatio)
 	throttler.check = NewThrottlerCheck(throttler)
 
@@ -183,6 +198,15 @@ func (throttler *Throttler) initThrottleTabletTypes() {
 	throttler.throttleTabletTypesMap[topodatapb.TabletType_REPLICA] = true
 }
 
+func (throttler *Throttler) initMetrics() {
+	metrics, err := parseMetricDefinitions(throttleThreshold.Seconds(), *throttleMetrics, *throttleCustomQuery, *throttleCustomQueryThreshold)
+	if err != nil {
+		log.Errorf("Throttler: ignoring additional metrics: %v", err)
+		metrics, _ = parseMetricDefinitions(throttleThreshold.Seconds(), "", "", 0)
+	}
+	throttler.metrics = metrics
+}
+
 // InitDBConfig initializes keyspace and shard
 func (throttler *Throttler) InitDBConfig(keyspace, shard string) {
 	throttler.keyspace = keyspace
@@ -195,19 +219,24 @@ func (throttler *Throttler) InitDBConfig(keyspace, shard string) {
 // initThrottler initializes config
 func (throttler *Throttler) initConfig(password string) {
 	log.Infof("Throttler: initializing config")
+	clusters := map[string](*config.MySQLClusterConfigurationSettings){}
+	for _, def := range throttler.metrics {
+		if def.storeType != mysqlStoreType {
+			continue
+		}
+		clusters[def.storeName] = &config.MySQLClusterConfigurationSettings{
+			User:              throttlerUser,
+			Password:          password,
+			ThrottleThreshold: def.threshold,
+			MetricQuery:       def.query,
+			IgnoreHostsCount:  0,
+		}
+	}
 	config.Instance = &config.ConfigurationSettings{
 		Stores: config.StoresSettings{
 			MySQL: config.MySQLConfigurationSettings{
 				IgnoreDialTCPErrors: true,
-				Clusters: map[string](*config.MySQLClusterConfigurationSettings){
-					localStoreName: &config.MySQLClusterConfigurationSettings{
-						User:              throttlerUser,
-						Password:          password,
-						ThrottleThreshold: throttleThreshold.Seconds(),
-						MetricQuery:       replicationLagQuery,
-						IgnoreHostsCount:  0,
-					},
-				},
+				Clusters:            clusters,
 			},
 		},
 	}
@@ -298,7 +327,14 @@ func (throttler *Throttler) createThrottlerUser(ctx context.Context) (password s
 			return password, err
 		}
 	}
-	for _, query := range sqlGrantThrottlerUser {
+	grants := sqlGrantThrottlerUser
+	for _, def := range throttler.metrics {
+		if def.needsProcess {
+			grants = append(grants, sqlGrantThrottlerUserProcess)
+			break
+		}
+	}
+	for _, query := range grants {
 		parsed := sqlparser.BuildParsedQuery(query, throttlerGrant)
 		if _, err := conn.ExecuteFetch(parsed.Query, 0, false); err != nil {
 			return password, err
@@ -340,6 +376,7 @@ func (throttler *Throttler) Operate(ctx context.Context) {
 	throttledAppsTicker := addTicker(throttledAppsSnapshotInterval)
 
 	shouldCreateThrottlerUser := false
+	var cancelConfigWatch context.CancelFunc
 	for {
 		select {
 		case <-leaderCheckTicker.C:
@@ -363,6 +400,17 @@ func (throttler *Throttler) Operate(ctx context.Context) {
 					if shouldBeLeader < throttler.isLeader {
 						log.Infof("Throttler: transition out of leadership")
 					}
+					// Close() may have revoked leadership without going through this transition,
+					// so the config watch follows shouldBeLeader rather than the transitions
+					if shouldBeLeader > 0 && cancelConfigWatch == nil {
+						var configCtx context.Context
+						configCtx, cancelConfigWatch = context.WithCancel(ctx)
+						go throttler.watchThrottlerConfig(configCtx)
+					}
+					if shouldBeLeader == 0 && cancelConfigWatch != nil {
+						cancelConfigWatch()
+						cancelConfigWatch = nil
+					}
 
 					atomic.StoreInt64(&throttler.isLeader, shouldBeLeader)
 
@@ -381,7 +429,7 @@ func (throttler *Throttler) Operate(ctx context.Context) {
 			{
 				if atomic.LoadInt64(&throttler.isLeader) > 0 {
 					// frequent
-					if !throttler.isDormant() {
+					if !throttler.isDormant() && throttler.isEnabled() {
 						throttler.collectMySQLMetrics(ctx)
 					}
 				}
@@ -390,7 +438,7 @@ func (throttler *Throttler) Operate(ctx context.Context) {
 			{
 				if atomic.LoadInt64(&throttler.isLeader) > 0 {
 					// infrequent
-					if throttler.isDormant() {
+					if throttler.isDormant() && throttler.isEnabled() {
 						throttler.collectMySQLMetrics(ctx)
 					}
 				}
@@ -428,6 +476,78 @@ func (throttler *Throttler) Operate(ctx context.Context) {
 	}
 }
 
+// watchThrottlerConfig watches the keyspace's ThrottlerConfig in topo and applies it, until ctx is done
+func (throttler *Throttler) watchThrottlerConfig(ctx context.Context) {
+	if throttler.ts == nil {
+		return
+	}
+	for {
+		current, changes, _ := throttler.ts.WatchThrottlerConfig(ctx, throttler.keyspace)
+		if current.Err != nil {
+			if topo.IsErrType(current.Err, topo.NoNode) {
+				throttler.applyThrottlerConfig(nil)
+			} else {
+				log.Errorf("Throttler: error watching ThrottlerConfig: %v", current.Err)
+			}
+		} else {
+			throttler.applyThrottlerConfig(current.Value)
+			for wd := range changes {
+				if wd.Err != nil {
+					if topo.IsErrType(wd.Err, topo.NoNode) {
+						throttler.applyThrottlerConfig(nil)
+					} else if !topo.IsErrType(wd.Err, topo.Interrupted) {
+						log.Errorf("Throttler: error watching ThrottlerConfig: %v", wd.Err)
+					}
+					continue
+				}
+				throttler.applyThrottlerConfig(wd.Value)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(configWatchRetryInterval):
+		}
+	}
+}
+
+// applyThrottlerConfig applies the keyspace's ThrottlerConfig: enablement, threshold and throttled apps.
+// Apps which were throttled by a previous config and no longer are, are unthrottled.
+func (throttler *Throttler) applyThrottlerConfig(throttlerConfig *topodatapb.ThrottlerConfig) {
+	throttler.topoConfigMutex.Lock()
+	defer throttler.topoConfigMutex.Unlock()
+
+	if !proto.Equal(throttler.topoConfig, throttlerConfig) {
+		log.Infof("Throttler: applying ThrottlerConfig: %v", throttlerConfig)
+	}
+	throttler.topoConfig = throttlerConfig
+	throttledApps := make(map[string]bool)
+	for appName, rule := range throttlerConfig.GetThrottledApps() {
+		throttler.ThrottleApp(appName, logutil.ProtoToTime(rule.ExpiresAt), rule.Ratio)
+		throttledApps[appName] = true
+	}
+	for appName := range throttler.topoThrottledApps {
+		if !throttledApps[appName] {
+			throttler.UnthrottleApp(appName)
+		}
+	}
+	throttler.topoThrottledApps = throttledApps
+}
+
+// isEnabled returns false when the keyspace's ThrottlerConfig disables the throttler
+func (throttler *Throttler) isEnabled() bool {
+	throttler.topoConfigMutex.RLock()
+	defer throttler.topoConfigMutex.RUnlock()
+	return throttler.topoConfig == nil || throttler.topoConfig.Enabled
+}
+
+// topoThreshold returns the replication lag threshold set in the keyspace's ThrottlerConfig, or 0
+func (throttler *Throttler) topoThreshold() float64 {
+	throttler.topoConfigMutex.RLock()
+	defer throttler.topoConfigMutex.RUnlock()
+	return throttler.topoConfig.GetThreshold()
+}
+
 func (throttler *Throttler) collectMySQLMetrics(ctx context.Context) error {
 	// synchronously, get lists of probes
 	for clusterName, probes := range throttler.mysqlInventory.ClustersProbes {
@@ -539,6 +659,9 @@ func (throttler *Throttler) aggregateMySQLMetrics(ctx context.Context) error {
 		aggregatedMetric := aggregateMySQLProbes(ctx, probes, clusterName, throttler.mysqlInventory.InstanceKeyMetrics, ignoreHostsCount, config.Settings().Stores.MySQL.IgnoreDialTCPErrors, ignoreHostsThreshold)
 		throttler.aggregatedMetrics.Set(metricName, aggregatedMetric, cache.DefaultExpiration)
 	}
+	if def, ok := throttler.metrics[loadAvgMetricName]; ok {
+		throttler.aggregatedMetrics.Set(def.fullName(), readLoadAvg(), cache.DefaultExpiration)
+	}
 	return nil
 }
 
@@ -552,6 +675,11 @@ func (throttler *Throttler) getNamedMetric(metricName string) base.MetricResult
 func (throttler *Throttler) getMySQLClusterMetrics(ctx context.Context, clusterName string) (base.MetricResult, float64) {
 	if thresholdVal, found := throttler.mysqlClusterThresholds.Get(clusterName); found {
 		threshold, _ := thresholdVal.(float64)
+		if clusterName == localStoreName {
+			if topoThreshold := throttler.topoThreshold(); topoThreshold > 0 {
+				threshold = topoThreshold
+			}
+		}
 		metricName := fmt.Sprintf("mysql/%s", clusterName)
 		return throttler.getNamedMetric(metricName), threshold
 	}
@@ -559,6 +687,15 @@ func (throttler *Throttler) getMySQLClusterMetrics(ctx context.Context, clusterN
 	return base.NoSuchMetric, 0
 }
 
+func (throttler *Throttler) getOSMetrics(ctx context.Context, storeName string) (base.MetricResult, float64) {
+	for _, def := range throttler.metrics {
+		if def.storeType == osStoreType && def.storeName == storeName {
+			return throttler.getNamedMetric(def.fullName()), def.threshold
+		}
+	}
+	return base.NoSuchMetric, 0
+}
+
 func (throttler *Throttler) aggregatedMetricsSnapshot() map[string]base.MetricResult {
 	snapshot := make(map[string]base.MetricResult)
 	for key, value := range throttler.aggregatedMetrics.Items() {
@@ -707,12 +844,40 @@ func (throttler *Throttler) AppRequestMetricResult(ctx context.Context, appName
 	return metricResultFunc()
 }
 
-// Check is the main serving function of the throttler, and returns a check result for this cluster's lag
+// Check is the main serving function of the throttler, and returns a check result for this cluster's lag,
+// or for the metrics requested in flags. When multiple metrics are requested, the result is that of the
+// first metric which is not OK, and the per-metric results are listed in the Metrics field.
 func (throttler *Throttler) Check(ctx context.Context, appName string, remoteAddr string, flags *CheckFlags) (checkResult *CheckResult) {
-	if !throttler.env.Config().EnableLagThrottler {
+	if !throttler.env.Config().EnableLagThrottler || !throttler.isEnabled() {
 		return okMetricCheckResult
 	}
-	return throttler.check.Check(ctx, appName, "mysql", localStoreName, remoteAddr, flags)
+	metricNames := flags.Metrics
+	if len(metricNames) == 0 {
+		metricNames = []string{LagMetricName}
+	}
+	checkMetric := func(metricName string) *CheckResult {
+		def, ok := throttler.metrics[metricName]
+		if !ok {
+			return NoSuchMetricCheckResult
+		}
+		return throttler.check.Check(ctx, appName, def.storeType, def.storeName, remoteAddr, flags)
+	}
+	if len(metricNames) == 1 {
+		return checkMetric(metricNames[0])
+	}
+	metricResults := make(map[string]*CheckResult, len(metricNames))
+	var combined *CheckResult
+	for _, metricName := range metricNames {
+		metricResult := checkMetric(metricName)
+		metricResults[metricName] = metricResult
+		if combined == nil || (combined.StatusCode == http.StatusOK && metricResult.StatusCode != http.StatusOK) {
+			combined = metricResult
+		}
+	}
+	checkResult = &CheckResult{}
+	*checkResult = *combined
+	checkResult.Metrics = metricResults
+	return checkResult
 }
 
 // Status exports a status breakdown
@@ -724,6 +889,7 @@ func (throttler *Throttler) Status() *ThrottlerStatus {
 		IsLeader:  (atomic.LoadInt64(&throttler.isLeader) > 0),
 		IsOpen:    (atomic.LoadInt64(&throttler.isOpen) > 0),
 		IsDormant: throttler.isDormant(),
+		IsEnabled: throttler.isEnabled(),
 
 		AggregatedMetrics: throttler.aggregatedMetricsSnapshot(),
 		MetricsHealth:     throttler.metricsHealthSnapshot(),
@@ -0,0 +1,104 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/base"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestWatchThrottlerConfig(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	throttler := newTestThrottler(t, "")
+	throttler.ts = memorytopo.NewServer("cell1")
+	throttler.keyspace = "ks"
+	require.NoError(t, throttler.ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}))
+	throttler.aggregatedMetrics.Set("mysql/local", base.NewSimpleMetricResult(1.5), cache.DefaultExpiration)
+
+	updateConfig := func(update func(*topodatapb.ThrottlerConfig)) {
+		_, err := throttler.ts.UpdateThrottlerConfigFields(ctx, "ks", func() *topodatapb.ThrottlerConfig {
+			return &topodatapb.ThrottlerConfig{Enabled: true}
+		}, func(config *topodatapb.ThrottlerConfig) error {
+			update(config)
+			return nil
+		})
+		require.NoError(t, err)
+	}
+	waitForStatus := func(appName string, statusCode int) {
+		t.Helper()
+		deadline := time.Now().Add(5 * time.Second)
+		for {
+			result := throttler.Check(ctx, appName, "", &CheckFlags{ReadCheck: true})
+			if result.StatusCode == statusCode {
+				return
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("timed out waiting for status %d for app %s, last result: %+v", statusCode, appName, result)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	// without a ThrottlerConfig, the -throttle_threshold flag applies
+	go throttler.watchThrottlerConfig(ctx)
+	waitForStatus("app1", http.StatusTooManyRequests)
+	assert.True(t, throttler.isEnabled())
+
+	updateConfig(func(config *topodatapb.ThrottlerConfig) {
+		config.Threshold = 2
+	})
+	waitForStatus("app1", http.StatusOK)
+
+	updateConfig(func(config *topodatapb.ThrottlerConfig) {
+		config.ThrottledApps = map[string]*topodatapb.ThrottledAppRule{
+			"app2": {Name: "app2", Ratio: 1, ExpiresAt: logutil.TimeToProto(time.Now().Add(time.Hour))},
+		}
+	})
+	waitForStatus("app2", http.StatusExpectationFailed)
+	waitForStatus("app1", http.StatusOK)
+
+	updateConfig(func(config *topodatapb.ThrottlerConfig) {
+		config.Threshold = 1
+		config.ThrottledApps = nil
+	})
+	waitForStatus("app2", http.StatusTooManyRequests)
+
+	updateConfig(func(config *topodatapb.ThrottlerConfig) {
+		config.Enabled = false
+	})
+	waitForStatus("app1", http.StatusOK)
+	assert.False(t, throttler.isEnabled())
+
+	// deleting the ThrottlerConfig restores the flags
+	require.NoError(t, throttler.ts.DeleteThrottlerConfig(ctx, "ks"))
+	waitForStatus("app1", http.StatusTooManyRequests)
+	assert.True(t, throttler.isEnabled())
+}
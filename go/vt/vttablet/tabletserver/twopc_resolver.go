@@ -0,0 +1,232 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/dtids"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// participantDialer returns a connection to the master of a participant
+// of a distributed transaction. The caller must close the connection.
+type participantDialer func(ctx context.Context, target *querypb.Target) (queryservice.QueryService, error)
+
+// newParticipantDialer returns a participantDialer that finds
+// the masters through the topo.
+func newParticipantDialer(ts *topo.Server) participantDialer {
+	return func(ctx context.Context, target *querypb.Target) (queryservice.QueryService, error) {
+		si, err := ts.GetShard(ctx, target.Keyspace, target.Shard)
+		if err != nil {
+			return nil, err
+		}
+		if si.MasterAlias == nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "shard %s/%s has no master", target.Keyspace, target.Shard)
+		}
+		ti, err := ts.GetTablet(ctx, si.MasterAlias)
+		if err != nil {
+			return nil, err
+		}
+		return tabletconn.GetDialer()(ti.Tablet, grpcclient.FailFast(false))
+	}
+}
+
+// dtResolver resolves the abandoned distributed transactions whose
+// metadata is managed by this tablet. It does the same work as the
+// coordinator: a transaction that's still in the PREPARE state is
+// rolled back, and the participants of a transaction that was decided
+// are driven to the decision. The metadata is then concluded, after
+// the conclude delay if there's one. Until then, the transaction is
+// still visible in /twopcz.
+type dtResolver struct {
+	te            *TxEngine
+	concludeDelay time.Duration
+
+	// resolved contains the transactions that are waiting to be
+	// concluded, and when they were resolved.
+	mu       sync.Mutex
+	resolved map[string]time.Time
+
+	resolutions *stats.CountersWithSingleLabel
+	concluded   *stats.Counter
+}
+
+func newDTResolver(te *TxEngine, concludeDelay time.Duration) *dtResolver {
+	dr := &dtResolver{
+		te:            te,
+		concludeDelay: concludeDelay,
+		resolved:      make(map[string]time.Time),
+	}
+	exporter := te.env.Exporter()
+	dr.resolutions = exporter.NewCountersWithSingleLabel("TwopcResolutions", "Abandoned distributed transactions resolved by the tablet", "decision", "Commit", "Rollback")
+	dr.concluded = exporter.NewCounter("TwopcConcluded", "Distributed transactions concluded by the tablet")
+	exporter.NewGaugeFunc("TwopcPendingConclusion", "Resolved distributed transactions waiting for the conclude delay", dr.pendingConclusion)
+	return dr
+}
+
+// resolve resolves the abandoned transactions, which were read
+// from the metadata.
+func (dr *dtResolver) resolve(ctx context.Context, abandoned map[string]time.Time) {
+	// Forget the transactions that were concluded by someone else.
+	dr.mu.Lock()
+	for dtid := range dr.resolved {
+		if _, ok := abandoned[dtid]; !ok {
+			delete(dr.resolved, dtid)
+		}
+	}
+	dr.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for dtid := range abandoned {
+		wg.Add(1)
+		go func(dtid string) {
+			defer wg.Done()
+			if err := dr.resolveOne(ctx, dtid); err != nil {
+				dr.te.env.Stats().InternalErrors.Add("TwopcResolve", 1)
+				log.Errorf("Error resolving dtid %s: %v", dtid, err)
+			}
+		}(dtid)
+	}
+	wg.Wait()
+}
+
+func (dr *dtResolver) resolveOne(ctx context.Context, dtid string) error {
+	dr.mu.Lock()
+	resolvedAt, ok := dr.resolved[dtid]
+	dr.mu.Unlock()
+	if ok {
+		if time.Since(resolvedAt) < dr.concludeDelay {
+			return nil
+		}
+		return dr.conclude(ctx, dtid)
+	}
+
+	txe := &TxExecutor{
+		ctx:      ctx,
+		logStats: tabletenv.NewLogStats(ctx, "DTResolver"),
+		te:       dr.te,
+	}
+	transaction, err := txe.ReadTransaction(dtid)
+	if err != nil {
+		return err
+	}
+	if transaction.Dtid == "" {
+		// It was already concluded.
+		return nil
+	}
+	var decision string
+	switch transaction.State {
+	case querypb.TransactionState_PREPARE:
+		// If state is PREPARE, make a decision to rollback and
+		// fallthrough to the rollback workflow. The original
+		// transaction of this shard is rolled back too.
+		originalID, err := dtids.TransactionID(dtid)
+		if err != nil {
+			return err
+		}
+		if err := txe.SetRollback(dtid, originalID); err != nil {
+			return err
+		}
+		fallthrough
+	case querypb.TransactionState_ROLLBACK:
+		err = dr.runParticipants(ctx, transaction.Participants, func(qs queryservice.QueryService, target *querypb.Target) error {
+			return qs.RollbackPrepared(ctx, target, dtid, 0)
+		})
+		decision = "Rollback"
+	case querypb.TransactionState_COMMIT:
+		err = dr.runParticipants(ctx, transaction.Participants, func(qs queryservice.QueryService, target *querypb.Target) error {
+			return qs.CommitPrepared(ctx, target, dtid)
+		})
+		decision = "Commit"
+	default:
+		// Should never happen.
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "invalid state: %v", transaction.State)
+	}
+	if err != nil {
+		return err
+	}
+	dr.resolutions.Add(decision, 1)
+	log.Infof("Resolved abandoned dtid %s: %s", dtid, decision)
+
+	if dr.concludeDelay == 0 {
+		return dr.conclude(ctx, dtid)
+	}
+	dr.mu.Lock()
+	dr.resolved[dtid] = time.Now()
+	dr.mu.Unlock()
+	return nil
+}
+
+// runParticipants executes the action on all participants in parallel
+// and returns a consolidated error.
+func (dr *dtResolver) runParticipants(ctx context.Context, participants []*querypb.Target, action func(queryservice.QueryService, *querypb.Target) error) error {
+	allErrors := new(concurrency.AllErrorRecorder)
+	var wg sync.WaitGroup
+	for _, target := range participants {
+		wg.Add(1)
+		go func(target *querypb.Target) {
+			defer wg.Done()
+			qs, err := dr.te.dialParticipant(ctx, target)
+			if err != nil {
+				allErrors.RecordError(err)
+				return
+			}
+			defer qs.Close(ctx)
+			if err := action(qs, target); err != nil {
+				allErrors.RecordError(err)
+			}
+		}(target)
+	}
+	wg.Wait()
+	return allErrors.AggrError(vterrors.Aggregate)
+}
+
+func (dr *dtResolver) conclude(ctx context.Context, dtid string) error {
+	txe := &TxExecutor{
+		ctx:      ctx,
+		logStats: tabletenv.NewLogStats(ctx, "DTResolver"),
+		te:       dr.te,
+	}
+	if err := txe.ConcludeTransaction(dtid); err != nil {
+		return err
+	}
+	dr.mu.Lock()
+	delete(dr.resolved, dtid)
+	dr.mu.Unlock()
+	dr.concluded.Add(1)
+	return nil
+}
+
+func (dr *dtResolver) pendingConclusion() int64 {
+	dr.mu.Lock()
+	defer dr.mu.Unlock()
+	return int64(len(dr.resolved))
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+const resolverDtid = "ks:0:1"
+
+// fakeParticipant records the 2PC actions executed on the participants.
+type fakeParticipant struct {
+	queryservice.QueryService
+
+	mu    sync.Mutex
+	calls []string
+	err   error
+}
+
+func (fp *fakeParticipant) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) error {
+	return fp.record(fmt.Sprintf("commit %s/%s %s", target.Keyspace, target.Shard, dtid))
+}
+
+func (fp *fakeParticipant) RollbackPrepared(ctx context.Context, target *querypb.Target, dtid string, originalID int64) error {
+	return fp.record(fmt.Sprintf("rollback %s/%s %s", target.Keyspace, target.Shard, dtid))
+}
+
+func (fp *fakeParticipant) Close(ctx context.Context) error {
+	return nil
+}
+
+func (fp *fakeParticipant) record(call string) error {
+	fp.mu.Lock()
+	defer fp.mu.Unlock()
+	fp.calls = append(fp.calls, call)
+	return fp.err
+}
+
+func (fp *fakeParticipant) Calls() []string {
+	fp.mu.Lock()
+	defer fp.mu.Unlock()
+	calls := append([]string(nil), fp.calls...)
+	sort.Strings(calls)
+	return calls
+}
+
+func newTestDTResolver(t *testing.T, concludeDelay time.Duration, state querypb.TransactionState) (*dtResolver, *fakeParticipant, *TabletServer, *fakesqldb.DB) {
+	_, tsv, db := newTestTxExecutor(t)
+	fp := &fakeParticipant{}
+	tsv.te.dialParticipant = func(ctx context.Context, target *querypb.Target) (queryservice.QueryService, error) {
+		return fp, nil
+	}
+
+	db.AddQuery("select dtid, state, time_created from _vt.dt_state where dtid = 'ks:0:1'", &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Type: sqltypes.VarBinary},
+			{Type: sqltypes.Int64},
+			{Type: sqltypes.Int64},
+		},
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewVarBinary(resolverDtid),
+			sqltypes.NewInt64(int64(state)),
+			sqltypes.NewInt64(1),
+		}},
+	})
+	db.AddQuery("select keyspace, shard from _vt.dt_participant where dtid = 'ks:0:1'", &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Type: sqltypes.VarChar},
+			{Type: sqltypes.VarChar},
+		},
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewVarBinary("ks"),
+			sqltypes.NewVarBinary("1"),
+		}, {
+			sqltypes.NewVarBinary("ks"),
+			sqltypes.NewVarBinary("2"),
+		}},
+	})
+	db.AddQuery("delete from _vt.dt_state where dtid = 'ks:0:1'", &sqltypes.Result{})
+	db.AddQuery("delete from _vt.dt_participant where dtid = 'ks:0:1'", &sqltypes.Result{})
+	return newDTResolver(tsv.te, concludeDelay), fp, tsv, db
+}
+
+func TestDTResolverCommit(t *testing.T) {
+	dr, fp, tsv, db := newTestDTResolver(t, 0, querypb.TransactionState_COMMIT)
+	defer db.Close()
+	defer tsv.StopService()
+	commits := dr.resolutions.Counts()["Commit"]
+
+	dr.resolve(ctx, map[string]time.Time{resolverDtid: time.Unix(0, 1)})
+	assert.Equal(t, []string{"commit ks/1 ks:0:1", "commit ks/2 ks:0:1"}, fp.Calls())
+	assert.Equal(t, commits+1, dr.resolutions.Counts()["Commit"])
+	assert.Equal(t, 1, db.GetQueryCalledNum("delete from _vt.dt_state where dtid = 'ks:0:1'"))
+	assert.EqualValues(t, 0, dr.pendingConclusion())
+}
+
+func TestDTResolverRollback(t *testing.T) {
+	dr, fp, tsv, db := newTestDTResolver(t, 0, querypb.TransactionState_PREPARE)
+	defer db.Close()
+	defer tsv.StopService()
+	rollbackTransition := fmt.Sprintf("update _vt.dt_state set state = %d where dtid = 'ks:0:1' and state = %d", int(querypb.TransactionState_ROLLBACK), int(querypb.TransactionState_PREPARE))
+	db.AddQuery(rollbackTransition, &sqltypes.Result{RowsAffected: 1})
+	rollbacks := dr.resolutions.Counts()["Rollback"]
+
+	// A transaction in the PREPARE state is rolled back.
+	dr.resolve(ctx, map[string]time.Time{resolverDtid: time.Unix(0, 1)})
+	assert.Equal(t, 1, db.GetQueryCalledNum(rollbackTransition))
+	assert.Equal(t, []string{"rollback ks/1 ks:0:1", "rollback ks/2 ks:0:1"}, fp.Calls())
+	assert.Equal(t, rollbacks+1, dr.resolutions.Counts()["Rollback"])
+	assert.Equal(t, 1, db.GetQueryCalledNum("delete from _vt.dt_state where dtid = 'ks:0:1'"))
+}
+
+func TestDTResolverConcludeDelay(t *testing.T) {
+	dr, fp, tsv, db := newTestDTResolver(t, time.Hour, querypb.TransactionState_COMMIT)
+	defer db.Close()
+	defer tsv.StopService()
+	concluded := dr.concluded.Get()
+	abandoned := map[string]time.Time{resolverDtid: time.Unix(0, 1)}
+
+	// The transaction is resolved, but not concluded.
+	dr.resolve(ctx, abandoned)
+	assert.Len(t, fp.Calls(), 2)
+	assert.Equal(t, 0, db.GetQueryCalledNum("delete from _vt.dt_state where dtid = 'ks:0:1'"))
+	assert.EqualValues(t, 1, dr.pendingConclusion())
+
+	// It's not resolved again while the delay is not over.
+	dr.resolve(ctx, abandoned)
+	assert.Len(t, fp.Calls(), 2)
+	assert.Equal(t, 0, db.GetQueryCalledNum("delete from _vt.dt_state where dtid = 'ks:0:1'"))
+
+	// It's concluded after the delay.
+	dr.mu.Lock()
+	dr.resolved[resolverDtid] = time.Now().Add(-2 * time.Hour)
+	dr.mu.Unlock()
+	dr.resolve(ctx, abandoned)
+	assert.Len(t, fp.Calls(), 2)
+	assert.Equal(t, 1, db.GetQueryCalledNum("delete from _vt.dt_state where dtid = 'ks:0:1'"))
+	assert.Equal(t, concluded+1, dr.concluded.Get())
+	assert.EqualValues(t, 0, dr.pendingConclusion())
+
+	// The transactions that are not abandoned any more are forgotten.
+	dr.mu.Lock()
+	dr.resolved["ks:0:2"] = time.Now()
+	dr.mu.Unlock()
+	dr.resolve(ctx, nil)
+	assert.EqualValues(t, 0, dr.pendingConclusion())
+}
+
+func TestDTResolverParticipantError(t *testing.T) {
+	dr, fp, tsv, db := newTestDTResolver(t, 0, querypb.TransactionState_COMMIT)
+	defer db.Close()
+	defer tsv.StopService()
+	fp.err = errors.New("participant error")
+	failures := tsv.stats.InternalErrors.Counts()["TwopcResolve"]
+
+	// The metadata is kept for a retry.
+	dr.resolve(ctx, map[string]time.Time{resolverDtid: time.Unix(0, 1)})
+	assert.Len(t, fp.Calls(), 2)
+	assert.Equal(t, 0, db.GetQueryCalledNum("delete from _vt.dt_state where dtid = 'ks:0:1'"))
+	assert.Equal(t, failures+1, tsv.stats.InternalErrors.Counts()["TwopcResolve"])
+	require.EqualValues(t, 0, dr.pendingConclusion())
+}
@@ -84,6 +84,12 @@ type TxEngine struct {
 	abandonAge          time.Duration
 	ticks               *timer.Timer
 
+	// dtResolver is set if abandoned transactions are resolved
+	// by the tablet instead of the coordinator. dialParticipant
+	// is used by it to reach the participants.
+	dtResolver      *dtResolver
+	dialParticipant participantDialer
+
 	// reservedConnStats keeps statistics about reserved connections
 	reservedConnStats *servenv.TimingsWrapper
 
@@ -104,7 +110,7 @@ func NewTxEngine(env tabletenv.Env) *TxEngine {
 	te.txPool = NewTxPool(env, limiter)
 	te.twopcEnabled = config.TwoPCEnable
 	if te.twopcEnabled {
-		if config.TwoPCCoordinatorAddress == "" {
+		if config.TwoPCCoordinatorAddress == "" && !config.TwoPCResolve {
 			log.Error("Coordinator address not specified: Disabling 2PC")
 			te.twopcEnabled = false
 		}
@@ -116,6 +122,9 @@ func NewTxEngine(env tabletenv.Env) *TxEngine {
 	te.coordinatorAddress = config.TwoPCCoordinatorAddress
 	te.abandonAge = config.TwoPCAbandonAge.Get()
 	te.ticks = timer.NewTimer(te.abandonAge / 2)
+	if te.twopcEnabled && config.TwoPCResolve {
+		te.dtResolver = newDTResolver(te, config.TwoPCConcludeDelay.Get())
+	}
 
 	// Set the prepared pool capacity to something lower than
 	// tx pool capacity. Those spare connections are needed to
@@ -425,7 +434,8 @@ func (te *TxEngine) rollbackPrepared() {
 }
 
 // startWatchdog starts the watchdog goroutine, which looks for abandoned
-// transactions and calls the notifier on them.
+// transactions and calls the notifier on them, or resolves them if
+// the tablet is the resolver.
 func (te *TxEngine) startWatchdog() {
 	te.ticks.Start(func() {
 		ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), te.abandonAge/4)
@@ -447,6 +457,10 @@ func (te *TxEngine) startWatchdog() {
 			log.Errorf("Error reading transactions for 2pc watchdog: %v", err)
 			return
 		}
+		if te.dtResolver != nil {
+			te.dtResolver.resolve(ctx, txs)
+			return
+		}
 		if len(txs) == 0 {
 			return
 		}
@@ -167,6 +167,24 @@ func TestTxEngineBegin(t *testing.T) {
 	require.Equal(t, "begin;commit", db.QueryLog())
 }
 
+func TestTxEngineDTResolver(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.TwoPCEnable = true
+	config.TwoPCAbandonAge = 10
+
+	// Without a coordinator, 2PC needs the tablet to resolve.
+	te := NewTxEngine(tabletenv.NewEnv(config, "TabletServerTest"))
+	assert.False(t, te.twopcEnabled)
+	assert.Nil(t, te.dtResolver)
+
+	config.TwoPCResolve = true
+	config.TwoPCConcludeDelay = 5
+	te = NewTxEngine(tabletenv.NewEnv(config, "TabletServerTest"))
+	assert.True(t, te.twopcEnabled)
+	require.NotNil(t, te.dtResolver)
+	assert.Equal(t, 5*time.Second, te.dtResolver.concludeDelay)
+}
+
 func TestTxEngineRenewFails(t *testing.T) {
 	db := setUpQueryExecutorTest(t)
 	defer db.Close()
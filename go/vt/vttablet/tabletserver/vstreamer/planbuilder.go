@@ -17,6 +17,7 @@ limitations under the License.
 package vstreamer
 
 import (
+	"bytes"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -58,13 +59,45 @@ const (
 	Equal = Opcode(iota)
 	// VindexMatch is used for an in_keyrange() construct
 	VindexMatch
+	// NotEqual is used for a "col != value" constraint.
+	NotEqual
+	// LessThan is used for a "col < value" constraint.
+	LessThan
+	// LessThanEqual is used for a "col <= value" constraint.
+	LessThanEqual
+	// GreaterThan is used for a "col > value" constraint.
+	GreaterThan
+	// GreaterThanEqual is used for a "col >= value" constraint.
+	GreaterThanEqual
+	// In is used for a "col in (values)" constraint.
+	In
+	// NotIn is used for a "col not in (values)" constraint.
+	NotIn
+	// IsNull is used for a "col is null" constraint.
+	IsNull
+	// IsNotNull is used for a "col is not null" constraint.
+	IsNotNull
 )
 
+// comparisonOpcodes maps the comparison operators to their Opcode.
+var comparisonOpcodes = map[sqlparser.ComparisonExprOperator]Opcode{
+	sqlparser.EqualOp:        Equal,
+	sqlparser.NotEqualOp:     NotEqual,
+	sqlparser.LessThanOp:     LessThan,
+	sqlparser.LessEqualOp:    LessThanEqual,
+	sqlparser.GreaterThanOp:  GreaterThan,
+	sqlparser.GreaterEqualOp: GreaterThanEqual,
+	sqlparser.InOp:           In,
+	sqlparser.NotInOp:        NotIn,
+}
+
 // Filter contains opcodes for filtering.
 type Filter struct {
 	Opcode Opcode
 	ColNum int
 	Value  sqltypes.Value
+	// Values is the list of values for In and NotIn.
+	Values []sqltypes.Value
 
 	// Parameters for VindexMatch.
 	// Vindex, VindexColumns and KeyRange, if set, will be used
@@ -91,6 +124,12 @@ type ColExpr struct {
 	Field *querypb.Field
 
 	FixedValue sqltypes.Value
+
+	// Expr, if set, is an arithmetic expression evaluated on the row.
+	// References contains the column numbers of the table that the
+	// expression uses: the result is NULL if any of them is NULL.
+	Expr       evalengine.Expr
+	References []int
 }
 
 // Table contains the metadata for a table.
@@ -113,20 +152,20 @@ func (plan *Plan) fields() []*querypb.Field {
 func (plan *Plan) filter(values []sqltypes.Value) (bool, []sqltypes.Value, error) {
 	for _, filter := range plan.Filters {
 		switch filter.Opcode {
-		case Equal:
-			result, err := evalengine.NullsafeCompare(values[filter.ColNum], filter.Value)
+		case VindexMatch:
+			ksid, err := getKeyspaceID(values, filter.Vindex, filter.VindexColumns)
 			if err != nil {
 				return false, nil, err
 			}
-			if result != 0 {
+			if !key.KeyRangeContains(filter.KeyRange, ksid) {
 				return false, nil, nil
 			}
-		case VindexMatch:
-			ksid, err := getKeyspaceID(values, filter.Vindex, filter.VindexColumns)
+		default:
+			match, err := filter.matches(values[filter.ColNum])
 			if err != nil {
 				return false, nil, err
 			}
-			if !key.KeyRangeContains(filter.KeyRange, ksid) {
+			if !match {
 				return false, nil, nil
 			}
 		}
@@ -134,6 +173,14 @@ func (plan *Plan) filter(values []sqltypes.Value) (bool, []sqltypes.Value, error
 
 	result := make([]sqltypes.Value, len(plan.ColExprs))
 	for i, colExpr := range plan.ColExprs {
+		if colExpr.Expr != nil {
+			val, err := colExpr.evaluate(values)
+			if err != nil {
+				return false, nil, err
+			}
+			result[i] = val
+			continue
+		}
 		if colExpr.ColNum == -1 {
 			result[i] = colExpr.FixedValue
 			continue
@@ -154,6 +201,74 @@ func (plan *Plan) filter(values []sqltypes.Value) (bool, []sqltypes.Value, error
 	return true, result, nil
 }
 
+// matches returns true if the column value satisfies the constraint.
+// As in SQL, a NULL value only satisfies "is null".
+func (filter *Filter) matches(value sqltypes.Value) (bool, error) {
+	switch filter.Opcode {
+	case IsNull:
+		return value.IsNull(), nil
+	case IsNotNull:
+		return !value.IsNull(), nil
+	}
+	if value.IsNull() {
+		return false, nil
+	}
+	if value.IsText() {
+		value = sqltypes.MakeTrusted(sqltypes.VarBinary, value.Raw())
+	}
+	switch filter.Opcode {
+	case In, NotIn:
+		found := false
+		for _, v := range filter.Values {
+			result, err := evalengine.NullsafeCompare(value, v)
+			if err != nil {
+				return false, err
+			}
+			if result == 0 {
+				found = true
+				break
+			}
+		}
+		return found == (filter.Opcode == In), nil
+	}
+	result, err := evalengine.NullsafeCompare(value, filter.Value)
+	if err != nil {
+		return false, err
+	}
+	switch filter.Opcode {
+	case Equal:
+		return result == 0, nil
+	case NotEqual:
+		return result != 0, nil
+	case LessThan:
+		return result < 0, nil
+	case LessThanEqual:
+		return result <= 0, nil
+	case GreaterThan:
+		return result > 0, nil
+	case GreaterThanEqual:
+		return result >= 0, nil
+	}
+	return false, fmt.Errorf("unexpected opcode: %v", filter.Opcode)
+}
+
+// evaluate computes the value of an expression column for the row.
+func (colExpr *ColExpr) evaluate(values []sqltypes.Value) (sqltypes.Value, error) {
+	for _, col := range colExpr.References {
+		if col >= len(values) {
+			return sqltypes.NULL, fmt.Errorf("index out of range, col: %d, len(values): %d", col, len(values))
+		}
+		if values[col].IsNull() {
+			return sqltypes.NULL, nil
+		}
+	}
+	result, err := colExpr.Expr.Evaluate(evalengine.ExpressionEnv{Row: values})
+	if err != nil {
+		return sqltypes.NULL, fmt.Errorf("could not evaluate %s: %v", colExpr.Field.Name, err)
+	}
+	return evalengine.Cast(result.Value(), colExpr.Field.Type)
+}
+
 func getKeyspaceID(values []sqltypes.Value, vindex vindexes.Vindex, vindexColumns []int) (key.DestinationKeyspaceID, error) {
 	vindexValues := make([]sqltypes.Value, 0, len(vindexColumns))
 	for _, col := range vindexColumns {
@@ -366,37 +481,78 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 	for _, expr := range exprs {
 		switch expr := expr.(type) {
 		case *sqlparser.ComparisonExpr:
-			qualifiedName, ok := expr.Left.(*sqlparser.ColName)
+			opcode, ok := comparisonOpcodes[expr.Operator]
 			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			if !qualifiedName.Qualifier.IsEmpty() {
-				return fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
+				return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
 			}
-			colnum, err := findColumn(plan.Table, qualifiedName.Name)
+			colnum, err := plan.filterColumn(expr.Left, expr)
 			if err != nil {
 				return err
 			}
-			val, ok := expr.Right.(*sqlparser.Literal)
-			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+			filter := Filter{
+				Opcode: opcode,
+				ColNum: colnum,
 			}
-			//StrVal is varbinary, we do not support varchar since we would have to implement all collation types
-			if val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+			if opcode == In || opcode == NotIn {
+				tuple, ok := expr.Right.(sqlparser.ValTuple)
+				if !ok {
+					return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+				}
+				for _, node := range tuple {
+					val, err := plan.filterValue(colnum, opcode, node, expr)
+					if err != nil {
+						return err
+					}
+					filter.Values = append(filter.Values, val)
+				}
+			} else {
+				filter.Value, err = plan.filterValue(colnum, opcode, expr.Right, expr)
+				if err != nil {
+					return err
+				}
 			}
-			pv, err := sqlparser.NewPlanValue(val)
+			plan.Filters = append(plan.Filters, filter)
+		case *sqlparser.RangeCond:
+			if expr.Operator != sqlparser.BetweenOp {
+				return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
+			}
+			colnum, err := plan.filterColumn(expr.Left, expr)
 			if err != nil {
 				return err
 			}
-			resolved, err := pv.ResolveValue(nil)
+			from, err := plan.filterValue(colnum, GreaterThanEqual, expr.From, expr)
+			if err != nil {
+				return err
+			}
+			to, err := plan.filterValue(colnum, LessThanEqual, expr.To, expr)
 			if err != nil {
 				return err
 			}
 			plan.Filters = append(plan.Filters, Filter{
-				Opcode: Equal,
+				Opcode: GreaterThanEqual,
+				ColNum: colnum,
+				Value:  from,
+			}, Filter{
+				Opcode: LessThanEqual,
+				ColNum: colnum,
+				Value:  to,
+			})
+		case *sqlparser.IsExpr:
+			opcode := IsNull
+			switch expr.Operator {
+			case sqlparser.IsNullOp:
+			case sqlparser.IsNotNullOp:
+				opcode = IsNotNull
+			default:
+				return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
+			}
+			colnum, err := plan.filterColumn(expr.Expr, expr)
+			if err != nil {
+				return err
+			}
+			plan.Filters = append(plan.Filters, Filter{
+				Opcode: opcode,
 				ColNum: colnum,
-				Value:  resolved,
 			})
 		case *sqlparser.FuncExpr:
 			if !expr.Name.EqualString("in_keyrange") {
@@ -412,6 +568,40 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 	return nil
 }
 
+// filterColumn returns the column number of the column a constraint applies to.
+func (plan *Plan) filterColumn(node sqlparser.Expr, constraint sqlparser.Expr) (int, error) {
+	qualifiedName, ok := node.(*sqlparser.ColName)
+	if !ok {
+		return 0, fmt.Errorf("unexpected: %v", sqlparser.String(constraint))
+	}
+	if !qualifiedName.Qualifier.IsEmpty() {
+		return 0, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
+	}
+	return findColumn(plan.Table, qualifiedName.Name)
+}
+
+// filterValue returns the value a column is compared to in a constraint.
+func (plan *Plan) filterValue(colnum int, opcode Opcode, node sqlparser.Expr, constraint sqlparser.Expr) (sqltypes.Value, error) {
+	val, ok := node.(*sqlparser.Literal)
+	if !ok {
+		return sqltypes.NULL, fmt.Errorf("unexpected: %v", sqlparser.String(constraint))
+	}
+	//StrVal is varbinary, we do not support varchar since we would have to implement all collation types
+	if val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal {
+		return sqltypes.NULL, fmt.Errorf("unexpected: %v", sqlparser.String(constraint))
+	}
+	// Text columns are compared byte by byte, as if they had a binary collation.
+	// This works for equality, but the order depends on the collation.
+	if sqltypes.IsText(plan.Table.Fields[colnum].Type) && opcode != Equal && opcode != NotEqual && opcode != In && opcode != NotIn {
+		return sqltypes.NULL, fmt.Errorf("unsupported: range constraint on text column %s: %v", plan.Table.Fields[colnum].Name, sqlparser.String(constraint))
+	}
+	pv, err := sqlparser.NewPlanValue(val)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	return pv.ResolveValue(nil)
+}
+
 // splitAndExpression breaks up the Expr into AND-separated conditions
 // and appends them to filters, which can be shuffled and recombined
 // as needed.
@@ -471,8 +661,27 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 			ColNum: colnum,
 			Field:  plan.Table.Fields[colnum],
 		}, nil
+	case *sqlparser.BinaryExpr:
+		expr, typ, refs, err := plan.analyzeArithmetic(inner)
+		if err != nil {
+			return ColExpr{}, err
+		}
+		as := aliased.As
+		if as.IsEmpty() {
+			as = sqlparser.NewColIdent(sqlparser.String(aliased.Expr))
+		}
+		return ColExpr{
+			Field: &querypb.Field{
+				Name: as.String(),
+				Type: typ,
+			},
+			ColNum:     -1,
+			Expr:       expr,
+			References: refs,
+		}, nil
 	case *sqlparser.FuncExpr:
 		if inner.Name.Lowered() != "keyspace_id" {
+			// Other scalar functions are evaluated by the target.
 			return ColExpr{}, fmt.Errorf("unsupported function: %v", sqlparser.String(inner))
 		}
 		if len(inner.Exprs) != 0 {
@@ -520,6 +729,94 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 	}
 }
 
+// analyzeArithmetic converts an arithmetic expression on the columns of the
+// table, like "a + b * 2", to an expression that can be evaluated on the rows.
+// It returns the type of the result, and the column numbers referenced.
+func (plan *Plan) analyzeArithmetic(node sqlparser.Expr) (evalengine.Expr, querypb.Type, []int, error) {
+	switch node := node.(type) {
+	case *sqlparser.ColName:
+		if !node.Qualifier.IsEmpty() {
+			return nil, 0, nil, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(node))
+		}
+		colnum, err := findColumn(plan.Table, node.Name)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		typ := plan.Table.Fields[colnum].Type
+		switch {
+		case sqltypes.IsSigned(typ):
+			typ = sqltypes.Int64
+		case sqltypes.IsUnsigned(typ):
+			typ = sqltypes.Uint64
+		case sqltypes.IsFloat(typ):
+			typ = sqltypes.Float64
+		case typ == sqltypes.Decimal:
+			// DECIMAL stays DECIMAL, like in mysql.
+		default:
+			return nil, 0, nil, fmt.Errorf("unsupported: non-numeric column %v in arithmetic expression", sqlparser.String(node))
+		}
+		return evalengine.NewColumn(colnum), typ, []int{colnum}, nil
+	case *sqlparser.Literal:
+		var typ querypb.Type
+		switch node.Type {
+		case sqlparser.IntVal:
+			typ = sqltypes.Int64
+		case sqlparser.FloatVal:
+			// Like in mysql, a literal with an exponent is a double,
+			// and one without is a decimal.
+			typ = sqltypes.Decimal
+			if bytes.ContainsAny(node.Val, "eE") {
+				typ = sqltypes.Float64
+			}
+		default:
+			return nil, 0, nil, fmt.Errorf("unsupported: non-numeric literal %v in arithmetic expression", sqlparser.String(node))
+		}
+		expr, err := sqlparser.Convert(node)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		return expr, typ, nil, nil
+	case *sqlparser.BinaryExpr:
+		var op evalengine.BinaryExpr
+		switch node.Operator {
+		case sqlparser.PlusOp:
+			op = &evalengine.Addition{}
+		case sqlparser.MinusOp:
+			op = &evalengine.Subtraction{}
+		case sqlparser.MultOp:
+			op = &evalengine.Multiplication{}
+		case sqlparser.DivOp:
+			op = &evalengine.Division{}
+		default:
+			return nil, 0, nil, fmt.Errorf("unsupported operator: %v", sqlparser.String(node))
+		}
+		left, ltyp, lrefs, err := plan.analyzeArithmetic(node.Left)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		right, rtyp, rrefs, err := plan.analyzeArithmetic(node.Right)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		// This matches the type promotion of mysql: a double operand
+		// makes the result a double, and a decimal one or a division
+		// a decimal.
+		typ := ltyp
+		switch {
+		case ltyp == sqltypes.Float64 || rtyp == sqltypes.Float64:
+			typ = sqltypes.Float64
+		case ltyp == sqltypes.Decimal || rtyp == sqltypes.Decimal || node.Operator == sqlparser.DivOp:
+			// The evalengine computes decimals as doubles, which
+			// loses precision. So, they're left to the target.
+			return nil, 0, nil, fmt.Errorf("unsupported: decimal arithmetic expression %v", sqlparser.String(node))
+		case ltyp == sqltypes.Uint64 || rtyp == sqltypes.Uint64:
+			typ = sqltypes.Uint64
+		}
+		return &evalengine.BinaryOp{Expr: op, Left: left, Right: right}, typ, append(lrefs, rrefs...), nil
+	}
+	return nil, 0, nil, fmt.Errorf("unsupported: %v in arithmetic expression", sqlparser.String(node))
+}
+
 // analyzeInKeyRange allows the following constructs: "in_keyrange('-80')",
 // "in_keyrange(col, 'hash', '-80')", "in_keyrange(col, 'local_vindex', '-80')", or
 // "in_keyrange(col, 'ks.external_vindex', '-80')".
@@ -21,9 +21,13 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"vitess.io/vitess/go/json2"
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
@@ -393,6 +397,38 @@ func TestPlanbuilder(t *testing.T) {
 				KeyRange:      nil,
 			}},
 		},
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id from t1 where id > 1 and id in (2, 3) and val is not null and id between 1 and 5"},
+		outPlan: &Plan{
+			ColExprs: []ColExpr{{
+				ColNum: 0,
+				Field: &querypb.Field{
+					Name: "id",
+					Type: sqltypes.Int64,
+				},
+			}},
+			Filters: []Filter{{
+				Opcode: GreaterThan,
+				ColNum: 0,
+				Value:  sqltypes.NewInt64(1),
+			}, {
+				Opcode: In,
+				ColNum: 0,
+				Values: []sqltypes.Value{sqltypes.NewInt64(2), sqltypes.NewInt64(3)},
+			}, {
+				Opcode: IsNotNull,
+				ColNum: 1,
+			}, {
+				Opcode: GreaterThanEqual,
+				ColNum: 0,
+				Value:  sqltypes.NewInt64(1),
+			}, {
+				Opcode: LessThanEqual,
+				ColNum: 0,
+				Value:  sqltypes.NewInt64(5),
+			}},
+		},
 	}, {
 		inTable: t2,
 		inRule:  &binlogdatapb.Rule{Match: "/t1/"},
@@ -517,6 +553,22 @@ func TestPlanbuilder(t *testing.T) {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where in_keyrange(id, 'hash', '-80-')"},
 		outErr:  `unexpected in_keyrange parameter: '-80-'`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where val like 'a%'"},
+		outErr:  `unsupported constraint: val like 'a%'`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id not between 1 and 2"},
+		outErr:  `unsupported constraint: id not between 1 and 2`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id is true"},
+		outErr:  `unsupported constraint: id is true`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id in (1, val)"},
+		outErr:  `unexpected: id in (1, val)`,
 	}, {
 		// analyzeExpr tests.
 		inTable: t1,
@@ -533,7 +585,69 @@ func TestPlanbuilder(t *testing.T) {
 	}, {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id+1, val from t1"},
-		outErr:  `unsupported: id + 1`,
+		outPlan: &Plan{
+			ColExprs: []ColExpr{{
+				ColNum: -1,
+				Field: &querypb.Field{
+					Name: "id + 1",
+					Type: sqltypes.Int64,
+				},
+				Expr: &evalengine.BinaryOp{
+					Expr:  &evalengine.Addition{},
+					Left:  evalengine.NewColumn(0),
+					Right: evalengine.NewLiteralInt(1),
+				},
+				References: []int{0},
+			}, {
+				ColNum: 1,
+				Field: &querypb.Field{
+					Name: "val",
+					Type: sqltypes.VarBinary,
+				},
+			}},
+		},
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id*2/2e0 as ratio from t1"},
+		outPlan: &Plan{
+			ColExprs: []ColExpr{{
+				ColNum: -1,
+				Field: &querypb.Field{
+					Name: "ratio",
+					Type: sqltypes.Float64,
+				},
+				Expr: &evalengine.BinaryOp{
+					Expr: &evalengine.Division{},
+					Left: &evalengine.BinaryOp{
+						Expr:  &evalengine.Multiplication{},
+						Left:  evalengine.NewColumn(0),
+						Right: evalengine.NewLiteralInt(2),
+					},
+					Right: mustLiteralFloat("2e0"),
+				},
+				References: []int{0},
+			}},
+		},
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id*2/id as ratio from t1"},
+		outErr:  `unsupported: decimal arithmetic expression id * 2 / id`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id+val from t1"},
+		outErr:  `unsupported: non-numeric column val in arithmetic expression`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id+'a' from t1"},
+		outErr:  `unsupported: non-numeric literal 'a' in arithmetic expression`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id%2 from t1"},
+		outErr:  `unsupported operator: id % 2`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id+length(val) from t1"},
+		outErr:  `unsupported: length(val) in arithmetic expression`,
 	}, {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select t1.id, val from t1"},
@@ -577,3 +691,129 @@ func TestPlanbuilder(t *testing.T) {
 		}
 	}
 }
+
+func TestPlanFilterExpressions(t *testing.T) {
+	t1 := &Table{
+		Name: "t1",
+		Fields: []*querypb.Field{{
+			Name: "id",
+			Type: sqltypes.Int64,
+		}, {
+			Name: "price",
+			Type: sqltypes.Decimal,
+		}, {
+			Name: "qty",
+			Type: sqltypes.Uint32,
+		}},
+	}
+	plan, err := buildPlan(t1, testLocalVSchema, &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: "select id, id * 10 + 1 as id10, price * 1e0 as total, qty + 1 as next_qty from t1"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*querypb.Field{
+		{Name: "id", Type: sqltypes.Int64},
+		{Name: "id10", Type: sqltypes.Int64},
+		{Name: "total", Type: sqltypes.Float64},
+		{Name: "next_qty", Type: sqltypes.Uint64},
+	}, plan.fields())
+
+	ok, got, err := plan.filter([]sqltypes.Value{sqltypes.NewInt64(2), sqltypes.MakeTrusted(sqltypes.Decimal, []byte("1.5")), sqltypes.NewUint32(3)})
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []sqltypes.Value{sqltypes.NewInt64(2), sqltypes.NewInt64(21), sqltypes.NewFloat64(1.5), sqltypes.NewUint64(4)}, got)
+
+	// NULL values propagate.
+	ok, got, err = plan.filter([]sqltypes.Value{sqltypes.NewInt64(2), sqltypes.NULL, sqltypes.NewUint32(3)})
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []sqltypes.Value{sqltypes.NewInt64(2), sqltypes.NewInt64(21), sqltypes.NULL, sqltypes.NewUint64(4)}, got)
+
+	// A double operand makes the result a double. The expressions that
+	// result in a decimal can't be evaluated exactly by the vstreamer.
+	plan, err = buildPlan(t1, testLocalVSchema, &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: "select id * 1.5e0 as a, price * 1.5e0 as b, id / 2e0 as c from t1"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*querypb.Field{
+		{Name: "a", Type: sqltypes.Float64},
+		{Name: "b", Type: sqltypes.Float64},
+		{Name: "c", Type: sqltypes.Float64},
+	}, plan.fields())
+	for _, expr := range []string{"id * 1.5", "price + 1", "price * qty", "id / 2", "(price + 1) * 1e0"} {
+		_, err = buildPlan(t1, testLocalVSchema, &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: fmt.Sprintf("select %s as a from t1", expr)}},
+		})
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestPlanFilterPredicates(t *testing.T) {
+	t1 := &Table{
+		Name: "t1",
+		Fields: []*querypb.Field{{
+			Name: "id",
+			Type: sqltypes.Int64,
+		}, {
+			Name: "state",
+			Type: sqltypes.VarChar,
+		}, {
+			Name: "created",
+			Type: sqltypes.Datetime,
+		}},
+	}
+	row := func(id int64, state, created string) []sqltypes.Value {
+		values := []sqltypes.Value{sqltypes.NewInt64(id), sqltypes.NULL, sqltypes.NULL}
+		if state != "" {
+			values[1] = sqltypes.NewVarChar(state)
+		}
+		if created != "" {
+			values[2] = sqltypes.MakeTrusted(sqltypes.Datetime, []byte(created))
+		}
+		return values
+	}
+	testcases := []struct {
+		where string
+		row   []sqltypes.Value
+		want  bool
+	}{
+		{"id != 1", row(2, "", ""), true},
+		{"id != 1", row(1, "", ""), false},
+		{"id < 2", row(1, "", ""), true},
+		{"id <= 2", row(3, "", ""), false},
+		{"id >= 2", row(2, "", ""), true},
+		{"id between 2 and 4", row(5, "", ""), false},
+		{"state in ('a', 'b')", row(1, "b", ""), true},
+		{"state in ('a', 'b')", row(1, "c", ""), false},
+		{"state in ('a', 'b')", row(1, "", ""), false},
+		{"state not in ('a', 'b')", row(1, "c", ""), true},
+		{"state not in ('a', 'b')", row(1, "", ""), false},
+		{"state = 'a'", row(1, "A", ""), false},
+		{"state is null", row(1, "", ""), true},
+		{"state is not null", row(1, "", ""), false},
+		{"created > '2021-01-01 00:00:00'", row(1, "", "2021-03-04 10:00:00"), true},
+		{"created > '2021-01-01 00:00:00'", row(1, "", "2020-03-04 10:00:00"), false},
+		{"created > '2021-01-01 00:00:00'", row(1, "", ""), false},
+	}
+	for _, tcase := range testcases {
+		plan, err := buildPlan(t1, testLocalVSchema, &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: "select id from t1 where " + tcase.where}},
+		})
+		require.NoError(t, err, tcase.where)
+		got, _, err := plan.filter(tcase.row)
+		require.NoError(t, err, tcase.where)
+		assert.Equal(t, tcase.want, got, "%s: %v", tcase.where, tcase.row)
+	}
+
+	_, err := buildPlan(t1, testLocalVSchema, &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: "select id from t1 where state > 'a'"}},
+	})
+	assert.EqualError(t, err, "unsupported: range constraint on text column state: state > 'a'")
+}
+
+func mustLiteralFloat(val string) evalengine.Expr {
+	expr, err := evalengine.NewLiteralFloat([]byte(val))
+	if err != nil {
+		panic(err)
+	}
+	return expr
+}
@@ -101,6 +101,15 @@ func (rs *rowStreamer) Stream() error {
 		return err
 	}
 	defer conn.Close()
+	if len(rs.pkColumns) == 0 {
+		if err := rs.buildUniqueKeyColumns(conn); err != nil {
+			return err
+		}
+	}
+	rs.sendQuery, err = rs.buildSelect()
+	if err != nil {
+		return err
+	}
 	if _, err := conn.ExecuteFetch("set names binary", 1, false); err != nil {
 		return err
 	}
@@ -132,25 +141,14 @@ func (rs *rowStreamer) buildPlan() error {
 		return err
 	}
 	rs.pkColumns, err = buildPKColumns(st)
-	if err != nil {
-		return err
-	}
-	rs.sendQuery, err = rs.buildSelect()
-	if err != nil {
-		return err
-	}
 	return err
 }
 
+// buildPKColumns returns the primary key columns of a table. It returns
+// nil if the table has no primary key: buildUniqueKeyColumns then
+// finds the columns that replace it.
 func buildPKColumns(st *binlogdatapb.MinimalTable) ([]int, error) {
-	var pkColumns = make([]int, 0)
-	if len(st.PKColumns) == 0 {
-		pkColumns = make([]int, len(st.Fields))
-		for i := range st.Fields {
-			pkColumns[i] = i
-		}
-		return pkColumns, nil
-	}
+	var pkColumns []int
 	for _, pk := range st.PKColumns {
 		if pk >= int64(len(st.Fields)) {
 			return nil, fmt.Errorf("primary key %d refers to non-existent column", pk)
@@ -160,6 +158,32 @@ func buildPKColumns(st *binlogdatapb.MinimalTable) ([]int, error) {
 	return pkColumns, nil
 }
 
+// buildUniqueKeyColumns sets the pk columns of a table without a
+// primary key. The columns of its best unique key are used if it has
+// one with NOT NULL columns. Otherwise, the rows are ordered by all
+// the columns. Such keyless tables can't be paginated reliably, because
+// of NULL values and duplicate rows: vreplication copies them in a
+// single pass, and never sends a lastpk for them.
+func (rs *rowStreamer) buildUniqueKeyColumns(conn *snapshotConn) error {
+	qr, err := conn.ExecuteFetch(UniqueKeyQuery(rs.cp.DBName(), rs.plan.Table.Name), 10000, false)
+	if err != nil {
+		return err
+	}
+	for _, col := range BestUniqueKey(qr) {
+		i, err := findColumn(rs.plan.Table, sqlparser.NewColIdent(col))
+		if err != nil {
+			return err
+		}
+		rs.pkColumns = append(rs.pkColumns, i)
+	}
+	if len(rs.pkColumns) == 0 {
+		for i := range rs.plan.Table.Fields {
+			rs.pkColumns = append(rs.pkColumns, i)
+		}
+	}
+	return nil
+}
+
 func (rs *rowStreamer) buildSelect() (string, error) {
 	buf := sqlparser.NewTrackedBuffer(nil)
 	// We could have used select *, but being explicit is more predictable.
@@ -170,11 +194,25 @@ func (rs *rowStreamer) buildSelect() (string, error) {
 		prefix = ", "
 	}
 	buf.Myprintf(" from %v", sqlparser.NewTableIdent(rs.plan.Table.Name))
+	pushdown := rs.pushdownFilters()
+	if len(pushdown) != 0 {
+		buf.WriteString(" where ")
+		for i, filter := range pushdown {
+			if i != 0 {
+				buf.WriteString(" and ")
+			}
+			rs.writeFilter(buf, filter)
+		}
+	}
 	if len(rs.lastpk) != 0 {
 		if len(rs.lastpk) != len(rs.pkColumns) {
 			return "", fmt.Errorf("primary key values don't match length: %v vs %v", rs.lastpk, rs.pkColumns)
 		}
-		buf.WriteString(" where ")
+		if len(pushdown) != 0 {
+			buf.WriteString(" and (")
+		} else {
+			buf.WriteString(" where ")
+		}
 		prefix := ""
 		// This loop handles the case for composite pks. For example,
 		// if lastpk was (1,2), the where clause would be:
@@ -193,6 +231,9 @@ func (rs *rowStreamer) buildSelect() (string, error) {
 			rs.lastpk[lastcol].EncodeSQL(buf)
 			buf.Myprintf(")")
 		}
+		if len(pushdown) != 0 {
+			buf.WriteString(")")
+		}
 	}
 	buf.Myprintf(" order by ", sqlparser.NewTableIdent(rs.plan.Table.Name))
 	prefix = ""
@@ -203,6 +244,73 @@ func (rs *rowStreamer) buildSelect() (string, error) {
 	return buf.String(), nil
 }
 
+// pushdownFilters returns the filters of the plan that can be added to the
+// select, so that mysql doesn't return rows that will be filtered out.
+// Rows are still filtered by the plan. So, a filter can only be pushed down
+// if mysql returns at least the rows that match it: this excludes filters
+// on text columns other than equality, because of collations, and filters
+// on non-integral numbers and temporal types, which mysql doesn't compare
+// byte by byte.
+func (rs *rowStreamer) pushdownFilters() []Filter {
+	var pushdown []Filter
+	for _, filter := range rs.plan.Filters {
+		typ := rs.plan.Table.Fields[filter.ColNum].Type
+		switch filter.Opcode {
+		case VindexMatch:
+			continue
+		case IsNull, IsNotNull:
+		case Equal, In:
+			if !sqltypes.IsIntegral(typ) && !sqltypes.IsBinary(typ) && !sqltypes.IsText(typ) {
+				continue
+			}
+		default:
+			if !sqltypes.IsIntegral(typ) && !sqltypes.IsBinary(typ) {
+				continue
+			}
+		}
+		pushdown = append(pushdown, filter)
+	}
+	return pushdown
+}
+
+func (rs *rowStreamer) writeFilter(buf *sqlparser.TrackedBuffer, filter Filter) {
+	buf.Myprintf("%v", sqlparser.NewColIdent(rs.plan.Table.Fields[filter.ColNum].Name))
+	switch filter.Opcode {
+	case IsNull:
+		buf.WriteString(" is null")
+		return
+	case IsNotNull:
+		buf.WriteString(" is not null")
+		return
+	case In, NotIn:
+		if filter.Opcode == In {
+			buf.WriteString(" in (")
+		} else {
+			buf.WriteString(" not in (")
+		}
+		for i, val := range filter.Values {
+			if i != 0 {
+				buf.WriteString(", ")
+			}
+			val.EncodeSQL(buf)
+		}
+		buf.WriteString(")")
+		return
+	}
+	buf.WriteString(filterOperators[filter.Opcode])
+	filter.Value.EncodeSQL(buf)
+}
+
+// filterOperators maps the comparison opcodes to their sql operator.
+var filterOperators = map[Opcode]string{
+	Equal:            " = ",
+	NotEqual:         " != ",
+	LessThan:         " < ",
+	LessThanEqual:    " <= ",
+	GreaterThan:      " > ",
+	GreaterThanEqual: " >= ",
+}
+
 func (rs *rowStreamer) streamQuery(conn *snapshotConn, send func(*binlogdatapb.VStreamRowsResponse) error) error {
 	log.Infof("Streaming query: %v\n", rs.sendQuery)
 	gtid, err := conn.streamWithSnapshot(rs.ctx, rs.plan.Table.Name, rs.sendQuery)
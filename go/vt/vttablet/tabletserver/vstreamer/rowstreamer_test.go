@@ -25,12 +25,14 @@ import (
 
 	"vitess.io/vitess/go/vt/log"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 func TestStreamRowsScan(t *testing.T) {
@@ -51,6 +53,9 @@ func TestStreamRowsScan(t *testing.T) {
 		// Three-column PK
 		"create table t4(id1 int, id2 int, id3 int, val varbinary(128), primary key(id1, id2, id3))",
 		"insert into t4 values (1, 2, 3, 'aaa'), (2, 3, 4, 'bbb')",
+		// No PK, unique NOT NULL key
+		"create table t5(id int, uk int not null, val varbinary(128), unique key id(id), unique key uk(uk))",
+		"insert into t5 values (1, 2, 'aaa'), (2, 1, 'bbb')",
 	})
 
 	defer execStatements(t, []string{
@@ -58,6 +63,7 @@ func TestStreamRowsScan(t *testing.T) {
 		"drop table t2",
 		"drop table t3",
 		"drop table t4",
+		"drop table t5",
 	})
 
 	engine.se.Reload(context.Background())
@@ -166,6 +172,22 @@ func TestStreamRowsScan(t *testing.T) {
 	wantQuery = "select id1, id2, id3, val from t4 where (id1 = 1 and id2 = 2 and id3 > 3) or (id1 = 1 and id2 > 2) or (id1 > 1) order by id1, id2, id3"
 	checkStream(t, "select * from t4", []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2), sqltypes.NewInt64(3)}, wantQuery, wantStream)
 
+	// t5: the unique key replaces the pk
+	wantStream = []string{
+		`fields:<name:"id" type:INT32 table:"t5" org_table:"t5" database:"vttest" org_name:"id" column_length:11 charset:63 > fields:<name:"uk" type:INT32 table:"t5" org_table:"t5" database:"vttest" org_name:"uk" column_length:11 charset:63 > fields:<name:"val" type:VARBINARY table:"t5" org_table:"t5" database:"vttest" org_name:"val" column_length:128 charset:63 > pkfields:<name:"uk" type:INT32 > `,
+		`rows:<lengths:1 lengths:1 lengths:3 values:"21bbb" > rows:<lengths:1 lengths:1 lengths:3 values:"12aaa" > lastpk:<lengths:1 values:"2" > `,
+	}
+	wantQuery = "select id, uk, val from t5 order by uk"
+	checkStream(t, "select * from t5", nil, wantQuery, wantStream)
+
+	// t5: lastpk: 1
+	wantStream = []string{
+		`fields:<name:"id" type:INT32 table:"t5" org_table:"t5" database:"vttest" org_name:"id" column_length:11 charset:63 > fields:<name:"uk" type:INT32 table:"t5" org_table:"t5" database:"vttest" org_name:"uk" column_length:11 charset:63 > fields:<name:"val" type:VARBINARY table:"t5" org_table:"t5" database:"vttest" org_name:"val" column_length:128 charset:63 > pkfields:<name:"uk" type:INT32 > `,
+		`rows:<lengths:1 lengths:1 lengths:3 values:"12aaa" > lastpk:<lengths:1 values:"2" > `,
+	}
+	wantQuery = "select id, uk, val from t5 where (uk > 1) order by uk"
+	checkStream(t, "select * from t5", []sqltypes.Value{sqltypes.NewInt64(1)}, wantQuery, wantStream)
+
 	// t1: test for unsupported integer literal
 	wantError := "only the integer literal 1 is supported"
 	expectStreamError(t, "select 2 from t1", wantError)
@@ -287,9 +309,9 @@ func TestStreamRowsFilterInt(t *testing.T) {
 
 	wantStream := []string{
 		`fields:<name:"id1" type:INT32 table:"t1" org_table:"t1" database:"vttest" org_name:"id1" column_length:11 charset:63 > fields:<name:"val" type:VARBINARY table:"t1" org_table:"t1" database:"vttest" org_name:"val" column_length:128 charset:63 > pkfields:<name:"id1" type:INT32 > `,
-		`rows:<lengths:1 lengths:3 values:"1aaa" > rows:<lengths:1 lengths:3 values:"4ddd" > lastpk:<lengths:1 values:"5" > `,
+		`rows:<lengths:1 lengths:3 values:"1aaa" > rows:<lengths:1 lengths:3 values:"4ddd" > lastpk:<lengths:1 values:"4" > `,
 	}
-	wantQuery := "select id1, id2, val from t1 order by id1"
+	wantQuery := "select id1, id2, val from t1 where id2 = 100 order by id1"
 	checkStream(t, "select id1, val from t1 where id2 = 100", nil, wantQuery, wantStream)
 	require.Equal(t, int64(0), engine.rowStreamerNumPackets.Get())
 	require.Equal(t, int64(2), engine.rowStreamerNumRows.Get())
@@ -320,12 +342,90 @@ func TestStreamRowsFilterVarBinary(t *testing.T) {
 
 	wantStream := []string{
 		`fields:<name:"id1" type:INT32 table:"t1" org_table:"t1" database:"vttest" org_name:"id1" column_length:11 charset:63 > fields:<name:"val" type:VARBINARY table:"t1" org_table:"t1" database:"vttest" org_name:"val" column_length:128 charset:63 > pkfields:<name:"id1" type:INT32 > `,
-		`rows:<lengths:1 lengths:6 values:"2newton" > rows:<lengths:1 lengths:6 values:"3newton" > rows:<lengths:1 lengths:6 values:"5newton" > lastpk:<lengths:1 values:"6" > `,
+		`rows:<lengths:1 lengths:6 values:"2newton" > rows:<lengths:1 lengths:6 values:"3newton" > rows:<lengths:1 lengths:6 values:"5newton" > lastpk:<lengths:1 values:"5" > `,
 	}
-	wantQuery := "select id1, val from t1 order by id1"
+	wantQuery := "select id1, val from t1 where val = 'newton' order by id1"
 	checkStream(t, "select id1, val from t1 where val = 'newton'", nil, wantQuery, wantStream)
 }
 
+func TestStreamRowsFilterPredicates(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	execStatements(t, []string{
+		"create table t1(id1 int, id2 int, val varbinary(128), created datetime, primary key(id1))",
+		"insert into t1 values (1, 100, 'aaa', '2021-01-01 00:00:00'), (2, 200, null, '2021-02-01 00:00:00'), (3, 300, 'ccc', '2021-03-01 00:00:00'), (4, 400, 'ddd', '2021-04-01 00:00:00'), (5, 500, 'eee', null)",
+	})
+
+	defer execStatements(t, []string{
+		"drop table t1",
+	})
+	engine.se.Reload(context.Background())
+
+	wantStream := []string{
+		`fields:<name:"id1" type:INT32 table:"t1" org_table:"t1" database:"vttest" org_name:"id1" column_length:11 charset:63 > fields:<name:"val" type:VARBINARY table:"t1" org_table:"t1" database:"vttest" org_name:"val" column_length:128 charset:63 > pkfields:<name:"id1" type:INT32 > `,
+		`rows:<lengths:1 lengths:3 values:"3ccc" > rows:<lengths:1 lengths:3 values:"4ddd" > lastpk:<lengths:1 values:"4" > `,
+	}
+	// The predicate on the datetime column is not pushed down.
+	wantQuery := "select id1, id2, val, created from t1 where id2 > 100 and id2 in (200, 300, 400) and val is not null order by id1"
+	checkStream(t, "select id1, val from t1 where id2 > 100 and id2 in (200, 300, 400) and val is not null and created >= '2021-03-01 00:00:00'", nil, wantQuery, wantStream)
+}
+
+func TestBuildSelectPushdown(t *testing.T) {
+	ti := &Table{
+		Name: "t1",
+		Fields: []*querypb.Field{{
+			Name: "id",
+			Type: sqltypes.Int64,
+		}, {
+			Name: "state",
+			Type: sqltypes.VarChar,
+		}, {
+			Name: "val",
+			Type: sqltypes.VarBinary,
+		}, {
+			Name: "created",
+			Type: sqltypes.Datetime,
+		}},
+	}
+	testcases := []struct {
+		filter string
+		lastpk []sqltypes.Value
+		want   string
+	}{{
+		filter: "select * from t1",
+		want:   "select id, state, val, created from t1 order by id",
+	}, {
+		filter: "select * from t1 where id between 10 and 20 and state in ('a', 'b') and val != 'c'",
+		want:   "select id, state, val, created from t1 where id >= 10 and id <= 20 and state in ('a', 'b') and val != 'c' order by id",
+	}, {
+		// Only equality is pushed down for text columns,
+		// and nothing for temporal columns.
+		filter: "select * from t1 where state != 'a' and state not in ('b') and created > '2021-01-01' and created is null",
+		lastpk: []sqltypes.Value{sqltypes.NewInt64(5)},
+		want:   "select id, state, val, created from t1 where created is null and ((id > 5)) order by id",
+	}, {
+		filter: "select * from t1 where state not in ('b')",
+		lastpk: []sqltypes.Value{sqltypes.NewInt64(5)},
+		want:   "select id, state, val, created from t1 where (id > 5) order by id",
+	}}
+	for _, tcase := range testcases {
+		plan, err := buildPlan(ti, testLocalVSchema, &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: tcase.filter}},
+		})
+		require.NoError(t, err, tcase.filter)
+		rs := &rowStreamer{
+			plan:      plan,
+			pkColumns: []int{0},
+			lastpk:    tcase.lastpk,
+		}
+		got, err := rs.buildSelect()
+		require.NoError(t, err)
+		assert.Equal(t, tcase.want, got, tcase.filter)
+	}
+}
+
 func TestStreamRowsMultiPacket(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -0,0 +1,71 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vstreamer
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+// uniqueKeyQuery lists the columns of the unique keys of a table, and
+// whether they are nullable. The key parts of functional indexes have
+// no column, and so no nullability.
+const uniqueKeyQuery = "select s.index_name, s.column_name, c.is_nullable " +
+	"from information_schema.statistics s left join information_schema.columns c " +
+	"on c.table_schema = s.table_schema and c.table_name = s.table_name and c.column_name = s.column_name " +
+	"where s.table_schema = %s and s.table_name = %s and s.non_unique = 0 and s.index_name != 'PRIMARY' " +
+	"order by s.index_name, s.seq_in_index"
+
+// UniqueKeyQuery returns the query that lists the unique keys of a
+// table, for BestUniqueKey.
+func UniqueKeyQuery(dbName, tableName string) string {
+	return fmt.Sprintf(uniqueKeyQuery, encodeString(dbName), encodeString(tableName))
+}
+
+// BestUniqueKey returns the columns of the unique key that can replace
+// the primary key of a table that has none, from the result of
+// UniqueKeyQuery. Only the keys with NOT NULL columns uniquely identify
+// a row. Of those, the one with the fewest columns is chosen, and the
+// first in name order if there's a tie. It returns nil if there's no
+// such key.
+func BestUniqueKey(qr *sqltypes.Result) []string {
+	var best, cur []string
+	curName := ""
+	usable := false
+	pick := func() {
+		if usable && len(cur) != 0 && (best == nil || len(cur) < len(best)) {
+			best = cur
+		}
+	}
+	for _, row := range qr.Rows {
+		name := row[0].ToString()
+		if name != curName || cur == nil {
+			pick()
+			curName = name
+			cur = []string{}
+			usable = true
+		}
+		if row[1].IsNull() || !strings.EqualFold(row[2].ToString(), "NO") {
+			usable = false
+		}
+		cur = append(cur, row[1].ToString())
+	}
+	pick()
+	return best
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vstreamer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestBestUniqueKey(t *testing.T) {
+	fields := sqltypes.MakeTestFields("index_name|column_name|is_nullable", "varchar|varchar|varchar")
+	testcases := []struct {
+		rows []string
+		want []string
+	}{{
+		rows: nil,
+		want: nil,
+	}, {
+		// Keys with nullable columns are skipped.
+		rows: []string{"a|c1|YES", "b|c2|NO", "b|c3|NO"},
+		want: []string{"c2", "c3"},
+	}, {
+		// The key with the fewest columns wins.
+		rows: []string{"a|c1|NO", "a|c2|NO", "b|c3|NO"},
+		want: []string{"c3"},
+	}, {
+		// The first key wins a tie.
+		rows: []string{"a|c1|NO", "b|c2|NO"},
+		want: []string{"c1"},
+	}, {
+		// Functional key parts have no column.
+		rows: []string{"a|null|null", "a|c1|NO", "b|c1|NO", "b|c2|YES"},
+		want: nil,
+	}}
+	for _, tcase := range testcases {
+		qr := sqltypes.MakeTestResult(fields, tcase.rows...)
+		assert.Equal(t, tcase.want, BestUniqueKey(qr), "%v", tcase.rows)
+	}
+	assert.Equal(t,
+		"select s.index_name, s.column_name, c.is_nullable from information_schema.statistics s left join information_schema.columns c "+
+			"on c.table_schema = s.table_schema and c.table_name = s.table_name and c.column_name = s.column_name "+
+			"where s.table_schema = 'vt_ks' and s.table_name = 't1' and s.non_unique = 0 and s.index_name != 'PRIMARY' "+
+			"order by s.index_name, s.seq_in_index",
+		UniqueKeyQuery("vt_ks", "t1"))
+}
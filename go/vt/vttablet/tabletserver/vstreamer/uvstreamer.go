@@ -362,6 +362,11 @@ func (uvs *uvstreamer) Stream() error {
 	if err := uvs.init(); err != nil {
 		return err
 	}
+	if uvs.filter.SendFieldsOnStart {
+		if err := uvs.sendStartFields(); err != nil {
+			return err
+		}
+	}
 	if len(uvs.plans) > 0 {
 		log.Info("TablePKs is not nil: starting vs.copy()")
 		if err := uvs.copy(uvs.ctx); err != nil {
@@ -377,6 +382,50 @@ func (uvs *uvstreamer) Stream() error {
 	return vs.Stream()
 }
 
+// sendStartFields sends a FIELD event for every table that matches
+// the filter, so that the schemas are known before any row is streamed.
+func (uvs *uvstreamer) sendStartFields() error {
+	tables := uvs.se.GetSchema()
+	tableNames := make([]string, 0, len(tables))
+	for tableName := range tables {
+		if tableName == "dual" {
+			continue
+		}
+		tableNames = append(tableNames, tableName)
+	}
+	sort.Strings(tableNames)
+
+	evs := []*binlogdatapb.VEvent{{
+		Type: binlogdatapb.VEventType_BEGIN,
+	}}
+	for _, tableName := range tableNames {
+		plan, err := buildPlan(&Table{
+			Name:   tableName,
+			Fields: tables[tableName].Fields,
+		}, uvs.getVSchema(), uvs.filter)
+		if err != nil {
+			return err
+		}
+		if plan == nil {
+			continue
+		}
+		evs = append(evs, &binlogdatapb.VEvent{
+			Type: binlogdatapb.VEventType_FIELD,
+			FieldEvent: &binlogdatapb.FieldEvent{
+				TableName: plan.Table.Name,
+				Fields:    plan.fields(),
+			},
+		})
+	}
+	if len(evs) == 1 {
+		return nil
+	}
+	evs = append(evs, &binlogdatapb.VEvent{
+		Type: binlogdatapb.VEventType_COMMIT,
+	})
+	return uvs.send(evs)
+}
+
 func (uvs *uvstreamer) lock(msg string) {
 	uvs.mu.Lock()
 }
@@ -157,7 +157,7 @@ func (tqsc *Controller) IsServing() bool {
 	return tqsc.queryServiceEnabled
 }
 
-// CurrentTarget returns the current target.
+// CurrentTarget is part of the tabletserver.Controller interface
 func (tqsc *Controller) CurrentTarget() querypb.Target {
 	tqsc.mu.Lock()
 	defer tqsc.mu.Unlock()
@@ -145,6 +145,9 @@ type TabletManagerClient interface {
 	VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error)
 	VReplicationWaitForPos(ctx context.Context, tablet *topodatapb.Tablet, id int, pos string) error
 
+	// VDiff performs a vdiff action on the tablet
+	VDiff(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error)
+
 	//
 	// Reparenting related functions
 	//
@@ -198,11 +201,14 @@ type TabletManagerClient interface {
 	// Backup / restore related methods
 	//
 
-	// Backup creates a database backup
-	Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster bool) (logutil.EventStream, error)
+	// Backup creates a database backup. If incrementalFromPos is set, only
+	// the binary logs since that position are backed up.
+	Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster bool, incrementalFromPos string) (logutil.EventStream, error)
 
-	// RestoreFromBackup deletes local data and restores database from backup
-	RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error)
+	// RestoreFromBackup deletes local data and restores database from backup.
+	// If restoreToPos or restoreToTimestamp is set, the tablet is restored to
+	// that point in time using the archived binary logs.
+	RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, restoreToPos string, restoreToTimestamp time.Time) (logutil.EventStream, error)
 
 	//
 	// Management methods
@@ -899,6 +899,37 @@ func tmRPCTestVReplicationWaitForPosPanic(ctx context.Context, t *testing.T, cli
 	expectHandleRPCPanic(t, "VReplicationWaitForPos", true /*verbose*/, err)
 }
 
+var (
+	testVDiffRequest = &tabletmanagerdatapb.VDiffRequest{
+		Keyspace:  "ks",
+		Workflow:  "wf",
+		Action:    "show",
+		VdiffUuid: "last",
+	}
+	testVDiffResponse = &tabletmanagerdatapb.VDiffResponse{
+		Output:    testExecuteFetchResult,
+		VdiffUuid: "d2ef1f2e-3f0b-11ec-9bbc-0242ac130002",
+	}
+)
+
+func (fra *fakeRPCTM) VDiff(ctx context.Context, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "VDiff request", req, testVDiffRequest)
+	return testVDiffResponse, nil
+}
+
+func tmRPCTestVDiff(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	resp, err := client.VDiff(ctx, tablet, testVDiffRequest)
+	compareError(t, "VDiff", err, resp, testVDiffResponse)
+}
+
+func tmRPCTestVDiffPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.VDiff(ctx, tablet, testVDiffRequest)
+	expectHandleRPCPanic(t, "VDiff", true /*verbose*/, err)
+}
+
 //
 // Reparenting related functions
 //
@@ -1151,22 +1182,26 @@ func tmRPCTestPromoteReplicaPanic(ctx context.Context, t *testing.T, client tmcl
 
 var testBackupConcurrency = 24
 var testBackupAllowMaster = false
+var testBackupIncrementalFromPos = "auto"
 var testBackupCalled = false
 var testRestoreFromBackupCalled = false
+var testRestoreFromBackupToPos = "MariaDB/1-123-456"
+var testRestoreFromBackupToTimestamp = time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC)
 
-func (fra *fakeRPCTM) Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowMaster bool) error {
+func (fra *fakeRPCTM) Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowMaster bool, incrementalFromPos string) error {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
 	compare(fra.t, "Backup args", concurrency, testBackupConcurrency)
 	compare(fra.t, "Backup args", allowMaster, testBackupAllowMaster)
+	compare(fra.t, "Backup args", incrementalFromPos, testBackupIncrementalFromPos)
 	logStuff(logger, 10)
 	testBackupCalled = true
 	return nil
 }
 
 func tmRPCTestBackup(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.Backup(ctx, tablet, testBackupConcurrency, testBackupAllowMaster)
+	stream, err := client.Backup(ctx, tablet, testBackupConcurrency, testBackupAllowMaster, testBackupIncrementalFromPos)
 	if err != nil {
 		t.Fatalf("Backup failed: %v", err)
 	}
@@ -1175,7 +1210,7 @@ func tmRPCTestBackup(ctx context.Context, t *testing.T, client tmclient.TabletMa
 }
 
 func tmRPCTestBackupPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.Backup(ctx, tablet, testBackupConcurrency, testBackupAllowMaster)
+	stream, err := client.Backup(ctx, tablet, testBackupConcurrency, testBackupAllowMaster, testBackupIncrementalFromPos)
 	if err != nil {
 		t.Fatalf("Backup failed: %v", err)
 	}
@@ -1186,17 +1221,19 @@ func tmRPCTestBackupPanic(ctx context.Context, t *testing.T, client tmclient.Tab
 	expectHandleRPCPanic(t, "Backup", true /*verbose*/, err)
 }
 
-func (fra *fakeRPCTM) RestoreFromBackup(ctx context.Context, logger logutil.Logger) error {
+func (fra *fakeRPCTM) RestoreFromBackup(ctx context.Context, logger logutil.Logger, restoreToPos string, restoreToTimestamp time.Time) error {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
+	compare(fra.t, "RestoreFromBackup args", restoreToPos, testRestoreFromBackupToPos)
+	compare(fra.t, "RestoreFromBackup args", restoreToTimestamp, testRestoreFromBackupToTimestamp)
 	logStuff(logger, 10)
 	testRestoreFromBackupCalled = true
 	return nil
 }
 
 func tmRPCTestRestoreFromBackup(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.RestoreFromBackup(ctx, tablet)
+	stream, err := client.RestoreFromBackup(ctx, tablet, testRestoreFromBackupToPos, testRestoreFromBackupToTimestamp)
 	if err != nil {
 		t.Fatalf("RestoreFromBackup failed: %v", err)
 	}
@@ -1205,7 +1242,7 @@ func tmRPCTestRestoreFromBackup(ctx context.Context, t *testing.T, client tmclie
 }
 
 func tmRPCTestRestoreFromBackupPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.RestoreFromBackup(ctx, tablet)
+	stream, err := client.RestoreFromBackup(ctx, tablet, testRestoreFromBackupToPos, testRestoreFromBackupToTimestamp)
 	if err != nil {
 		t.Fatalf("RestoreFromBackup failed: %v", err)
 	}
@@ -1273,6 +1310,7 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	// VReplication methods
 	tmRPCTestVReplicationExec(ctx, t, client, tablet)
 	tmRPCTestVReplicationWaitForPos(ctx, t, client, tablet)
+	tmRPCTestVDiff(ctx, t, client, tablet)
 
 	// Reparenting related functions
 	tmRPCTestResetReplication(ctx, t, client, tablet)
@@ -1325,6 +1363,7 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	// VReplication methods
 	tmRPCTestVReplicationExecPanic(ctx, t, client, tablet)
 	tmRPCTestVReplicationWaitForPosPanic(ctx, t, client, tablet)
+	tmRPCTestVDiffPanic(ctx, t, client, tablet)
 
 	// Reparenting related functions
 	tmRPCTestResetReplicationPanic(ctx, t, client, tablet)
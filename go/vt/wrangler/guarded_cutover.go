@@ -0,0 +1,281 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// A guarded cutover switches the writes of a workflow with reverse
+// replication, and monitors the target masters and the reverse streams
+// for a while. If they stay unhealthy for too long, the writes are
+// switched back to the sources through the reverse streams, which makes
+// the original streams replicate again.
+// Each step is recorded as an event of the workflow in the
+// _vt.workflow_events table of the target masters.
+
+const createWorkflowEventsTable = `create table if not exists _vt.workflow_events (
+  id bigint auto_increment,
+  workflow varbinary(1000) not null,
+  db_name varbinary(255) not null,
+  event_type varbinary(64) not null,
+  message text not null,
+  created_at timestamp not null default current_timestamp,
+  primary key (id),
+  key workflow_idx (workflow(64))
+) engine=InnoDB`
+
+// The following constants are the types of the events of a guarded cutover.
+const (
+	EventCutoverStarted    = "CutoverStarted"
+	EventWritesSwitched    = "WritesSwitched"
+	EventSwitchFailed      = "SwitchFailed"
+	EventHealthCheckFailed = "HealthCheckFailed"
+	EventRollbackStarted   = "RollbackStarted"
+	EventRolledBack        = "RolledBack"
+	EventRollbackFailed    = "RollbackFailed"
+	EventCutoverCompleted  = "CutoverCompleted"
+	EventMonitorAborted    = "MonitorAborted"
+)
+
+// GuardedCutoverParams configures a guarded cutover.
+type GuardedCutoverParams struct {
+	// Timeout is the timeout of the switch of the writes, in both directions.
+	Timeout time.Duration
+	// MonitorDuration is how long the cutover is monitored once the
+	// writes are switched.
+	MonitorDuration time.Duration
+	// CheckInterval is the interval between two health checks.
+	CheckInterval time.Duration
+	// MaxErrorRate is the maximum rate of errors per second of a target
+	// master. Zero disables the check.
+	MaxErrorRate float64
+	// MaxReverseLag is the maximum replication lag of a reverse stream.
+	// Zero disables the check.
+	MaxReverseLag time.Duration
+	// FailureThreshold is the number of consecutive failed health checks
+	// that trigger the rollback.
+	FailureThreshold int
+}
+
+// GuardedSwitchWrites switches the writes of a workflow, and monitors the
+// cutover as specified by params. It returns rolledBack=true if the writes
+// were switched back to the sources.
+func (wr *Wrangler) GuardedSwitchWrites(ctx context.Context, targetKeyspace, workflow string, params *GuardedCutoverParams) (journalID int64, rolledBack bool, err error) {
+	if params.CheckInterval <= 0 {
+		return 0, false, fmt.Errorf("check interval must be positive: %v", params.CheckInterval)
+	}
+	if params.FailureThreshold < 1 {
+		params.FailureThreshold = 1
+	}
+	// The targets must be known before the switch: the workflow is
+	// frozen afterwards.
+	ts, err := wr.buildTrafficSwitcher(ctx, targetKeyspace, workflow)
+	if err != nil {
+		return 0, false, err
+	}
+	if ts.frozen {
+		return 0, false, fmt.Errorf("writes have already been switched for workflow %s", workflow)
+	}
+
+	ts.recordEvent(ctx, EventCutoverStarted, fmt.Sprintf("monitoring for %v after the switch", params.MonitorDuration))
+	journalID, _, err = wr.SwitchWrites(ctx, targetKeyspace, workflow, params.Timeout, false, false, true, false)
+	if err != nil {
+		ts.recordEvent(ctx, EventSwitchFailed, err.Error())
+		return 0, false, err
+	}
+	ts.recordEvent(ctx, EventWritesSwitched, fmt.Sprintf("journal id %d", journalID))
+
+	cause, err := ts.monitorCutover(ctx, params)
+	if err != nil {
+		ts.recordEvent(ctx, EventMonitorAborted, err.Error())
+		return journalID, false, err
+	}
+	if cause == nil {
+		ts.recordEvent(ctx, EventCutoverCompleted, "")
+		return journalID, false, nil
+	}
+
+	ts.recordEvent(ctx, EventRollbackStarted, cause.Error())
+	if _, _, err := wr.SwitchWrites(ctx, ts.sourceKeyspace, ts.reverseWorkflow, params.Timeout, false, true, true, false); err != nil {
+		ts.recordEvent(ctx, EventRollbackFailed, err.Error())
+		return journalID, false, vterrors.Wrapf(err, "rollback triggered by %v failed", cause)
+	}
+	ts.recordEvent(ctx, EventRolledBack, "")
+	return journalID, true, nil
+}
+
+// monitorCutover checks the health of the cutover until params.MonitorDuration
+// has elapsed. It returns the cause of the rollback if too many consecutive
+// checks failed, and an error if the monitoring was interrupted.
+func (ts *trafficSwitcher) monitorCutover(ctx context.Context, params *GuardedCutoverParams) (cause error, err error) {
+	deadline := time.Now().Add(params.MonitorDuration)
+	ticker := time.NewTicker(params.CheckInterval)
+	defer ticker.Stop()
+	failures := 0
+	for {
+		if checkErr := ts.checkCutoverHealth(ctx, params); checkErr != nil {
+			failures++
+			ts.recordEvent(ctx, EventHealthCheckFailed, fmt.Sprintf("%d/%d: %v", failures, params.FailureThreshold, checkErr))
+			if failures >= params.FailureThreshold {
+				return checkErr, nil
+			}
+		} else {
+			failures = 0
+		}
+		if !time.Now().Before(deadline) {
+			return nil, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("monitoring of the cutover was interrupted: %v", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkCutoverHealth checks the target masters, which now serve the
+// writes, and the reverse streams on the source masters.
+func (ts *trafficSwitcher) checkCutoverHealth(ctx context.Context, params *GuardedCutoverParams) error {
+	err := ts.forAllTargets(func(target *tsTarget) error {
+		stats, err := ts.wr.realtimeStats(ctx, target.master.Tablet)
+		if err != nil {
+			return err
+		}
+		return checkTargetStats(topoproto.TabletAliasString(target.master.Alias), stats, params.MaxErrorRate)
+	})
+	if err != nil {
+		return err
+	}
+	return ts.forAllSources(func(source *tsSource) error {
+		query := fmt.Sprintf("select id, state, message, transaction_timestamp, unix_timestamp() from _vt.vreplication where db_name=%s and workflow=%s",
+			encodeString(source.master.DbName()), encodeString(ts.reverseWorkflow))
+		p3qr, err := ts.wr.tmc.VReplicationExec(ctx, source.master.Tablet, query)
+		if err != nil {
+			return err
+		}
+		return checkReverseStreams(topoproto.TabletAliasString(source.master.Alias), sqltypes.Proto3ToResult(p3qr), params.MaxReverseLag)
+	})
+}
+
+// realtimeStats returns the current health stats of a tablet.
+func (wr *Wrangler) realtimeStats(ctx context.Context, tablet *topodatapb.Tablet) (*querypb.RealtimeStats, error) {
+	// The stats are refreshed by the health check.
+	if err := wr.tmc.RunHealthCheck(ctx, tablet); err != nil {
+		return nil, err
+	}
+	conn, err := tabletconn.GetDialer()(tablet, grpcclient.FailFast(false))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close(ctx)
+	var stats *querypb.RealtimeStats
+	err = conn.StreamHealth(ctx, func(shr *querypb.StreamHealthResponse) error {
+		stats = shr.RealtimeStats
+		return io.EOF
+	})
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if stats == nil {
+		return nil, fmt.Errorf("tablet %v did not return its health stats", topoproto.TabletAliasString(tablet.Alias))
+	}
+	return stats, nil
+}
+
+// checkTargetStats returns an error if a target master is unhealthy.
+func checkTargetStats(alias string, stats *querypb.RealtimeStats, maxErrorRate float64) error {
+	if stats.HealthError != "" {
+		return fmt.Errorf("target master %s is unhealthy: %s", alias, stats.HealthError)
+	}
+	if maxErrorRate > 0 && stats.ErrorRate > maxErrorRate {
+		return fmt.Errorf("error rate of target master %s is %.1f/s, above %.1f/s", alias, stats.ErrorRate, maxErrorRate)
+	}
+	return nil
+}
+
+// checkReverseStreams returns an error if a reverse stream of a source
+// master is not running, or lags too much. The rows of qr are made of the
+// id, state, message and transaction_timestamp of the streams, and the
+// current time. Like for the other lag checks, the lag is the age of the
+// last replicated transaction.
+func checkReverseStreams(alias string, qr *sqltypes.Result, maxLag time.Duration) error {
+	if len(qr.Rows) == 0 {
+		return fmt.Errorf("no reverse stream found on source master %s", alias)
+	}
+	for _, row := range qr.Rows {
+		id := row[0].ToString()
+		state := row[1].ToString()
+		message := row[2].ToString()
+		if state != binlogplayer.BlpRunning || strings.HasPrefix(message, "Error:") {
+			return fmt.Errorf("reverse stream %s on source master %s is %s: %s", id, alias, state, message)
+		}
+		if maxLag <= 0 {
+			continue
+		}
+		txTimestamp, err := evalengine.ToInt64(row[3])
+		if err != nil {
+			return err
+		}
+		if txTimestamp == 0 {
+			// The stream has not replicated any transaction yet.
+			continue
+		}
+		now, err := evalengine.ToInt64(row[4])
+		if err != nil {
+			return err
+		}
+		if lag := time.Duration(now-txTimestamp) * time.Second; lag > maxLag {
+			return fmt.Errorf("reverse stream %s on source master %s lags by %v, above %v", id, alias, lag, maxLag)
+		}
+	}
+	return nil
+}
+
+// recordEvent records an event of the workflow on the target masters.
+// Events are best effort: failures are only logged.
+func (ts *trafficSwitcher) recordEvent(ctx context.Context, eventType, message string) {
+	ts.wr.Logger().Infof("Workflow %s.%s: %s %s", ts.targetKeyspace, ts.workflow, eventType, message)
+	err := ts.forAllTargets(func(target *tsTarget) error {
+		query := fmt.Sprintf("insert into _vt.workflow_events(workflow, db_name, event_type, message) values (%s, %s, %s, %s)",
+			encodeString(ts.workflow), encodeString(target.master.DbName()), encodeString(eventType), encodeString(message))
+		for _, q := range []string{createWorkflowEventsTable, query} {
+			if _, err := ts.wr.tmc.ExecuteFetchAsDba(ctx, target.master.Tablet, true, []byte(q), 0, false, false); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		ts.wr.Logger().Warningf("Could not record event %s of workflow %s: %v", eventType, ts.workflow, err)
+	}
+}
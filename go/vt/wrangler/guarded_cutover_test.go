@@ -0,0 +1,309 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/queryservice/fakes"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestCheckTargetStats(t *testing.T) {
+	assert.NoError(t, checkTargetStats("zone1-100", &querypb.RealtimeStats{ErrorRate: 12}, 0))
+	assert.NoError(t, checkTargetStats("zone1-100", &querypb.RealtimeStats{ErrorRate: 2}, 5))
+	assert.EqualError(t, checkTargetStats("zone1-100", &querypb.RealtimeStats{ErrorRate: 12}, 5),
+		"error rate of target master zone1-100 is 12.0/s, above 5.0/s")
+	assert.EqualError(t, checkTargetStats("zone1-100", &querypb.RealtimeStats{HealthError: "mysqld down"}, 5),
+		"target master zone1-100 is unhealthy: mysqld down")
+}
+
+func TestCheckReverseStreams(t *testing.T) {
+	fields := sqltypes.MakeTestFields("id|state|message|transaction_timestamp|now", "int64|varchar|varchar|int64|int64")
+	testcases := []struct {
+		rows   []string
+		maxLag time.Duration
+		err    string
+	}{{
+		rows:   []string{"1|Running||100|130", "2|Running||125|130"},
+		maxLag: 10 * time.Second,
+		err:    "reverse stream 1 on source master zone1-200 lags by 30s, above 10s",
+	}, {
+		rows: []string{"1|Running||100|130"},
+	}, {
+		rows:   []string{"1|Running||125|130"},
+		maxLag: 10 * time.Second,
+	}, {
+		// No transaction was replicated yet.
+		rows:   []string{"1|Running||0|130"},
+		maxLag: 10 * time.Second,
+	}, {
+		rows: []string{"1|Stopped|Stopped at DDL|125|130"},
+		err:  "reverse stream 1 on source master zone1-200 is Stopped: Stopped at DDL",
+	}, {
+		rows: []string{"1|Running|Error: duplicate key|125|130"},
+		err:  "reverse stream 1 on source master zone1-200 is Running: Error: duplicate key",
+	}, {
+		err: "no reverse stream found on source master zone1-200",
+	}}
+	for _, tcase := range testcases {
+		err := checkReverseStreams("zone1-200", sqltypes.MakeTestResult(fields, tcase.rows...), tcase.maxLag)
+		if tcase.err == "" {
+			assert.NoError(t, err, "%v", tcase.rows)
+			continue
+		}
+		assert.EqualError(t, err, tcase.err, "%v", tcase.rows)
+	}
+}
+
+// guardedCutoverStats are the health stats returned by the target
+// masters, by tablet uid. It has to be a global for RegisterDialer to work.
+var guardedCutoverStats = struct {
+	mu    sync.Mutex
+	stats map[uint32]*querypb.RealtimeStats
+}{stats: make(map[uint32]*querypb.RealtimeStats)}
+
+func init() {
+	tabletconn.RegisterDialer("GuardedCutoverTest", func(tablet *topodatapb.Tablet, failFast grpcclient.FailFast) (queryservice.QueryService, error) {
+		guardedCutoverStats.mu.Lock()
+		defer guardedCutoverStats.mu.Unlock()
+		stats, ok := guardedCutoverStats.stats[tablet.Alias.Uid]
+		if !ok {
+			stats = &querypb.RealtimeStats{}
+		}
+		return &guardedCutoverTablet{QueryService: fakes.ErrorQueryService, stats: stats}, nil
+	})
+}
+
+// guardedCutoverTablet is the query service of a target master.
+type guardedCutoverTablet struct {
+	queryservice.QueryService
+	stats *querypb.RealtimeStats
+}
+
+func (tablet *guardedCutoverTablet) StreamHealth(ctx context.Context, callback func(*querypb.StreamHealthResponse) error) error {
+	return callback(&querypb.StreamHealthResponse{Serving: true, RealtimeStats: tablet.stats})
+}
+
+func setGuardedCutoverStats(tme *testShardMigraterEnv, stats *querypb.RealtimeStats) {
+	flag.Set("tablet_protocol", "GuardedCutoverTest")
+	guardedCutoverStats.mu.Lock()
+	defer guardedCutoverStats.mu.Unlock()
+	for _, master := range tme.targetMasters {
+		guardedCutoverStats.stats[master.Tablet.Alias.Uid] = stats
+	}
+}
+
+// recordWorkflowEvents returns the types of the events recorded on the
+// target masters. They share tme.tmeDB: every event is recorded once per
+// target master.
+func recordWorkflowEvents(tme *testShardMigraterEnv) func() []string {
+	var events []string
+	eventType := regexp.MustCompile(`values \('test', 'vt_ks', '(\w+)'`)
+	tme.tmeDB.AddQueryPattern("create table if not exists _vt.workflow_events.*", &sqltypes.Result{})
+	tme.tmeDB.AddQueryPatternWithCallback("insert into _vt.workflow_events.*", &sqltypes.Result{}, func(query string) {
+		events = append(events, eventType.FindStringSubmatch(query)[1])
+	})
+	return func() []string {
+		return events
+	}
+}
+
+// expectGuardedSwitchWrites adds the queries of the switch of the writes
+// of a guarded cutover.
+func (tme *testShardMigraterEnv) expectGuardedSwitchWrites() {
+	tme.expectCheckJournals()
+	for _, dbclient := range tme.dbSourceClients {
+		dbclient.addQuery("select id, workflow, source, pos from _vt.vreplication where db_name='vt_ks' and workflow != 'test_reverse' and state = 'Stopped' and message != 'FROZEN'", &sqltypes.Result{}, nil)
+		dbclient.addQuery("select id, workflow, source, pos from _vt.vreplication where db_name='vt_ks' and workflow != 'test_reverse'", &sqltypes.Result{}, nil)
+	}
+	tme.expectWaitForCatchup()
+	tme.expectCreateReverseVReplication()
+	tme.expectCreateJournals()
+	tme.expectStartReverseVReplication()
+	tme.expectFrozenTargetVReplication()
+}
+
+const vreplQueryksReverse = "select id, source, message, cell, tablet_types from _vt.vreplication where workflow='test_reverse' and db_name='vt_ks'"
+
+var reverseStreamsFields = sqltypes.MakeTestFields("id|state|message|transaction_timestamp|unix_timestamp()", "int64|varchar|varchar|int64|int64")
+
+func TestGuardedSwitchWrites(t *testing.T) {
+	ctx := context.Background()
+	tme := newTestShardMigrater(ctx, t, []string{"0"}, []string{"-80", "80-"})
+	defer tme.stopTablets(t)
+
+	tme.expectNoPreviousJournals()
+	_, err := tme.wr.SwitchReads(ctx, tme.targetKeyspace, "test", rdOnly, nil, DirectionForward, false)
+	require.NoError(t, err)
+	tme.expectNoPreviousJournals()
+	_, err = tme.wr.SwitchReads(ctx, tme.targetKeyspace, "test", replica, nil, DirectionForward, false)
+	require.NoError(t, err)
+
+	setGuardedCutoverStats(tme, &querypb.RealtimeStats{ErrorRate: 1})
+	tme.expectGuardedSwitchWrites()
+	tme.dbSourceClients[0].addQuery(
+		"select id, state, message, transaction_timestamp, unix_timestamp() from _vt.vreplication where db_name='vt_ks' and workflow='test_reverse'",
+		sqltypes.MakeTestResult(reverseStreamsFields, "1|Running||125|130", "2|Running||125|130"),
+		nil,
+	)
+	params := &GuardedCutoverParams{
+		Timeout:       1 * time.Second,
+		CheckInterval: time.Millisecond,
+		MaxErrorRate:  5,
+		MaxReverseLag: 10 * time.Second,
+	}
+	events := recordWorkflowEvents(tme)
+	journalID, rolledBack, err := tme.wr.GuardedSwitchWrites(ctx, tme.targetKeyspace, "test", params)
+	require.NoError(t, err)
+	assert.NotZero(t, journalID)
+	assert.False(t, rolledBack)
+	verifyQueries(t, tme.allDBClients)
+	assert.Equal(t, []string{
+		EventCutoverStarted, EventCutoverStarted,
+		EventWritesSwitched, EventWritesSwitched,
+		EventCutoverCompleted, EventCutoverCompleted,
+	}, events())
+
+	checkServedTypes(t, tme.ts, "ks:0", 0)
+	checkServedTypes(t, tme.ts, "ks:-80", 3)
+	checkServedTypes(t, tme.ts, "ks:80-", 3)
+	checkIsMasterServing(t, tme.ts, "ks:0", false)
+	checkIsMasterServing(t, tme.ts, "ks:-80", true)
+	checkIsMasterServing(t, tme.ts, "ks:80-", true)
+}
+
+func TestGuardedSwitchWritesRollback(t *testing.T) {
+	ctx := context.Background()
+	tme := newTestShardMigrater(ctx, t, []string{"0"}, []string{"-80", "80-"})
+	defer tme.stopTablets(t)
+
+	tme.expectNoPreviousJournals()
+	_, err := tme.wr.SwitchReads(ctx, tme.targetKeyspace, "test", rdOnly, nil, DirectionForward, false)
+	require.NoError(t, err)
+	tme.expectNoPreviousJournals()
+	_, err = tme.wr.SwitchReads(ctx, tme.targetKeyspace, "test", replica, nil, DirectionForward, false)
+	require.NoError(t, err)
+
+	// The target masters fail their health checks.
+	setGuardedCutoverStats(tme, &querypb.RealtimeStats{HealthError: "mysqld down"})
+	tme.expectGuardedSwitchWrites()
+
+	// The reverse workflow replicates from the targets to the source.
+	var rows []string
+	for i, targetShard := range tme.targetShards {
+		bls := &binlogdatapb.BinlogSource{
+			Keyspace: "ks",
+			Shard:    targetShard,
+			Filter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "/.*",
+					Filter: "-",
+				}},
+			},
+		}
+		rows = append(rows, fmt.Sprintf("%d|%v|||", i+1, bls))
+	}
+	tme.dbSourceClients[0].addInvariant(vreplQueryksReverse, sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"id|source|message|cell|tablet_types",
+		"int64|varchar|varchar|varchar|varchar"),
+		rows...),
+	)
+	for _, dbclient := range tme.dbTargetClients {
+		dbclient.addInvariant(vreplQueryksReverse, &sqltypes.Result{})
+	}
+
+	// The rollback switches the writes of the reverse workflow.
+	tme.expectNoPreviousReverseJournals()
+	for _, dbclient := range tme.dbTargetClients {
+		dbclient.addQuery("select id, workflow, source, pos from _vt.vreplication where db_name='vt_ks' and workflow != 'test' and state = 'Stopped' and message != 'FROZEN'", &sqltypes.Result{}, nil)
+		dbclient.addQuery("select id, workflow, source, pos from _vt.vreplication where db_name='vt_ks' and workflow != 'test'", &sqltypes.Result{}, nil)
+	}
+	state := sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"pos|state|message",
+		"varchar|varchar|varchar"),
+		"MariaDB/5-456-893|Running",
+	)
+	for i := range tme.targetShards {
+		id := i + 1
+		tme.dbSourceClients[0].addQuery(fmt.Sprintf("select pos, state, message from _vt.vreplication where id=%d", id), state, nil)
+		tme.dbSourceClients[0].addQuery(fmt.Sprintf("select id from _vt.vreplication where id = %d", id), &sqltypes.Result{Rows: [][]sqltypes.Value{{sqltypes.NewInt64(int64(id))}}}, nil)
+		tme.dbSourceClients[0].addQuery(fmt.Sprintf("update _vt.vreplication set state = 'Stopped', message = 'stopped for cutover' where id in (%d)", id), &sqltypes.Result{}, nil)
+		tme.dbSourceClients[0].addQuery(fmt.Sprintf("select * from _vt.vreplication where id = %d", id), stoppedResult(id), nil)
+	}
+	// The original streams are recreated on the targets.
+	for i, dbclient := range tme.dbTargetClients {
+		dbclient.addQuery("select id from _vt.vreplication where db_name = 'vt_ks' and workflow = 'test'", resultid1, nil)
+		dbclient.addQuery("delete from _vt.vreplication where id in (1)", &sqltypes.Result{}, nil)
+		dbclient.addQuery("delete from _vt.copy_state where vrepl_id in (1)", &sqltypes.Result{}, nil)
+		dbclient.addQueryRE(fmt.Sprintf("insert into _vt.vreplication.*test.*%s.*MariaDB/5-456-892.*Stopped", tme.targetShards[i]), &sqltypes.Result{InsertID: 1}, nil)
+		dbclient.addQuery("select * from _vt.vreplication where id = 1", stoppedResult(1), nil)
+		dbclient.addQueryRE("insert into _vt.resharding_journal.*", &sqltypes.Result{}, nil)
+		dbclient.addQuery("select id from _vt.vreplication where db_name = 'vt_ks'", resultid1, nil)
+		dbclient.addQuery("update _vt.vreplication set state = 'Running', message = '' where id in (1)", &sqltypes.Result{}, nil)
+		dbclient.addQuery("select * from _vt.vreplication where id = 1", runningResult(1), nil)
+	}
+	// The reverse streams are frozen.
+	tme.dbSourceClients[0].addQuery("select id from _vt.vreplication where db_name = 'vt_ks' and workflow = 'test_reverse'", resultid12, nil)
+	tme.dbSourceClients[0].addQuery("update _vt.vreplication set message = 'FROZEN' where id in (1, 2)", &sqltypes.Result{}, nil)
+	tme.dbSourceClients[0].addQuery("select * from _vt.vreplication where id = 1", stoppedResult(1), nil)
+	tme.dbSourceClients[0].addQuery("select * from _vt.vreplication where id = 2", stoppedResult(2), nil)
+
+	params := &GuardedCutoverParams{
+		Timeout:          1 * time.Second,
+		MonitorDuration:  time.Minute,
+		CheckInterval:    time.Millisecond,
+		FailureThreshold: 1,
+	}
+	events := recordWorkflowEvents(tme)
+	journalID, rolledBack, err := tme.wr.GuardedSwitchWrites(ctx, tme.targetKeyspace, "test", params)
+	require.NoError(t, err)
+	assert.NotZero(t, journalID)
+	assert.True(t, rolledBack)
+	verifyQueries(t, tme.allDBClients)
+	assert.Equal(t, []string{
+		EventCutoverStarted, EventCutoverStarted,
+		EventWritesSwitched, EventWritesSwitched,
+		EventHealthCheckFailed, EventHealthCheckFailed,
+		EventRollbackStarted, EventRollbackStarted,
+		EventRolledBack, EventRolledBack,
+	}, events())
+
+	// Only the writes are switched back to the source.
+	checkServedTypes(t, tme.ts, "ks:0", 1)
+	checkServedTypes(t, tme.ts, "ks:-80", 2)
+	checkServedTypes(t, tme.ts, "ks:80-", 2)
+	checkIsMasterServing(t, tme.ts, "ks:0", true)
+	checkIsMasterServing(t, tme.ts, "ks:-80", false)
+	checkIsMasterServing(t, tme.ts, "ks:80-", false)
+}
@@ -394,3 +394,154 @@ func TestRestoreUnreachableMaster(t *testing.T) {
 	assert.True(t, destTablet.FakeMysqlDaemon.Replicating)
 	assert.True(t, destTablet.FakeMysqlDaemon.Running)
 }
+
+func TestBackupRestoreToPointInTime(t *testing.T) {
+	delay := discovery.GetTabletPickerRetryDelay()
+	defer func() {
+		discovery.SetTabletPickerRetryDelay(delay)
+	}()
+	discovery.SetTabletPickerRetryDelay(5 * time.Millisecond)
+
+	// Initialize our environment
+	db := fakesqldb.New(t)
+	defer db.Close()
+	ts := memorytopo.NewServer("cell1")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+	vp := NewVtctlPipe(t, ts)
+	defer vp.Close()
+
+	// Set up mock query results.
+	db.AddQuery("CREATE DATABASE IF NOT EXISTS _vt", &sqltypes.Result{})
+	db.AddQuery("BEGIN", &sqltypes.Result{})
+	db.AddQuery("COMMIT", &sqltypes.Result{})
+	db.AddQueryPattern(`SET @@session\.sql_log_bin = .*`, &sqltypes.Result{})
+	db.AddQueryPattern(`CREATE TABLE IF NOT EXISTS _vt\.shard_metadata .*`, &sqltypes.Result{})
+	db.AddQueryPattern(`CREATE TABLE IF NOT EXISTS _vt\.local_metadata .*`, &sqltypes.Result{})
+	db.AddQueryPattern(`ALTER TABLE _vt\.local_metadata .*`, &sqltypes.Result{})
+	db.AddQueryPattern(`ALTER TABLE _vt\.shard_metadata .*`, &sqltypes.Result{})
+	db.AddQueryPattern(`UPDATE _vt\.local_metadata SET db_name=.*`, &sqltypes.Result{})
+	db.AddQueryPattern(`UPDATE _vt\.shard_metadata SET db_name=.*`, &sqltypes.Result{})
+	db.AddQueryPattern(`INSERT INTO _vt\.local_metadata .*`, &sqltypes.Result{})
+
+	// Initialize our temp dirs
+	root, err := ioutil.TempDir("", "backuptest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	// Initialize BackupStorage
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "fbs")
+	*backupstorage.BackupStorageImplementation = "file"
+
+	// Initialize the fake mysql root directories
+	sourceInnodbDataDir := path.Join(root, "source_innodb_data")
+	sourceInnodbLogDir := path.Join(root, "source_innodb_log")
+	sourceDataDir := path.Join(root, "source_data")
+	sourceDataDbDir := path.Join(sourceDataDir, "vt_db")
+	masterBinlogDir := path.Join(root, "master_binlogs")
+	for _, s := range []string{sourceInnodbDataDir, sourceInnodbLogDir, sourceDataDbDir, masterBinlogDir} {
+		require.NoError(t, os.MkdirAll(s, os.ModePerm))
+	}
+	require.NoError(t, ioutil.WriteFile(path.Join(sourceInnodbDataDir, "innodb_data_1"), []byte("innodb data 1 contents"), os.ModePerm))
+	require.NoError(t, ioutil.WriteFile(path.Join(sourceInnodbLogDir, "innodb_log_1"), []byte("innodb log 1 contents"), os.ModePerm))
+	require.NoError(t, ioutil.WriteFile(path.Join(sourceDataDbDir, "db.opt"), []byte("db opt file"), os.ModePerm))
+	for _, name := range []string{"binlog.000001", "binlog.000002", "binlog.000003"} {
+		require.NoError(t, ioutil.WriteFile(path.Join(masterBinlogDir, name), []byte(name+" contents"), os.ModePerm))
+	}
+
+	sid := "00010203-0405-0607-0809-0a0b0c0d0e0f"
+	pos := func(gtids string) mysql.Position {
+		return mysql.MustParsePosition(mysql.Mysql56FlavorID, sid+":"+gtids)
+	}
+	binlogEvents := func(previousGTIDs string) *sqltypes.Result {
+		return sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("Log_name|Pos|Event_type|Server_id|End_log_pos|Info", "varchar|int64|varchar|int64|int64|varchar"),
+			"binlog|4|Format_desc|1|123|Server ver: 5.7.31-log, Binlog ver: 4",
+			"binlog|123|Previous_gtids|1|154|"+previousGTIDs,
+		)
+	}
+
+	// create a master tablet, at the same position as the replica
+	// so the full backup doesn't wait for catchup
+	master := NewFakeTablet(t, wr, "cell1", 0, topodatapb.TabletType_MASTER, db)
+	master.FakeMysqlDaemon.ReadOnly = false
+	master.FakeMysqlDaemon.Replicating = false
+	master.FakeMysqlDaemon.CurrentMasterPosition = pos("1-5")
+	master.StartActionLoop(t, wr)
+	defer master.StopActionLoop(t)
+
+	// take a full backup on a replica
+	sourceTablet := NewFakeTablet(t, wr, "cell1", 1, topodatapb.TabletType_REPLICA, db)
+	sourceTablet.FakeMysqlDaemon.ReadOnly = true
+	sourceTablet.FakeMysqlDaemon.Replicating = true
+	sourceTablet.FakeMysqlDaemon.CurrentMasterPosition = pos("1-5")
+	sourceTablet.FakeMysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		"STOP SLAVE",
+		"START SLAVE",
+	}
+	sourceTablet.StartActionLoop(t, wr)
+	defer sourceTablet.StopActionLoop(t)
+	sourceTablet.TM.Cnf = &mysqlctl.Mycnf{
+		DataDir:               sourceDataDir,
+		InnodbDataHomeDir:     sourceInnodbDataDir,
+		InnodbLogGroupHomeDir: sourceInnodbLogDir,
+	}
+	require.NoError(t, vp.Run([]string{"Backup", topoproto.TabletAliasString(sourceTablet.Tablet.Alias)}))
+	require.NoError(t, sourceTablet.FakeMysqlDaemon.CheckSuperQueryList())
+
+	// archive the binary logs of the master since the full backup
+	master.FakeMysqlDaemon.CurrentMasterPosition = pos("1-10")
+	master.FakeMysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		"FLUSH BINARY LOGS",
+	}
+	master.FakeMysqlDaemon.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"SHOW BINARY LOGS": sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("Log_name|File_size", "varchar|int64"),
+			"binlog.000001|100",
+			"binlog.000002|100",
+			"binlog.000003|100",
+		),
+		"SHOW BINLOG EVENTS IN 'binlog.000001' LIMIT 2": binlogEvents(""),
+		"SHOW BINLOG EVENTS IN 'binlog.000002' LIMIT 2": binlogEvents(sid + ":1-5"),
+		"SHOW BINLOG EVENTS IN 'binlog.000003' LIMIT 2": binlogEvents(sid + ":1-10"),
+	}
+	master.TM.Cnf = &mysqlctl.Mycnf{
+		BinLogPath: path.Join(masterBinlogDir, "binlog"),
+	}
+	require.NoError(t, vp.Run([]string{"Backup", "-allow_master", "-incremental_from_pos=auto", topoproto.TabletAliasString(master.Tablet.Alias)}))
+	require.NoError(t, master.FakeMysqlDaemon.CheckSuperQueryList())
+
+	// nothing new to archive
+	err = vp.Run([]string{"Backup", "-allow_master", "-incremental_from_pos=auto", topoproto.TabletAliasString(master.Tablet.Alias)})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), mysqlctl.ErrNoNewTransactions.Error())
+
+	// restore a new tablet to a position between the two backups
+	destTablet := NewFakeTablet(t, wr, "cell1", 2, topodatapb.TabletType_REPLICA, db)
+	destTablet.FakeMysqlDaemon.ReadOnly = true
+	destTablet.FakeMysqlDaemon.Replicating = false
+	destTablet.FakeMysqlDaemon.CurrentMasterPosition = pos("1-8")
+	destTablet.FakeMysqlDaemon.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"SHOW DATABASES": {},
+	}
+	destTablet.StartActionLoop(t, wr)
+	defer destTablet.StopActionLoop(t)
+	destTablet.TM.Cnf = &mysqlctl.Mycnf{
+		DataDir:               path.Join(root, "dest_data"),
+		InnodbDataHomeDir:     path.Join(root, "dest_innodb_data"),
+		InnodbLogGroupHomeDir: path.Join(root, "dest_innodb_log"),
+		BinLogPath:            path.Join(root, "dest_binlogs/binlog"),
+		RelayLogPath:          path.Join(root, "relay-logs/filename_prefix"),
+		RelayLogIndexPath:     path.Join(root, "relay-log.index"),
+		RelayLogInfoPath:      path.Join(root, "relay-log.info"),
+	}
+	require.NoError(t, vp.Run([]string{"RestoreFromBackup", "-restore_to_pos=" + mysql.EncodePosition(pos("1-8")), topoproto.TabletAliasString(destTablet.Tablet.Alias)}))
+
+	// the archived binary log was applied, and replication was not started
+	require.Len(t, destTablet.FakeMysqlDaemon.AppliedBinlogFiles, 1)
+	assert.Equal(t, "binlog.000002", path.Base(destTablet.FakeMysqlDaemon.AppliedBinlogFiles[0]))
+	require.NoError(t, destTablet.FakeMysqlDaemon.CheckSuperQueryList())
+	assert.False(t, destTablet.FakeMysqlDaemon.Replicating)
+	ti, err := ts.GetTablet(context.Background(), destTablet.Tablet.Alias)
+	require.NoError(t, err)
+	assert.Equal(t, topodatapb.TabletType_DRAINED, ti.Type)
+}
@@ -48,17 +48,12 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff/diffutil"
 	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
 )
 
 // DiffReport is the summary of differences for one table.
-type DiffReport struct {
-	ProcessedRows   int
-	MatchingRows    int
-	MismatchedRows  int
-	ExtraRowsSource int
-	ExtraRowsTarget int
-}
+type DiffReport = diffutil.DiffReport
 
 // vdiff contains the metadata for performing vdiff for one workflow.
 type vdiff struct {
@@ -78,6 +73,10 @@ type vdiff struct {
 	workflow       string
 	targetKeyspace string
 	tables         []string
+
+	// repair is set if the statements that fix the differences
+	// should be generated.
+	repair *VDiffRepairOptions
 }
 
 // tableDiffer performs a diff for one table in the workflow.
@@ -99,6 +98,9 @@ type tableDiffer struct {
 	// results from source and target.
 	sourcePrimitive engine.Primitive
 	targetPrimitive engine.Primitive
+
+	// repairer is set in repair mode.
+	repairer *vdiffRepairer
 }
 
 // shardStreamer streams rows from one shard. This works for
@@ -118,8 +120,10 @@ type shardStreamer struct {
 }
 
 // VDiff reports differences between the sources and targets of a vreplication workflow.
+// If repair is set, the statements that make the targets match the sources are also
+// generated.
 func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflow, sourceCell, targetCell, tabletTypesStr string,
-	filteredReplicationWaitTime time.Duration, format string, maxRows int64, tables string) (map[string]*DiffReport, error) {
+	filteredReplicationWaitTime time.Duration, format string, maxRows int64, tables string, repair *VDiffRepairOptions) (map[string]*DiffReport, error) {
 	log.Infof("Starting VDiff for %s.%s, sourceCell %s, targetCell %s, tabletTypes %s, timeout %s",
 		targetKeyspace, workflow, sourceCell, targetCell, tabletTypesStr, filteredReplicationWaitTime.String())
 	// Assign defaults to sourceCell and targetCell if not specified.
@@ -168,6 +172,7 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflow, sourceC
 		workflow:       workflow,
 		targetKeyspace: targetKeyspace,
 		tables:         includeTables,
+		repair:         repair,
 	}
 	for shard, source := range ts.sources {
 		df.sources[shard] = &shardStreamer{
@@ -193,6 +198,13 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflow, sourceC
 	if err = df.buildVDiffPlan(ctx, oneFilter, schm, df.tables); err != nil {
 		return nil, vterrors.Wrap(err, "buildVDiffPlan")
 	}
+	if repair != nil {
+		for _, td := range df.differs {
+			if td.repairer, err = newVDiffRepairer(ctx, ts, repair, td); err != nil {
+				return nil, vterrors.Wrap(err, "newVDiffRepairer")
+			}
+		}
+	}
 
 	if err := df.selectTablets(ctx); err != nil {
 		return nil, vterrors.Wrap(err, "selectTablets")
@@ -222,6 +234,15 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflow, sourceC
 		if err != nil {
 			return nil, vterrors.Wrap(err, "diff")
 		}
+		if td.repairer != nil {
+			wr.Logger().Printf("Repair for %v: %d statements generated\n", td.targetTable, td.repairer.statements)
+			if repair.Apply {
+				// The targets were kept stopped while the repairs were applied.
+				if err := df.restartTargets(ctx); err != nil {
+					return nil, vterrors.Wrap(err, "restartTargets")
+				}
+			}
+		}
 		if format == "json" {
 			json, err := json.MarshalIndent(*dr, "", "")
 			if err != nil {
@@ -261,7 +282,14 @@ func (df *vdiff) diffTable(ctx context.Context, wr *Wrangler, table string, td *
 		}
 	}()
 
+	// In repair apply mode, the targets must not move past the snapshot
+	// of the diff until the repairs are applied: the caller restarts them
+	// once the query streams are running. On errors, they are restarted here.
+	keepStopped := false
 	defer func() {
+		if keepStopped {
+			return
+		}
 		log.Errorf("restarting targets for workflow %s in keyspace %s", df.workflow, df.targetKeyspace)
 		if err := df.restartTargets(ctx); err != nil {
 			log.Errorf("Error restarting targets for workflow %s in keyspace %s", df.workflow, df.targetKeyspace)
@@ -284,6 +312,7 @@ func (df *vdiff) diffTable(ctx context.Context, wr *Wrangler, table string, td *
 		return vterrors.Wrap(err, "startQueryStreams(targets)")
 	}
 	// Now that queries are running, target vreplication streams can be restarted.
+	keepStopped = df.repair != nil && df.repair.Apply
 	return nil
 }
 
@@ -328,42 +357,6 @@ func (df *vdiff) buildVDiffPlan(ctx context.Context, filter *binlogdatapb.Filter
 	return nil
 }
 
-// findPKs identifies PKs and removes them from the columns to do data comparison
-func findPKs(table *tabletmanagerdatapb.TableDefinition, targetSelect *sqlparser.Select, td *tableDiffer) (sqlparser.OrderBy, error) {
-	var orderby sqlparser.OrderBy
-	for _, pk := range table.PrimaryKeyColumns {
-		found := false
-		for i, selExpr := range targetSelect.SelectExprs {
-			expr := selExpr.(*sqlparser.AliasedExpr).Expr
-			colname := ""
-			switch ct := expr.(type) {
-			case *sqlparser.ColName:
-				colname = ct.Name.String()
-			case *sqlparser.FuncExpr: //eg. weight_string()
-				//no-op
-			default:
-				log.Warningf("Not considering column %v for PK, type %v not handled", selExpr, ct)
-			}
-			if strings.EqualFold(pk, colname) {
-				td.comparePKs = append(td.comparePKs, td.compareCols[i])
-				// We'll be comparing pks separately. So, remove them from compareCols.
-				td.compareCols[i] = -1
-				found = true
-				break
-			}
-		}
-		if !found {
-			// Unreachable.
-			return nil, fmt.Errorf("column %v not found in table %v", pk, table.Name)
-		}
-		orderby = append(orderby, &sqlparser.Order{
-			Expr:      &sqlparser.ColName{Name: sqlparser.NewColIdent(pk)},
-			Direction: sqlparser.AscOrder,
-		})
-	}
-	return orderby, nil
-}
-
 // buildTablePlan builds one tableDiffer.
 func (df *vdiff) buildTablePlan(table *tabletmanagerdatapb.TableDefinition, query string) (*tableDiffer, error) {
 	statement, err := sqlparser.Parse(query)
@@ -435,8 +428,8 @@ func (df *vdiff) buildTablePlan(table *tabletmanagerdatapb.TableDefinition, quer
 		td.compareCols[i] = i
 		if sqltypes.IsText(typ) {
 			// For text columns, we need to additionally pull their weight string values for lexical comparisons.
-			sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, wrapWeightString(sourceSelect.SelectExprs[i]))
-			targetSelect.SelectExprs = append(targetSelect.SelectExprs, wrapWeightString(targetSelect.SelectExprs[i]))
+			sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, diffutil.WrapWeightString(sourceSelect.SelectExprs[i]))
+			targetSelect.SelectExprs = append(targetSelect.SelectExprs, diffutil.WrapWeightString(targetSelect.SelectExprs[i]))
 			// Update the column number to point at the weight_string column instead.
 			td.compareCols[i] = len(sourceSelect.SelectExprs) - 1
 		}
@@ -453,12 +446,13 @@ func (df *vdiff) buildTablePlan(table *tabletmanagerdatapb.TableDefinition, quer
 		},
 	}
 
-	orderby, err := findPKs(table, targetSelect, td)
+	orderby, comparePKs, _, err := diffutil.FindPKs(table, targetSelect, td.compareCols)
 	if err != nil {
 		return nil, err
 	}
+	td.comparePKs = comparePKs
 	// Remove in_keyrange. It's not understood by mysql.
-	sourceSelect.Where = removeKeyrange(sel.Where)
+	sourceSelect.Where = diffutil.RemoveKeyrange(sel.Where)
 	// The source should also perform the group by.
 	sourceSelect.GroupBy = sel.GroupBy
 	sourceSelect.OrderBy = orderby
@@ -469,8 +463,8 @@ func (df *vdiff) buildTablePlan(table *tabletmanagerdatapb.TableDefinition, quer
 	td.sourceExpression = sqlparser.String(sourceSelect)
 	td.targetExpression = sqlparser.String(targetSelect)
 
-	td.sourcePrimitive = newMergeSorter(df.sources, td.comparePKs)
-	td.targetPrimitive = newMergeSorter(df.targets, td.comparePKs)
+	td.sourcePrimitive = diffutil.NewMergeSorter(streamExecutors(df.sources), td.comparePKs)
+	td.targetPrimitive = diffutil.NewMergeSorter(streamExecutors(df.targets), td.comparePKs)
 	// If there were aggregate expressions, we have to re-aggregate
 	// the results, which engine.OrderedAggregate can do.
 	if len(aggregates) != 0 {
@@ -484,20 +478,14 @@ func (df *vdiff) buildTablePlan(table *tabletmanagerdatapb.TableDefinition, quer
 	return td, nil
 }
 
-// newMergeSorter creates an engine.MergeSort based on the shard streamers and pk columns.
-func newMergeSorter(participants map[string]*shardStreamer, comparePKs []int) *engine.MergeSort {
+// streamExecutors returns the shard streamers of participants as the
+// inputs of a merge sort.
+func streamExecutors(participants map[string]*shardStreamer) []engine.StreamExecutor {
 	prims := make([]engine.StreamExecutor, 0, len(participants))
 	for _, participant := range participants {
 		prims = append(prims, participant)
 	}
-	ob := make([]engine.OrderbyParams, 0, len(comparePKs))
-	for _, cpk := range comparePKs {
-		ob = append(ob, engine.OrderbyParams{Col: cpk})
-	}
-	return &engine.MergeSort{
-		Primitives: prims,
-		OrderBy:    ob,
-	}
+	return prims
 }
 
 // selectTablets selects the tablets that will be used for the diff.
@@ -744,66 +732,6 @@ func (df *vdiff) forAll(participants map[string]*shardStreamer, f func(string, *
 	return allErrors.AggrError(vterrors.Aggregate)
 }
 
-//-----------------------------------------------------------------
-// primitiveExecutor
-
-// primitiveExecutor starts execution on the top level primitive
-// and provides convenience functions for row-by-row iteration.
-type primitiveExecutor struct {
-	prim     engine.Primitive
-	rows     [][]sqltypes.Value
-	resultch chan *sqltypes.Result
-	err      error
-}
-
-func newPrimitiveExecutor(ctx context.Context, prim engine.Primitive) *primitiveExecutor {
-	pe := &primitiveExecutor{
-		prim:     prim,
-		resultch: make(chan *sqltypes.Result, 1),
-	}
-	vcursor := &contextVCursor{ctx: ctx}
-	go func() {
-		defer close(pe.resultch)
-		pe.err = pe.prim.StreamExecute(vcursor, make(map[string]*querypb.BindVariable), false, func(qr *sqltypes.Result) error {
-			select {
-			case pe.resultch <- qr:
-			case <-ctx.Done():
-				return vterrors.Wrap(ctx.Err(), "Outer Stream")
-			}
-			return nil
-		})
-	}()
-	return pe
-}
-
-func (pe *primitiveExecutor) next() ([]sqltypes.Value, error) {
-	for len(pe.rows) == 0 {
-		qr, ok := <-pe.resultch
-		if !ok {
-			return nil, pe.err
-		}
-		pe.rows = qr.Rows
-	}
-
-	row := pe.rows[0]
-	pe.rows = pe.rows[1:]
-	return row, nil
-}
-
-func (pe *primitiveExecutor) drain(ctx context.Context) (int, error) {
-	count := 0
-	for {
-		row, err := pe.next()
-		if err != nil {
-			return 0, err
-		}
-		if row == nil {
-			return count, nil
-		}
-		count++
-	}
-}
-
 //-----------------------------------------------------------------
 // shardStreamer
 
@@ -856,15 +784,15 @@ func logSteps(n int64) string {
 // tableDiffer
 
 func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler, rowsToCompare *int64) (*DiffReport, error) {
-	sourceExecutor := newPrimitiveExecutor(ctx, td.sourcePrimitive)
-	targetExecutor := newPrimitiveExecutor(ctx, td.targetPrimitive)
+	sourceExecutor := diffutil.NewPrimitiveExecutor(ctx, td.sourcePrimitive)
+	targetExecutor := diffutil.NewPrimitiveExecutor(ctx, td.targetPrimitive)
 	dr := &DiffReport{}
 	var sourceRow, targetRow []sqltypes.Value
 	var err error
 	advanceSource := true
 	advanceTarget := true
 	for {
-		if s := logSteps(int64(dr.ProcessedRows)); s != "" {
+		if s := logSteps(dr.ProcessedRows); s != "" {
 			log.Infof("VDiff progress:: table %s: %s rows", td.targetTable, s)
 		}
 		*rowsToCompare--
@@ -873,13 +801,13 @@ func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler, rowsToCompare *in
 			return dr, nil
 		}
 		if advanceSource {
-			sourceRow, err = sourceExecutor.next()
+			sourceRow, err = sourceExecutor.Next()
 			if err != nil {
 				return nil, err
 			}
 		}
 		if advanceTarget {
-			targetRow, err = targetExecutor.next()
+			targetRow, err = targetExecutor.Next()
 			if err != nil {
 				return nil, err
 			}
@@ -892,10 +820,10 @@ func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler, rowsToCompare *in
 		advanceSource = true
 		advanceTarget = true
 
-		if sourceRow == nil {
+		if sourceRow == nil && td.repairer == nil {
 			// drain target, update count
 			wr.Logger().Errorf("Draining extra row(s) found on the target starting with: %v", targetRow)
-			count, err := targetExecutor.drain(ctx)
+			count, err := targetExecutor.Drain()
 			if err != nil {
 				return nil, err
 			}
@@ -903,11 +831,11 @@ func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler, rowsToCompare *in
 			dr.ProcessedRows += 1 + count
 			return dr, nil
 		}
-		if targetRow == nil {
+		if targetRow == nil && td.repairer == nil {
 			// no more rows from the target
 			// we know we have rows from source, drain, update count
 			wr.Logger().Warningf("Draining extra row(s) found on the source starting with: %v", sourceRow)
-			count, err := sourceExecutor.drain(ctx)
+			count, err := sourceExecutor.Drain()
 			if err != nil {
 				return nil, err
 			}
@@ -918,8 +846,17 @@ func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler, rowsToCompare *in
 
 		dr.ProcessedRows++
 
-		// Compare pk values.
-		c, err := td.compare(sourceRow, targetRow, td.comparePKs)
+		// Compare pk values. In repair mode, the streams are not
+		// drained, and a missing row sorts after all others.
+		var c int
+		switch {
+		case sourceRow == nil:
+			c = 1
+		case targetRow == nil:
+			c = -1
+		default:
+			c, err = td.compare(sourceRow, targetRow, td.comparePKs)
+		}
 		switch {
 		case err != nil:
 			return nil, err
@@ -928,6 +865,11 @@ func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler, rowsToCompare *in
 				wr.Logger().Errorf("[table=%v] Extra row %v on source: %v", td.targetTable, dr.ExtraRowsSource, sourceRow)
 			}
 			dr.ExtraRowsSource++
+			if td.repairer != nil {
+				if err := td.repairer.extraSource(ctx, sourceRow); err != nil {
+					return nil, err
+				}
+			}
 			advanceTarget = false
 			continue
 		case c > 0:
@@ -935,6 +877,11 @@ func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler, rowsToCompare *in
 				wr.Logger().Errorf("[table=%v] Extra row %v on target: %v", td.targetTable, dr.ExtraRowsTarget, targetRow)
 			}
 			dr.ExtraRowsTarget++
+			if td.repairer != nil {
+				if err := td.repairer.extraTarget(ctx, targetRow); err != nil {
+					return nil, err
+				}
+			}
 			advanceSource = false
 			continue
 		}
@@ -950,6 +897,11 @@ func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler, rowsToCompare *in
 				wr.Logger().Errorf("[table=%v] Different content %v in same PK: %v != %v", td.targetTable, dr.MismatchedRows, sourceRow, targetRow)
 			}
 			dr.MismatchedRows++
+			if td.repairer != nil {
+				if err := td.repairer.mismatch(ctx, sourceRow, targetRow); err != nil {
+					return nil, err
+				}
+			}
 		default:
 			dr.MatchingRows++
 		}
@@ -971,66 +923,3 @@ func (td *tableDiffer) compare(sourceRow, targetRow []sqltypes.Value, cols []int
 	}
 	return 0, nil
 }
-
-//-----------------------------------------------------------------
-// contextVCursor
-
-// contextVCursor satisfies VCursor, but only implements Context().
-// MergeSort only requires Context to be implemented.
-type contextVCursor struct {
-	engine.VCursor
-	ctx context.Context
-}
-
-func (vc *contextVCursor) Context() context.Context {
-	return vc.ctx
-}
-
-//-----------------------------------------------------------------
-// Utility functions
-
-func removeKeyrange(where *sqlparser.Where) *sqlparser.Where {
-	if where == nil {
-		return nil
-	}
-	if isFuncKeyrange(where.Expr) {
-		return nil
-	}
-	where.Expr = removeExprKeyrange(where.Expr)
-	return where
-}
-
-func removeExprKeyrange(node sqlparser.Expr) sqlparser.Expr {
-	switch node := node.(type) {
-	case *sqlparser.AndExpr:
-		if isFuncKeyrange(node.Left) {
-			return removeExprKeyrange(node.Right)
-		}
-		if isFuncKeyrange(node.Right) {
-			return removeExprKeyrange(node.Left)
-		}
-		return &sqlparser.AndExpr{
-			Left:  removeExprKeyrange(node.Left),
-			Right: removeExprKeyrange(node.Right),
-		}
-	}
-	return node
-}
-
-func isFuncKeyrange(expr sqlparser.Expr) bool {
-	funcExpr, ok := expr.(*sqlparser.FuncExpr)
-	return ok && funcExpr.Name.EqualString("in_keyrange")
-}
-
-func wrapWeightString(expr sqlparser.SelectExpr) *sqlparser.AliasedExpr {
-	return &sqlparser.AliasedExpr{
-		Expr: &sqlparser.FuncExpr{
-			Name: sqlparser.NewColIdent("weight_string"),
-			Exprs: []sqlparser.SelectExpr{
-				&sqlparser.AliasedExpr{
-					Expr: expr.(*sqlparser.AliasedExpr).Expr,
-				},
-			},
-		},
-	}
-}
@@ -0,0 +1,219 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/vterrors"
+	tabletvdiff "vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// VDiffSummary is the summary of a vdiff that runs on the target
+// masters of a workflow, across all the target shards.
+type VDiffSummary struct {
+	UUID        string
+	Workflow    string
+	State       string
+	StartedAt   string
+	CompletedAt string
+	// ETA is the estimated completion time of the vdiff, if it's running.
+	ETA string
+	// Errors contains the error of the vdiff on each shard, if any.
+	Errors map[string]string `json:",omitempty"`
+	Tables map[string]*VDiffTableSummary
+}
+
+// VDiffTableSummary is the summary of the diff of one table of a vdiff.
+type VDiffTableSummary struct {
+	State        string
+	TableRows    int64
+	RowsCompared int64
+	// Progress is the percentage of the rows that were compared.
+	Progress float64
+	DiffReport
+}
+
+// vdiffStatePriority orders the states that a vdiff can have on the
+// target shards. The vdiff has the state with the highest priority.
+var vdiffStatePriority = map[string]int{
+	"completed": 0,
+	"stopped":   1,
+	"pending":   2,
+	"started":   3,
+	"error":     4,
+}
+
+// VDiffAction performs a create, show, stop or resume action on a vdiff
+// that runs on the target masters of the workflow. For create, a new
+// vdiff uuid is returned. For show, the vdiffs selected by vdiffUUID
+// are returned, which can also be tabletvdiff.LastVDiff or tabletvdiff.AllVDiffs.
+func (wr *Wrangler) VDiffAction(ctx context.Context, targetKeyspace, workflow, action, vdiffUUID string, options *tabletmanagerdatapb.VDiffOptions) (string, []*VDiffSummary, error) {
+	ts, err := wr.buildTrafficSwitcher(ctx, targetKeyspace, workflow)
+	if err != nil {
+		return "", nil, err
+	}
+	if action == tabletvdiff.CreateAction {
+		vdiffUUID = uuid.New().String()
+	}
+	req := &tabletmanagerdatapb.VDiffRequest{
+		Keyspace:  targetKeyspace,
+		Workflow:  workflow,
+		Action:    action,
+		VdiffUuid: vdiffUUID,
+		Options:   options,
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	allErrors := &concurrency.AllErrorRecorder{}
+	outputs := make(map[string]*querypb.QueryResult)
+	for shard, target := range ts.targets {
+		wg.Add(1)
+		go func(shard string, target *tsTarget) {
+			defer wg.Done()
+			resp, err := wr.tmc.VDiff(ctx, target.master.Tablet, req)
+			if err != nil {
+				allErrors.RecordError(vterrors.Wrapf(err, "VDiff on tablet %v", target.master.AliasString()))
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			outputs[shard] = resp.Output
+		}(shard, target)
+	}
+	wg.Wait()
+	if allErrors.HasErrors() {
+		return "", nil, allErrors.AggrError(vterrors.Aggregate)
+	}
+	if action != tabletvdiff.ShowAction {
+		return vdiffUUID, nil, nil
+	}
+	summaries, err := summarizeVDiffs(outputs)
+	if err != nil {
+		return "", nil, err
+	}
+	return vdiffUUID, summaries, nil
+}
+
+// summarizeVDiffs merges the show outputs of the target shards, which
+// contain one row per vdiff and table, into one summary per vdiff.
+func summarizeVDiffs(outputs map[string]*querypb.QueryResult) ([]*VDiffSummary, error) {
+	shards := make([]string, 0, len(outputs))
+	for shard := range outputs {
+		shards = append(shards, shard)
+	}
+	sort.Strings(shards)
+
+	var summaries []*VDiffSummary
+	byUUID := make(map[string]*VDiffSummary)
+	for _, shard := range shards {
+		if outputs[shard] == nil {
+			continue
+		}
+		for _, row := range sqltypes.ToNamedResult(sqltypes.Proto3ToResult(outputs[shard])).Rows {
+			id := row.AsString("vdiff_uuid", "")
+			summary, ok := byUUID[id]
+			if !ok {
+				summary = &VDiffSummary{
+					UUID:     id,
+					Workflow: row.AsString("workflow", ""),
+					Tables:   make(map[string]*VDiffTableSummary),
+				}
+				byUUID[id] = summary
+				summaries = append(summaries, summary)
+			}
+			summary.State = mergeVDiffState(summary.State, row.AsString("state", ""))
+			if startedAt := row.AsString("started_at", ""); startedAt != "" && (summary.StartedAt == "" || startedAt < summary.StartedAt) {
+				summary.StartedAt = startedAt
+			}
+			if completedAt := row.AsString("completed_at", ""); completedAt > summary.CompletedAt {
+				summary.CompletedAt = completedAt
+			}
+			if eta := row.AsString("eta", ""); eta > summary.ETA {
+				summary.ETA = eta
+			}
+			if lastError := row.AsString("last_error", ""); lastError != "" {
+				if summary.Errors == nil {
+					summary.Errors = make(map[string]string)
+				}
+				summary.Errors[shard] = lastError
+			}
+
+			tableName := row.AsString("table_name", "")
+			if tableName == "" {
+				// The vdiff has not started on this shard yet.
+				continue
+			}
+			table, ok := summary.Tables[tableName]
+			if !ok {
+				table = &VDiffTableSummary{}
+				summary.Tables[tableName] = table
+			}
+			table.State = mergeVDiffState(table.State, row.AsString("table_state", ""))
+			table.TableRows += row.AsInt64("table_rows", 0)
+			table.RowsCompared += row.AsInt64("rows_compared", 0)
+			if report := row.AsString("report", ""); report != "" {
+				var dr DiffReport
+				if err := json.Unmarshal([]byte(report), &dr); err != nil {
+					return nil, err
+				}
+				table.ProcessedRows += dr.ProcessedRows
+				table.MatchingRows += dr.MatchingRows
+				table.MismatchedRows += dr.MismatchedRows
+				table.ExtraRowsSource += dr.ExtraRowsSource
+				table.ExtraRowsTarget += dr.ExtraRowsTarget
+			}
+		}
+	}
+
+	for _, summary := range summaries {
+		if summary.State != "completed" {
+			summary.CompletedAt = ""
+		}
+		for _, table := range summary.Tables {
+			switch {
+			case table.State == "completed":
+				table.Progress = 100
+			case table.TableRows > 0:
+				// The row count of the table is an estimate.
+				table.Progress = float64(table.RowsCompared) * 100 / float64(table.TableRows)
+				if table.Progress > 99 {
+					table.Progress = 99
+				}
+			}
+		}
+	}
+	return summaries, nil
+}
+
+func mergeVDiffState(s1, s2 string) string {
+	if s1 == "" || vdiffStatePriority[s2] > vdiffStatePriority[s1] {
+		return s2
+	}
+	return s1
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestSummarizeVDiffs(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"vdiff_uuid|workflow|state|started_at|completed_at|last_error|table_name|table_state|table_rows|rows_compared|report|eta",
+		"varchar|varbinary|varbinary|timestamp|timestamp|varbinary|varbinary|varbinary|int64|int64|varbinary|varchar",
+	)
+	outputs := map[string]*querypb.QueryResult{
+		"-80": sqltypes.ResultToProto3(sqltypes.MakeTestResult(fields,
+			`u1|wf|completed|2021-01-01 10:00:00|2021-01-01 11:00:00||t1|completed|10|10|{"ProcessedRows":10,"MatchingRows":10}|`,
+			`u1|wf|completed|2021-01-01 10:00:00|2021-01-01 11:00:00||t2|completed|5|5|{"ProcessedRows":5,"MatchingRows":4,"MismatchedRows":1}|`,
+		)),
+		"80-": sqltypes.ResultToProto3(sqltypes.MakeTestResult(fields,
+			`u1|wf|started|2021-01-01 09:00:00|null||t1|completed|10|10|{"ProcessedRows":10,"MatchingRows":10}|`,
+			`u1|wf|started|2021-01-01 09:00:00|null||t2|started|10|5|{"ProcessedRows":5,"MatchingRows":5}|2021-01-01 12:00:00`,
+		)),
+	}
+	summaries, err := summarizeVDiffs(outputs)
+	require.NoError(t, err)
+	require.Len(t, summaries, 1)
+	summary := summaries[0]
+	assert.Equal(t, "u1", summary.UUID)
+	assert.Equal(t, "wf", summary.Workflow)
+	assert.Equal(t, "started", summary.State)
+	assert.Equal(t, "2021-01-01 09:00:00", summary.StartedAt)
+	assert.Equal(t, "", summary.CompletedAt)
+	assert.Equal(t, "2021-01-01 12:00:00", summary.ETA)
+	assert.Nil(t, summary.Errors)
+
+	t1 := summary.Tables["t1"]
+	assert.Equal(t, "completed", t1.State)
+	assert.Equal(t, float64(100), t1.Progress)
+	assert.Equal(t, 20, int(t1.ProcessedRows))
+	assert.Equal(t, 20, int(t1.MatchingRows))
+
+	t2 := summary.Tables["t2"]
+	assert.Equal(t, "started", t2.State)
+	assert.Equal(t, int64(15), t2.TableRows)
+	assert.Equal(t, int64(10), t2.RowsCompared)
+	assert.InDelta(t, 66.67, t2.Progress, 0.01)
+	assert.Equal(t, 9, int(t2.MatchingRows))
+	assert.Equal(t, 1, int(t2.MismatchedRows))
+
+	outputs["80-"] = sqltypes.ResultToProto3(sqltypes.MakeTestResult(fields,
+		`u1|wf|error|2021-01-01 09:00:00|null|no primary key|null|null|null|null|null|`,
+	))
+	summaries, err = summarizeVDiffs(outputs)
+	require.NoError(t, err)
+	assert.Equal(t, "error", summaries[0].State)
+	assert.Equal(t, map[string]string{"80-": "no primary key"}, summaries[0].Errors)
+	assert.Len(t, summaries[0].Tables, 2)
+}
@@ -19,6 +19,7 @@ package wrangler
 import (
 	"flag"
 	"fmt"
+	"strings"
 	"sync"
 
 	"context"
@@ -279,14 +280,23 @@ type testVDiffTMClient struct {
 	waitpos   map[int]string
 	vrpos     map[int]string
 	pos       map[int]string
+
+	// dbaQueries records the queries executed by ExecuteFetchAsDba.
+	dbaQueries map[int][]string
+
+	mu sync.Mutex
+	// restarts counts the restarts of the target streams by tablet.
+	restarts map[int]int
 }
 
 func newTestVDiffTMClient() *testVDiffTMClient {
 	return &testVDiffTMClient{
-		vrQueries: make(map[int]map[string]*querypb.QueryResult),
-		waitpos:   make(map[int]string),
-		vrpos:     make(map[int]string),
-		pos:       make(map[int]string),
+		vrQueries:  make(map[int]map[string]*querypb.QueryResult),
+		waitpos:    make(map[int]string),
+		vrpos:      make(map[int]string),
+		pos:        make(map[int]string),
+		dbaQueries: make(map[int][]string),
+		restarts:   make(map[int]int),
 	}
 }
 
@@ -308,6 +318,11 @@ func (tmc *testVDiffTMClient) VReplicationExec(ctx context.Context, tablet *topo
 	if !ok {
 		return nil, fmt.Errorf("query %q not found for tablet %d", query, tablet.Alias.Uid)
 	}
+	if strings.HasPrefix(query, "update _vt.vreplication set state='Running', message=''") {
+		tmc.mu.Lock()
+		tmc.restarts[int(tablet.Alias.Uid)]++
+		tmc.mu.Unlock()
+	}
 	return result, nil
 }
 
@@ -342,3 +357,8 @@ func (tmc *testVDiffTMClient) MasterPosition(ctx context.Context, tablet *topoda
 	}
 	return pos, nil
 }
+
+func (tmc *testVDiffTMClient) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool) (*querypb.QueryResult, error) {
+	tmc.dbaQueries[int(tablet.Alias.Uid)] = append(tmc.dbaQueries[int(tablet.Alias.Uid)], string(query))
+	return &querypb.QueryResult{}, nil
+}
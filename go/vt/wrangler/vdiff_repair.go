@@ -0,0 +1,282 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+// VDiffRepairOptions enables the repair mode of VDiff. For every row
+// that differs, the statement that makes the target row match the
+// source row is generated.
+type VDiffRepairOptions struct {
+	// Output receives the statements, one per line, if set. The
+	// statements are not routed to shards, they are meant to be
+	// executed through vtgate.
+	Output io.Writer
+	// Apply executes the statements on the target masters. The target
+	// streams of the workflow stay stopped at the position of the diff
+	// while the statements of a table are applied, so that they can't
+	// be overwritten by older events.
+	Apply bool
+}
+
+// vdiffRepairer generates the statements that reconcile the target
+// rows of a tableDiffer with the source rows.
+type vdiffRepairer struct {
+	ts      *trafficSwitcher
+	options *VDiffRepairOptions
+	table   sqlparser.TableIdent
+
+	// columns are the target columns, in the order of the target query.
+	columns sqlparser.Columns
+	// compareCols and pkCols point at the values to compare and the
+	// pk values of the rows.
+	compareCols []int
+	pkCols      []int
+
+	// vindex and vindexCols are used to find the target shard of
+	// a row, if the statements are applied to a sharded keyspace.
+	vindex     vindexes.Vindex
+	vindexCols []int
+
+	statements int
+}
+
+func newVDiffRepairer(ctx context.Context, ts *trafficSwitcher, options *VDiffRepairOptions, td *tableDiffer) (*vdiffRepairer, error) {
+	statement, err := sqlparser.Parse(td.targetExpression)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := statement.(*sqlparser.Select)
+	if !ok {
+		return nil, fmt.Errorf("unexpected: %v", td.targetExpression)
+	}
+	rp := &vdiffRepairer{
+		ts:          ts,
+		options:     options,
+		table:       sqlparser.NewTableIdent(td.targetTable),
+		compareCols: td.compareCols,
+	}
+	// The weight_string columns that follow the table columns are skipped.
+	for _, selExpr := range sel.SelectExprs {
+		col, ok := selExpr.(*sqlparser.AliasedExpr).Expr.(*sqlparser.ColName)
+		if !ok {
+			break
+		}
+		rp.columns = append(rp.columns, col.Name)
+	}
+	for _, order := range sel.OrderBy {
+		i := rp.findColumn(order.Expr.(*sqlparser.ColName).Name)
+		if i == -1 {
+			// Unreachable.
+			return nil, fmt.Errorf("pk column %v not found in table %v", sqlparser.String(order.Expr), td.targetTable)
+		}
+		rp.pkCols = append(rp.pkCols, i)
+	}
+	if !options.Apply || len(ts.targets) == 1 {
+		return rp, nil
+	}
+
+	vs, err := ts.wr.ts.GetVSchema(ctx, ts.targetKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	ksschema, err := vindexes.BuildKeyspaceSchema(vs, ts.targetKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	table := ksschema.Tables[td.targetTable]
+	if table == nil || len(table.ColumnVindexes) == 0 {
+		return nil, fmt.Errorf("cannot apply the repairs of table %s: it has no primary vindex in the vschema of keyspace %s", td.targetTable, ts.targetKeyspace)
+	}
+	colVindex := table.ColumnVindexes[0]
+	if colVindex.Vindex.NeedsVCursor() {
+		return nil, fmt.Errorf("cannot apply the repairs of table %s: its primary vindex %s is not functional", td.targetTable, colVindex.Name)
+	}
+	rp.vindex = colVindex.Vindex
+	for _, col := range colVindex.Columns {
+		i := rp.findColumn(col)
+		if i == -1 {
+			return nil, fmt.Errorf("cannot apply the repairs of table %s: the workflow does not select the vindex column %v", td.targetTable, col)
+		}
+		rp.vindexCols = append(rp.vindexCols, i)
+	}
+	return rp, nil
+}
+
+func (rp *vdiffRepairer) findColumn(name sqlparser.ColIdent) int {
+	for i, col := range rp.columns {
+		if col.Equal(name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// extraSource inserts a row that is missing on the target.
+func (rp *vdiffRepairer) extraSource(ctx context.Context, sourceRow []sqltypes.Value) error {
+	return rp.execute(ctx, sourceRow, rp.insert(sourceRow))
+}
+
+// extraTarget deletes a row that is missing on the source.
+func (rp *vdiffRepairer) extraTarget(ctx context.Context, targetRow []sqltypes.Value) error {
+	return rp.execute(ctx, targetRow, rp.delete(targetRow))
+}
+
+// mismatch updates a target row to match its source row. If the row
+// has to move to another shard, it's deleted and inserted instead.
+func (rp *vdiffRepairer) mismatch(ctx context.Context, sourceRow, targetRow []sqltypes.Value) error {
+	if rp.options.Apply && rp.vindex != nil {
+		sourceShard, err := rp.shardFor(sourceRow)
+		if err != nil {
+			return err
+		}
+		targetShard, err := rp.shardFor(targetRow)
+		if err != nil {
+			return err
+		}
+		if sourceShard != targetShard {
+			if err := rp.extraTarget(ctx, targetRow); err != nil {
+				return err
+			}
+			return rp.extraSource(ctx, sourceRow)
+		}
+	}
+	query, err := rp.update(sourceRow, targetRow)
+	if err != nil {
+		return err
+	}
+	return rp.execute(ctx, targetRow, query)
+}
+
+func (rp *vdiffRepairer) insert(row []sqltypes.Value) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("insert into %v%v values (", rp.table, rp.columns)
+	for i := range rp.columns {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		row[i].EncodeSQL(buf)
+	}
+	buf.WriteString(")")
+	return buf.String()
+}
+
+func (rp *vdiffRepairer) delete(row []sqltypes.Value) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("delete from %v", rp.table)
+	rp.writePKWhere(buf, row)
+	return buf.String()
+}
+
+func (rp *vdiffRepairer) update(sourceRow, targetRow []sqltypes.Value) (string, error) {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("update %v set ", rp.table)
+	first := true
+	for i := range rp.columns {
+		if rp.compareCols[i] == -1 {
+			continue
+		}
+		c, err := evalengine.NullsafeCompare(sourceRow[rp.compareCols[i]], targetRow[rp.compareCols[i]])
+		if err != nil {
+			return "", err
+		}
+		if c == 0 {
+			continue
+		}
+		if !first {
+			buf.WriteString(", ")
+		}
+		first = false
+		buf.Myprintf("%v = ", rp.columns[i])
+		sourceRow[i].EncodeSQL(buf)
+	}
+	rp.writePKWhere(buf, targetRow)
+	return buf.String(), nil
+}
+
+func (rp *vdiffRepairer) writePKWhere(buf *sqlparser.TrackedBuffer, row []sqltypes.Value) {
+	for i, col := range rp.pkCols {
+		if i == 0 {
+			buf.WriteString(" where ")
+		} else {
+			buf.WriteString(" and ")
+		}
+		buf.Myprintf("%v = ", rp.columns[col])
+		row[col].EncodeSQL(buf)
+	}
+}
+
+// execute writes the statement to the output, and applies it to
+// the target shard of row.
+func (rp *vdiffRepairer) execute(ctx context.Context, row []sqltypes.Value, query string) error {
+	rp.statements++
+	if rp.options.Output != nil {
+		if _, err := fmt.Fprintf(rp.options.Output, "%s;\n", query); err != nil {
+			return err
+		}
+	}
+	if !rp.options.Apply {
+		return nil
+	}
+	shard, err := rp.shardFor(row)
+	if err != nil {
+		return err
+	}
+	master := rp.ts.targets[shard].master
+	if _, err := rp.ts.wr.tmc.ExecuteFetchAsDba(ctx, master.Tablet, false, []byte(query), 0, false, false); err != nil {
+		return vterrors.Wrapf(err, "repair on tablet %v: %s", master.AliasString(), query)
+	}
+	return nil
+}
+
+// shardFor returns the target shard of row.
+func (rp *vdiffRepairer) shardFor(row []sqltypes.Value) (string, error) {
+	if rp.vindex == nil {
+		for shard := range rp.ts.targets {
+			return shard, nil
+		}
+	}
+	values := make([]sqltypes.Value, 0, len(rp.vindexCols))
+	for _, col := range rp.vindexCols {
+		values = append(values, row[col])
+	}
+	destinations, err := vindexes.Map(rp.vindex, nil, [][]sqltypes.Value{values})
+	if err != nil {
+		return "", err
+	}
+	ksid, ok := destinations[0].(key.DestinationKeyspaceID)
+	if !ok {
+		return "", fmt.Errorf("cannot find the target shard of %v: vindex %s returned %v", values, rp.vindex, destinations[0])
+	}
+	for shard, target := range rp.ts.targets {
+		if key.KeyRangeContains(target.si.KeyRange, ksid) {
+			return shard, nil
+		}
+	}
+	return "", fmt.Errorf("no target shard found for keyspace id %v", ksid)
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package wrangler
 
 import (
+	"bytes"
 	"strconv"
 	"strings"
 	"testing"
@@ -24,8 +25,6 @@ import (
 
 	"vitess.io/vitess/go/vt/topo"
 
-	"vitess.io/vitess/go/vt/sqlparser"
-
 	"context"
 
 	"github.com/stretchr/testify/assert"
@@ -34,7 +33,9 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff/diffutil"
 )
 
 func TestVDiffPlanSuccess(t *testing.T) {
@@ -82,8 +83,8 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
 			comparePKs:       []int{0},
-			sourcePrimitive:  newMergeSorter(nil, []int{0}),
-			targetPrimitive:  newMergeSorter(nil, []int{0}),
+			sourcePrimitive:  diffutil.NewMergeSorter(nil, []int{0}),
+			targetPrimitive:  diffutil.NewMergeSorter(nil, []int{0}),
 		},
 	}, {
 		input: &binlogdatapb.Rule{
@@ -97,8 +98,8 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
 			comparePKs:       []int{0},
-			sourcePrimitive:  newMergeSorter(nil, []int{0}),
-			targetPrimitive:  newMergeSorter(nil, []int{0}),
+			sourcePrimitive:  diffutil.NewMergeSorter(nil, []int{0}),
+			targetPrimitive:  diffutil.NewMergeSorter(nil, []int{0}),
 		},
 	}, {
 		input: &binlogdatapb.Rule{
@@ -112,8 +113,8 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
 			comparePKs:       []int{0},
-			sourcePrimitive:  newMergeSorter(nil, []int{0}),
-			targetPrimitive:  newMergeSorter(nil, []int{0}),
+			sourcePrimitive:  diffutil.NewMergeSorter(nil, []int{0}),
+			targetPrimitive:  diffutil.NewMergeSorter(nil, []int{0}),
 		},
 	}, {
 		input: &binlogdatapb.Rule{
@@ -127,8 +128,8 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c2, c1 from t1 order by c1 asc",
 			compareCols:      []int{0, -1},
 			comparePKs:       []int{1},
-			sourcePrimitive:  newMergeSorter(nil, []int{1}),
-			targetPrimitive:  newMergeSorter(nil, []int{1}),
+			sourcePrimitive:  diffutil.NewMergeSorter(nil, []int{1}),
+			targetPrimitive:  diffutil.NewMergeSorter(nil, []int{1}),
 		},
 	}, {
 		input: &binlogdatapb.Rule{
@@ -142,8 +143,8 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
 			comparePKs:       []int{0},
-			sourcePrimitive:  newMergeSorter(nil, []int{0}),
-			targetPrimitive:  newMergeSorter(nil, []int{0}),
+			sourcePrimitive:  diffutil.NewMergeSorter(nil, []int{0}),
+			targetPrimitive:  diffutil.NewMergeSorter(nil, []int{0}),
 		},
 	}, {
 		// non-pk text column.
@@ -158,8 +159,8 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c1, textcol, weight_string(textcol) from nonpktext order by c1 asc",
 			compareCols:      []int{-1, 2},
 			comparePKs:       []int{0},
-			sourcePrimitive:  newMergeSorter(nil, []int{0}),
-			targetPrimitive:  newMergeSorter(nil, []int{0}),
+			sourcePrimitive:  diffutil.NewMergeSorter(nil, []int{0}),
+			targetPrimitive:  diffutil.NewMergeSorter(nil, []int{0}),
 		},
 	}, {
 		// non-pk text column, different order.
@@ -174,8 +175,8 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select textcol, c1, weight_string(textcol) from nonpktext order by c1 asc",
 			compareCols:      []int{2, -1},
 			comparePKs:       []int{1},
-			sourcePrimitive:  newMergeSorter(nil, []int{1}),
-			targetPrimitive:  newMergeSorter(nil, []int{1}),
+			sourcePrimitive:  diffutil.NewMergeSorter(nil, []int{1}),
+			targetPrimitive:  diffutil.NewMergeSorter(nil, []int{1}),
 		},
 	}, {
 		// pk text column.
@@ -190,8 +191,8 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select textcol, c2, weight_string(textcol) from pktext order by textcol asc",
 			compareCols:      []int{-1, 1},
 			comparePKs:       []int{2},
-			sourcePrimitive:  newMergeSorter(nil, []int{2}),
-			targetPrimitive:  newMergeSorter(nil, []int{2}),
+			sourcePrimitive:  diffutil.NewMergeSorter(nil, []int{2}),
+			targetPrimitive:  diffutil.NewMergeSorter(nil, []int{2}),
 		},
 	}, {
 		// pk text column, different order.
@@ -206,8 +207,8 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c2, textcol, weight_string(textcol) from pktext order by textcol asc",
 			compareCols:      []int{0, -1},
 			comparePKs:       []int{2},
-			sourcePrimitive:  newMergeSorter(nil, []int{2}),
-			targetPrimitive:  newMergeSorter(nil, []int{2}),
+			sourcePrimitive:  diffutil.NewMergeSorter(nil, []int{2}),
+			targetPrimitive:  diffutil.NewMergeSorter(nil, []int{2}),
 		},
 	}, {
 		// text column as expression.
@@ -222,8 +223,8 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c2, textcol, weight_string(textcol) from pktext order by textcol asc",
 			compareCols:      []int{0, -1},
 			comparePKs:       []int{2},
-			sourcePrimitive:  newMergeSorter(nil, []int{2}),
-			targetPrimitive:  newMergeSorter(nil, []int{2}),
+			sourcePrimitive:  diffutil.NewMergeSorter(nil, []int{2}),
+			targetPrimitive:  diffutil.NewMergeSorter(nil, []int{2}),
 		},
 	}, {
 		input: &binlogdatapb.Rule{
@@ -236,8 +237,8 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c1, c2 from multipk order by c1 asc, c2 asc",
 			compareCols:      []int{-1, -1},
 			comparePKs:       []int{0, 1},
-			sourcePrimitive:  newMergeSorter(nil, []int{0, 1}),
-			targetPrimitive:  newMergeSorter(nil, []int{0, 1}),
+			sourcePrimitive:  diffutil.NewMergeSorter(nil, []int{0, 1}),
+			targetPrimitive:  diffutil.NewMergeSorter(nil, []int{0, 1}),
 		},
 	}, {
 		// in_keyrange
@@ -252,8 +253,8 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
 			comparePKs:       []int{0},
-			sourcePrimitive:  newMergeSorter(nil, []int{0}),
-			targetPrimitive:  newMergeSorter(nil, []int{0}),
+			sourcePrimitive:  diffutil.NewMergeSorter(nil, []int{0}),
+			targetPrimitive:  diffutil.NewMergeSorter(nil, []int{0}),
 		},
 	}, {
 		// in_keyrange on RHS of AND.
@@ -269,8 +270,8 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
 			comparePKs:       []int{0},
-			sourcePrimitive:  newMergeSorter(nil, []int{0}),
-			targetPrimitive:  newMergeSorter(nil, []int{0}),
+			sourcePrimitive:  diffutil.NewMergeSorter(nil, []int{0}),
+			targetPrimitive:  diffutil.NewMergeSorter(nil, []int{0}),
 		},
 	}, {
 		// in_keyrange on LHS of AND.
@@ -286,8 +287,8 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
 			comparePKs:       []int{0},
-			sourcePrimitive:  newMergeSorter(nil, []int{0}),
-			targetPrimitive:  newMergeSorter(nil, []int{0}),
+			sourcePrimitive:  diffutil.NewMergeSorter(nil, []int{0}),
+			targetPrimitive:  diffutil.NewMergeSorter(nil, []int{0}),
 		},
 	}, {
 		// in_keyrange on cascaded AND expression
@@ -303,8 +304,8 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
 			comparePKs:       []int{0},
-			sourcePrimitive:  newMergeSorter(nil, []int{0}),
-			targetPrimitive:  newMergeSorter(nil, []int{0}),
+			sourcePrimitive:  diffutil.NewMergeSorter(nil, []int{0}),
+			targetPrimitive:  diffutil.NewMergeSorter(nil, []int{0}),
 		},
 	}, {
 		// in_keyrange parenthesized
@@ -320,8 +321,8 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
 			comparePKs:       []int{0},
-			sourcePrimitive:  newMergeSorter(nil, []int{0}),
-			targetPrimitive:  newMergeSorter(nil, []int{0}),
+			sourcePrimitive:  diffutil.NewMergeSorter(nil, []int{0}),
+			targetPrimitive:  diffutil.NewMergeSorter(nil, []int{0}),
 		},
 	}, {
 		// group by
@@ -336,8 +337,8 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
 			comparePKs:       []int{0},
-			sourcePrimitive:  newMergeSorter(nil, []int{0}),
-			targetPrimitive:  newMergeSorter(nil, []int{0}),
+			sourcePrimitive:  diffutil.NewMergeSorter(nil, []int{0}),
+			targetPrimitive:  diffutil.NewMergeSorter(nil, []int{0}),
 		},
 	}, {
 		// aggregations
@@ -361,9 +362,9 @@ func TestVDiffPlanSuccess(t *testing.T) {
 					Col:    3,
 				}},
 				Keys:  []int{0},
-				Input: newMergeSorter(nil, []int{0}),
+				Input: diffutil.NewMergeSorter(nil, []int{0}),
 			},
-			targetPrimitive: newMergeSorter(nil, []int{0}),
+			targetPrimitive: diffutil.NewMergeSorter(nil, []int{0}),
 		},
 	}}
 	for _, tcase := range testcases {
@@ -565,7 +566,7 @@ func TestVDiffUnsharded(t *testing.T) {
 		env.tablets[101].setResults("select c1, c2 from t1 order by c1 asc", vdiffSourceGtid, tcase.source)
 		env.tablets[201].setResults("select c1, c2 from t1 order by c1 asc", vdiffTargetMasterPosition, tcase.target)
 
-		dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "")
+		dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", nil)
 		require.NoError(t, err)
 		assert.Equal(t, tcase.dr, dr["t1"], tcase.id)
 	}
@@ -627,7 +628,7 @@ func TestVDiffSharded(t *testing.T) {
 		),
 	)
 
-	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "")
+	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", nil)
 	require.NoError(t, err)
 	wantdr := &DiffReport{
 		ProcessedRows: 3,
@@ -636,6 +637,112 @@ func TestVDiffSharded(t *testing.T) {
 	assert.Equal(t, wantdr, dr["t1"])
 }
 
+func TestVDiffRepair(t *testing.T) {
+	env := newTestVDiffEnv([]string{"-40", "40-"}, []string{"-80", "80-"}, "", nil)
+	defer env.close()
+
+	schm := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              "t1",
+			Columns:           []string{"c1", "c2"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            sqltypes.MakeTestFields("c1|c2", "int64|int64"),
+		}},
+	}
+	env.tmc.schema = schm
+
+	query := "select c1, c2 from t1 order by c1 asc"
+	fields := sqltypes.MakeTestFields(
+		"c1|c2",
+		"int64|int64",
+	)
+	setResults := func() {
+		env.tablets[101].setResults(query, vdiffSourceGtid, sqltypes.MakeTestStreamingResults(fields,
+			"1|3",
+			"2|4",
+		))
+		env.tablets[111].setResults(query, vdiffSourceGtid, sqltypes.MakeTestStreamingResults(fields,
+			"3|5",
+			"5|1",
+		))
+		// 2, 3 and 5 belong to -80, 1 and 4 to 80-.
+		env.tablets[201].setResults(query, vdiffTargetMasterPosition, sqltypes.MakeTestStreamingResults(fields,
+			"2|5",
+			"3|5",
+		))
+		env.tablets[211].setResults(query, vdiffTargetMasterPosition, sqltypes.MakeTestStreamingResults(fields,
+			"1|3",
+			"4|1",
+		))
+	}
+	wantdr := &DiffReport{
+		ProcessedRows:   5,
+		MatchingRows:    2,
+		MismatchedRows:  1,
+		ExtraRowsSource: 1,
+		ExtraRowsTarget: 1,
+	}
+
+	setResults()
+	output := &bytes.Buffer{}
+	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", &VDiffRepairOptions{Output: output})
+	require.NoError(t, err)
+	assert.Equal(t, wantdr, dr["t1"])
+	assert.Equal(t, "update t1 set c2 = 4 where c1 = 2;\n"+
+		"delete from t1 where c1 = 4;\n"+
+		"insert into t1(c1, c2) values (5, 1);\n",
+		output.String())
+	assert.Empty(t, env.tmc.dbaQueries)
+
+	// Applying the repairs needs the primary vindex of the table.
+	_, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", &VDiffRepairOptions{Apply: true})
+	assert.EqualError(t, err, "newVDiffRepairer: cannot apply the repairs of table t1: it has no primary vindex in the vschema of keyspace target")
+
+	err = env.topoServ.SaveVSchema(context.Background(), "target", &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {Type: "hash"},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "c1", Name: "hash"}},
+			},
+		},
+	})
+	require.NoError(t, err)
+	setResults()
+	dr, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", &VDiffRepairOptions{Apply: true})
+	require.NoError(t, err)
+	assert.Equal(t, wantdr, dr["t1"])
+	assert.Equal(t, map[int][]string{
+		200: {"update t1 set c2 = 4 where c1 = 2", "insert into t1(c1, c2) values (5, 1)"},
+		210: {"delete from t1 where c1 = 4"},
+	}, env.tmc.dbaQueries)
+}
+
+func TestVDiffRepairRestartsTargetsOnError(t *testing.T) {
+	env := newTestVDiffEnv([]string{"0"}, []string{"0"}, "", nil)
+	defer env.close()
+
+	schm := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              "t1",
+			Columns:           []string{"c1", "c2"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            sqltypes.MakeTestFields("c1|c2", "int64|int64"),
+		}},
+	}
+	env.tmc.schema = schm
+
+	// No results are set for the source query: its stream fails to start.
+	_, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", &VDiffRepairOptions{Apply: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "startQueryStreams(sources)")
+	// The targets are restarted by the table diff as well as by VDiff.
+	assert.Equal(t, 2, env.tmc.restarts[200])
+	assert.Empty(t, env.tmc.dbaQueries)
+}
+
 func TestVDiffAggregates(t *testing.T) {
 	env := newTestVDiffEnv([]string{"-40", "40-"}, []string{"-80", "80-"}, "select c1, count(*) c2, sum(c3) c3 from t group by c1", nil)
 	defer env.close()
@@ -693,7 +800,7 @@ func TestVDiffAggregates(t *testing.T) {
 		),
 	)
 
-	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "")
+	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", nil)
 	require.NoError(t, err)
 	wantdr := &DiffReport{
 		ProcessedRows: 5,
@@ -757,7 +864,7 @@ func TestVDiffPKWeightString(t *testing.T) {
 		),
 	)
 
-	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "")
+	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", nil)
 	require.NoError(t, err)
 	wantdr := &DiffReport{
 		ProcessedRows: 4,
@@ -821,7 +928,7 @@ func TestVDiffNoPKWeightString(t *testing.T) {
 		),
 	)
 
-	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "")
+	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", nil)
 	require.NoError(t, err)
 	wantdr := &DiffReport{
 		ProcessedRows: 4,
@@ -859,23 +966,23 @@ func TestVDiffDefaults(t *testing.T) {
 	env.tablets[101].setResults("select c1, c2 from t1 order by c1 asc", vdiffSourceGtid, source)
 	env.tablets[201].setResults("select c1, c2 from t1 order by c1 asc", vdiffTargetMasterPosition, target)
 
-	_, err := env.wr.VDiff(context.Background(), "target", env.workflow, "", "", "replica", 30*time.Second, "", 100, "")
+	_, err := env.wr.VDiff(context.Background(), "target", env.workflow, "", "", "replica", 30*time.Second, "", 100, "", nil)
 	require.NoError(t, err)
-	_, err = env.wr.VDiff(context.Background(), "target", env.workflow, "", env.cell, "replica", 30*time.Second, "", 100, "")
+	_, err = env.wr.VDiff(context.Background(), "target", env.workflow, "", env.cell, "replica", 30*time.Second, "", 100, "", nil)
 	require.NoError(t, err)
 
 	var df map[string]*DiffReport
-	df, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, "", "replica", 30*time.Second, "", 100, "")
+	df, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, "", "replica", 30*time.Second, "", 100, "", nil)
 	require.NoError(t, err)
-	require.Equal(t, df["t1"].ProcessedRows, 3)
-	df, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, "", "replica", 30*time.Second, "", 1, "")
+	require.EqualValues(t, 3, df["t1"].ProcessedRows)
+	df, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, "", "replica", 30*time.Second, "", 1, "", nil)
 	require.NoError(t, err)
-	require.Equal(t, df["t1"].ProcessedRows, 1)
-	df, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, "", "replica", 30*time.Second, "", 0, "")
+	require.EqualValues(t, 1, df["t1"].ProcessedRows)
+	df, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, "", "replica", 30*time.Second, "", 0, "", nil)
 	require.NoError(t, err)
-	require.Equal(t, df["t1"].ProcessedRows, 0)
+	require.EqualValues(t, 0, df["t1"].ProcessedRows)
 
-	_, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, "", "replica", 1*time.Nanosecond, "", 100, "")
+	_, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, "", "replica", 1*time.Nanosecond, "", 100, "", nil)
 	require.Error(t, err)
 	err = topo.CheckKeyspaceLocked(context.Background(), "target")
 	require.EqualErrorf(t, err, "keyspace target is not locked (no locksInfo)", "")
@@ -912,80 +1019,11 @@ func TestVDiffReplicationWait(t *testing.T) {
 	env.tablets[101].setResults("select c1, c2 from t1 order by c1 asc", vdiffSourceGtid, source)
 	env.tablets[201].setResults("select c1, c2 from t1 order by c1 asc", vdiffTargetMasterPosition, target)
 
-	_, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 0*time.Second, "", 100, "")
+	_, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 0*time.Second, "", 100, "", nil)
 	require.Error(t, err)
 	require.True(t, strings.Contains(err.Error(), "context deadline exceeded"))
 }
 
-func TestVDiffFindPKs(t *testing.T) {
-
-	testcases := []struct {
-		name         string
-		table        *tabletmanagerdatapb.TableDefinition
-		targetSelect *sqlparser.Select
-		tdIn         *tableDiffer
-		tdOut        *tableDiffer
-		errorString  string
-	}{
-		{
-			name: "",
-			table: &tabletmanagerdatapb.TableDefinition{
-				Name:              "t1",
-				Columns:           []string{"c1", "c2"},
-				PrimaryKeyColumns: []string{"c1"},
-				Fields:            sqltypes.MakeTestFields("c1|c2", "int64|int64"),
-			},
-			targetSelect: &sqlparser.Select{
-				SelectExprs: sqlparser.SelectExprs{
-					&sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent("c1")}},
-					&sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent("c2")}},
-				},
-			},
-			tdIn: &tableDiffer{
-				compareCols: []int{0, 1},
-				comparePKs:  []int{},
-			},
-			tdOut: &tableDiffer{
-				compareCols: []int{-1, 1},
-				comparePKs:  []int{0},
-			},
-		}, {
-			name: "",
-			table: &tabletmanagerdatapb.TableDefinition{
-				Name:              "t1",
-				Columns:           []string{"c1", "c2", "c3", "c4"},
-				PrimaryKeyColumns: []string{"c1", "c4"},
-				Fields:            sqltypes.MakeTestFields("c1|c2|c3|c4", "int64|int64|varchar|int64"),
-			},
-			targetSelect: &sqlparser.Select{
-				SelectExprs: sqlparser.SelectExprs{
-					&sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent("c1")}},
-					&sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent("c2")}},
-					&sqlparser.AliasedExpr{Expr: &sqlparser.FuncExpr{Name: sqlparser.NewColIdent("c3")}},
-					&sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent("c4")}},
-				},
-			},
-			tdIn: &tableDiffer{
-				compareCols: []int{0, 1, 2, 3},
-				comparePKs:  []int{},
-			},
-			tdOut: &tableDiffer{
-				compareCols: []int{-1, 1, 2, -1},
-				comparePKs:  []int{0, 3},
-			},
-		},
-	}
-
-	for _, tc := range testcases {
-		t.Run(tc.name, func(t *testing.T) {
-			_, err := findPKs(tc.table, tc.targetSelect, tc.tdIn)
-			require.NoError(t, err)
-			require.EqualValues(t, tc.tdOut, tc.tdIn)
-		})
-	}
-
-}
-
 func TestLogSteps(t *testing.T) {
 	testcases := []struct {
 		n   int64